@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+)
+
+type (
+	// AbandonedCartScanner periodically looks for carts with items and a contact mail that have not been modified for
+	// the configured period and dispatches a CartAbandonedEvent for each of them
+	AbandonedCartScanner struct {
+		finder              cartDomain.AbandonedCartFinder
+		cartRecoveryService *CartRecoveryService
+		eventRouter         flamingo.EventRouter
+		logger              flamingo.Logger
+		enabled             bool
+		scanInterval        time.Duration
+		abandonedAfter      time.Duration
+
+		mutex    sync.Mutex
+		lastScan time.Time
+		stop     chan struct{}
+	}
+)
+
+// Inject dependencies
+func (s *AbandonedCartScanner) Inject(
+	cartRecoveryService *CartRecoveryService,
+	eventRouter flamingo.EventRouter,
+	logger flamingo.Logger,
+	config *struct {
+		Enabled               bool `inject:"config:commerce.cart.abandonedCart.enabled"`
+		ScanIntervalSeconds   int  `inject:"config:commerce.cart.abandonedCart.scanIntervalSeconds"`
+		AbandonedAfterSeconds int  `inject:"config:commerce.cart.abandonedCart.abandonedAfterSeconds"`
+	},
+	optionals *struct {
+		Finder cartDomain.AbandonedCartFinder `inject:",optional"`
+	},
+) *AbandonedCartScanner {
+	s.cartRecoveryService = cartRecoveryService
+	s.eventRouter = eventRouter
+	s.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "application.abandonedCartScanner")
+	if config != nil {
+		s.enabled = config.Enabled
+		s.scanInterval = time.Duration(config.ScanIntervalSeconds) * time.Second
+		s.abandonedAfter = time.Duration(config.AbandonedAfterSeconds) * time.Second
+	}
+	if optionals != nil {
+		s.finder = optionals.Finder
+	}
+
+	return s
+}
+
+// Notify starts the periodic scan with the server and stops it on shutdown
+func (s *AbandonedCartScanner) Notify(ctx context.Context, event flamingo.Event) {
+	switch event.(type) {
+	case *flamingo.ServerStartEvent:
+		s.start()
+	case *flamingo.ServerShutdownEvent:
+		s.shutdown()
+	}
+}
+
+func (s *AbandonedCartScanner) start() {
+	if !s.enabled {
+		return
+	}
+
+	if s.finder == nil {
+		s.logger.Warn("abandoned cart detection enabled but no AbandonedCartFinder bound")
+		return
+	}
+
+	if s.scanInterval <= 0 {
+		s.logger.Warn("abandoned cart detection enabled but scanIntervalSeconds is not positive")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(s.scanInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				_, err := s.Scan(context.Background(), now)
+				if err != nil {
+					s.logger.Error("abandoned cart scan failed: ", err)
+				}
+			}
+		}
+	}(s.stop)
+}
+
+func (s *AbandonedCartScanner) shutdown() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// Scan dispatches a CartAbandonedEvent for every cart that became abandoned since the last scan and returns their number.
+// A cart is abandoned if it has items, a contact mail and its last modification is older than the configured period.
+func (s *AbandonedCartScanner) Scan(ctx context.Context, now time.Time) (int, error) {
+	if s.finder == nil {
+		return 0, nil
+	}
+
+	s.mutex.Lock()
+	lastScan := s.lastScan
+	s.mutex.Unlock()
+
+	if lastScan.IsZero() {
+		lastScan = now.Add(-s.scanInterval)
+	}
+
+	carts, err := s.finder.FindCartsModifiedBetween(ctx, lastScan.Add(-s.abandonedAfter), now.Add(-s.abandonedAfter))
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, cart := range carts {
+		if cart.IsEmpty() || cart.GetContactMail() == "" {
+			continue
+		}
+
+		token, err := s.cartRecoveryService.CreateRecoveryToken(cart.ID)
+		if err != nil {
+			return count, err
+		}
+
+		s.eventRouter.Dispatch(ctx, &events.CartAbandonedEvent{
+			Cart:          cart,
+			ContactMail:   cart.GetContactMail(),
+			RecoveryToken: token,
+		})
+		count++
+	}
+
+	s.mutex.Lock()
+	s.lastScan = now
+	s.mutex.Unlock()
+
+	return count, nil
+}
@@ -0,0 +1,119 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+)
+
+type recordingEventRouter struct {
+	events []flamingo.Event
+}
+
+func (r *recordingEventRouter) Dispatch(_ context.Context, event flamingo.Event) {
+	r.events = append(r.events, event)
+}
+
+type stubAbandonedCartFinder struct {
+	carts    []*cartDomain.Cart
+	from, to time.Time
+}
+
+func (f *stubAbandonedCartFinder) FindCartsModifiedBetween(_ context.Context, from time.Time, to time.Time) ([]*cartDomain.Cart, error) {
+	f.from, f.to = from, to
+	return f.carts, nil
+}
+
+func newCartRecoveryService(secret string, lifetimeSeconds int) *cartApplication.CartRecoveryService {
+	return new(cartApplication.CartRecoveryService).Inject(nil, nil, nil, new(flamingo.NullLogger), &struct {
+		Secret               string `inject:"config:commerce.cart.abandonedCart.recoveryTokenSecret"`
+		TokenLifetimeSeconds int    `inject:"config:commerce.cart.abandonedCart.recoveryTokenLifetimeSeconds"`
+	}{Secret: secret, TokenLifetimeSeconds: lifetimeSeconds})
+}
+
+func TestCartRecoveryService_RecoveryToken(t *testing.T) {
+	service := newCartRecoveryService("secret", 60)
+
+	token, err := service.CreateRecoveryToken("cart|1")
+	require.NoError(t, err)
+
+	cartID, err := service.ParseRecoveryToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "cart|1", cartID)
+
+	_, err = newCartRecoveryService("other-secret", 60).ParseRecoveryToken(token)
+	assert.Equal(t, cartApplication.ErrInvalidRecoveryToken, err)
+
+	_, err = service.ParseRecoveryToken("invalid")
+	assert.Equal(t, cartApplication.ErrInvalidRecoveryToken, err)
+
+	expiredToken, err := newCartRecoveryService("secret", -10).CreateRecoveryToken("cart")
+	require.NoError(t, err)
+	_, err = service.ParseRecoveryToken(expiredToken)
+	assert.Equal(t, cartApplication.ErrRecoveryTokenExpired, err)
+
+	_, err = newCartRecoveryService("", 60).CreateRecoveryToken("cart")
+	assert.Equal(t, cartApplication.ErrRecoveryTokenSecretMissing, err)
+}
+
+func TestAbandonedCartScanner_Scan(t *testing.T) {
+	now := time.Now()
+
+	withItems := func(cart *cartDomain.Cart, email string) *cartDomain.Cart {
+		cart.Deliveries = []cartDomain.Delivery{{
+			DeliveryInfo: cartDomain.DeliveryInfo{Code: "delivery", DeliveryLocation: cartDomain.DeliveryLocation{Address: &cartDomain.Address{Email: email}}},
+			Cartitems:    []cartDomain.Item{{ID: "item", MarketplaceCode: "product", Qty: 1}},
+		}}
+		return cart
+	}
+
+	finder := &stubAbandonedCartFinder{carts: []*cartDomain.Cart{
+		withItems(&cartDomain.Cart{ID: "abandoned"}, "mail@example.com"),
+		withItems(&cartDomain.Cart{ID: "no-mail"}, ""),
+		{ID: "empty", BillingAddress: &cartDomain.Address{Email: "mail@example.com"}},
+	}}
+
+	eventRouter := new(recordingEventRouter)
+	scanner := new(cartApplication.AbandonedCartScanner).Inject(
+		newCartRecoveryService("secret", 60),
+		eventRouter,
+		new(flamingo.NullLogger),
+		&struct {
+			Enabled               bool `inject:"config:commerce.cart.abandonedCart.enabled"`
+			ScanIntervalSeconds   int  `inject:"config:commerce.cart.abandonedCart.scanIntervalSeconds"`
+			AbandonedAfterSeconds int  `inject:"config:commerce.cart.abandonedCart.abandonedAfterSeconds"`
+		}{Enabled: true, ScanIntervalSeconds: 7200, AbandonedAfterSeconds: 86400},
+		&struct {
+			Finder cartDomain.AbandonedCartFinder `inject:",optional"`
+		}{Finder: finder},
+	)
+
+	count, err := scanner.Scan(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, now.Add(-26*time.Hour), finder.from)
+	assert.Equal(t, now.Add(-24*time.Hour), finder.to)
+	require.Len(t, eventRouter.events, 1)
+
+	event, ok := eventRouter.events[0].(*events.CartAbandonedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "abandoned", event.Cart.ID)
+	assert.Equal(t, "mail@example.com", event.ContactMail)
+	cartID, err := newCartRecoveryService("secret", 60).ParseRecoveryToken(event.RecoveryToken)
+	require.NoError(t, err)
+	assert.Equal(t, "abandoned", cartID)
+
+	// the next scan only looks at carts that became abandoned since the last scan
+	_, err = scanner.Scan(context.Background(), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), finder.from)
+	assert.Equal(t, now.Add(time.Minute-24*time.Hour), finder.to)
+}
@@ -0,0 +1,390 @@
+package application
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+)
+
+type (
+	// CartMergeStrategy defines how the guest cart is merged into the customer cart after a login
+	CartMergeStrategy interface {
+		// Name of the strategy
+		Name() string
+		// Merge takes over the guest cart into the current (customer) cart of the session
+		Merge(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, customerCart cartDomain.Cart) (events.CartMergeResult, error)
+	}
+
+	// CartMergeStrategyKeepCustomer keeps the customer cart untouched and drops the guest cart
+	CartMergeStrategyKeepCustomer struct{}
+
+	// CartMergeStrategyReplace replaces the customer cart with the content of the guest cart
+	CartMergeStrategyReplace struct {
+		merger cartMerger
+	}
+
+	// CartMergeStrategyMerge adds all guest cart items to the customer cart, quantities of identical items are summed up
+	CartMergeStrategyMerge struct {
+		merger cartMerger
+	}
+
+	// CartMergeStrategyMergeRestricted works like CartMergeStrategyMerge but caps the quantities to the
+	// max quantities of the RestrictionService
+	CartMergeStrategyMergeRestricted struct {
+		merger cartMerger
+	}
+
+	// CartMergeStrategyDedupe adds the guest cart items to the customer cart but skips items that already exist in the customer cart
+	CartMergeStrategyDedupe struct {
+		merger cartMerger
+	}
+
+	// cartMerger contains the logic shared by the merge strategies
+	cartMerger struct {
+		cartService *CartService
+		logger      flamingo.Logger
+	}
+
+	itemMergeMode int
+)
+
+const (
+	// CartMergeResultSessionKey is used to store the result of the last cart merge in the session
+	CartMergeResultSessionKey = "cart.merge.result"
+)
+
+const (
+	itemMergeModeSum itemMergeMode = iota
+	itemMergeModeSumRestricted
+	itemMergeModeDedupe
+)
+
+var (
+	_ CartMergeStrategy = (*CartMergeStrategyKeepCustomer)(nil)
+	_ CartMergeStrategy = (*CartMergeStrategyReplace)(nil)
+	_ CartMergeStrategy = (*CartMergeStrategyMerge)(nil)
+	_ CartMergeStrategy = (*CartMergeStrategyMergeRestricted)(nil)
+	_ CartMergeStrategy = (*CartMergeStrategyDedupe)(nil)
+)
+
+func init() {
+	gob.Register(events.CartMergeResult{})
+}
+
+// Name of the strategy
+func (s *CartMergeStrategyKeepCustomer) Name() string {
+	return "keepCustomer"
+}
+
+// Merge drops all items and codes of the guest cart
+func (s *CartMergeStrategyKeepCustomer) Merge(_ context.Context, _ *web.Session, guestCart cartDomain.Cart, _ cartDomain.Cart) (events.CartMergeResult, error) {
+	result := events.CartMergeResult{Strategy: s.Name()}
+	for _, delivery := range guestCart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			result.DroppedItems = append(result.DroppedItems, newCartMergeItem(delivery.DeliveryInfo.Code, item, events.CartMergeReasonKeptCustomerCart))
+		}
+	}
+
+	for _, code := range guestCart.AppliedCouponCodes {
+		result.DroppedCouponCodes = append(result.DroppedCouponCodes, events.CartMergeCode{Code: code.Code, Reason: events.CartMergeReasonKeptCustomerCart})
+	}
+
+	for _, giftCard := range guestCart.AppliedGiftCards {
+		result.DroppedGiftCards = append(result.DroppedGiftCards, events.CartMergeCode{Code: giftCard.Code, Reason: events.CartMergeReasonKeptCustomerCart})
+	}
+
+	return result, nil
+}
+
+// Inject dependencies
+func (s *CartMergeStrategyReplace) Inject(cartService *CartService, logger flamingo.Logger) *CartMergeStrategyReplace {
+	s.merger = newCartMerger(cartService, logger)
+
+	return s
+}
+
+// Name of the strategy
+func (s *CartMergeStrategyReplace) Name() string {
+	return "replace"
+}
+
+// Merge cleans the customer cart and takes over everything from the guest cart
+func (s *CartMergeStrategyReplace) Merge(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, customerCart cartDomain.Cart) (events.CartMergeResult, error) {
+	result := events.CartMergeResult{Strategy: s.Name()}
+	for _, delivery := range customerCart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			result.DroppedItems = append(result.DroppedItems, newCartMergeItem(delivery.DeliveryInfo.Code, item, events.CartMergeReasonReplaced))
+		}
+	}
+
+	err := s.merger.cartService.Clean(ctx, session)
+	if err != nil {
+		return result, err
+	}
+
+	s.merger.mergeItems(ctx, session, guestCart, itemMergeModeSum, &result)
+	s.merger.mergeCartData(ctx, session, guestCart, true)
+	s.merger.mergeCodes(ctx, session, guestCart, &result)
+
+	return result, nil
+}
+
+// Inject dependencies
+func (s *CartMergeStrategyMerge) Inject(cartService *CartService, logger flamingo.Logger) *CartMergeStrategyMerge {
+	s.merger = newCartMerger(cartService, logger)
+
+	return s
+}
+
+// Name of the strategy
+func (s *CartMergeStrategyMerge) Name() string {
+	return "merge"
+}
+
+// Merge adds the guest cart items to the customer cart
+func (s *CartMergeStrategyMerge) Merge(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, _ cartDomain.Cart) (events.CartMergeResult, error) {
+	result := events.CartMergeResult{Strategy: s.Name()}
+	s.merger.mergeItems(ctx, session, guestCart, itemMergeModeSum, &result)
+	s.merger.mergeCartData(ctx, session, guestCart, false)
+	s.merger.mergeCodes(ctx, session, guestCart, &result)
+
+	return result, nil
+}
+
+// Inject dependencies
+func (s *CartMergeStrategyMergeRestricted) Inject(cartService *CartService, logger flamingo.Logger) *CartMergeStrategyMergeRestricted {
+	s.merger = newCartMerger(cartService, logger)
+
+	return s
+}
+
+// Name of the strategy
+func (s *CartMergeStrategyMergeRestricted) Name() string {
+	return "mergeRestricted"
+}
+
+// Merge adds the guest cart items to the customer cart as long as the max quantity restrictions allow it
+func (s *CartMergeStrategyMergeRestricted) Merge(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, _ cartDomain.Cart) (events.CartMergeResult, error) {
+	result := events.CartMergeResult{Strategy: s.Name()}
+	s.merger.mergeItems(ctx, session, guestCart, itemMergeModeSumRestricted, &result)
+	s.merger.mergeCartData(ctx, session, guestCart, false)
+	s.merger.mergeCodes(ctx, session, guestCart, &result)
+
+	return result, nil
+}
+
+// Inject dependencies
+func (s *CartMergeStrategyDedupe) Inject(cartService *CartService, logger flamingo.Logger) *CartMergeStrategyDedupe {
+	s.merger = newCartMerger(cartService, logger)
+
+	return s
+}
+
+// Name of the strategy
+func (s *CartMergeStrategyDedupe) Name() string {
+	return "dedupe"
+}
+
+// Merge adds all guest cart items that are not yet part of the customer cart
+func (s *CartMergeStrategyDedupe) Merge(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, _ cartDomain.Cart) (events.CartMergeResult, error) {
+	result := events.CartMergeResult{Strategy: s.Name()}
+	s.merger.mergeItems(ctx, session, guestCart, itemMergeModeDedupe, &result)
+	s.merger.mergeCartData(ctx, session, guestCart, false)
+	s.merger.mergeCodes(ctx, session, guestCart, &result)
+
+	return result, nil
+}
+
+func newCartMerger(cartService *CartService, logger flamingo.Logger) cartMerger {
+	return cartMerger{
+		cartService: cartService,
+		logger:      logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "cartMerge"),
+	}
+}
+
+func newCartMergeItem(deliveryCode string, item cartDomain.Item, reason string) events.CartMergeItem {
+	return events.CartMergeItem{
+		DeliveryCode:           deliveryCode,
+		MarketplaceCode:        item.MarketplaceCode,
+		VariantMarketplaceCode: item.VariantMarketPlaceCode,
+		ProductName:            item.ProductName,
+		RequestedQty:           item.Qty,
+		Reason:                 reason,
+	}
+}
+
+// mergeItems takes over the deliveries and items of the guest cart
+func (m cartMerger) mergeItems(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, mode itemMergeMode, result *events.CartMergeResult) {
+	for _, delivery := range guestCart.Deliveries {
+		m.logger.WithContext(ctx).Info(fmt.Sprintf("Merging delivery with code %v of guestCart with ID %v", delivery.DeliveryInfo.Code, guestCart.ID))
+		err := m.cartService.UpdateDeliveryInfo(ctx, session, delivery.DeliveryInfo.Code, cartDomain.CreateDeliveryInfoUpdateCommand(delivery.DeliveryInfo))
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart UpdateDeliveryInfo error", err)
+			for _, item := range delivery.Cartitems {
+				droppedItem := newCartMergeItem(delivery.DeliveryInfo.Code, item, events.CartMergeReasonError)
+				droppedItem.Message = err.Error()
+				result.DroppedItems = append(result.DroppedItems, droppedItem)
+			}
+			continue
+		}
+
+		for _, item := range delivery.Cartitems {
+			m.mergeItem(ctx, session, delivery.DeliveryInfo.Code, item, mode, result)
+		}
+	}
+}
+
+func (m cartMerger) mergeItem(ctx context.Context, session *web.Session, deliveryCode string, item cartDomain.Item, mode itemMergeMode, result *events.CartMergeResult) {
+	m.logger.WithContext(ctx).Debugf("Merging item from guest to user cart %v", item)
+	mergeItem := newCartMergeItem(deliveryCode, item, "")
+
+	drop := func(reason string, err error) {
+		mergeItem.Reason = reason
+		if err != nil {
+			mergeItem.Message = err.Error()
+		}
+		result.DroppedItems = append(result.DroppedItems, mergeItem)
+	}
+
+	currentCart, _, err := m.cartService.cartReceiverService.GetCart(ctx, session)
+	if err != nil {
+		drop(events.CartMergeReasonError, err)
+		return
+	}
+
+	existingItem := findMatchingItem(currentCart, deliveryCode, item)
+	if existingItem != nil && mode == itemMergeModeDedupe {
+		drop(events.CartMergeReasonDuplicate, nil)
+		return
+	}
+
+	qty := item.Qty
+	if mode == itemMergeModeSumRestricted {
+		allowedQty, restrictorName, err := m.allowedQty(ctx, session, currentCart, deliveryCode, item)
+		if err != nil {
+			drop(events.CartMergeReasonError, err)
+			return
+		}
+		if allowedQty < 1 {
+			mergeItem.Message = restrictorName
+			drop(events.CartMergeReasonRestricted, nil)
+			return
+		}
+		if allowedQty < qty {
+			qty = allowedQty
+			mergeItem.Reason = events.CartMergeReasonRestricted
+			mergeItem.Message = restrictorName
+		}
+	}
+
+	if existingItem != nil {
+		err = m.cartService.UpdateItemQty(ctx, session, existingItem.ID, deliveryCode, existingItem.Qty+qty)
+	} else {
+		addRequest := m.cartService.BuildAddRequest(ctx, item.MarketplaceCode, item.VariantMarketPlaceCode, qty, item.AdditionalData)
+		_, err = m.cartService.AddProduct(ctx, session, deliveryCode, addRequest)
+	}
+
+	if err != nil {
+		m.logger.WithContext(ctx).Error("customerCart product has merge error", item.MarketplaceCode, err)
+		if _, ok := err.(*RestrictionError); ok {
+			drop(events.CartMergeReasonRestricted, err)
+			return
+		}
+		drop(events.CartMergeReasonError, err)
+		return
+	}
+
+	mergeItem.Qty = qty
+	result.MergedItems = append(result.MergedItems, mergeItem)
+}
+
+// allowedQty returns the quantity that can still be added according to the restriction service
+func (m cartMerger) allowedQty(ctx context.Context, session *web.Session, currentCart *cartDomain.Cart, deliveryCode string, item cartDomain.Item) (int, string, error) {
+	product, err := m.cartService.productService.Get(ctx, item.MarketplaceCode)
+	if err != nil {
+		return 0, "", err
+	}
+
+	product, err = m.cartService.getProductWithActiveVariantIfProductIsConfigurable(ctx, product, item.VariantMarketPlaceCode)
+	if err != nil {
+		return 0, "", err
+	}
+
+	restrictionResult := m.cartService.restrictionService.RestrictQty(ctx, session, product, currentCart, deliveryCode)
+	if !restrictionResult.IsRestricted {
+		return item.Qty, "", nil
+	}
+
+	return restrictionResult.RemainingDifference, restrictionResult.RestrictorName, nil
+}
+
+// mergeCartData takes over billing address, purchaser and payment selection, existing data is only replaced if overwrite is set
+func (m cartMerger) mergeCartData(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, overwrite bool) {
+	currentCart, _, err := m.cartService.cartReceiverService.GetCart(ctx, session)
+	if err != nil {
+		m.logger.WithContext(ctx).Error("customerCart cannot be received", err)
+		return
+	}
+
+	if guestCart.BillingAddress != nil && (overwrite || currentCart.BillingAddress == nil) {
+		err := m.cartService.UpdateBillingAddress(ctx, session, guestCart.BillingAddress)
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart UpdateBillingAddress error", err)
+		}
+	}
+
+	if guestCart.Purchaser != nil && (overwrite || currentCart.Purchaser == nil) {
+		err := m.cartService.UpdatePurchaser(ctx, session, guestCart.Purchaser, &guestCart.AdditionalData)
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart UpdatePurchaser error", err)
+		}
+	}
+
+	if guestCart.PaymentSelection != nil && (overwrite || currentCart.PaymentSelection == nil) {
+		err := m.cartService.UpdatePaymentSelection(ctx, session, guestCart.PaymentSelection)
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart UpdatePaymentSelection error", err)
+		}
+	}
+}
+
+// mergeCodes applies the coupon codes and gift cards of the guest cart
+func (m cartMerger) mergeCodes(ctx context.Context, session *web.Session, guestCart cartDomain.Cart, result *events.CartMergeResult) {
+	for _, code := range guestCart.AppliedCouponCodes {
+		_, err := m.cartService.ApplyVoucher(ctx, session, code.Code)
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart ApplyVoucher has error", code.Code, err)
+			result.DroppedCouponCodes = append(result.DroppedCouponCodes, events.CartMergeCode{Code: code.Code, Reason: events.CartMergeReasonError, Message: err.Error()})
+		}
+	}
+
+	for _, giftCard := range guestCart.AppliedGiftCards {
+		_, err := m.cartService.ApplyGiftCard(ctx, session, giftCard.Code)
+		if err != nil {
+			m.logger.WithContext(ctx).Error("customerCart ApplyGiftCard has error", giftCard.Code, err)
+			result.DroppedGiftCards = append(result.DroppedGiftCards, events.CartMergeCode{Code: giftCard.Code, Reason: events.CartMergeReasonError, Message: err.Error()})
+		}
+	}
+}
+
+// findMatchingItem returns the item of the delivery with the same product and variant
+func findMatchingItem(cart *cartDomain.Cart, deliveryCode string, item cartDomain.Item) *cartDomain.Item {
+	delivery, found := cart.GetDeliveryByCode(deliveryCode)
+	if !found {
+		return nil
+	}
+
+	for _, existingItem := range delivery.Cartitems {
+		if existingItem.MarketplaceCode == item.MarketplaceCode && existingItem.VariantMarketPlaceCode == item.VariantMarketPlaceCode {
+			return &existingItem
+		}
+	}
+
+	return nil
+}
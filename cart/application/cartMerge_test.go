@@ -0,0 +1,296 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
+	"github.com/lunarforge/flamingo_commerce/cart/infrastructure"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	mergeProductService struct{}
+
+	// mergeMaxQtyRestrictor allows max qty pieces of each product per delivery
+	mergeMaxQtyRestrictor struct {
+		max int
+	}
+
+	// mergeTestSetup holds a cart service working on an in memory guest cart which acts as the customer cart
+	mergeTestSetup struct {
+		ctx          context.Context
+		session      *web.Session
+		cartService  *cartApplication.CartService
+		cartReceiver *cartApplication.CartReceiverService
+	}
+)
+
+func (mergeProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	return productDomain.SimpleProduct{
+		BasicProductData: productDomain.BasicProductData{MarketPlaceCode: marketplaceCode, Title: marketplaceCode},
+		Saleable: productDomain.Saleable{
+			IsSaleable:  true,
+			ActivePrice: productDomain.PriceInfo{Default: priceDomain.NewFromInt(1000, 100, "EUR")},
+		},
+	}, nil
+}
+
+func (r *mergeMaxQtyRestrictor) Name() string {
+	return "maxQty"
+}
+
+func (r *mergeMaxQtyRestrictor) Restrict(_ context.Context, _ *web.Session, product productDomain.BasicProduct, cart *cartDomain.Cart, deliveryCode string) *validation.RestrictionResult {
+	qty := 0
+	if delivery, found := cart.GetDeliveryByCode(deliveryCode); found {
+		for _, item := range delivery.Cartitems {
+			if item.MarketplaceCode == product.BaseData().MarketPlaceCode {
+				qty += item.Qty
+			}
+		}
+	}
+
+	return &validation.RestrictionResult{
+		IsRestricted:        true,
+		MaxAllowed:          r.max,
+		RemainingDifference: r.max - qty,
+		RestrictorName:      r.Name(),
+	}
+}
+
+// newMergeTestSetup creates a cart service whose session cart contains the given items in the delivery "delivery"
+func newMergeTestSetup(t *testing.T, restrictors []validation.MaxQuantityRestrictor, items map[string]int) *mergeTestSetup {
+	t.Helper()
+
+	storage := new(infrastructure.InMemoryCartStorage).Inject()
+	behaviour := new(infrastructure.DefaultCartBehaviour)
+	behaviour.Inject(
+		storage,
+		mergeProductService{},
+		flamingo.NullLogger{},
+		func() *cartDomain.ItemBuilder { return &cartDomain.ItemBuilder{} },
+		func() *cartDomain.DeliveryBuilder { return &cartDomain.DeliveryBuilder{} },
+		func() *cartDomain.Builder { return &cartDomain.Builder{} },
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	guestCartService := new(infrastructure.DefaultGuestCartService)
+	guestCartService.Inject(behaviour, flamingo.NullLogger{})
+
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			return nil, errors.New("not logged in")
+		},
+	)
+	webIdentityService := new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil)
+
+	cartReceiver := new(cartApplication.CartReceiverService)
+	cartReceiver.Inject(guestCartService, nil, nil, webIdentityService, flamingo.NullLogger{}, new(recordingEventRouter), nil)
+
+	cartService := new(cartApplication.CartService)
+	cartService.Inject(
+		cartReceiver,
+		mergeProductService{},
+		nil,
+		new(recordingEventRouter),
+		new(MockDeliveryInfoBuilder),
+		new(validation.RestrictionService).Inject(restrictors),
+		webIdentityService,
+		flamingo.NullLogger{},
+		&struct {
+			DefaultDeliveryCode string `inject:"config:commerce.cart.defaultDeliveryCode,optional"`
+			DeleteEmptyDelivery bool   `inject:"config:commerce.cart.deleteEmptyDelivery,optional"`
+		}{DefaultDeliveryCode: "delivery"},
+		nil,
+	)
+
+	session := web.EmptySession()
+	setup := &mergeTestSetup{
+		ctx:          web.ContextWithRequest(context.Background(), web.CreateRequest(nil, session)),
+		session:      session,
+		cartService:  cartService,
+		cartReceiver: cartReceiver,
+	}
+
+	require.NoError(t, cartService.UpdateDeliveryInfo(setup.ctx, session, "delivery", cartDomain.CreateDeliveryInfoUpdateCommand(cartDomain.DeliveryInfo{Code: "delivery"})))
+	for marketplaceCode, qty := range items {
+		_, err := cartService.AddProduct(setup.ctx, session, "delivery", cartDomain.AddRequest{MarketplaceCode: marketplaceCode, Qty: qty})
+		require.NoError(t, err)
+	}
+
+	return setup
+}
+
+// quantities returns the item quantities of the session cart by marketplace code
+func (s *mergeTestSetup) quantities(t *testing.T) map[string]int {
+	t.Helper()
+
+	cart, _, err := s.cartReceiver.GetCart(s.ctx, s.session)
+	require.NoError(t, err)
+
+	result := make(map[string]int)
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			result[item.MarketplaceCode] += item.Qty
+		}
+	}
+
+	return result
+}
+
+func mergeGuestCart() cartDomain.Cart {
+	return cartDomain.Cart{
+		ID: "guest",
+		Deliveries: []cartDomain.Delivery{
+			{
+				DeliveryInfo: cartDomain.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cartDomain.Item{
+					{ID: "1", MarketplaceCode: "shared", Qty: 2},
+					{ID: "2", MarketplaceCode: "guest-only", Qty: 1},
+				},
+			},
+		},
+	}
+}
+
+func mergedQuantities(result events.CartMergeResult) map[string]int {
+	quantities := make(map[string]int)
+	for _, item := range result.MergedItems {
+		quantities[item.MarketplaceCode] = item.Qty
+	}
+
+	return quantities
+}
+
+func TestCartMergeStrategyKeepCustomer_Merge(t *testing.T) {
+	guestCart := cartDomain.Cart{
+		ID: "guest",
+		Deliveries: []cartDomain.Delivery{
+			{
+				DeliveryInfo: cartDomain.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cartDomain.Item{
+					{ID: "1", MarketplaceCode: "product-1", Qty: 2},
+				},
+			},
+		},
+		AppliedCouponCodes: []cartDomain.CouponCode{{Code: "coupon"}},
+		AppliedGiftCards:   []cartDomain.AppliedGiftCard{{Code: "giftcard"}},
+	}
+
+	strategy := new(cartApplication.CartMergeStrategyKeepCustomer)
+	result, err := strategy.Merge(context.Background(), web.EmptySession(), guestCart, cartDomain.Cart{ID: "customer"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "keepCustomer", result.Strategy)
+	assert.Empty(t, result.MergedItems)
+	assert.Equal(t, []events.CartMergeItem{
+		{
+			DeliveryCode:    "delivery",
+			MarketplaceCode: "product-1",
+			RequestedQty:    2,
+			Reason:          events.CartMergeReasonKeptCustomerCart,
+		},
+	}, result.DroppedItems)
+	assert.Equal(t, []events.CartMergeCode{{Code: "coupon", Reason: events.CartMergeReasonKeptCustomerCart}}, result.DroppedCouponCodes)
+	assert.Equal(t, []events.CartMergeCode{{Code: "giftcard", Reason: events.CartMergeReasonKeptCustomerCart}}, result.DroppedGiftCards)
+	assert.True(t, result.HasChanges())
+}
+
+func TestCartMergeResult_HasChanges(t *testing.T) {
+	assert.False(t, events.CartMergeResult{}.HasChanges())
+	assert.False(t, events.CartMergeResult{MergedItems: []events.CartMergeItem{{RequestedQty: 2, Qty: 2}}}.HasChanges())
+	assert.True(t, events.CartMergeResult{MergedItems: []events.CartMergeItem{{RequestedQty: 5, Qty: 2}}}.HasChanges())
+	assert.True(t, events.CartMergeResult{DroppedCouponCodes: []events.CartMergeCode{{Code: "coupon"}}}.HasChanges())
+}
+
+func TestCartMergeStrategyMerge_Merge(t *testing.T) {
+	setup := newMergeTestSetup(t, nil, map[string]int{"shared": 1, "customer-only": 1})
+
+	strategy := new(cartApplication.CartMergeStrategyMerge).Inject(setup.cartService, flamingo.NullLogger{})
+	result, err := strategy.Merge(setup.ctx, setup.session, mergeGuestCart(), cartDomain.Cart{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "merge", result.Strategy)
+	assert.Empty(t, result.DroppedItems)
+	assert.Equal(t, map[string]int{"shared": 2, "guest-only": 1}, mergedQuantities(result))
+	assert.False(t, result.HasChanges())
+	assert.Equal(t, map[string]int{"shared": 3, "guest-only": 1, "customer-only": 1}, setup.quantities(t))
+}
+
+func TestCartMergeStrategyMergeRestricted_Merge(t *testing.T) {
+	setup := newMergeTestSetup(t, []validation.MaxQuantityRestrictor{&mergeMaxQtyRestrictor{max: 2}}, map[string]int{"shared": 2})
+
+	guestCart := mergeGuestCart()
+	guestCart.Deliveries[0].Cartitems = append(guestCart.Deliveries[0].Cartitems, cartDomain.Item{ID: "3", MarketplaceCode: "capped", Qty: 5})
+
+	strategy := new(cartApplication.CartMergeStrategyMergeRestricted).Inject(setup.cartService, flamingo.NullLogger{})
+	result, err := strategy.Merge(setup.ctx, setup.session, guestCart, cartDomain.Cart{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "mergeRestricted", result.Strategy)
+	assert.Equal(t, []events.CartMergeItem{
+		{
+			DeliveryCode:    "delivery",
+			MarketplaceCode: "shared",
+			RequestedQty:    2,
+			Reason:          events.CartMergeReasonRestricted,
+			Message:         "maxQty",
+		},
+	}, result.DroppedItems)
+	assert.Equal(t, map[string]int{"guest-only": 1, "capped": 2}, mergedQuantities(result))
+	assert.True(t, result.HasChanges())
+	assert.Equal(t, map[string]int{"shared": 2, "guest-only": 1, "capped": 2}, setup.quantities(t))
+}
+
+func TestCartMergeStrategyDedupe_Merge(t *testing.T) {
+	setup := newMergeTestSetup(t, nil, map[string]int{"shared": 1})
+
+	strategy := new(cartApplication.CartMergeStrategyDedupe).Inject(setup.cartService, flamingo.NullLogger{})
+	result, err := strategy.Merge(setup.ctx, setup.session, mergeGuestCart(), cartDomain.Cart{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "dedupe", result.Strategy)
+	assert.Equal(t, []events.CartMergeItem{
+		{
+			DeliveryCode:    "delivery",
+			MarketplaceCode: "shared",
+			RequestedQty:    2,
+			Reason:          events.CartMergeReasonDuplicate,
+		},
+	}, result.DroppedItems)
+	assert.Equal(t, map[string]int{"guest-only": 1}, mergedQuantities(result))
+	assert.Equal(t, map[string]int{"shared": 1, "guest-only": 1}, setup.quantities(t))
+}
+
+func TestCartMergeStrategyReplace_Merge(t *testing.T) {
+	setup := newMergeTestSetup(t, nil, map[string]int{"shared": 1, "customer-only": 4})
+	customerCart, _, err := setup.cartReceiver.GetCart(setup.ctx, setup.session)
+	require.NoError(t, err)
+
+	strategy := new(cartApplication.CartMergeStrategyReplace).Inject(setup.cartService, flamingo.NullLogger{})
+	result, err := strategy.Merge(setup.ctx, setup.session, mergeGuestCart(), *customerCart)
+	require.NoError(t, err)
+
+	assert.Equal(t, "replace", result.Strategy)
+	assert.ElementsMatch(t, []events.CartMergeItem{
+		{DeliveryCode: "delivery", MarketplaceCode: "shared", ProductName: "shared", RequestedQty: 1, Reason: events.CartMergeReasonReplaced},
+		{DeliveryCode: "delivery", MarketplaceCode: "customer-only", ProductName: "customer-only", RequestedQty: 4, Reason: events.CartMergeReasonReplaced},
+	}, result.DroppedItems)
+	assert.Equal(t, map[string]int{"shared": 2, "guest-only": 1}, mergedQuantities(result))
+	assert.Equal(t, map[string]int{"shared": 2, "guest-only": 1}, setup.quantities(t))
+}
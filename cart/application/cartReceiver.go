@@ -32,11 +32,17 @@ type (
 var (
 	//ErrTemporaryCartService - should be returned if it is likely that the backend service will return a cart on a next try
 	ErrTemporaryCartService = errors.New("the cart could not be received currently - try again later")
+	// ErrNamedCartsNotSupported - returned if the CustomerCartService does not implement the NamedCartService
+	ErrNamedCartsNotSupported = errors.New("the customer cart service does not support multiple carts")
 )
 
 const (
 	// GuestCartSessionKey is a prefix
 	GuestCartSessionKey = "cart.guestid"
+	// ActiveCustomerCartSessionKey holds the id of the customer cart that has been selected with SwitchCart
+	ActiveCustomerCartSessionKey = "cart.customer.activeid"
+	// mainCustomerCartID is passed to the CustomerCartService to get the main cart of the customer
+	mainCustomerCartID = "me"
 )
 
 // Inject the dependencies
@@ -186,7 +192,7 @@ func (cs *CartReceiverService) getCustomerCart(ctx context.Context, session *web
 	}
 
 	if !found {
-		cart, err = cs.customerCartService.GetCart(ctx, identitiy, "me")
+		cart, err = cs.getActiveCustomerCart(ctx, session, identitiy)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -201,6 +207,22 @@ func (cs *CartReceiverService) getCustomerCart(ctx context.Context, session *web
 	return cart, behaviour, nil
 }
 
+// getActiveCustomerCart returns the cart selected with SwitchCart, or the main cart of the customer
+func (cs *CartReceiverService) getActiveCustomerCart(ctx context.Context, session *web.Session, identity auth.Identity) (*cartDomain.Cart, error) {
+	activeCartID, ok := session.Load(ActiveCustomerCartSessionKey)
+	if ok {
+		cart, err := cs.customerCartService.GetCart(ctx, identity, activeCartID.(string))
+		if err != cartDomain.ErrCartNotFound {
+			return cart, err
+		}
+
+		cs.logger.WithContext(ctx).Warn("cart.application.cartservice: active customer cart %v not found, using the main cart", activeCartID)
+		session.Delete(ActiveCustomerCartSessionKey)
+	}
+
+	return cs.customerCartService.GetCart(ctx, identity, mainCustomerCartID)
+}
+
 func (cs *CartReceiverService) getCartFromCacheIfCacheIsEnabled(ctx context.Context, session *web.Session) (*cartDomain.Cart, bool, error) {
 	if cs.cartCache == nil {
 		return nil, false, nil
@@ -300,7 +322,7 @@ func (cs *CartReceiverService) GetCartWithoutCache(ctx context.Context, session
 
 	identitiy := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
 	if identitiy != nil {
-		return cs.customerCartService.GetCart(ctx, identitiy, "me")
+		return cs.getActiveCustomerCart(ctx, session, identitiy)
 	}
 
 	if cs.ShouldHaveGuestCart(session) {
@@ -328,6 +350,121 @@ func (cs *CartReceiverService) ViewGuestCart(ctx context.Context, session *web.S
 	return cs.getEmptyCart(), nil
 }
 
+// DeleteGuestCart removes a guest cart that is no longer used, nothing is done if the GuestCartService doesn't support it
+func (cs *CartReceiverService) DeleteGuestCart(ctx context.Context, cartID string) error {
+	deleter, ok := cs.guestCartService.(cartDomain.GuestCartDeleter)
+	if !ok {
+		return nil
+	}
+
+	return deleter.DeleteCart(ctx, cartID)
+}
+
+// GetCustomerCartByID returns a cart of the logged in customer and the behaviour to modify it
+func (cs *CartReceiverService) GetCustomerCartByID(ctx context.Context, cartID string) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return nil, nil, application.ErrNoIdentity
+	}
+
+	cart, err := cs.customerCartService.GetCart(ctx, identity, cartID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	behaviour, err := cs.customerCartService.GetModifyBehaviour(ctx, identity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cart, behaviour, nil
+}
+
+// ListCustomerCarts returns all carts of the logged in customer
+func (cs *CartReceiverService) ListCustomerCarts(ctx context.Context) ([]*cartDomain.Cart, error) {
+	identity, namedCartService, err := cs.namedCartService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return namedCartService.ListCarts(ctx, identity)
+}
+
+// CreateCustomerCart creates a new named cart for the logged in customer, the active cart is not changed
+func (cs *CartReceiverService) CreateCustomerCart(ctx context.Context, name string) (*cartDomain.Cart, error) {
+	identity, namedCartService, err := cs.namedCartService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return namedCartService.CreateCart(ctx, identity, name)
+}
+
+// DeleteCustomerCart deletes a cart of the logged in customer, the main cart becomes active if the active cart is deleted
+func (cs *CartReceiverService) DeleteCustomerCart(ctx context.Context, session *web.Session, cartID string) error {
+	identity, namedCartService, err := cs.namedCartService(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = namedCartService.DeleteCart(ctx, identity, cartID)
+	if err != nil {
+		return err
+	}
+
+	if activeCartID, ok := session.Load(ActiveCustomerCartSessionKey); ok && activeCartID == cartID {
+		session.Delete(ActiveCustomerCartSessionKey)
+	}
+	cs.deleteCartInCacheIfCacheIsEnabled(ctx, session)
+
+	return nil
+}
+
+// SwitchCart selects the cart of the logged in customer that is returned by GetCart
+func (cs *CartReceiverService) SwitchCart(ctx context.Context, session *web.Session, cartID string) (*cartDomain.Cart, error) {
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return nil, application.ErrNoIdentity
+	}
+
+	cart, err := cs.customerCartService.GetCart(ctx, identity, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Store(ActiveCustomerCartSessionKey, cart.ID)
+	cs.deleteCartInCacheIfCacheIsEnabled(ctx, session)
+
+	return cart, nil
+}
+
+func (cs *CartReceiverService) namedCartService(ctx context.Context) (auth.Identity, cartDomain.NamedCartService, error) {
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return nil, nil, application.ErrNoIdentity
+	}
+
+	namedCartService, ok := cs.customerCartService.(cartDomain.NamedCartService)
+	if !ok {
+		return nil, nil, ErrNamedCartsNotSupported
+	}
+
+	return identity, namedCartService, nil
+}
+
+func (cs *CartReceiverService) deleteCartInCacheIfCacheIsEnabled(ctx context.Context, session *web.Session) {
+	if cs.cartCache == nil {
+		return
+	}
+
+	id, err := cs.cartCache.BuildIdentifier(ctx, session)
+	if err != nil {
+		return
+	}
+
+	_ = cs.cartCache.Delete(ctx, session, id)
+}
+
 // DeleteSavedSessionGuestCartID deletes a guest cart Key from the Session Values
 func (cs *CartService) DeleteSavedSessionGuestCartID(session *web.Session) error {
 	session.Delete(GuestCartSessionKey)
@@ -236,6 +236,7 @@ func TestCartReceiverService_ShouldHaveGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -257,6 +258,7 @@ func TestCartReceiverService_ShouldHaveGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -328,6 +330,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -352,6 +355,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -376,6 +380,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -463,6 +468,7 @@ func TestCartReceiverService_DecorateCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -487,6 +493,7 @@ func TestCartReceiverService_DecorateCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -587,6 +594,7 @@ func TestCartReceiverService_GetDecoratedCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -611,6 +619,7 @@ func TestCartReceiverService_GetDecoratedCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -891,6 +900,7 @@ func TestCartReceiverService_ModifyBehaviour(t *testing.T) {
 			&auth.WebIdentityService{},
 			flamingo.NullLogger{},
 			nil,
+			nil,
 			&struct {
 				CartCache cartApplication.CartCache `inject:",optional"`
 			}{
@@ -920,6 +930,7 @@ func TestCartReceiverService_ModifyBehaviour(t *testing.T) {
 			new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{mockIdentifier}, nil, nil, nil),
 			flamingo.NullLogger{},
 			nil,
+			nil,
 			&struct {
 				CartCache cartApplication.CartCache `inject:",optional"`
 			}{
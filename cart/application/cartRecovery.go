@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+type (
+	// CartRecoveryService creates and redeems signed tokens that restore an abandoned cart in the session of a visitor
+	CartRecoveryService struct {
+		guestCartService    cartDomain.GuestCartService
+		cartReceiverService *CartReceiverService
+		webIdentityService  *auth.WebIdentityService
+		logger              flamingo.Logger
+		secret              []byte
+		tokenLifetime       time.Duration
+	}
+)
+
+var (
+	// ErrRecoveryTokenSecretMissing is returned if no secret is configured to sign recovery tokens
+	ErrRecoveryTokenSecretMissing = errors.New("no secret configured for cart recovery tokens")
+	// ErrInvalidRecoveryToken is returned if a recovery token is malformed or its signature doesn't match
+	ErrInvalidRecoveryToken = errors.New("invalid cart recovery token")
+	// ErrRecoveryTokenExpired is returned if the lifetime of a recovery token is exceeded
+	ErrRecoveryTokenExpired = errors.New("cart recovery token expired")
+	// ErrCartRecoveryNotAllowed is returned if the cart doesn't belong to the current visitor,
+	// customer carts can only be recovered by the logged in customer and guest carts only by guests
+	ErrCartRecoveryNotAllowed = errors.New("cart recovery not allowed for the current visitor")
+)
+
+// Inject dependencies
+func (s *CartRecoveryService) Inject(
+	guestCartService cartDomain.GuestCartService,
+	cartReceiverService *CartReceiverService,
+	webIdentityService *auth.WebIdentityService,
+	logger flamingo.Logger,
+	config *struct {
+		Secret               string `inject:"config:commerce.cart.abandonedCart.recoveryTokenSecret"`
+		TokenLifetimeSeconds int    `inject:"config:commerce.cart.abandonedCart.recoveryTokenLifetimeSeconds"`
+	},
+) *CartRecoveryService {
+	s.guestCartService = guestCartService
+	s.cartReceiverService = cartReceiverService
+	s.webIdentityService = webIdentityService
+	s.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "application.cartRecoveryService")
+	if config != nil {
+		s.secret = []byte(config.Secret)
+		s.tokenLifetime = time.Duration(config.TokenLifetimeSeconds) * time.Second
+	}
+
+	return s
+}
+
+// CreateRecoveryToken returns a signed token for the given cart that is valid for the configured lifetime
+func (s *CartRecoveryService) CreateRecoveryToken(cartID string) (string, error) {
+	if len(s.secret) == 0 {
+		return "", ErrRecoveryTokenSecretMissing
+	}
+
+	payload := cartID + "|" + strconv.FormatInt(time.Now().Add(s.tokenLifetime).Unix(), 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload)), nil
+}
+
+// ParseRecoveryToken verifies the token and returns the id of the cart
+func (s *CartRecoveryService) ParseRecoveryToken(token string) (string, error) {
+	if len(s.secret) == 0 {
+		return "", ErrRecoveryTokenSecretMissing
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return "", ErrInvalidRecoveryToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", ErrInvalidRecoveryToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, s.sign(string(payload))) {
+		return "", ErrInvalidRecoveryToken
+	}
+
+	separator := strings.LastIndex(string(payload), "|")
+	if separator < 1 {
+		return "", ErrInvalidRecoveryToken
+	}
+
+	expiry, err := strconv.ParseInt(string(payload[separator+1:]), 10, 64)
+	if err != nil {
+		return "", ErrInvalidRecoveryToken
+	}
+
+	if time.Now().Unix() > expiry {
+		return "", ErrRecoveryTokenExpired
+	}
+
+	return string(payload[:separator]), nil
+}
+
+// RecoverCart restores the cart of the token in the session of the current visitor
+func (s *CartRecoveryService) RecoverCart(ctx context.Context, session *web.Session, token string) (*cartDomain.Cart, error) {
+	cartID, err := s.ParseRecoveryToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := s.guestCartService.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := s.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if cart.BelongsToAuthenticatedUser {
+		if identity == nil || identity.Subject() != cart.AuthenticatedUserID {
+			return nil, ErrCartRecoveryNotAllowed
+		}
+
+		// the cart already belongs to the customer, it just has to become the active one
+		return s.cartReceiverService.SwitchCart(ctx, session, cart.ID)
+	}
+
+	if identity != nil {
+		return nil, ErrCartRecoveryNotAllowed
+	}
+
+	return s.cartReceiverService.RestoreCart(ctx, session, *cart)
+}
+
+func (s *CartRecoveryService) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	_, _ = mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
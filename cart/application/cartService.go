@@ -16,6 +16,7 @@ import (
 	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
 	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
 )
 
@@ -38,6 +39,8 @@ type (
 		itemValidator     validation.ItemValidator
 		cartCache         CartCache
 		placeOrderService placeorder.Service
+		couponPolicy      CouponPolicy
+		orderService      orderDomain.CustomerIdentityOrderService
 	}
 
 	// RestrictionError error enriched with result of restrictions
@@ -89,10 +92,12 @@ func (cs *CartService) Inject(
 		DeleteEmptyDelivery bool   `inject:"config:commerce.cart.deleteEmptyDelivery,optional"`
 	},
 	optionals *struct {
-		CartValidator     validation.Validator     `inject:",optional"`
-		ItemValidator     validation.ItemValidator `inject:",optional"`
-		CartCache         CartCache                `inject:",optional"`
-		PlaceOrderService placeorder.Service       `inject:",optional"`
+		CartValidator     validation.Validator                     `inject:",optional"`
+		ItemValidator     validation.ItemValidator                 `inject:",optional"`
+		CartCache         CartCache                                `inject:",optional"`
+		PlaceOrderService placeorder.Service                       `inject:",optional"`
+		CouponPolicy      CouponPolicy                             `inject:",optional"`
+		OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
 	},
 ) {
 	cs.cartReceiverService = cartReceiverService
@@ -112,6 +117,8 @@ func (cs *CartService) Inject(
 		cs.itemValidator = optionals.ItemValidator
 		cs.cartCache = optionals.CartCache
 		cs.placeOrderService = optionals.PlaceOrderService
+		cs.couponPolicy = optionals.CouponPolicy
+		cs.orderService = optionals.OrderService
 	}
 }
 
@@ -442,6 +449,133 @@ func (cs *CartService) DeleteItem(ctx context.Context, session *web.Session, ite
 	return nil
 }
 
+// MoveItem moves an item of the current cart to another cart of the logged in customer, e.g. to a "saved for later" cart.
+// The item is added to the delivery with the same code in the target cart
+func (cs *CartService) MoveItem(ctx context.Context, session *web.Session, itemID string, deliveryCode string, targetCartID string) error {
+	if deliveryCode == "" {
+		deliveryCode = cs.defaultDeliveryCode
+	}
+
+	cart, behaviour, err := cs.cartReceiverService.GetCart(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	targetCart, targetBehaviour, err := cs.cartReceiverService.GetCustomerCartByID(ctx, targetCartID)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Info(err)
+
+		return err
+	}
+	if targetCart.ID == cart.ID {
+		return errors.New("item can not be moved to the same cart")
+	}
+
+	// cart cache must be updated - with the current value of cart
+	var defers cartDomain.DeferEvents
+	defer func() {
+		cs.updateCartInCacheIfCacheIsEnabled(ctx, session, cart)
+
+		cs.handleEmptyDelivery(ctx, session, cart, deliveryCode)
+		cs.dispatchAllEvents(ctx, defers)
+	}()
+
+	item, err := cart.GetByItemID(itemID)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Error(err)
+
+		return err
+	}
+
+	addRequest := cs.BuildAddRequest(ctx, item.MarketplaceCode, item.VariantMarketPlaceCode, item.Qty, item.AdditionalData)
+	addRequest, product, err := cs.checkProductForAddRequest(ctx, session, targetCart, deliveryCode, addRequest)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Info(err)
+
+		return err
+	}
+
+	err = cs.checkProductQtyRestrictions(ctx, session, product, targetCart, addRequest.Qty, deliveryCode, "")
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Info(err)
+
+		return err
+	}
+
+	targetCart, targetDefers, err := cs.addToTargetCart(ctx, targetCart, targetBehaviour, deliveryCode, addRequest)
+	defers = append(defers, targetDefers...)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Error(err)
+
+		return err
+	}
+
+	defers = append(defers, &events.AddToCartEvent{
+		Cart:                   targetCart,
+		MarketplaceCode:        addRequest.MarketplaceCode,
+		VariantMarketplaceCode: addRequest.VariantMarketplaceCode,
+		ProductName:            item.ProductName,
+		Qty:                    addRequest.Qty,
+	})
+
+	var deleteDefers cartDomain.DeferEvents
+	cart, deleteDefers, err = behaviour.DeleteItem(ctx, cart, itemID, deliveryCode)
+	defers = append(defers, deleteDefers...)
+	if err != nil {
+		cs.handleCartNotFound(session, err)
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveItem").Error(errors.Wrap(err, "Trying to delete SKU :"+item.MarketplaceCode))
+
+		return err
+	}
+
+	defers = append(defers, &events.ChangedQtyInCartEvent{
+		Cart:                   cart,
+		CartID:                 cart.ID,
+		MarketplaceCode:        item.MarketplaceCode,
+		VariantMarketplaceCode: item.VariantMarketPlaceCode,
+		ProductName:            item.ProductName,
+		QtyBefore:              item.Qty,
+		QtyAfter:               0,
+	})
+
+	return nil
+}
+
+// addToTargetCart adds the requested qty to the target cart, an existing item of the same product is increased
+func (cs *CartService) addToTargetCart(ctx context.Context, targetCart *cartDomain.Cart, behaviour cartDomain.ModifyBehaviour, deliveryCode string, addRequest cartDomain.AddRequest) (*cartDomain.Cart, cartDomain.DeferEvents, error) {
+	var defers cartDomain.DeferEvents
+
+	if !targetCart.HasDeliveryForCode(deliveryCode) {
+		delInfo, err := cs.deliveryInfoBuilder.BuildByDeliveryCode(deliveryCode)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var deliveryDefers cartDomain.DeferEvents
+		targetCart, deliveryDefers, err = behaviour.UpdateDeliveryInfo(ctx, targetCart, deliveryCode, cartDomain.DeliveryInfoUpdateCommand{DeliveryInfo: *delInfo})
+		defers = append(defers, deliveryDefers...)
+		if err != nil {
+			return nil, defers, err
+		}
+	}
+
+	delivery, _ := targetCart.GetDeliveryByCode(deliveryCode)
+	for _, existingItem := range delivery.Cartitems {
+		if existingItem.MarketplaceCode != addRequest.MarketplaceCode || existingItem.VariantMarketPlaceCode != addRequest.VariantMarketplaceCode {
+			continue
+		}
+
+		qty := existingItem.Qty + addRequest.Qty
+		updatedCart, updateDefers, err := behaviour.UpdateItem(ctx, targetCart, cartDomain.ItemUpdateCommand{ItemID: existingItem.ID, Qty: &qty})
+
+		return updatedCart, append(defers, updateDefers...), err
+	}
+
+	updatedCart, addDefers, err := behaviour.AddToCart(ctx, targetCart, deliveryCode, addRequest)
+
+	return updatedCart, append(defers, addDefers...), err
+}
+
 // DeleteAllItems in current cart
 func (cs *CartService) DeleteAllItems(ctx context.Context, session *web.Session) error {
 	cart, behaviour, err := cs.cartReceiverService.GetCart(ctx, session)
@@ -767,7 +901,18 @@ func (cs *CartService) ApplyVoucher(ctx context.Context, session *web.Session, c
 	if err != nil {
 		return nil, err
 	}
-	return cs.executeVoucherBehaviour(ctx, session, cart, couponCode, behaviour.ApplyVoucher)
+
+	replacedCodes, err := cs.checkCouponPolicy(ctx, cart, couponCode)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err = cs.executeVoucherBehaviour(ctx, session, cart, couponCode, behaviour.ApplyVoucher)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.removeReplacedCouponCodes(ctx, session, cart, behaviour, couponCode, replacedCodes)
 }
 
 // ApplyAny applies a voucher or giftcard to the cart
@@ -776,10 +921,63 @@ func (cs *CartService) ApplyAny(ctx context.Context, session *web.Session, anyCo
 	if err != nil {
 		return nil, err
 	}
-	if giftCardAndVoucherBehaviour, ok := behaviour.(cartDomain.GiftCardAndVoucherBehaviour); ok {
-		return cs.executeVoucherBehaviour(ctx, session, cart, anyCode, giftCardAndVoucherBehaviour.ApplyAny)
+	giftCardAndVoucherBehaviour, ok := behaviour.(cartDomain.GiftCardAndVoucherBehaviour)
+	if !ok {
+		return nil, errors.New("ApplyAny not supported")
+	}
+
+	replacedCodes, err := cs.checkCouponPolicy(ctx, cart, anyCode)
+	if err != nil {
+		// the coupon policy doesn't restrict gift cards
+		giftCardBehaviour, ok := behaviour.(cartDomain.GiftCardBehaviour)
+		if !ok {
+			return nil, err
+		}
+		giftCardCart, giftCardErr := cs.executeVoucherBehaviour(ctx, session, cart, anyCode, giftCardBehaviour.ApplyGiftCard)
+		if giftCardErr != nil {
+			return nil, err
+		}
+		return giftCardCart, nil
+	}
+
+	cart, err = cs.executeVoucherBehaviour(ctx, session, cart, anyCode, giftCardAndVoucherBehaviour.ApplyAny)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errors.New("ApplyAny not supported")
+
+	return cs.removeReplacedCouponCodes(ctx, session, cart, behaviour, anyCode, replacedCodes)
+}
+
+// checkCouponPolicy returns the coupon codes that are replaced by the new code or the error of the policy
+func (cs *CartService) checkCouponPolicy(ctx context.Context, cart *cartDomain.Cart, couponCode string) ([]string, error) {
+	if cs.couponPolicy == nil {
+		return nil, nil
+	}
+
+	replacedCodes, err := cs.couponPolicy.Check(ctx, cart, couponCode)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "checkCouponPolicy").Info(err)
+		return nil, err
+	}
+
+	return replacedCodes, nil
+}
+
+// removeReplacedCouponCodes removes the replaced codes once the new code has been applied as coupon code
+func (cs *CartService) removeReplacedCouponCodes(ctx context.Context, session *web.Session, cart *cartDomain.Cart, behaviour cartDomain.ModifyBehaviour, couponCode string, replacedCodes []string) (*cartDomain.Cart, error) {
+	if len(replacedCodes) == 0 || !isCouponCodeApplied(cart, couponCode) {
+		return cart, nil
+	}
+
+	for _, replacedCode := range replacedCodes {
+		var err error
+		cart, err = cs.executeVoucherBehaviour(ctx, session, cart, replacedCode, behaviour.RemoveVoucher)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cart, nil
 }
 
 // RemoveVoucher removes a voucher from the cart
@@ -815,6 +1013,16 @@ func (cs *CartService) RemoveGiftCard(ctx context.Context, session *web.Session,
 	return nil, errors.New("RemoveGiftCard not supported")
 }
 
+func isCouponCodeApplied(cart *cartDomain.Cart, couponCode string) bool {
+	for _, coupon := range cart.AppliedCouponCodes {
+		if coupon.Code == couponCode {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Get current cart from session and corresponding behaviour
 func (cs *CartService) getCartAndBehaviour(ctx context.Context, session *web.Session, logKey string) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
 	cart, behaviour, err := cs.cartReceiverService.GetCart(ctx, session)
@@ -868,6 +1076,16 @@ func (cs *CartService) checkProductForAddRequest(ctx context.Context, session *w
 		}
 	}
 
+	if bundleProduct, ok := product.(productDomain.BundleProduct); ok {
+		bundleWithActiveChoices, err := bundleProduct.GetBundleWithActiveChoices(addRequest.BundleConfiguration)
+		if err != nil {
+			return addRequest, nil, err
+		}
+
+		product = bundleWithActiveChoices
+		addRequest.BundleConfiguration = bundleWithActiveChoices.Configuration()
+	}
+
 	// Now Validate the Item with the optional registered ItemValidator
 	if cs.itemValidator != nil {
 		decoratedCart, _ := cs.cartReceiverService.DecorateCart(ctx, cart)
@@ -1021,6 +1239,7 @@ func (cs *CartService) CancelOrder(ctx context.Context, session *web.Session, or
 	if err != nil {
 		return nil, err
 	}
+	cs.eventRouter.Dispatch(ctx, &events.OrderCancelledEvent{Cart: &cart, PlacedOrderInfos: orderInfos})
 
 	restoredCart, err := cs.RestoreCart(ctx, &cart)
 	if err != nil {
@@ -1054,7 +1273,13 @@ func (cs *CartService) cancelOrder(ctx context.Context, session *web.Session, or
 
 // CancelOrderWithoutRestore cancels a previously placed order
 func (cs *CartService) CancelOrderWithoutRestore(ctx context.Context, session *web.Session, orderInfos placeorder.PlacedOrderInfos) error {
-	return cs.cancelOrder(ctx, session, orderInfos)
+	err := cs.cancelOrder(ctx, session, orderInfos)
+	if err != nil {
+		return err
+	}
+	cs.eventRouter.Dispatch(ctx, &events.OrderCancelledEvent{PlacedOrderInfos: orderInfos})
+
+	return nil
 }
 
 // GetDefaultDeliveryCode returns the configured default deliverycode
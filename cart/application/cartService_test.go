@@ -22,6 +22,7 @@ import (
 
 	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
 	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
 	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
 )
 
@@ -64,6 +65,7 @@ func TestCartService_DeleteSavedSessionGuestCartID(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -172,6 +174,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -229,6 +232,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -302,6 +306,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -430,6 +435,7 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -480,6 +486,7 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -533,10 +540,12 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 				tt.fields.Logger,
 				tt.fields.config,
 				&struct {
-					CartValidator     validation.Validator      `inject:",optional"`
-					ItemValidator     validation.ItemValidator  `inject:",optional"`
-					CartCache         cartApplication.CartCache `inject:",optional"`
-					PlaceOrderService placeorder.Service        `inject:",optional"`
+					CartValidator     validation.Validator                     `inject:",optional"`
+					ItemValidator     validation.ItemValidator                 `inject:",optional"`
+					CartCache         cartApplication.CartCache                `inject:",optional"`
+					PlaceOrderService placeorder.Service                       `inject:",optional"`
+					CouponPolicy      cartApplication.CouponPolicy             `inject:",optional"`
+					OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
 				}{
 					PlaceOrderService: tt.fields.PlaceOrderService,
 				},
@@ -593,6 +602,7 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -643,6 +653,7 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -696,10 +707,12 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 				tt.fields.Logger,
 				tt.fields.config,
 				&struct {
-					CartValidator     validation.Validator      `inject:",optional"`
-					ItemValidator     validation.ItemValidator  `inject:",optional"`
-					CartCache         cartApplication.CartCache `inject:",optional"`
-					PlaceOrderService placeorder.Service        `inject:",optional"`
+					CartValidator     validation.Validator                     `inject:",optional"`
+					ItemValidator     validation.ItemValidator                 `inject:",optional"`
+					CartCache         cartApplication.CartCache                `inject:",optional"`
+					PlaceOrderService placeorder.Service                       `inject:",optional"`
+					CouponPolicy      cartApplication.CouponPolicy             `inject:",optional"`
+					OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
 				}{
 					PlaceOrderService: tt.fields.PlaceOrderService,
 				},
@@ -770,6 +783,7 @@ func (m *MockGuestCartServiceWithModifyBehaviour) GetModifyBehaviour(context.Con
 		nil,
 		nil,
 		nil,
+		nil,
 	)
 
 	return cob, nil
@@ -929,6 +943,7 @@ func TestCartService_CartInEvent(t *testing.T) {
 				result.Inject(
 					&MockProductService{},
 					flamingo.NullLogger{},
+					nil,
 				)
 
 				return result
@@ -0,0 +1,199 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// CouponPolicy decides how a coupon code interacts with the codes that are already applied to the cart
+	CouponPolicy interface {
+		// Check returns the applied codes that have to be replaced by the new coupon code,
+		// or a *CouponPolicyError if the coupon code must not be applied
+		Check(ctx context.Context, cart *cartDomain.Cart, couponCode string) (replacedCodes []string, err error)
+	}
+
+	// DefaultCouponPolicy limits the number of coupon codes per cart, keeps codes of an exclusive group from being
+	// combined and optionally rejects codes for carts with products that deny further discounts
+	DefaultCouponPolicy struct {
+		productService           productDomain.ProductService
+		logger                   flamingo.Logger
+		maxCodes                 int
+		exclusiveGroups          map[string][]string
+		replaceConflicting       bool
+		respectDenyMoreDiscounts bool
+	}
+
+	// CouponPolicyError is returned if a coupon code is rejected by the CouponPolicy
+	CouponPolicyError struct {
+		// Reason is one of the CouponPolicyReason constants
+		Reason     string
+		CouponCode string
+		// ConflictingCodes contains the applied codes that prevent the coupon code from being applied
+		ConflictingCodes []string
+	}
+)
+
+// Reasons of a CouponPolicyError, they are used as message code
+const (
+	CouponPolicyReasonMaxCodesReached    = "coupon_max_codes_reached"
+	CouponPolicyReasonExclusiveGroup     = "coupon_exclusive_group"
+	CouponPolicyReasonDiscountedProducts = "coupon_discounted_products"
+)
+
+var (
+	_ CouponPolicy = new(DefaultCouponPolicy)
+)
+
+// Error returns the message of the policy violation
+func (e *CouponPolicyError) Error() string {
+	switch e.Reason {
+	case CouponPolicyReasonMaxCodesReached:
+		return fmt.Sprintf("coupon code %q can't be applied, the maximum number of coupon codes is reached", e.CouponCode)
+	case CouponPolicyReasonExclusiveGroup:
+		return fmt.Sprintf("coupon code %q can't be combined with %s", e.CouponCode, strings.Join(e.ConflictingCodes, ", "))
+	case CouponPolicyReasonDiscountedProducts:
+		return fmt.Sprintf("coupon code %q can't be combined with discounted products", e.CouponCode)
+	}
+
+	return fmt.Sprintf("coupon code %q can't be applied", e.CouponCode)
+}
+
+// MessageCode returns the reason of the policy violation
+func (e *CouponPolicyError) MessageCode() string {
+	return e.Reason
+}
+
+// Inject dependencies
+func (p *DefaultCouponPolicy) Inject(
+	productService productDomain.ProductService,
+	logger flamingo.Logger,
+	cfg *struct {
+		MaxCodes                 int        `inject:"config:commerce.cart.couponPolicy.maxCodes,optional"`
+		ExclusiveGroups          config.Map `inject:"config:commerce.cart.couponPolicy.exclusiveGroups,optional"`
+		OnConflict               string     `inject:"config:commerce.cart.couponPolicy.onConflict,optional"`
+		RespectDenyMoreDiscounts bool       `inject:"config:commerce.cart.couponPolicy.respectDenyMoreDiscounts,optional"`
+	},
+) *DefaultCouponPolicy {
+	p.productService = productService
+	p.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "application.couponPolicy")
+	if cfg != nil {
+		p.maxCodes = cfg.MaxCodes
+		p.replaceConflicting = cfg.OnConflict == "replace"
+		p.respectDenyMoreDiscounts = cfg.RespectDenyMoreDiscounts
+		if cfg.ExclusiveGroups != nil {
+			err := cfg.ExclusiveGroups.MapInto(&p.exclusiveGroups)
+			if err != nil {
+				p.logger.Error("exclusive coupon groups could not be mapped: ", err)
+			}
+		}
+	}
+
+	return p
+}
+
+// Check the coupon code against the applied coupon codes of the cart
+func (p *DefaultCouponPolicy) Check(ctx context.Context, cart *cartDomain.Cart, couponCode string) ([]string, error) {
+	applied := make([]string, 0, len(cart.AppliedCouponCodes))
+	for _, coupon := range cart.AppliedCouponCodes {
+		if coupon.Code == couponCode {
+			// applying a code twice doesn't change anything
+			return nil, nil
+		}
+		applied = append(applied, coupon.Code)
+	}
+
+	if p.respectDenyMoreDiscounts && p.hasProductDenyingDiscounts(ctx, cart) {
+		return nil, &CouponPolicyError{Reason: CouponPolicyReasonDiscountedProducts, CouponCode: couponCode}
+	}
+
+	var replaced []string
+
+	conflicting := p.exclusiveCodes(couponCode, applied)
+	if len(conflicting) > 0 {
+		if !p.replaceConflicting {
+			return nil, &CouponPolicyError{Reason: CouponPolicyReasonExclusiveGroup, CouponCode: couponCode, ConflictingCodes: conflicting}
+		}
+		replaced = append(replaced, conflicting...)
+	}
+
+	if p.maxCodes > 0 {
+		remaining := make([]string, 0, len(applied))
+		for _, code := range applied {
+			if !contains(replaced, code) {
+				remaining = append(remaining, code)
+			}
+		}
+
+		if exceeding := len(remaining) + 1 - p.maxCodes; exceeding > 0 {
+			if !p.replaceConflicting {
+				return nil, &CouponPolicyError{Reason: CouponPolicyReasonMaxCodesReached, CouponCode: couponCode, ConflictingCodes: remaining}
+			}
+			// the oldest codes make room for the new one
+			replaced = append(replaced, remaining[:exceeding]...)
+		}
+	}
+
+	return replaced, nil
+}
+
+// exclusiveCodes returns all applied codes that share an exclusive group with the coupon code
+func (p *DefaultCouponPolicy) exclusiveCodes(couponCode string, applied []string) []string {
+	var result []string
+	for _, group := range p.exclusiveGroups {
+		if !contains(group, couponCode) {
+			continue
+		}
+		for _, code := range applied {
+			if contains(group, code) && !contains(result, code) {
+				result = append(result, code)
+			}
+		}
+	}
+
+	return result
+}
+
+func (p *DefaultCouponPolicy) hasProductDenyingDiscounts(ctx context.Context, cart *cartDomain.Cart) bool {
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			product, err := p.productService.Get(ctx, item.MarketplaceCode)
+			if err != nil {
+				p.logger.WithContext(ctx).Warn("product ", item.MarketplaceCode, " could not be loaded: ", err)
+				continue
+			}
+
+			if configurable, ok := product.(productDomain.ConfigurableProduct); ok && item.VariantMarketPlaceCode != "" {
+				variant, err := configurable.GetConfigurableWithActiveVariant(item.VariantMarketPlaceCode)
+				if err != nil {
+					p.logger.WithContext(ctx).Warn("variant ", item.VariantMarketPlaceCode, " could not be loaded: ", err)
+					continue
+				}
+				product = variant
+			}
+
+			if product.SaleableData().ActivePrice.DenyMoreDiscounts {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type denyMoreDiscountsProductService struct{}
+
+func (denyMoreDiscountsProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	return productDomain.SimpleProduct{
+		BasicProductData: productDomain.BasicProductData{MarketPlaceCode: marketplaceCode},
+		Saleable: productDomain.Saleable{
+			ActivePrice: productDomain.PriceInfo{DenyMoreDiscounts: marketplaceCode == "reduced"},
+		},
+	}, nil
+}
+
+func newCouponPolicy(maxCodes int, onConflict string, respectDenyMoreDiscounts bool) *cartApplication.DefaultCouponPolicy {
+	return new(cartApplication.DefaultCouponPolicy).Inject(
+		denyMoreDiscountsProductService{},
+		new(flamingo.NullLogger),
+		&struct {
+			MaxCodes                 int        `inject:"config:commerce.cart.couponPolicy.maxCodes,optional"`
+			ExclusiveGroups          config.Map `inject:"config:commerce.cart.couponPolicy.exclusiveGroups,optional"`
+			OnConflict               string     `inject:"config:commerce.cart.couponPolicy.onConflict,optional"`
+			RespectDenyMoreDiscounts bool       `inject:"config:commerce.cart.couponPolicy.respectDenyMoreDiscounts,optional"`
+		}{
+			MaxCodes:                 maxCodes,
+			ExclusiveGroups:          config.Map{"newsletter": config.Slice{"welcome", "newsletter10"}},
+			OnConflict:               onConflict,
+			RespectDenyMoreDiscounts: respectDenyMoreDiscounts,
+		},
+	)
+}
+
+func TestDefaultCouponPolicy_Check(t *testing.T) {
+	cartWithCodes := func(marketplaceCode string, codes ...string) *cartDomain.Cart {
+		cart := &cartDomain.Cart{
+			Deliveries: []cartDomain.Delivery{{Cartitems: []cartDomain.Item{{ID: "item", MarketplaceCode: marketplaceCode, Qty: 1}}}},
+		}
+		for _, code := range codes {
+			cart.AppliedCouponCodes = append(cart.AppliedCouponCodes, cartDomain.CouponCode{Code: code})
+		}
+		return cart
+	}
+
+	tests := []struct {
+		name         string
+		policy       *cartApplication.DefaultCouponPolicy
+		cart         *cartDomain.Cart
+		couponCode   string
+		wantReplaced []string
+		wantErr      *cartApplication.CouponPolicyError
+	}{
+		{
+			name:       "no restrictions",
+			policy:     newCouponPolicy(0, "reject", false),
+			cart:       cartWithCodes("reduced", "summer", "winter"),
+			couponCode: "spring",
+		},
+		{
+			name:       "code already applied",
+			policy:     newCouponPolicy(1, "reject", false),
+			cart:       cartWithCodes("product", "summer"),
+			couponCode: "summer",
+		},
+		{
+			name:       "max codes reached",
+			policy:     newCouponPolicy(2, "reject", false),
+			cart:       cartWithCodes("product", "summer", "winter"),
+			couponCode: "spring",
+			wantErr: &cartApplication.CouponPolicyError{
+				Reason:           cartApplication.CouponPolicyReasonMaxCodesReached,
+				CouponCode:       "spring",
+				ConflictingCodes: []string{"summer", "winter"},
+			},
+		},
+		{
+			name:         "max codes reached replaces the oldest code",
+			policy:       newCouponPolicy(2, "replace", false),
+			cart:         cartWithCodes("product", "summer", "winter"),
+			couponCode:   "spring",
+			wantReplaced: []string{"summer"},
+		},
+		{
+			name:       "exclusive group",
+			policy:     newCouponPolicy(0, "reject", false),
+			cart:       cartWithCodes("product", "summer", "welcome"),
+			couponCode: "newsletter10",
+			wantErr: &cartApplication.CouponPolicyError{
+				Reason:           cartApplication.CouponPolicyReasonExclusiveGroup,
+				CouponCode:       "newsletter10",
+				ConflictingCodes: []string{"welcome"},
+			},
+		},
+		{
+			name:         "exclusive group replaces the conflicting code",
+			policy:       newCouponPolicy(2, "replace", false),
+			cart:         cartWithCodes("product", "summer", "welcome"),
+			couponCode:   "newsletter10",
+			wantReplaced: []string{"welcome"},
+		},
+		{
+			name:       "discounted product denies more discounts",
+			policy:     newCouponPolicy(0, "replace", true),
+			cart:       cartWithCodes("reduced"),
+			couponCode: "summer",
+			wantErr: &cartApplication.CouponPolicyError{
+				Reason:     cartApplication.CouponPolicyReasonDiscountedProducts,
+				CouponCode: "summer",
+			},
+		},
+		{
+			name:       "product allows more discounts",
+			policy:     newCouponPolicy(0, "reject", true),
+			cart:       cartWithCodes("product"),
+			couponCode: "summer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replaced, err := tt.policy.Check(context.Background(), tt.cart, tt.couponCode)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.wantErr.Reason, err.(*cartApplication.CouponPolicyError).MessageCode())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReplaced, replaced)
+		})
+	}
+}
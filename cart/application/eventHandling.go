@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"fmt"
 
 	"flamingo.me/flamingo/v3/core/auth"
 	"flamingo.me/flamingo/v3/framework/web"
@@ -10,6 +9,7 @@ import (
 	"flamingo.me/flamingo/v3/framework/flamingo"
 
 	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
 )
 
 type (
@@ -20,6 +20,9 @@ type (
 		cartReceiverService *CartReceiverService
 		cartCache           CartCache
 		webIdentityService  *auth.WebIdentityService
+		cartMergeStrategy   CartMergeStrategy
+		eventRouter         flamingo.EventRouter
+		giftCardService     cartDomain.GiftCardService
 	}
 )
 
@@ -29,16 +32,22 @@ func (e *EventReceiver) Inject(
 	cartService *CartService,
 	cartReceiverService *CartReceiverService,
 	webIdentityService *auth.WebIdentityService,
+	cartMergeStrategy CartMergeStrategy,
+	eventRouter flamingo.EventRouter,
 	optionals *struct {
-		CartCache CartCache `inject:",optional"`
+		CartCache       CartCache                  `inject:",optional"`
+		GiftCardService cartDomain.GiftCardService `inject:",optional"`
 	},
 ) {
 	e.logger = logger.WithField(flamingo.LogKeyCategory, "cart").WithField(flamingo.LogKeySubCategory, "cart-events")
 	e.cartService = cartService
 	e.cartReceiverService = cartReceiverService
 	e.webIdentityService = webIdentityService
+	e.cartMergeStrategy = cartMergeStrategy
+	e.eventRouter = eventRouter
 	if optionals != nil {
 		e.cartCache = optionals.CartCache
+		e.giftCardService = optionals.GiftCardService
 	}
 }
 
@@ -47,6 +56,7 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 	switch currentEvent := event.(type) {
 	// Handle Logout
 	case *auth.WebLogoutEvent:
+		currentEvent.Request.Session().Delete(ActiveCustomerCartSessionKey)
 		if e.cartCache != nil {
 			_ = e.cartCache.DeleteAll(ctx, currentEvent.Request.Session())
 		}
@@ -79,56 +89,28 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 			if err != nil {
 				e.logger.WithContext(ctx).Error("WebLoginEvent - DeleteSavedSessionGuestCartID Error", err)
 			}
-			for _, d := range guestCart.Deliveries {
-				e.logger.WithContext(ctx).Info(fmt.Sprintf("Merging delivery with code %v of guestCart with ID %v into customerCart with ID %v", d.DeliveryInfo.Code, guestCart.ID, customerCart.ID))
-				err := e.cartService.UpdateDeliveryInfo(ctx, session, d.DeliveryInfo.Code, cartDomain.CreateDeliveryInfoUpdateCommand(d.DeliveryInfo))
-				if err != nil {
-					e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart UpdateDeliveryInfo error", err)
-					continue
-				}
-				for _, item := range d.Cartitems {
-					e.logger.WithContext(ctx).Debugf("Merging item from guest to user cart %v", item)
-					addRequest := e.cartService.BuildAddRequest(ctx, item.MarketplaceCode, item.VariantMarketPlaceCode, item.Qty, item.AdditionalData)
-					_, err := e.cartService.AddProduct(ctx, session, d.DeliveryInfo.Code, addRequest)
-					if err != nil {
-						e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart product has merge error", addRequest.MarketplaceCode, err)
-					}
-				}
-			}
-			if customerCart.BillingAddress == nil && guestCart.BillingAddress != nil {
-				err := e.cartService.UpdateBillingAddress(ctx, session, guestCart.BillingAddress)
-				if err != nil {
-					e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart UpdateBillingAddress error", err)
-				}
-			}
-			if customerCart.Purchaser == nil && guestCart.Purchaser != nil {
-				err := e.cartService.UpdatePurchaser(ctx, session, guestCart.Purchaser, &guestCart.AdditionalData)
-				if err != nil {
-					e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart UpdatePurchaser error", err)
-				}
-			}
-			if customerCart.PaymentSelection == nil && guestCart.PaymentSelection != nil {
-				err := e.cartService.UpdatePaymentSelection(ctx, session, guestCart.PaymentSelection)
-				if err != nil {
-					e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart UpdatePaymentSelection error", err)
-				}
+			// the guest cart is not reachable anymore, deleting it releases e.g. the usages of its coupon codes before they are applied to the customer cart
+			err = e.cartReceiverService.DeleteGuestCart(ctx, guestCart.ID)
+			if err != nil {
+				e.logger.WithContext(ctx).Error("WebLoginEvent - DeleteGuestCart Error", err)
 			}
-			if guestCart.HasAppliedCouponCode() {
-				for _, code := range guestCart.AppliedCouponCodes {
-					_, err := e.cartService.ApplyVoucher(ctx, session, code.Code)
-					if err != nil {
-						e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart ApplyVoucher has error", code.Code, err)
-					}
-				}
+
+			result, err := e.cartMergeStrategy.Merge(ctx, session, *guestCart, *customerCart)
+			if err != nil {
+				e.logger.WithContext(ctx).Error("WebLoginEvent - cart merge error", err)
 			}
-			if guestCart.HasAppliedGiftCards() {
-				for _, code := range guestCart.AppliedGiftCards {
-					_, err := e.cartService.ApplyGiftCard(ctx, session, code.Code)
-					if err != nil {
-						e.logger.WithContext(ctx).Error("WebLoginEvent - customerCart ApplyGiftCard has error", code.Code, err)
-					}
-				}
+
+			session.Store(CartMergeResultSessionKey, result)
+			mergedCart, err := e.cartReceiverService.ViewCart(ctx, session)
+			if err != nil {
+				e.logger.WithContext(ctx).Error("WebLoginEvent - merged customerCart cannot be received %v", err)
+				mergedCart = customerCart
 			}
+			e.eventRouter.Dispatch(ctx, &events.CartMergedEvent{
+				GuestCart:    guestCart,
+				CustomerCart: mergedCart,
+				Result:       result,
+			})
 
 			if e.cartCache != nil {
 				session := web.SessionFromContext(ctx)
@@ -141,6 +123,26 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 				}
 			}
 		})
+	// Handle OrderPlacedEvent and capture the gift cards reserved for the placed cart
+	case *events.OrderPlacedEvent:
+		if e.giftCardService != nil && currentEvent.Cart != nil {
+			for _, giftCard := range currentEvent.Cart.AppliedGiftCards {
+				err := e.giftCardService.Capture(ctx, giftCard.Code, currentEvent.Cart.ID)
+				if err != nil {
+					e.logger.WithContext(ctx).Error("OrderPlacedEvent - gift card ", giftCard.Code, " could not be captured: ", err)
+				}
+			}
+		}
+	// Handle OrderCancelledEvent and release the gift cards booked for the cart of the cancelled order
+	case *events.OrderCancelledEvent:
+		if e.giftCardService != nil && currentEvent.Cart != nil {
+			for _, giftCard := range currentEvent.Cart.AppliedGiftCards {
+				err := e.giftCardService.Release(ctx, giftCard.Code, currentEvent.Cart.ID)
+				if err != nil {
+					e.logger.WithContext(ctx).Error("OrderCancelledEvent - gift card ", giftCard.Code, " could not be released: ", err)
+				}
+			}
+		}
 	// Handle Event to Invalidate the Cart Cache
 	case *cartDomain.InvalidateCartEvent:
 		if e.cartCache != nil {
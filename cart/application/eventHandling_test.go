@@ -0,0 +1,81 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+// recordingGiftCardService records the captured and released gift card bookings as "code/reference"
+type recordingGiftCardService struct {
+	captured []string
+	released []string
+}
+
+func (s *recordingGiftCardService) Balance(_ context.Context, code string) (*cartDomain.GiftCardBalance, error) {
+	return &cartDomain.GiftCardBalance{Code: code}, nil
+}
+
+func (s *recordingGiftCardService) Reserve(context.Context, string, priceDomain.Price, string) error {
+	return nil
+}
+
+func (s *recordingGiftCardService) Capture(_ context.Context, code string, reference string) error {
+	s.captured = append(s.captured, code+"/"+reference)
+	return nil
+}
+
+func (s *recordingGiftCardService) Release(_ context.Context, code string, reference string) error {
+	s.released = append(s.released, code+"/"+reference)
+	return nil
+}
+
+func newGiftCardEventReceiver(giftCardService cartDomain.GiftCardService) *cartApplication.EventReceiver {
+	receiver := new(cartApplication.EventReceiver)
+	receiver.Inject(flamingo.NullLogger{}, nil, nil, nil, nil, new(recordingEventRouter), &struct {
+		CartCache       cartApplication.CartCache  `inject:",optional"`
+		GiftCardService cartDomain.GiftCardService `inject:",optional"`
+	}{GiftCardService: giftCardService})
+
+	return receiver
+}
+
+func TestEventReceiver_GiftCards(t *testing.T) {
+	cart := &cartDomain.Cart{
+		ID: "cart-1",
+		AppliedGiftCards: []cartDomain.AppliedGiftCard{
+			{Code: "gift-a", Applied: priceDomain.NewFromInt(500, 100, "EUR")},
+			{Code: "gift-b", Applied: priceDomain.NewFromInt(300, 100, "EUR")},
+		},
+	}
+
+	t.Run("placed orders capture the gift cards", func(t *testing.T) {
+		giftCardService := new(recordingGiftCardService)
+		newGiftCardEventReceiver(giftCardService).Notify(context.Background(), &events.OrderPlacedEvent{Cart: cart})
+
+		assert.Equal(t, []string{"gift-a/cart-1", "gift-b/cart-1"}, giftCardService.captured)
+		assert.Empty(t, giftCardService.released)
+	})
+
+	t.Run("cancelled orders release the gift cards", func(t *testing.T) {
+		giftCardService := new(recordingGiftCardService)
+		newGiftCardEventReceiver(giftCardService).Notify(context.Background(), &events.OrderCancelledEvent{Cart: cart})
+
+		assert.Equal(t, []string{"gift-a/cart-1", "gift-b/cart-1"}, giftCardService.released)
+		assert.Empty(t, giftCardService.captured)
+	})
+
+	t.Run("cancelled orders without cart are ignored", func(t *testing.T) {
+		giftCardService := new(recordingGiftCardService)
+		newGiftCardEventReceiver(giftCardService).Notify(context.Background(), &events.OrderCancelledEvent{})
+
+		assert.Empty(t, giftCardService.released)
+	})
+}
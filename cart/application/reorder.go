@@ -0,0 +1,207 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/pkg/errors"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/customer/application"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// ReorderResult lists the items of a past order that have been added to the cart and the ones that could not be added
+	ReorderResult struct {
+		OrderID  string
+		Added    []ReorderedItem
+		NotAdded []NotReorderedItem
+	}
+
+	// ReorderedItem is an order item that has been added to the cart
+	ReorderedItem struct {
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		DeliveryCode           string
+		Qty                    int
+	}
+
+	// NotReorderedItem is an order item (or a part of its qty) that could not be added to the cart
+	NotReorderedItem struct {
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Name                   string
+		Qty                    int
+		// Reason is one of the ReorderReason constants
+		Reason string
+		// Message contains details about the reason, e.g. the error of the add request
+		Message string
+	}
+)
+
+const (
+	// ReorderReasonProductNotFound the product does not exist anymore
+	ReorderReasonProductNotFound = "product_not_found"
+	// ReorderReasonNotSaleable the product is not saleable anymore
+	ReorderReasonNotSaleable = "not_saleable"
+	// ReorderReasonQtyRestricted the qty is restricted by the RestrictionService, e.g. because of the available stock
+	ReorderReasonQtyRestricted = "qty_restricted"
+	// ReorderReasonAddFailed the product could not be added to the cart
+	ReorderReasonAddFailed = "add_failed"
+)
+
+var (
+	// ErrNoOrderService is returned if no CustomerIdentityOrderService is bound
+	ErrNoOrderService = errors.New("no order service available")
+)
+
+// AddFromOrder adds the still saleable items of a past order of the logged in customer to the current cart.
+// The items are added to the deliveries with the codes of the order deliveries, the qty is reduced if it is restricted.
+// Deliveries that are not yet in the cart are created with the delivery info of the order, bundles are added with the
+// components chosen in the order.
+func (cs *CartService) AddFromOrder(ctx context.Context, session *web.Session, orderID string) (*ReorderResult, error) {
+	if cs.orderService == nil {
+		return nil, ErrNoOrderService
+	}
+
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return nil, application.ErrNoIdentity
+	}
+
+	order, err := cs.orderService.GetByID(ctx, identity, orderID)
+	if err != nil {
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "AddFromOrder").Error(err)
+
+		return nil, err
+	}
+
+	result := &ReorderResult{
+		OrderID:  order.ID,
+		Added:    []ReorderedItem{},
+		NotAdded: []NotReorderedItem{},
+	}
+	for _, delivery := range order.Deliveries {
+		deliveryInfo := reorderDeliveryInfo(delivery.DeliveryInfo, cs.defaultDeliveryCode)
+		for _, item := range delivery.Items {
+			cs.reorderItem(ctx, session, deliveryInfo, item, result)
+		}
+	}
+
+	return result, nil
+}
+
+// reorderDeliveryInfo returns the delivery info of the order delivery for the cart, the desired time of the past order is not taken over
+func reorderDeliveryInfo(deliveryInfo cartDomain.DeliveryInfo, defaultDeliveryCode string) cartDomain.DeliveryInfo {
+	if deliveryInfo.Code == "" {
+		deliveryInfo.Code = defaultDeliveryCode
+	}
+	deliveryInfo.DesiredTime = time.Time{}
+
+	return deliveryInfo
+}
+
+// reorderItem adds the item to the cart and records the outcome in the result
+func (cs *CartService) reorderItem(ctx context.Context, session *web.Session, deliveryInfo cartDomain.DeliveryInfo, item *orderDomain.OrderItem, result *ReorderResult) {
+	deliveryCode := deliveryInfo.Code
+	notAdded := func(qty int, reason string, message string) {
+		result.NotAdded = append(result.NotAdded, NotReorderedItem{
+			MarketplaceCode:        item.MarketplaceCode,
+			VariantMarketplaceCode: item.VariantMarketplaceCode,
+			Name:                   item.Name,
+			Qty:                    qty,
+			Reason:                 reason,
+			Message:                message,
+		})
+	}
+
+	product, err := cs.reorderProduct(ctx, item)
+	if err != nil {
+		notAdded(item.Qty, ReorderReasonProductNotFound, err.Error())
+		return
+	}
+
+	if bundle, ok := product.(productDomain.BundleProduct); ok && bundle.Saleable.IsSaleable {
+		product, err = bundle.GetBundleWithActiveChoices(item.BundleConfiguration)
+		if err != nil {
+			notAdded(item.Qty, ReorderReasonNotSaleable, err.Error())
+			return
+		}
+	}
+
+	if !product.IsSaleable() {
+		notAdded(item.Qty, ReorderReasonNotSaleable, "product is not saleable")
+		return
+	}
+
+	qty := item.Qty
+	cart, _, err := cs.cartReceiverService.GetCart(ctx, session)
+	if err != nil {
+		notAdded(item.Qty, ReorderReasonAddFailed, err.Error())
+		return
+	}
+
+	restrictionResult := cs.restrictionService.RestrictQty(ctx, session, product, cart, deliveryCode)
+	if restrictionResult.IsRestricted && qty > restrictionResult.RemainingDifference {
+		qty = restrictionResult.RemainingDifference
+		if qty < 0 {
+			qty = 0
+		}
+		notAdded(item.Qty-qty, ReorderReasonQtyRestricted, restrictionResult.RestrictorName)
+	}
+
+	if qty == 0 {
+		return
+	}
+
+	if !cart.HasDeliveryForCode(deliveryCode) {
+		err = cs.UpdateDeliveryInfo(ctx, session, deliveryCode, cartDomain.CreateDeliveryInfoUpdateCommand(deliveryInfo))
+		if err != nil {
+			notAdded(qty, ReorderReasonAddFailed, err.Error())
+			return
+		}
+	}
+
+	addRequest := cs.BuildAddRequest(ctx, item.MarketplaceCode, item.VariantMarketplaceCode, qty, nil)
+	addRequest.BundleConfiguration = item.BundleConfiguration
+	_, err = cs.AddProduct(ctx, session, deliveryCode, addRequest)
+	if err != nil {
+		notAdded(qty, ReorderReasonAddFailed, err.Error())
+		return
+	}
+
+	result.Added = append(result.Added, ReorderedItem{
+		MarketplaceCode:        item.MarketplaceCode,
+		VariantMarketplaceCode: item.VariantMarketplaceCode,
+		DeliveryCode:           deliveryCode,
+		Qty:                    qty,
+	})
+}
+
+// reorderProduct loads the current product of the item, the active variant for configurable products
+func (cs *CartService) reorderProduct(ctx context.Context, item *orderDomain.OrderItem) (productDomain.BasicProduct, error) {
+	product, err := cs.productService.Get(ctx, item.MarketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Type() != productDomain.TypeConfigurable {
+		return product, nil
+	}
+
+	configurable, ok := product.(productDomain.ConfigurableProduct)
+	if !ok || !configurable.HasVariant(item.VariantMarketplaceCode) {
+		return nil, errors.Errorf("variant %q of product %q not found", item.VariantMarketplaceCode, item.MarketplaceCode)
+	}
+
+	return configurable.GetConfigurableWithActiveVariant(item.VariantMarketplaceCode)
+}
+
+// HasNotAddedItems returns true if at least one item could not be added completely
+func (r ReorderResult) HasNotAddedItems() bool {
+	return len(r.NotAdded) > 0
+}
@@ -0,0 +1,311 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
+	"github.com/lunarforge/flamingo_commerce/cart/infrastructure"
+	customerApplication "github.com/lunarforge/flamingo_commerce/customer/application"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	reorderOrderService struct {
+		orders map[string]*orderDomain.Order
+	}
+
+	reorderProductService struct{}
+
+	// saleableReorderProductService returns a saleable simple product and a saleable bundle with a memory option group
+	saleableReorderProductService struct{}
+)
+
+func (s *reorderOrderService) Get(context.Context, auth.Identity) ([]*orderDomain.Order, error) {
+	return nil, nil
+}
+
+func (s *reorderOrderService) GetByID(_ context.Context, _ auth.Identity, orderID string) (*orderDomain.Order, error) {
+	if order, ok := s.orders[orderID]; ok {
+		return order, nil
+	}
+
+	return nil, orderDomain.ErrOrderNotFound
+}
+
+func (s *reorderOrderService) Find(context.Context, auth.Identity, orderDomain.Filter) (*orderDomain.OrderPage, error) {
+	return nil, nil
+}
+
+func (s *reorderProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	if marketplaceCode == "bundle" {
+		return productDomain.BundleProduct{}, nil
+	}
+
+	return nil, productDomain.ProductNotFound{MarketplaceCode: marketplaceCode}
+}
+
+func (saleableReorderProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	saleable := productDomain.Saleable{
+		IsSaleable:  true,
+		ActivePrice: productDomain.PriceInfo{Default: priceDomain.NewFromInt(1000, 100, "EUR")},
+	}
+
+	switch marketplaceCode {
+	case "simple":
+		return productDomain.SimpleProduct{
+			BasicProductData: productDomain.BasicProductData{MarketPlaceCode: marketplaceCode, Title: marketplaceCode},
+			Saleable:         saleable,
+		}, nil
+	case "bundle":
+		return productDomain.BundleProduct{
+			BasicProductData: productDomain.BasicProductData{MarketPlaceCode: marketplaceCode, Title: marketplaceCode},
+			Saleable:         saleable,
+			OptionGroups: []productDomain.BundleOptionGroup{
+				{
+					Code:       "memory",
+					MinChoices: 1,
+					MaxChoices: 1,
+					Choices: []productDomain.BundleChoice{
+						{Code: "8gb", Surcharge: priceDomain.NewZero("EUR")},
+						{Code: "16gb", Surcharge: priceDomain.NewFromInt(5000, 100, "EUR")},
+					},
+				},
+			},
+		}, nil
+	}
+
+	return nil, productDomain.ProductNotFound{MarketplaceCode: marketplaceCode}
+}
+
+func newReorderCartService(identity auth.Identity, orderService orderDomain.CustomerIdentityOrderService) *cartApplication.CartService {
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			if identity == nil {
+				return nil, errors.New("not logged in")
+			}
+			return identity, nil
+		},
+	)
+
+	cs := &cartApplication.CartService{}
+	cs.Inject(
+		nil,
+		&reorderProductService{},
+		nil,
+		nil,
+		nil,
+		nil,
+		new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil),
+		flamingo.NullLogger{},
+		&struct {
+			DefaultDeliveryCode string `inject:"config:commerce.cart.defaultDeliveryCode,optional"`
+			DeleteEmptyDelivery bool   `inject:"config:commerce.cart.deleteEmptyDelivery,optional"`
+		}{DefaultDeliveryCode: "default"},
+		&struct {
+			CartValidator     validation.Validator                     `inject:",optional"`
+			ItemValidator     validation.ItemValidator                 `inject:",optional"`
+			CartCache         cartApplication.CartCache                `inject:",optional"`
+			PlaceOrderService placeorder.Service                       `inject:",optional"`
+			CouponPolicy      cartApplication.CouponPolicy             `inject:",optional"`
+			OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
+		}{OrderService: orderService},
+	)
+
+	return cs
+}
+
+func TestCartService_AddFromOrder(t *testing.T) {
+	t.Parallel()
+
+	orderService := &reorderOrderService{orders: map[string]*orderDomain.Order{
+		"order-1": {
+			ID: "order-1",
+			Deliveries: []*orderDomain.Delivery{
+				{
+					Items: []*orderDomain.OrderItem{
+						{MarketplaceCode: "missing", Name: "Missing", Qty: 1},
+						{MarketplaceCode: "bundle", Name: "Bundle", Qty: 2},
+					},
+				},
+			},
+		},
+	}}
+
+	ctx := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, web.EmptySession()))
+
+	t.Run("no order service", func(t *testing.T) {
+		t.Parallel()
+
+		cs := newReorderCartService(&authMock.Identity{Sub: "customer"}, nil)
+		_, err := cs.AddFromOrder(ctx, web.EmptySession(), "order-1")
+		assert.Equal(t, cartApplication.ErrNoOrderService, err)
+	})
+
+	t.Run("no identity", func(t *testing.T) {
+		t.Parallel()
+
+		cs := newReorderCartService(nil, orderService)
+		_, err := cs.AddFromOrder(ctx, web.EmptySession(), "order-1")
+		assert.Equal(t, customerApplication.ErrNoIdentity, err)
+	})
+
+	t.Run("unknown order", func(t *testing.T) {
+		t.Parallel()
+
+		cs := newReorderCartService(&authMock.Identity{Sub: "customer"}, orderService)
+		_, err := cs.AddFromOrder(ctx, web.EmptySession(), "order-2")
+		assert.Equal(t, orderDomain.ErrOrderNotFound, err)
+	})
+
+	t.Run("items that are not available anymore are reported", func(t *testing.T) {
+		t.Parallel()
+
+		cs := newReorderCartService(&authMock.Identity{Sub: "customer"}, orderService)
+		result, err := cs.AddFromOrder(ctx, web.EmptySession(), "order-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "order-1", result.OrderID)
+		assert.Empty(t, result.Added)
+		assert.True(t, result.HasNotAddedItems())
+		require.Len(t, result.NotAdded, 2)
+		assert.Equal(t, cartApplication.ReorderReasonProductNotFound, result.NotAdded[0].Reason)
+		assert.Equal(t, "Missing", result.NotAdded[0].Name)
+		assert.Equal(t, cartApplication.ReorderReasonNotSaleable, result.NotAdded[1].Reason)
+		assert.Equal(t, 2, result.NotAdded[1].Qty)
+	})
+}
+
+// newInMemoryReorderCartService returns a cart service working on an in memory guest cart for the logged in customer
+func newInMemoryReorderCartService(orderService orderDomain.CustomerIdentityOrderService) (*cartApplication.CartService, *cartApplication.CartReceiverService) {
+	storage := new(infrastructure.InMemoryCartStorage).Inject()
+	behaviour := new(infrastructure.DefaultCartBehaviour)
+	behaviour.Inject(
+		storage,
+		saleableReorderProductService{},
+		flamingo.NullLogger{},
+		func() *cartDomain.ItemBuilder { return &cartDomain.ItemBuilder{} },
+		func() *cartDomain.DeliveryBuilder { return &cartDomain.DeliveryBuilder{} },
+		func() *cartDomain.Builder { return &cartDomain.Builder{} },
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	guestCartService := new(infrastructure.DefaultGuestCartService)
+	guestCartService.Inject(behaviour, flamingo.NullLogger{})
+
+	// the cart receiver works on the guest cart, the cart service identifies the customer to load the order
+	guestIdentifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			return nil, errors.New("not logged in")
+		},
+	)
+	customerIdentifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			return &authMock.Identity{Sub: "customer"}, nil
+		},
+	)
+
+	cartReceiver := new(cartApplication.CartReceiverService)
+	cartReceiver.Inject(guestCartService, nil, nil, new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{guestIdentifier}, nil, nil, nil), flamingo.NullLogger{}, new(recordingEventRouter), nil)
+
+	cs := new(cartApplication.CartService)
+	cs.Inject(
+		cartReceiver,
+		saleableReorderProductService{},
+		nil,
+		new(recordingEventRouter),
+		new(MockDeliveryInfoBuilder),
+		new(validation.RestrictionService).Inject(nil),
+		new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{customerIdentifier}, nil, nil, nil),
+		flamingo.NullLogger{},
+		&struct {
+			DefaultDeliveryCode string `inject:"config:commerce.cart.defaultDeliveryCode,optional"`
+			DeleteEmptyDelivery bool   `inject:"config:commerce.cart.deleteEmptyDelivery,optional"`
+		}{DefaultDeliveryCode: "default"},
+		&struct {
+			CartValidator     validation.Validator                     `inject:",optional"`
+			ItemValidator     validation.ItemValidator                 `inject:",optional"`
+			CartCache         cartApplication.CartCache                `inject:",optional"`
+			PlaceOrderService placeorder.Service                       `inject:",optional"`
+			CouponPolicy      cartApplication.CouponPolicy             `inject:",optional"`
+			OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
+		}{OrderService: orderService},
+	)
+
+	return cs, cartReceiver
+}
+
+func TestCartService_AddFromOrderCopiesDeliveryInfoAndBundleConfiguration(t *testing.T) {
+	t.Parallel()
+
+	bundleConfiguration := productDomain.BundleConfiguration{{OptionGroupCode: "memory", ChoiceCode: "16gb", Qty: 1}}
+	orderService := &reorderOrderService{orders: map[string]*orderDomain.Order{
+		"order-1": {
+			ID: "order-1",
+			Deliveries: []*orderDomain.Delivery{
+				{
+					DeliveryInfo: cartDomain.DeliveryInfo{
+						Code:             "pickup",
+						Workflow:         cartDomain.DeliveryWorkflowPickup,
+						Method:           "express",
+						DeliveryLocation: cartDomain.DeliveryLocation{Type: cartDomain.DeliverylocationTypeCollectionpoint, Code: "store-1"},
+						DesiredTime:      time.Now().AddDate(0, -1, 0),
+						AdditionalData:   map[string]string{"note": "ring twice"},
+					},
+					Items: []*orderDomain.OrderItem{
+						{MarketplaceCode: "simple", Name: "Simple", Qty: 2},
+						{MarketplaceCode: "bundle", Name: "Bundle", Qty: 1, BundleConfiguration: bundleConfiguration},
+					},
+				},
+				{
+					Items: []*orderDomain.OrderItem{
+						{MarketplaceCode: "bundle", Name: "Bundle", Qty: 1, BundleConfiguration: productDomain.BundleConfiguration{{OptionGroupCode: "memory", ChoiceCode: "unknown"}}},
+					},
+				},
+			},
+		},
+	}}
+
+	cs, cartReceiver := newInMemoryReorderCartService(orderService)
+	session := web.EmptySession()
+	ctx := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, session))
+
+	result, err := cs.AddFromOrder(ctx, session, "order-1")
+	require.NoError(t, err)
+	assert.Len(t, result.Added, 2)
+	require.Len(t, result.NotAdded, 1)
+	assert.Equal(t, cartApplication.ReorderReasonNotSaleable, result.NotAdded[0].Reason, "the chosen component doesn't exist anymore")
+
+	cart, _, err := cartReceiver.GetCart(ctx, session)
+	require.NoError(t, err)
+	delivery, found := cart.GetDeliveryByCode("pickup")
+	require.True(t, found)
+	assert.Equal(t, cartDomain.DeliveryWorkflowPickup, delivery.DeliveryInfo.Workflow)
+	assert.Equal(t, "express", delivery.DeliveryInfo.Method)
+	assert.Equal(t, "store-1", delivery.DeliveryInfo.DeliveryLocation.Code)
+	assert.Equal(t, "ring twice", delivery.DeliveryInfo.AdditionalData["note"])
+	assert.True(t, delivery.DeliveryInfo.DesiredTime.IsZero(), "the desired time of the past order is not taken over")
+	assert.False(t, cart.HasDeliveryForCode("default"), "no delivery is created for items that were not added")
+
+	require.Len(t, delivery.Cartitems, 2)
+	assert.Equal(t, "simple", delivery.Cartitems[0].MarketplaceCode)
+	assert.Equal(t, 2, delivery.Cartitems[0].Qty)
+	assert.Equal(t, "bundle", delivery.Cartitems[1].MarketplaceCode)
+	assert.True(t, bundleConfiguration.Equals(delivery.Cartitems[1].BundleConfiguration))
+}
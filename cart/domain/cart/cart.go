@@ -23,6 +23,9 @@ type (
 		// EntityID is a second identifier that may be used by some backends
 		EntityID string
 
+		// Name distinguishes the carts of a customer that keeps multiple carts (e.g. "main" or "saved for later")
+		Name string
+
 		// BillingAddress is the main billing address (relevant for all payments/invoices)
 		BillingAddress *Address
 
@@ -54,6 +57,9 @@ type (
 
 		// AppliedGiftCards is a list of applied gift cards
 		AppliedGiftCards []AppliedGiftCard
+
+		// Version is the revision of the cart, maintained by storages that support optimistic locking
+		Version int64
 	}
 
 	// Teaser represents some teaser infos for cart
@@ -441,7 +447,9 @@ func (c Cart) SumTaxes() Taxes {
 
 	for _, del := range c.Deliveries {
 		newTaxes = newTaxes.AddTaxesWithMerge(del.SumRowTaxes())
-		if !del.ShippingItem.TaxAmount.IsZero() {
+		if len(del.ShippingItem.Taxes) > 0 {
+			newTaxes = newTaxes.AddTaxesWithMerge(del.ShippingItem.Taxes)
+		} else if !del.ShippingItem.TaxAmount.IsZero() {
 			newTaxes = newTaxes.AddTax(del.ShippingItem.Tax())
 		}
 	}
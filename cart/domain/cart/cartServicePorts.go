@@ -3,11 +3,14 @@ package cart
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"flamingo.me/flamingo/v3/core/auth"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 
 	"github.com/pkg/errors"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
 )
 
 type (
@@ -25,6 +28,12 @@ type (
 		RestoreCart(ctx context.Context, cart Cart) (*Cart, error)
 	}
 
+	// GuestCartDeleter can be implemented by the GuestCartService to remove guest carts that are no longer used,
+	// e.g. after they have been merged into the customer cart
+	GuestCartDeleter interface {
+		DeleteCart(ctx context.Context, cartID string) error
+	}
+
 	// CustomerCartService interface - Secondary PORT
 	CustomerCartService interface {
 		// GetModifyBehaviour gets the behaviour for the customer cart service
@@ -37,6 +46,23 @@ type (
 		RestoreCart(ctx context.Context, identity auth.Identity, cart Cart) (*Cart, error)
 	}
 
+	// NamedCartService interface - can be implemented by the CustomerCartService to support multiple named carts per customer.
+	// The cart returned by CustomerCartService.GetCart with cartID "me" is the main cart of the customer
+	NamedCartService interface {
+		// ListCarts returns all carts of the authenticated user
+		ListCarts(ctx context.Context, identity auth.Identity) ([]*Cart, error)
+		// CreateCart creates a new empty cart with the given name for the authenticated user
+		CreateCart(ctx context.Context, identity auth.Identity, name string) (*Cart, error)
+		// DeleteCart deletes the cart with the given id of the authenticated user
+		DeleteCart(ctx context.Context, identity auth.Identity, cartID string) error
+	}
+
+	// AbandonedCartFinder interface - Secondary PORT used to detect abandoned carts
+	AbandonedCartFinder interface {
+		// FindCartsModifiedBetween returns all carts whose last modification is within [from, to)
+		FindCartsModifiedBetween(ctx context.Context, from time.Time, to time.Time) ([]*Cart, error)
+	}
+
 	// DeferEvents represents events that should be dispatched after a cart modify call
 	DeferEvents []flamingo.Event
 
@@ -85,6 +111,8 @@ type (
 		Qty                    int
 		VariantMarketplaceCode string
 		AdditionalData         map[string]string
+		// BundleConfiguration contains the chosen components if a bundle product is added
+		BundleConfiguration domain.BundleConfiguration
 	}
 
 	// ItemUpdateCommand defines the update item command
@@ -113,6 +141,13 @@ var (
 	ErrItemNotFound = errors.New("Item not found")
 	// ErrDeliveryCodeNotFound is used if a delivery was not found
 	ErrDeliveryCodeNotFound = errors.New("Delivery not found")
+	// ErrCartNameAlreadyUsed is used if a customer tries to create a second cart with the same name
+	ErrCartNameAlreadyUsed = errors.New("Cart name already used")
+)
+
+const (
+	// MainCartName is the name of the cart that is used if a customer did not select another cart
+	MainCartName = "main"
 )
 
 // CreateDeliveryInfoUpdateCommand - factory to get the update command based on the given deliveryInfos (which might come from cart)
@@ -46,6 +46,8 @@ type (
 		PriceNet         priceDomain.Price
 		TaxAmount        priceDomain.Price
 		AppliedDiscounts AppliedDiscounts
+		// Taxes optionally splits the TaxAmount by tax type and rate
+		Taxes Taxes
 	}
 
 	// AdditionalDeliverInfo is an interface that allows to store "any" additional objects on the cart
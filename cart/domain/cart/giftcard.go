@@ -1,6 +1,10 @@
 package cart
 
 import (
+	"context"
+
+	"github.com/pkg/errors"
+
 	"github.com/lunarforge/flamingo_commerce/price/domain"
 )
 
@@ -16,6 +20,26 @@ type (
 	// AppliedGiftCards convenience wrapper for array of applied gift cards
 	AppliedGiftCards []AppliedGiftCard
 
+	// GiftCardBalance value object represents the current balance of a gift card
+	GiftCardBalance struct {
+		Code      string
+		Available domain.Price // how much of the gift card can still be used
+		Reserved  domain.Price // how much of the gift card is blocked by completed but not yet placed carts
+	}
+
+	// GiftCardService interface - Secondary PORT to manage the balance of gift cards
+	GiftCardService interface {
+		// Balance returns the current balance of the gift card
+		Balance(ctx context.Context, code string) (*GiftCardBalance, error)
+		// Reserve blocks the amount on the gift card for the reference (e.g. the cart id),
+		// reserving again for the same reference replaces the previous reservation
+		Reserve(ctx context.Context, code string, amount domain.Price, reference string) error
+		// Capture finally books the amount that is reserved for the reference
+		Capture(ctx context.Context, code string, reference string) error
+		// Release frees the amount that is reserved or captured for the reference
+		Release(ctx context.Context, code string, reference string) error
+	}
+
 	// WithGiftCard interface for a cart that is able to handle gift cards
 	WithGiftCard interface {
 		HasRemainingGiftCards() bool
@@ -28,6 +52,13 @@ type (
 var (
 	// interface assertion
 	_ WithGiftCard = &Cart{}
+
+	// ErrGiftCardNotFound is used if the gift card code is unknown
+	ErrGiftCardNotFound = errors.New("Gift card not found")
+	// ErrGiftCardInsufficientBalance is used if the gift card balance doesn't cover the amount to reserve
+	ErrGiftCardInsufficientBalance = errors.New("Gift card balance insufficient")
+	// ErrGiftCardReservationNotFound is used if there is no reservation of the gift card for the reference
+	ErrGiftCardReservationNotFound = errors.New("Gift card reservation not found")
 )
 
 // HasRemaining checks whether gift card has a remaining balance
@@ -46,6 +46,9 @@ type (
 
 		// AppliedDiscounts contains the details about the discounts applied to this item - they can be "itemrelated" or not
 		AppliedDiscounts AppliedDiscounts
+
+		// BundleConfiguration contains the chosen components if the item is a bundle product
+		BundleConfiguration domain.BundleConfiguration
 	}
 
 	// ItemBuilder can be used to construct an item with a fluent interface
@@ -206,6 +209,7 @@ func (f *ItemBuilder) SetFromItem(item Item) *ItemBuilder {
 	f.AddDiscounts(item.AppliedDiscounts...)
 	f.SetSinglePriceGross(item.SinglePriceGross)
 	f.SetSinglePriceNet(item.SinglePriceNet)
+	f.SetBundleConfiguration(item.BundleConfiguration)
 
 	return f
 }
@@ -217,6 +221,13 @@ func (f *ItemBuilder) SetVariantMarketPlaceCode(id string) *ItemBuilder {
 	return f
 }
 
+// SetBundleConfiguration sets the chosen components (only for bundles relevant)
+func (f *ItemBuilder) SetBundleConfiguration(configuration domain.BundleConfiguration) *ItemBuilder {
+	f.init()
+	f.itemInBuilding.BundleConfiguration = configuration
+	return f
+}
+
 // SetSourceID sets optional source ID
 func (f *ItemBuilder) SetSourceID(id string) *ItemBuilder {
 	f.init()
@@ -376,6 +387,10 @@ func (f *ItemBuilder) SetByProduct(product domain.BasicProduct) *ItemBuilder {
 		f.itemInBuilding.VariantMarketPlaceCode = configurable.ActiveVariant.MarketPlaceCode
 	}
 
+	if bundle, ok := product.(domain.BundleProductWithActiveChoices); ok {
+		f.itemInBuilding.BundleConfiguration = bundle.Configuration()
+	}
+
 	if f.configUseGrossPrice {
 		f.SetSinglePriceGross(product.SaleableData().ActivePrice.GetFinalPrice())
 		f.CalculatePricesAndTaxAmountsFromSinglePriceGross()
@@ -0,0 +1,29 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// TaxCalculator interface - Secondary PORT to determine the taxes of items and shipping items when they are built
+	TaxCalculator interface {
+		// ItemTaxes returns the taxes (type and rate) of an item of the product that is shipped to the address.
+		// The address may be nil if it is not known yet
+		ItemTaxes(ctx context.Context, address *Address, product domain.BasicProduct) (Taxes, error)
+		// ShippingTaxes returns the taxes (type, rate and amount) of the shipping item of the delivery
+		ShippingTaxes(ctx context.Context, address *Address, delivery Delivery) (Taxes, error)
+	}
+)
+
+// TaxAddress returns the address that is relevant for the taxes of the delivery:
+// the delivery address, or the billing address if the delivery uses it or has no address
+func (c Cart) TaxAddress(delivery Delivery) *Address {
+	location := delivery.DeliveryInfo.DeliveryLocation
+	if location.Address != nil && !location.UseBillingAddress {
+		return location.Address
+	}
+
+	return c.BillingAddress
+}
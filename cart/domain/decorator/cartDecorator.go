@@ -15,8 +15,10 @@ import (
 type (
 	// DecoratedCartFactory - Factory to be injected: If you need to create a new Decorator then get the factory injected and use the factory
 	DecoratedCartFactory struct {
-		productService domain.ProductService
-		logger         flamingo.Logger
+		productService      domain.ProductService
+		batchProductService domain.BatchProductService
+		maxParallelLookups  int
+		logger              flamingo.Logger
 	}
 
 	// DecoratedCart Decorates Access To a Cart
@@ -52,18 +54,31 @@ type (
 func (df *DecoratedCartFactory) Inject(
 	productService domain.ProductService,
 	logger flamingo.Logger,
+	optionals *struct {
+		BatchProductService domain.BatchProductService `inject:",optional"`
+		MaxParallelLookups  float64                    `inject:"config:commerce.product.maxParallelLookups,optional"`
+	},
 ) {
 	df.productService = productService
 	df.logger = logger
+	if optionals != nil {
+		df.batchProductService = optionals.BatchProductService
+		df.maxParallelLookups = int(optionals.MaxParallelLookups)
+	}
 }
 
 // Create Factory method to get Decorated Cart
 func (df *DecoratedCartFactory) Create(ctx context.Context, Cart cart.Cart) *DecoratedCart {
 	decoratedCart := DecoratedCart{Cart: Cart, Logger: df.logger}
+	var items []cart.Item
+	for _, d := range Cart.Deliveries {
+		items = append(items, d.Cartitems...)
+	}
+	products := df.loadProducts(ctx, items...)
 	for _, d := range Cart.Deliveries {
 		decoratedCart.DecoratedDeliveries = append(decoratedCart.DecoratedDeliveries, DecoratedDelivery{
 			Delivery:       d,
-			DecoratedItems: df.CreateDecorateCartItems(ctx, d.Cartitems),
+			DecoratedItems: df.decorateCartItems(ctx, d.Cartitems, products),
 			logger:         df.logger,
 		})
 	}
@@ -73,18 +88,38 @@ func (df *DecoratedCartFactory) Create(ctx context.Context, Cart cart.Cart) *Dec
 
 // CreateDecorateCartItems Factory method to get Decorated Cart
 func (df *DecoratedCartFactory) CreateDecorateCartItems(ctx context.Context, items []cart.Item) []DecoratedCartItem {
+	return df.decorateCartItems(ctx, items, df.loadProducts(ctx, items...))
+}
+
+func (df *DecoratedCartFactory) decorateCartItems(ctx context.Context, items []cart.Item, products domain.ProductLoadResults) []DecoratedCartItem {
 	var decoratedItems []DecoratedCartItem
 	for _, cartitem := range items {
-		decoratedItem := df.decorateCartItem(ctx, cartitem)
+		decoratedItem := df.decorateCartItem(ctx, cartitem, products)
 		decoratedItems = append(decoratedItems, decoratedItem)
 	}
 	return decoratedItems
 }
 
+// loadProducts gets the products of all items with one batch call or parallel calls, every product only once
+func (df *DecoratedCartFactory) loadProducts(ctx context.Context, items ...cart.Item) domain.ProductLoadResults {
+	marketplaceCodes := make([]string, 0, len(items))
+	for _, item := range items {
+		marketplaceCodes = append(marketplaceCodes, item.MarketplaceCode)
+	}
+
+	loader := domain.ProductLoader{
+		ProductService:      df.productService,
+		BatchProductService: df.batchProductService,
+		MaxParallel:         df.maxParallelLookups,
+	}
+
+	return loader.Load(ctx, marketplaceCodes)
+}
+
 // decorateCartItem factory method
-func (df *DecoratedCartFactory) decorateCartItem(ctx context.Context, cartitem cart.Item) DecoratedCartItem {
+func (df *DecoratedCartFactory) decorateCartItem(ctx context.Context, cartitem cart.Item, products domain.ProductLoadResults) DecoratedCartItem {
 	decorateditem := DecoratedCartItem{Item: cartitem, logger: df.logger}
-	product, e := df.productService.Get(ctx, cartitem.MarketplaceCode)
+	product, e := products.Get(cartitem.MarketplaceCode)
 	if e != nil {
 		df.logger.WithContext(ctx).Error("cart.decorator - no product for item", e)
 		if product == nil {
@@ -113,6 +148,18 @@ func (df *DecoratedCartFactory) decorateCartItem(ctx context.Context, cartitem c
 			}
 		}
 	}
+	if bundle, ok := product.(domain.BundleProduct); ok {
+		bundleWithActiveChoices, err := bundle.GetBundleWithActiveChoices(cartitem.BundleConfiguration)
+		if err != nil {
+			product = domain.SimpleProduct{
+				BasicProductData: domain.BasicProductData{
+					Title: cartitem.ProductName + "[outdated]",
+				},
+			}
+		} else {
+			product = bundleWithActiveChoices
+		}
+	}
 	decorateditem.Product = product
 	return decorateditem
 }
@@ -125,6 +172,14 @@ func (dci DecoratedCartItem) IsConfigurable() bool {
 	return dci.Product.Type() == domain.TypeConfigurableWithActiveVariant
 }
 
+// IsBundle - checks if current CartItem is a Bundle Product with chosen components
+func (dci DecoratedCartItem) IsBundle() bool {
+	if dci.Product == nil {
+		return false
+	}
+	return dci.Product.Type() == domain.TypeBundleWithActiveChoices
+}
+
 // GetVariant getter
 func (dci DecoratedCartItem) GetVariant() (*domain.Variant, error) {
 	return dci.Product.(domain.ConfigurableProductWithActiveVariant).Variant(dci.Item.VariantMarketPlaceCode)
@@ -0,0 +1,114 @@
+package decorator_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/decorator"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	countingProductService struct {
+		mutex sync.Mutex
+		calls map[string]int
+	}
+
+	batchProductService struct {
+		calls [][]string
+	}
+)
+
+func (s *countingProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	s.mutex.Lock()
+	s.calls[marketplaceCode]++
+	s.mutex.Unlock()
+
+	if marketplaceCode == "missing" {
+		return nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return domain.SimpleProduct{Identifier: marketplaceCode, BasicProductData: domain.BasicProductData{Title: marketplaceCode}}, nil
+}
+
+func (s *batchProductService) GetMany(_ context.Context, marketplaceCodes []string) (map[string]domain.BasicProduct, error) {
+	s.calls = append(s.calls, marketplaceCodes)
+
+	products := make(map[string]domain.BasicProduct)
+	for _, code := range marketplaceCodes {
+		if code != "missing" {
+			products[code] = domain.SimpleProduct{Identifier: code, BasicProductData: domain.BasicProductData{Title: code}}
+		}
+	}
+
+	return products, nil
+}
+
+func decoratorTestCart() cart.Cart {
+	return cart.Cart{
+		Deliveries: []cart.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "home"},
+				Cartitems: []cart.Item{
+					{ID: "1", MarketplaceCode: "product-a"},
+					{ID: "2", MarketplaceCode: "missing", ProductName: "Gone"},
+				},
+			},
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "pickup"},
+				Cartitems: []cart.Item{
+					{ID: "3", MarketplaceCode: "product-a"},
+					{ID: "4", MarketplaceCode: "product-b"},
+				},
+			},
+		},
+	}
+}
+
+func assertDecoratedTitles(t *testing.T, decoratedCart *decorator.DecoratedCart) {
+	t.Helper()
+
+	var titles []string
+	for _, delivery := range decoratedCart.DecoratedDeliveries {
+		for _, item := range delivery.DecoratedItems {
+			titles = append(titles, item.Product.BaseData().Title)
+		}
+	}
+	assert.Equal(t, []string{"product-a", "Gone[outdated]", "product-a", "product-b"}, titles)
+}
+
+func TestDecoratedCartFactory_Create(t *testing.T) {
+	t.Run("products are loaded once with parallel calls", func(t *testing.T) {
+		productService := &countingProductService{calls: make(map[string]int)}
+		factory := new(decorator.DecoratedCartFactory)
+		factory.Inject(productService, flamingo.NullLogger{}, nil)
+
+		decoratedCart := factory.Create(context.Background(), decoratorTestCart())
+
+		assertDecoratedTitles(t, decoratedCart)
+		assert.Equal(t, map[string]int{"product-a": 1, "missing": 1, "product-b": 1}, productService.calls)
+	})
+
+	t.Run("products are loaded with one batch call", func(t *testing.T) {
+		productService := &countingProductService{calls: make(map[string]int)}
+		batchService := new(batchProductService)
+		factory := new(decorator.DecoratedCartFactory)
+		factory.Inject(productService, flamingo.NullLogger{}, &struct {
+			BatchProductService domain.BatchProductService `inject:",optional"`
+			MaxParallelLookups  float64                    `inject:"config:commerce.product.maxParallelLookups,optional"`
+		}{BatchProductService: batchService})
+
+		decoratedCart := factory.Create(context.Background(), decoratorTestCart())
+
+		assertDecoratedTitles(t, decoratedCart)
+		require.Len(t, batchService.calls, 1)
+		assert.Equal(t, []string{"product-a", "missing", "product-b"}, batchService.calls[0])
+		assert.Empty(t, productService.calls)
+	})
+}
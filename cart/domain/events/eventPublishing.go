@@ -33,6 +33,8 @@ var (
 	_ flamingo.Event = (*AddToCartEvent)(nil)
 	_ flamingo.Event = (*PaymentSelectionHasBeenResetEvent)(nil)
 	_ flamingo.Event = (*ChangedQtyInCartEvent)(nil)
+	_ flamingo.Event = (*CartMergedEvent)(nil)
+	_ flamingo.Event = (*CartAbandonedEvent)(nil)
 )
 
 // Inject dependencies
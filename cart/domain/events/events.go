@@ -12,6 +12,13 @@ type (
 		PlacedOrderInfos placeorder.PlacedOrderInfos
 	}
 
+	// OrderCancelledEvent is dispatched after a placed order has been cancelled
+	OrderCancelledEvent struct {
+		// Cart is the cart of the cancelled order, nil if it is unknown
+		Cart             *cartDomain.Cart
+		PlacedOrderInfos placeorder.PlacedOrderInfos
+	}
+
 	// AddToCartEvent defines event properties
 	AddToCartEvent struct {
 		Cart                   *cartDomain.Cart
@@ -38,4 +45,78 @@ type (
 		Cart                     *cartDomain.Cart
 		ResettedPaymentSelection *cartDomain.PaymentSelection
 	}
+
+	// CartMergedEvent is dispatched after the guest cart has been merged into the customer cart
+	CartMergedEvent struct {
+		GuestCart    *cartDomain.Cart
+		CustomerCart *cartDomain.Cart
+		Result       CartMergeResult
+	}
+
+	// CartAbandonedEvent is dispatched for carts with items and a contact mail that have not been modified for a while
+	CartAbandonedEvent struct {
+		Cart        *cartDomain.Cart
+		ContactMail string
+		// RecoveryToken is a signed token that restores the cart in the session of a visitor, see route cart.recover
+		RecoveryToken string
+	}
+
+	// CartMergeResult describes what happened to the guest cart during the merge
+	CartMergeResult struct {
+		// Strategy is the name of the used merge strategy
+		Strategy string
+		// MergedItems are the items that have been taken over, Qty might differ from RequestedQty
+		MergedItems []CartMergeItem
+		// DroppedItems are the items that are not (or no longer) part of the resulting cart
+		DroppedItems []CartMergeItem
+		// DroppedCouponCodes are the coupon codes of the guest cart that couldn't be applied
+		DroppedCouponCodes []CartMergeCode
+		// DroppedGiftCards are the gift cards of the guest cart that couldn't be applied
+		DroppedGiftCards []CartMergeCode
+	}
+
+	// CartMergeItem describes the merge result of a single cart item
+	CartMergeItem struct {
+		DeliveryCode           string
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		ProductName            string
+		RequestedQty           int
+		Qty                    int
+		// Reason is one of the CartMergeReason constants, empty if the item has been merged unchanged
+		Reason string
+		// Message contains additional details, e.g. the error that occurred
+		Message string
+	}
+
+	// CartMergeCode describes a coupon code or gift card that has been dropped during the merge
+	CartMergeCode struct {
+		Code    string
+		Reason  string
+		Message string
+	}
+)
+
+// Reasons for changed or dropped items / codes during the cart merge
+const (
+	CartMergeReasonKeptCustomerCart = "keptCustomerCart"
+	CartMergeReasonReplaced         = "replacedByGuestCart"
+	CartMergeReasonDuplicate        = "duplicate"
+	CartMergeReasonRestricted       = "restricted"
+	CartMergeReasonError            = "error"
 )
+
+// HasChanges returns true if anything of the guest cart has not been taken over as it was
+func (r CartMergeResult) HasChanges() bool {
+	if len(r.DroppedItems) > 0 || len(r.DroppedCouponCodes) > 0 || len(r.DroppedGiftCards) > 0 {
+		return true
+	}
+
+	for _, item := range r.MergedItems {
+		if item.Qty != item.RequestedQty {
+			return true
+		}
+	}
+
+	return false
+}
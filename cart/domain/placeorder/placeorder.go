@@ -90,8 +90,17 @@ const (
 	PaymentStatusAuthorized = "AUTHORIZED"
 	// PaymentStatusOpen payment is still open
 	PaymentStatusOpen = "OPEN"
+
+	// HoldForReviewAttribute is set to "true" in the custom attributes of the cart if the order must be held for a manual review,
+	// e.g. because of its fraud risk. Services that support it place such carts in a held status.
+	HoldForReviewAttribute = "placeorder.holdForReview"
 )
 
+// IsHeldForReview checks if the order of the cart must be held for a manual review
+func IsHeldForReview(c *cart.Cart) bool {
+	return c.AdditionalData.CustomAttributes[HoldForReviewAttribute] == "true"
+}
+
 // AddTransaction for a paymentInfo with items
 func (cp *Payment) AddTransaction(transaction Transaction) {
 	cp.Transactions = append(cp.Transactions, transaction)
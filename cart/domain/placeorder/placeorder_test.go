@@ -0,0 +1,20 @@
+package placeorder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+)
+
+func TestIsHeldForReview(t *testing.T) {
+	assert.False(t, placeorder.IsHeldForReview(&cart.Cart{}), "cart without custom attributes")
+
+	held := &cart.Cart{AdditionalData: cart.AdditionalData{CustomAttributes: map[string]string{placeorder.HoldForReviewAttribute: "true"}}}
+	assert.True(t, placeorder.IsHeldForReview(held))
+
+	notHeld := &cart.Cart{AdditionalData: cart.AdditionalData{CustomAttributes: map[string]string{placeorder.HoldForReviewAttribute: "false"}}}
+	assert.False(t, placeorder.IsHeldForReview(notHeld))
+}
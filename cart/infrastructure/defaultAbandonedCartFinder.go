@@ -0,0 +1,39 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+type (
+	// DefaultAbandonedCartFinder finds abandoned carts in the CartStorage of the default cart adapter
+	DefaultAbandonedCartFinder struct {
+		cartStorage CartStorage
+	}
+)
+
+var (
+	_ domaincart.AbandonedCartFinder = (*DefaultAbandonedCartFinder)(nil)
+)
+
+// Inject dependencies
+func (f *DefaultAbandonedCartFinder) Inject(cartStorage CartStorage) *DefaultAbandonedCartFinder {
+	f.cartStorage = cartStorage
+
+	return f
+}
+
+// FindCartsModifiedBetween returns all carts whose last modification is within [from, to),
+// the CartStorage has to implement the ActivityCartStorage
+func (f *DefaultAbandonedCartFinder) FindCartsModifiedBetween(ctx context.Context, from time.Time, to time.Time) ([]*domaincart.Cart, error) {
+	storage, ok := f.cartStorage.(ActivityCartStorage)
+	if !ok {
+		return nil, errors.Errorf("cart storage %T does not support the abandoned cart detection", f.cartStorage)
+	}
+
+	return storage.GetCartsModifiedBetween(ctx, from, to)
+}
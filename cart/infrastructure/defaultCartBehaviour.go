@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"reflect"
 	"strconv"
+	"time"
 
 	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
@@ -26,6 +28,9 @@ type (
 		cartBuilderProvider     domaincart.BuilderProvider
 		giftCardHandler         GiftCardHandler
 		voucherHandler          VoucherHandler
+		taxCalculator           domaincart.TaxCalculator
+		batchProductService     domain.BatchProductService
+		maxParallelLookups      int
 		defaultTaxRate          float64
 	}
 
@@ -37,6 +42,18 @@ type (
 		RemoveCart(ctx context.Context, cart *domaincart.Cart) error
 	}
 
+	// CustomerCartStorage can be implemented by a CartStorage to support multiple carts per customer
+	CustomerCartStorage interface {
+		GetCartsByCustomer(ctx context.Context, customerID string) ([]*domaincart.Cart, error)
+	}
+
+	// ActivityCartStorage can be implemented by a CartStorage that tracks the modification time of the stored carts,
+	// it is required for the abandoned cart detection
+	ActivityCartStorage interface {
+		// GetCartsModifiedBetween returns all carts whose last modification is within [from, to)
+		GetCartsModifiedBetween(ctx context.Context, from time.Time, to time.Time) ([]*domaincart.Cart, error)
+	}
+
 	// GiftCardHandler enables the projects to have specific GiftCard handling within the in-memory cart
 	GiftCardHandler interface {
 		ApplyGiftCard(ctx context.Context, cart *domaincart.Cart, giftCardCode string) (*domaincart.Cart, error)
@@ -49,8 +66,30 @@ type (
 		RemoveVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, error)
 	}
 
-	// DefaultGiftCardHandler implements a basic gift card handler
-	DefaultGiftCardHandler struct{}
+	// DiscountRecalculator can be implemented by a VoucherHandler whose discounts depend on the cart content,
+	// the DefaultCartBehaviour calls it whenever the items or deliveries of a cart change
+	DiscountRecalculator interface {
+		RecalculateDiscounts(ctx context.Context, cart *domaincart.Cart) (*domaincart.Cart, error)
+	}
+
+	// VoucherReleaser can be implemented by a VoucherHandler that counts the usages of its codes,
+	// the DefaultCartBehaviour calls it when the applied codes of a cart are dropped without being removed one by one
+	VoucherReleaser interface {
+		ReleaseVouchers(ctx context.Context, cart *domaincart.Cart) error
+	}
+
+	// GiftCardReservationHandler can be implemented by a GiftCardHandler to reserve the applied gift cards
+	// when the cart is completed and to release them again when the cart is restored
+	GiftCardReservationHandler interface {
+		ReserveGiftCards(ctx context.Context, cart *domaincart.Cart) error
+		ReleaseGiftCards(ctx context.Context, cart *domaincart.Cart) error
+	}
+
+	// DefaultGiftCardHandler implements a basic gift card handler,
+	// the balance of the gift cards is managed by the GiftCardService if one is bound
+	DefaultGiftCardHandler struct {
+		giftCardService domaincart.GiftCardService
+	}
 
 	// DefaultVoucherHandler implements a basic voucher handler
 	DefaultVoucherHandler struct{}
@@ -61,6 +100,7 @@ var (
 	_ domaincart.GiftCardAndVoucherBehaviour = (*DefaultCartBehaviour)(nil)
 	_ domaincart.CompleteBehaviour           = (*DefaultCartBehaviour)(nil)
 	_ GiftCardHandler                        = (*DefaultGiftCardHandler)(nil)
+	_ GiftCardReservationHandler             = (*DefaultGiftCardHandler)(nil)
 	_ VoucherHandler                         = (*DefaultVoucherHandler)(nil)
 )
 
@@ -77,6 +117,11 @@ func (cob *DefaultCartBehaviour) Inject(
 	config *struct {
 		DefaultTaxRate float64 `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
 	},
+	optionals *struct {
+		TaxCalculator       domaincart.TaxCalculator   `inject:",optional"`
+		BatchProductService domain.BatchProductService `inject:",optional"`
+		MaxParallelLookups  float64                    `inject:"config:commerce.product.maxParallelLookups,optional"`
+	},
 ) {
 	cob.cartStorage = CartStorage
 	cob.productService = ProductService
@@ -89,27 +134,56 @@ func (cob *DefaultCartBehaviour) Inject(
 	if config != nil {
 		cob.defaultTaxRate = config.DefaultTaxRate
 	}
+	if optionals != nil {
+		cob.taxCalculator = optionals.TaxCalculator
+		cob.batchProductService = optionals.BatchProductService
+		cob.maxParallelLookups = int(optionals.MaxParallelLookups)
+	}
 }
 
-// Complete a cart and remove from storage
+// Complete a cart, reserve the applied gift cards and remove the cart from storage
 func (cob *DefaultCartBehaviour) Complete(ctx context.Context, cart *domaincart.Cart) (*domaincart.Cart, domaincart.DeferEvents, error) {
+	reservationHandler, hasReservations := cob.giftCardHandler.(GiftCardReservationHandler)
+	if hasReservations {
+		err := reservationHandler.ReserveGiftCards(ctx, cart)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	err := cob.cartStorage.RemoveCart(ctx, cart)
 	if err != nil {
+		if hasReservations {
+			cob.releaseGiftCards(ctx, reservationHandler, cart)
+		}
 		return nil, nil, err
 	}
 	return cart, nil, nil
 }
 
-// Restore supplied cart (implements CompleteBehaviour)
+// Restore supplied cart and release its gift card reservations (implements CompleteBehaviour)
 func (cob *DefaultCartBehaviour) Restore(ctx context.Context, cart *domaincart.Cart) (*domaincart.Cart, domaincart.DeferEvents, error) {
 	newCart := cart
 	err := cob.cartStorage.StoreCart(ctx, newCart)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	if reservationHandler, ok := cob.giftCardHandler.(GiftCardReservationHandler); ok {
+		cob.releaseGiftCards(ctx, reservationHandler, newCart)
+	}
+
 	return newCart, nil, nil
 }
 
+// releaseGiftCards releases the gift card reservations of the cart, a failure must not prevent the cart from being restored
+func (cob *DefaultCartBehaviour) releaseGiftCards(ctx context.Context, reservationHandler GiftCardReservationHandler, cart *domaincart.Cart) {
+	err := reservationHandler.ReleaseGiftCards(ctx, cart)
+	if err != nil {
+		cob.logger.WithContext(ctx).Error(fmt.Sprintf("gift cards of cart %q could not be released: %v", cart.ID, err))
+	}
+}
+
 // DeleteItem removes an item from the cart
 func (cob *DefaultCartBehaviour) DeleteItem(ctx context.Context, cart *domaincart.Cart, itemID string, deliveryCode string) (*domaincart.Cart, domaincart.DeferEvents, error) {
 	if !cob.cartStorage.HasCart(ctx, cart.ID) {
@@ -136,7 +210,12 @@ func (cob *DefaultCartBehaviour) DeleteItem(ctx context.Context, cart *domaincar
 		}
 	}
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	cart, err := cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -154,6 +233,11 @@ func (cob *DefaultCartBehaviour) UpdateItem(ctx context.Context, cart *domaincar
 		return nil, nil, err
 	}
 
+	cart, err = cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
@@ -175,7 +259,12 @@ func (cob *DefaultCartBehaviour) UpdateItems(ctx context.Context, cart *domainca
 		}
 	}
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	cart, err := cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -201,7 +290,13 @@ func (cob *DefaultCartBehaviour) updateItem(ctx context.Context, cart *domaincar
 			if itemUpdateCommand.SourceID != nil {
 				itemBuilder.SetSourceID(*itemUpdateCommand.SourceID)
 			}
-			itemBuilder.AddTaxInfo("default", big.NewFloat(cob.defaultTaxRate), nil).CalculatePricesAndTax()
+			if cob.taxCalculator == nil {
+				itemBuilder.AddTaxInfo("default", big.NewFloat(cob.defaultTaxRate), nil)
+			} else {
+				// the taxes are recalculated with the current address and product afterwards
+				addTaxInfos(itemBuilder, item.RowTaxes)
+			}
+			itemBuilder.CalculatePricesAndTax()
 			newItem, err := itemBuilder.Build()
 			if err != nil {
 				return err
@@ -243,7 +338,7 @@ func (cob *DefaultCartBehaviour) AddToCart(ctx context.Context, cart *domaincart
 	delivery, _ := cart.GetDeliveryByCode(deliveryCode)
 
 	// create and add new item
-	cartItem, err := cob.buildItemForCart(ctx, addRequest)
+	cartItem, err := cob.buildItemForCart(ctx, cart.TaxAddress(*delivery), addRequest)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -252,7 +347,7 @@ func (cob *DefaultCartBehaviour) AddToCart(ctx context.Context, cart *domaincart
 	itemFound := false
 
 	for i, item := range delivery.Cartitems {
-		if item.MarketplaceCode == addRequest.MarketplaceCode {
+		if item.MarketplaceCode == addRequest.MarketplaceCode && item.BundleConfiguration.Equals(cartItem.BundleConfiguration) {
 			delivery.Cartitems[i] = *cartItem
 			itemFound = true
 		}
@@ -268,6 +363,11 @@ func (cob *DefaultCartBehaviour) AddToCart(ctx context.Context, cart *domaincart
 		}
 	}
 
+	cart, err = cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
@@ -276,7 +376,7 @@ func (cob *DefaultCartBehaviour) AddToCart(ctx context.Context, cart *domaincart
 	return cob.resetPaymentSelectionIfInvalid(ctx, cart)
 }
 
-func (cob *DefaultCartBehaviour) buildItemForCart(ctx context.Context, addRequest domaincart.AddRequest) (*domaincart.Item, error) {
+func (cob *DefaultCartBehaviour) buildItemForCart(ctx context.Context, address *domaincart.Address, addRequest domaincart.AddRequest) (*domaincart.Item, error) {
 	itemBuilder := cob.itemBuilderProvider()
 
 	// create and add new item
@@ -294,9 +394,21 @@ func (cob *DefaultCartBehaviour) buildItemForCart(ctx context.Context, addReques
 		product = productWithActiveVariant
 	}
 
-	itemBuilder.
-		SetQty(addRequest.Qty).
-		AddTaxInfo("default", big.NewFloat(cob.defaultTaxRate), nil).
+	// Get bundle with the chosen components
+	if bundleProduct, ok := product.(domain.BundleProduct); ok {
+		bundleWithActiveChoices, err := bundleProduct.GetBundleWithActiveChoices(addRequest.BundleConfiguration)
+		if err != nil {
+			return nil, err
+		}
+		product = bundleWithActiveChoices
+	}
+
+	taxes, err := cob.itemTaxes(ctx, address, product)
+	if err != nil {
+		return nil, err
+	}
+
+	addTaxInfos(itemBuilder.SetQty(addRequest.Qty), taxes).
 		SetByProduct(product).
 		SetID(strconv.Itoa(rand.Int())).
 		SetExternalReference(strconv.Itoa(rand.Int())).
@@ -311,6 +423,7 @@ func (cob *DefaultCartBehaviour) CleanCart(ctx context.Context, cart *domaincart
 		return nil, nil, fmt.Errorf("cart.infrastructure.DefaultCartBehaviour: Cannot delete - Guestcart with id %v not existent", cart.ID)
 	}
 
+	appliedVouchers := &domaincart.Cart{ID: cart.ID, AppliedCouponCodes: cart.AppliedCouponCodes}
 	cart.Deliveries = []domaincart.Delivery{}
 	cart.AppliedCouponCodes = nil
 	cart.AppliedGiftCards = nil
@@ -325,6 +438,8 @@ func (cob *DefaultCartBehaviour) CleanCart(ctx context.Context, cart *domaincart
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
 
+	cob.releaseVouchers(ctx, appliedVouchers)
+
 	return cart, nil, nil
 }
 
@@ -352,7 +467,12 @@ func (cob *DefaultCartBehaviour) CleanDelivery(ctx context.Context, cart *domain
 	cart.Deliveries[newLength] = domaincart.Delivery{}
 	cart.Deliveries = cart.Deliveries[:newLength]
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	cart, err := cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -379,8 +499,18 @@ func (cob *DefaultCartBehaviour) UpdatePurchaser(ctx context.Context, cart *doma
 // UpdateBillingAddress - updates address
 func (cob *DefaultCartBehaviour) UpdateBillingAddress(ctx context.Context, cart *domaincart.Cart, billingAddress domaincart.Address) (*domaincart.Cart, domaincart.DeferEvents, error) {
 
+	previousTaxAddresses := taxAddresses(cart)
 	cart.BillingAddress = &billingAddress
 
+	// deliveries without own address are taxed with the billing address
+	if changed := changedTaxAddresses(cart, previousTaxAddresses); len(changed) > 0 {
+		var err error
+		cart, err = cob.recalculateCart(ctx, cart, changed...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	err := cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
@@ -427,8 +557,13 @@ func (cob *DefaultCartBehaviour) UpdateDeliveryInfo(ctx context.Context, cart *d
 
 	for key, delivery := range cart.Deliveries {
 		if delivery.DeliveryInfo.Code == deliveryCode {
+			previousTaxAddresses := taxAddresses(cart)
 			cart.Deliveries[key].DeliveryInfo = deliveryInfo
-			err := cob.cartStorage.StoreCart(ctx, cart)
+			cart, err := cob.recalculateCart(ctx, cart, changedTaxAddresses(cart, previousTaxAddresses)...)
+			if err != nil {
+				return nil, nil, err
+			}
+			err = cob.cartStorage.StoreCart(ctx, cart)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 			}
@@ -437,7 +572,12 @@ func (cob *DefaultCartBehaviour) UpdateDeliveryInfo(ctx context.Context, cart *d
 	}
 	cart.Deliveries = append(cart.Deliveries, domaincart.Delivery{DeliveryInfo: deliveryInfo})
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	cart, err := cob.recalculateCart(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -471,6 +611,41 @@ func (cob *DefaultCartBehaviour) StoreNewCart(ctx context.Context, newCart *doma
 	return newCart, cob.cartStorage.StoreCart(ctx, newCart)
 }
 
+// GetCustomerCarts returns all stored carts of a customer, the cart storage has to implement CustomerCartStorage
+func (cob *DefaultCartBehaviour) GetCustomerCarts(ctx context.Context, customerID string) ([]*domaincart.Cart, error) {
+	storage, ok := cob.cartStorage.(CustomerCartStorage)
+	if !ok {
+		return nil, errors.New("cart.infrastructure.DefaultCartBehaviour: the cart storage does not support listing the carts of a customer")
+	}
+
+	return storage.GetCartsByCustomer(ctx, customerID)
+}
+
+// DeleteCart removes a cart from the storage and releases the usages of its vouchers
+func (cob *DefaultCartBehaviour) DeleteCart(ctx context.Context, cart *domaincart.Cart) error {
+	err := cob.cartStorage.RemoveCart(ctx, cart)
+	if err != nil {
+		return err
+	}
+
+	cob.releaseVouchers(ctx, cart)
+
+	return nil
+}
+
+// releaseVouchers releases the voucher usages of the cart, a failure must not prevent the cart from being deleted
+func (cob *DefaultCartBehaviour) releaseVouchers(ctx context.Context, cart *domaincart.Cart) {
+	releaser, ok := cob.voucherHandler.(VoucherReleaser)
+	if !ok {
+		return
+	}
+
+	err := releaser.ReleaseVouchers(ctx, cart)
+	if err != nil {
+		cob.logger.WithContext(ctx).Error(fmt.Sprintf("vouchers of cart %q could not be released: %v", cart.ID, err))
+	}
+}
+
 // ApplyVoucher applies a voucher to the cart
 func (cob *DefaultCartBehaviour) ApplyVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, domaincart.DeferEvents, error) {
 	cart, err := cob.voucherHandler.ApplyVoucher(ctx, cart, couponCode)
@@ -545,6 +720,152 @@ func (cob *DefaultCartBehaviour) RemoveGiftCard(ctx context.Context, cart *domai
 	return cob.resetPaymentSelectionIfInvalid(ctx, cart)
 }
 
+// recalculateCart updates the discounts of the cart if the voucher handler depends on the cart content
+// and the taxes if a TaxCalculator is bound. The item taxes only depend on the product and the tax address,
+// so they are only recalculated for the deliveries whose tax address changed, the other items keep their taxes.
+func (cob *DefaultCartBehaviour) recalculateCart(ctx context.Context, cart *domaincart.Cart, taxAddressChanged ...string) (*domaincart.Cart, error) {
+	if recalculator, ok := cob.voucherHandler.(DiscountRecalculator); ok {
+		var err error
+		cart, err = recalculator.RecalculateDiscounts(ctx, cart)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cob.taxCalculator == nil {
+		return cart, nil
+	}
+
+	products := cob.loadProducts(ctx, cart, taxAddressChanged)
+	for i, delivery := range cart.Deliveries {
+		address := cart.TaxAddress(delivery)
+		if containsString(taxAddressChanged, delivery.DeliveryInfo.Code) {
+			for j, item := range delivery.Cartitems {
+				product, err := itemProduct(products, item)
+				if err != nil {
+					// items whose product is not available anymore keep their taxes, the cart validation reports them
+					cob.logger.WithContext(ctx).Warn(fmt.Sprintf("cart.infrastructure.DefaultCartBehaviour: taxes of item %q not recalculated: %s", item.ID, err))
+					continue
+				}
+
+				taxedItem, err := cob.recalculateItemTaxes(ctx, address, item, product)
+				if err != nil {
+					return nil, err
+				}
+				cart.Deliveries[i].Cartitems[j] = *taxedItem
+			}
+		}
+
+		shippingTaxes, err := cob.taxCalculator.ShippingTaxes(ctx, address, cart.Deliveries[i])
+		if err != nil {
+			return nil, err
+		}
+		cart.Deliveries[i].ShippingItem.Taxes = shippingTaxes
+		if len(shippingTaxes) > 0 {
+			cart.Deliveries[i].ShippingItem.TaxAmount = shippingTaxes.TotalAmount()
+		} else {
+			cart.Deliveries[i].ShippingItem.TaxAmount = priceDomain.NewZero(cart.Deliveries[i].ShippingItem.PriceNet.Currency())
+		}
+	}
+
+	return cart, nil
+}
+
+// taxAddresses returns the tax address of every delivery of the cart by delivery code
+func taxAddresses(cart *domaincart.Cart) map[string]*domaincart.Address {
+	addresses := make(map[string]*domaincart.Address, len(cart.Deliveries))
+	for _, delivery := range cart.Deliveries {
+		addresses[delivery.DeliveryInfo.Code] = cart.TaxAddress(delivery)
+	}
+
+	return addresses
+}
+
+// changedTaxAddresses returns the codes of the deliveries whose tax address differs from the previous one
+func changedTaxAddresses(cart *domaincart.Cart, previous map[string]*domaincart.Address) []string {
+	var changed []string
+	for _, delivery := range cart.Deliveries {
+		code := delivery.DeliveryInfo.Code
+		if !reflect.DeepEqual(previous[code], cart.TaxAddress(delivery)) {
+			changed = append(changed, code)
+		}
+	}
+
+	return changed
+}
+
+// loadProducts gets the products of the items of the deliveries with one batch call or parallel calls, every product only once
+func (cob *DefaultCartBehaviour) loadProducts(ctx context.Context, cart *domaincart.Cart, deliveryCodes []string) domain.ProductLoadResults {
+	var marketplaceCodes []string
+	for _, delivery := range cart.Deliveries {
+		if !containsString(deliveryCodes, delivery.DeliveryInfo.Code) {
+			continue
+		}
+
+		for _, item := range delivery.Cartitems {
+			marketplaceCodes = append(marketplaceCodes, item.MarketplaceCode)
+		}
+	}
+
+	loader := domain.ProductLoader{
+		ProductService:      cob.productService,
+		BatchProductService: cob.batchProductService,
+		MaxParallel:         cob.maxParallelLookups,
+	}
+
+	return loader.Load(ctx, marketplaceCodes)
+}
+
+// itemProduct returns the loaded product of the item, with the active variant for items of configurable products
+func itemProduct(products domain.ProductLoadResults, item domaincart.Item) (domain.BasicProduct, error) {
+	product, err := products.Get(item.MarketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if configurableProduct, ok := product.(domain.ConfigurableProduct); ok && item.VariantMarketPlaceCode != "" {
+		return configurableProduct.GetConfigurableWithActiveVariant(item.VariantMarketPlaceCode)
+	}
+
+	return product, nil
+}
+
+// recalculateItemTaxes rebuilds the item with the taxes of its product for the address
+func (cob *DefaultCartBehaviour) recalculateItemTaxes(ctx context.Context, address *domaincart.Address, item domaincart.Item, product domain.BasicProduct) (*domaincart.Item, error) {
+	taxes, err := cob.itemTaxes(ctx, address, product)
+	if err != nil {
+		return nil, err
+	}
+
+	itemBuilder := cob.itemBuilderProvider()
+	itemBuilder.SetFromItem(item).SetSourceID(item.SourceID).SetAdditionalData(item.AdditionalData)
+
+	return addTaxInfos(itemBuilder, taxes).CalculatePricesAndTax().Build()
+}
+
+// itemTaxes returns the taxes of an item of the product, the default tax rate is used if no TaxCalculator is bound
+func (cob *DefaultCartBehaviour) itemTaxes(ctx context.Context, address *domaincart.Address, product domain.BasicProduct) (domaincart.Taxes, error) {
+	if cob.taxCalculator == nil {
+		return domaincart.Taxes{{Type: "default", Rate: big.NewFloat(cob.defaultTaxRate)}}, nil
+	}
+
+	return cob.taxCalculator.ItemTaxes(ctx, address, product)
+}
+
+// addTaxInfos adds the taxes to the item builder, taxes with a rate get their amount calculated
+func addTaxInfos(itemBuilder *domaincart.ItemBuilder, taxes domaincart.Taxes) *domaincart.ItemBuilder {
+	for _, tax := range taxes {
+		if tax.Rate != nil {
+			itemBuilder.AddTaxInfo(tax.Type, tax.Rate, nil)
+			continue
+		}
+		amount := tax.Amount
+		itemBuilder.AddTaxInfo(tax.Type, nil, &amount)
+	}
+
+	return itemBuilder
+}
+
 func (cob *DefaultCartBehaviour) isCurrentPaymentSelectionValid(ctx context.Context, cart *domaincart.Cart) bool {
 	return cob.checkPaymentSelection(ctx, cart, cart.PaymentSelection) == nil
 }
@@ -605,8 +926,25 @@ func (DefaultVoucherHandler) RemoveVoucher(_ context.Context, cart *domaincart.C
 	return cart, nil
 }
 
+// Inject dependencies
+func (h *DefaultGiftCardHandler) Inject(
+	optionals *struct {
+		GiftCardService domaincart.GiftCardService `inject:",optional"`
+	},
+) *DefaultGiftCardHandler {
+	if optionals != nil {
+		h.giftCardService = optionals.GiftCardService
+	}
+
+	return h
+}
+
 // ApplyGiftCard checks the gift card and adds it to the supplied cart if valid
-func (DefaultGiftCardHandler) ApplyGiftCard(_ context.Context, cart *domaincart.Cart, giftCardCode string) (*domaincart.Cart, error) {
+func (h DefaultGiftCardHandler) ApplyGiftCard(ctx context.Context, cart *domaincart.Cart, giftCardCode string) (*domaincart.Cart, error) {
+	if h.giftCardService != nil {
+		return h.applyGiftCardFromBalance(ctx, cart, giftCardCode)
+	}
+
 	if giftCardCode != "valid_giftcard" && giftCardCode != "valid" {
 		return nil, errors.New("Code invalid")
 	}
@@ -634,3 +972,89 @@ func (DefaultGiftCardHandler) RemoveGiftCard(_ context.Context, cart *domaincart
 
 	return cart, nil
 }
+
+// applyGiftCardFromBalance uses as much of the gift card balance as is needed to pay the cart
+func (h DefaultGiftCardHandler) applyGiftCardFromBalance(ctx context.Context, cart *domaincart.Cart, giftCardCode string) (*domaincart.Cart, error) {
+	for _, giftCard := range cart.AppliedGiftCards {
+		if giftCard.Code == giftCardCode {
+			return cart, nil
+		}
+	}
+
+	balance, err := h.giftCardService.Balance(ctx, giftCardCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !balance.Available.IsPositive() {
+		return nil, domaincart.ErrGiftCardInsufficientBalance
+	}
+
+	openTotal, err := cart.SumGrandTotalWithGiftCards()
+	if err != nil {
+		return nil, err
+	}
+
+	if !openTotal.IsZero() && openTotal.Currency() != balance.Available.Currency() {
+		return nil, errors.Errorf("gift card currency %q doesn't match the cart currency %q", balance.Available.Currency(), openTotal.Currency())
+	}
+
+	if !openTotal.IsPositive() {
+		return nil, errors.New("cart is already paid by gift cards")
+	}
+
+	applied := balance.Available
+	if openTotal.IsLessThen(applied) {
+		applied = openTotal
+	}
+
+	remaining, err := balance.Available.Sub(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	cart.AppliedGiftCards = append(cart.AppliedGiftCards, domaincart.AppliedGiftCard{
+		Code:      giftCardCode,
+		Applied:   applied,
+		Remaining: remaining,
+	})
+
+	return cart, nil
+}
+
+// ReserveGiftCards reserves the applied amounts of all gift cards for the cart,
+// if one reservation fails the already reserved gift cards are released again
+func (h DefaultGiftCardHandler) ReserveGiftCards(ctx context.Context, cart *domaincart.Cart) error {
+	if h.giftCardService == nil {
+		return nil
+	}
+
+	for i, giftCard := range cart.AppliedGiftCards {
+		err := h.giftCardService.Reserve(ctx, giftCard.Code, giftCard.Applied, cart.ID)
+		if err != nil {
+			for _, reserved := range cart.AppliedGiftCards[:i] {
+				_ = h.giftCardService.Release(ctx, reserved.Code, cart.ID)
+			}
+			return errors.Wrapf(err, "gift card %q could not be reserved", giftCard.Code)
+		}
+	}
+
+	return nil
+}
+
+// ReleaseGiftCards releases the reservations of all gift cards applied to the cart
+func (h DefaultGiftCardHandler) ReleaseGiftCards(ctx context.Context, cart *domaincart.Cart) error {
+	if h.giftCardService == nil {
+		return nil
+	}
+
+	var lastErr error
+	for _, giftCard := range cart.AppliedGiftCards {
+		err := h.giftCardService.Release(ctx, giftCard.Code, cart.ID)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "gift card %q could not be released", giftCard.Code)
+		}
+	}
+
+	return lastErr
+}
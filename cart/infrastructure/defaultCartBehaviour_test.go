@@ -3,13 +3,17 @@ package infrastructure
 import (
 	"context"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"github.com/go-test/deep"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInMemoryBehaviour_CleanCart(t *testing.T) {
@@ -49,6 +53,7 @@ func TestInMemoryBehaviour_CleanCart(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 			cart := &domaincart.Cart{
 				ID: "17",
@@ -176,6 +181,7 @@ func TestInMemoryBehaviour_CleanDelivery(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			)
 			if err := cob.cartStorage.StoreCart(context.Background(), tt.args.cart); err != nil {
 				t.Fatalf("cart could not be initialized")
@@ -246,6 +252,7 @@ func TestInMemoryBehaviour_ApplyVoucher(t *testing.T) {
 				&DefaultVoucherHandler{},
 				&DefaultGiftCardHandler{},
 				nil,
+				nil,
 			)
 			got, _, err := cob.ApplyVoucher(context.Background(), tt.args.cart, tt.args.voucherCode)
 			if (err != nil) != tt.wantErr {
@@ -341,6 +348,7 @@ func TestInMemoryBehaviour_RemoveVoucher(t *testing.T) {
 				&DefaultVoucherHandler{},
 				&DefaultGiftCardHandler{},
 				nil,
+				nil,
 			)
 
 			if err := cob.cartStorage.StoreCart(context.Background(), tt.args.cart); err != nil {
@@ -408,6 +416,7 @@ func TestInMemoryBehaviour_ApplyGiftCard(t *testing.T) {
 				&DefaultVoucherHandler{},
 				&DefaultGiftCardHandler{},
 				nil,
+				nil,
 			)
 			got, _, err := cob.ApplyGiftCard(context.Background(), tt.args.cart, tt.args.giftCardCode)
 			if (err != nil) != tt.wantErr {
@@ -477,6 +486,7 @@ func TestInMemoryBehaviour_RemoveGiftCard(t *testing.T) {
 				&DefaultVoucherHandler{},
 				&DefaultGiftCardHandler{},
 				nil,
+				nil,
 			)
 			got, _, err := cob.RemoveGiftCard(context.Background(), tt.args.cart, tt.args.giftCardCode)
 			if (err != nil) != tt.wantErr {
@@ -504,6 +514,7 @@ func TestInMemoryBehaviour_Complete(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 		)
 		cart, err := cob.StoreNewCart(context.Background(), &domaincart.Cart{ID: "test-id"})
 		assert.NoError(t, err)
@@ -531,6 +542,7 @@ func TestInMemoryBehaviour_Restore(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 		)
 		cart := &domaincart.Cart{ID: "1234"}
 
@@ -542,9 +554,219 @@ func TestInMemoryBehaviour_Restore(t *testing.T) {
 	})
 }
 
+func TestInMemoryBehaviour_ReleaseVouchers(t *testing.T) {
+	t.Parallel()
+
+	newBehaviour := func() *DefaultCartBehaviour {
+		cob := &DefaultCartBehaviour{}
+		cob.Inject(
+			newInMemoryStorage(),
+			nil,
+			flamingo.NullLogger{},
+			nil,
+			nil,
+			nil,
+			newPromotionVoucherHandler(PromotionRule{Code: "limited", Type: PromotionTypePercentageOff, Value: 10, UsageLimit: 1}),
+			nil,
+			nil,
+			nil,
+		)
+
+		return cob
+	}
+
+	applyToNewCart := func(cob *DefaultCartBehaviour, id string) (*domaincart.Cart, error) {
+		cart, err := cob.StoreNewCart(context.Background(), &domaincart.Cart{ID: id})
+		require.NoError(t, err)
+		cart, _, err = cob.ApplyVoucher(context.Background(), cart, "limited")
+
+		return cart, err
+	}
+
+	t.Run("delete cart", func(t *testing.T) {
+		cob := newBehaviour()
+		cart, err := applyToNewCart(cob, "first")
+		require.NoError(t, err)
+		_, err = applyToNewCart(cob, "second")
+		require.Equal(t, ErrPromotionUsageLimitReached, err)
+
+		require.NoError(t, cob.DeleteCart(context.Background(), cart))
+
+		_, err = applyToNewCart(cob, "third")
+		assert.NoError(t, err)
+	})
+
+	t.Run("clean cart", func(t *testing.T) {
+		cob := newBehaviour()
+		cart, err := applyToNewCart(cob, "first")
+		require.NoError(t, err)
+
+		cart, _, err = cob.CleanCart(context.Background(), cart)
+		require.NoError(t, err)
+		assert.Empty(t, cart.AppliedCouponCodes)
+
+		_, err = applyToNewCart(cob, "second")
+		assert.NoError(t, err)
+	})
+
+	t.Run("complete keeps the usage", func(t *testing.T) {
+		cob := newBehaviour()
+		cart, err := applyToNewCart(cob, "first")
+		require.NoError(t, err)
+
+		_, _, err = cob.Complete(context.Background(), cart)
+		require.NoError(t, err)
+
+		_, err = applyToNewCart(cob, "second")
+		assert.Equal(t, ErrPromotionUsageLimitReached, err)
+	})
+}
+
+// countingProductService counts the Get calls per marketplace code
+type countingProductService struct {
+	mutex    sync.Mutex
+	products map[string]domain.BasicProduct
+	calls    map[string]int
+}
+
+func (s *countingProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.calls[marketplaceCode]++
+	product, found := s.products[marketplaceCode]
+	if !found {
+		return nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return product, nil
+}
+
+func TestInMemoryBehaviour_RecalculateTaxes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pricedProduct := func(marketplaceCode string, taxClass string) domain.BasicProduct {
+		return domain.SimpleProduct{
+			BasicProductData: domain.BasicProductData{MarketPlaceCode: marketplaceCode, Title: marketplaceCode},
+			Saleable: domain.Saleable{
+				IsSaleable:  true,
+				ActivePrice: domain.PriceInfo{Default: priceDomain.NewFromFloat(10, "EUR"), TaxClass: taxClass},
+			},
+		}
+	}
+	productService := &countingProductService{
+		products: map[string]domain.BasicProduct{
+			"reduced":  pricedProduct("reduced", "reduced"),
+			"standard": pricedProduct("standard", ""),
+		},
+		calls: make(map[string]int),
+	}
+
+	cob := &DefaultCartBehaviour{}
+	cob.Inject(
+		newInMemoryStorage(),
+		productService,
+		flamingo.NullLogger{},
+		func() *domaincart.ItemBuilder { return &domaincart.ItemBuilder{} },
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		&struct {
+			TaxCalculator       domaincart.TaxCalculator   `inject:",optional"`
+			BatchProductService domain.BatchProductService `inject:",optional"`
+			MaxParallelLookups  float64                    `inject:"config:commerce.product.maxParallelLookups,optional"`
+		}{TaxCalculator: newRuleTaxCalculator()},
+	)
+
+	cart, err := cob.StoreNewCart(ctx, &domaincart.Cart{ID: "cart", BillingAddress: &domaincart.Address{CountryCode: "DE"}})
+	require.NoError(t, err)
+	cart, _, err = cob.AddToCart(ctx, cart, "delivery", domaincart.AddRequest{MarketplaceCode: "reduced", Qty: 1})
+	require.NoError(t, err)
+	cart, _, err = cob.AddToCart(ctx, cart, "delivery", domaincart.AddRequest{MarketplaceCode: "standard", Qty: 1})
+	require.NoError(t, err)
+
+	// only the added product is loaded for the new item, the other items keep their taxes
+	assert.Equal(t, map[string]int{"reduced": 1, "standard": 1}, productService.calls)
+
+	// a product that is not available anymore doesn't prevent changes of the cart and keeps its taxes
+	delete(productService.products, "reduced")
+	cart.Deliveries[0].ShippingItem.TaxAmount = priceDomain.NewFromFloat(1.9, "EUR")
+	qty := 3
+	cart, _, err = cob.UpdateItem(ctx, cart, domaincart.ItemUpdateCommand{ItemID: cart.Deliveries[0].Cartitems[1].ID, Qty: &qty})
+	require.NoError(t, err)
+
+	items := cart.Deliveries[0].Cartitems
+	require.Len(t, items, 2)
+	require.Len(t, items[0].RowTaxes, 1)
+	assert.Equal(t, "7", items[0].RowTaxes[0].Rate.String())
+	require.Len(t, items[1].RowTaxes, 1)
+	assert.Equal(t, "19", items[1].RowTaxes[0].Rate.String())
+	assert.Equal(t, 3, items[1].Qty)
+
+	// the tax amount of a shipping item without taxes is reset
+	assert.True(t, cart.Deliveries[0].ShippingItem.TaxAmount.IsZero())
+	assert.Empty(t, cart.Deliveries[0].ShippingItem.Taxes)
+	assert.Equal(t, map[string]int{"reduced": 1, "standard": 1}, productService.calls)
+
+	// a new tax address of the delivery recalculates the taxes of its items with their products
+	deliveryInfo := domaincart.DeliveryInfo{
+		Code:             "delivery",
+		DeliveryLocation: domaincart.DeliveryLocation{Address: &domaincart.Address{CountryCode: "US", RegionCode: "CA"}},
+	}
+	cart, _, err = cob.UpdateDeliveryInfo(ctx, cart, "delivery", domaincart.DeliveryInfoUpdateCommand{DeliveryInfo: deliveryInfo})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"reduced": 2, "standard": 2}, productService.calls)
+
+	items = cart.Deliveries[0].Cartitems
+	require.Len(t, items[0].RowTaxes, 1)
+	assert.Equal(t, "7", items[0].RowTaxes[0].Rate.String())
+	rates := make(map[string]string)
+	for _, tax := range items[1].RowTaxes {
+		rates[tax.Type] = tax.Rate.String()
+	}
+	assert.Equal(t, map[string]string{"state": "6", "county": "1.25"}, rates)
+
+	// the same tax address doesn't load the products again, the billing address is not used by the delivery
+	cart, _, err = cob.UpdateDeliveryInfo(ctx, cart, "delivery", domaincart.DeliveryInfoUpdateCommand{DeliveryInfo: deliveryInfo})
+	require.NoError(t, err)
+	cart, _, err = cob.UpdateBillingAddress(ctx, cart, domaincart.Address{CountryCode: "US"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"reduced": 2, "standard": 2}, productService.calls)
+
+	// a delivery that uses the billing address is recalculated with a new billing address
+	deliveryInfo.DeliveryLocation.UseBillingAddress = true
+	cart, _, err = cob.UpdateDeliveryInfo(ctx, cart, "delivery", domaincart.DeliveryInfoUpdateCommand{DeliveryInfo: deliveryInfo})
+	require.NoError(t, err)
+	_, _, err = cob.UpdateBillingAddress(ctx, cart, domaincart.Address{CountryCode: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"reduced": 4, "standard": 4}, productService.calls)
+}
+
 func newInMemoryStorage() *InMemoryCartStorage {
 	result := &InMemoryCartStorage{}
 	result.Inject()
 
 	return result
 }
+
+func TestInMemoryCartStorage_GetCartsModifiedBetween(t *testing.T) {
+	storage := newInMemoryStorage()
+	require.NoError(t, storage.StoreCart(context.Background(), &domaincart.Cart{ID: "old"}))
+	require.NoError(t, storage.StoreCart(context.Background(), &domaincart.Cart{ID: "new"}))
+
+	now := time.Now()
+	storage.activities["old"] = cartActivity{createdAt: now.Add(-2 * time.Hour), updatedAt: now.Add(-time.Hour)}
+
+	carts, err := storage.GetCartsModifiedBetween(context.Background(), now.Add(-90*time.Minute), now.Add(-30*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, carts, 1)
+	assert.Equal(t, "old", carts[0].ID)
+
+	require.NoError(t, storage.RemoveCart(context.Background(), carts[0]))
+	carts, err = storage.GetCartsModifiedBetween(context.Background(), now.Add(-90*time.Minute), now.Add(-30*time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, carts)
+}
@@ -2,8 +2,13 @@ package infrastructure
 
 import (
 	"context"
+	"sort"
+
 	"flamingo.me/flamingo/v3/core/auth"
 	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/google/uuid"
+
+	"github.com/pkg/errors"
 
 	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 )
@@ -18,6 +23,7 @@ type (
 
 var (
 	_ cart.CustomerCartService = (*DefaultCustomerCartService)(nil)
+	_ cart.NamedCartService    = (*DefaultCustomerCartService)(nil)
 )
 
 // Inject dependencies
@@ -29,15 +35,28 @@ func (cs *DefaultCustomerCartService) Inject(
 	cs.logger = logger
 }
 
-// GetCart gets a customer cart from the in memory customer cart service
-func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth.Identity, _ string) (*cart.Cart, error) {
+// GetCart gets a customer cart from the in memory customer cart service.
+// The cartID "me" (or an empty cartID) returns the main cart of the customer, other carts must belong to the customer
+func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth.Identity, cartID string) (*cart.Cart, error) {
 	id := identity.Subject()
+	if cartID != "" && cartID != "me" && cartID != id {
+		foundCart, err := cs.defaultBehaviour.GetCart(ctx, cartID)
+		if err != nil {
+			return nil, err
+		}
+		if !foundCart.BelongsToAuthenticatedUser || foundCart.AuthenticatedUserID != id {
+			return nil, cart.ErrCartNotFound
+		}
+
+		return foundCart, nil
+	}
+
 	foundCart, err := cs.defaultBehaviour.GetCart(ctx, id)
 	if err == nil {
 		return foundCart, err
 	}
 	if err == cart.ErrCartNotFound {
-		cart := &cart.Cart{ID: id}
+		cart := &cart.Cart{ID: id, Name: cart.MainCartName}
 		cart.BelongsToAuthenticatedUser = true
 		cart.AuthenticatedUserID = id
 		return cs.defaultBehaviour.StoreNewCart(ctx, cart)
@@ -45,6 +64,67 @@ func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth
 	return nil, err
 }
 
+// ListCarts returns all carts of the customer, starting with the main cart
+func (cs *DefaultCustomerCartService) ListCarts(ctx context.Context, identity auth.Identity) ([]*cart.Cart, error) {
+	mainCart, err := cs.GetCart(ctx, identity, "me")
+	if err != nil {
+		return nil, err
+	}
+
+	storedCarts, err := cs.defaultBehaviour.GetCustomerCarts(ctx, identity.Subject())
+	if err != nil {
+		return nil, err
+	}
+
+	carts := []*cart.Cart{mainCart}
+	for _, storedCart := range storedCarts {
+		if storedCart.ID != mainCart.ID {
+			carts = append(carts, storedCart)
+		}
+	}
+
+	sort.SliceStable(carts[1:], func(i, j int) bool {
+		return carts[i+1].Name < carts[j+1].Name
+	})
+
+	return carts, nil
+}
+
+// CreateCart creates a new named cart for the customer, the name must be unique for the customer
+func (cs *DefaultCustomerCartService) CreateCart(ctx context.Context, identity auth.Identity, name string) (*cart.Cart, error) {
+	if name == "" {
+		return nil, errors.New("no cart name given")
+	}
+
+	carts, err := cs.ListCarts(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existingCart := range carts {
+		if existingCart.Name == name {
+			return nil, cart.ErrCartNameAlreadyUsed
+		}
+	}
+
+	id := identity.Subject()
+	newCart := &cart.Cart{ID: id + "-" + uuid.New().String(), Name: name}
+	newCart.BelongsToAuthenticatedUser = true
+	newCart.AuthenticatedUserID = id
+
+	return cs.defaultBehaviour.StoreNewCart(ctx, newCart)
+}
+
+// DeleteCart deletes a cart of the customer, deleting the main cart empties it
+func (cs *DefaultCustomerCartService) DeleteCart(ctx context.Context, identity auth.Identity, cartID string) error {
+	customerCart, err := cs.GetCart(ctx, identity, cartID)
+	if err != nil {
+		return err
+	}
+
+	return cs.defaultBehaviour.DeleteCart(ctx, customerCart)
+}
+
 // GetModifyBehaviour gets the cart order behaviour of the service
 func (cs *DefaultCustomerCartService) GetModifyBehaviour(context.Context, auth.Identity) (cart.ModifyBehaviour, error) {
 	return cs.defaultBehaviour, nil
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+func newDefaultCustomerCartService() *DefaultCustomerCartService {
+	behaviour := &DefaultCartBehaviour{}
+	behaviour.Inject(
+		newInMemoryStorage(),
+		nil,
+		flamingo.NullLogger{},
+		func() *domaincart.ItemBuilder {
+			return &domaincart.ItemBuilder{}
+		},
+		func() *domaincart.DeliveryBuilder {
+			return &domaincart.DeliveryBuilder{}
+		},
+		func() *domaincart.Builder {
+			return &domaincart.Builder{}
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	service := &DefaultCustomerCartService{}
+	service.Inject(behaviour, flamingo.NullLogger{})
+
+	return service
+}
+
+func TestDefaultCustomerCartService_NamedCarts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	service := newDefaultCustomerCartService()
+	customer := &authMock.Identity{Sub: "customer"}
+	otherCustomer := &authMock.Identity{Sub: "other"}
+
+	mainCart, err := service.GetCart(ctx, customer, "me")
+	require.NoError(t, err)
+	assert.Equal(t, "customer", mainCart.ID)
+	assert.Equal(t, domaincart.MainCartName, mainCart.Name)
+
+	savedForLater, err := service.CreateCart(ctx, customer, "saved for later")
+	require.NoError(t, err)
+	assert.NotEqual(t, mainCart.ID, savedForLater.ID)
+	assert.Equal(t, "customer", savedForLater.AuthenticatedUserID)
+
+	_, err = service.CreateCart(ctx, customer, "saved for later")
+	assert.Equal(t, domaincart.ErrCartNameAlreadyUsed, err)
+
+	project, err := service.CreateCart(ctx, customer, "project a")
+	require.NoError(t, err)
+
+	carts, err := service.ListCarts(ctx, customer)
+	require.NoError(t, err)
+	require.Len(t, carts, 3)
+	assert.Equal(t, mainCart.ID, carts[0].ID)
+	assert.Equal(t, project.ID, carts[1].ID)
+	assert.Equal(t, savedForLater.ID, carts[2].ID)
+
+	found, err := service.GetCart(ctx, customer, savedForLater.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "saved for later", found.Name)
+
+	_, err = service.GetCart(ctx, otherCustomer, savedForLater.ID)
+	assert.Equal(t, domaincart.ErrCartNotFound, err, "carts of other customers must not be accessible")
+
+	require.NoError(t, service.DeleteCart(ctx, customer, project.ID))
+	carts, err = service.ListCarts(ctx, customer)
+	require.NoError(t, err)
+	assert.Len(t, carts, 2)
+}
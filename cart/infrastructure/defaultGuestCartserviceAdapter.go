@@ -18,6 +18,7 @@ type (
 
 var (
 	_ cart.GuestCartService = (*DefaultGuestCartService)(nil)
+	_ cart.GuestCartDeleter = (*DefaultGuestCartService)(nil)
 )
 
 // Inject dependencies
@@ -40,6 +41,16 @@ func (gcs *DefaultGuestCartService) GetNewCart(ctx context.Context) (*cart.Cart,
 	return gcs.defaultBehaviour.StoreNewCart(ctx, &cart.Cart{ID: strconv.Itoa(rand.Int())})
 }
 
+// DeleteCart removes the guest cart from the storage
+func (gcs *DefaultGuestCartService) DeleteCart(ctx context.Context, cartID string) error {
+	guestCart, err := gcs.defaultBehaviour.GetCart(ctx, cartID)
+	if err != nil {
+		return err
+	}
+
+	return gcs.defaultBehaviour.DeleteCart(ctx, guestCart)
+}
+
 // GetModifyBehaviour returns the cart order behaviour of the service
 func (gcs *DefaultGuestCartService) GetModifyBehaviour(context.Context) (cart.ModifyBehaviour, error) {
 	return gcs.defaultBehaviour, nil
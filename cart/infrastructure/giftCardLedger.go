@@ -0,0 +1,194 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+type (
+	// GiftCardDefinition describes a gift card that is issued by the configuration
+	GiftCardDefinition struct {
+		Code     string  `json:"code"`
+		Amount   float64 `json:"amount"`
+		Currency string  `json:"currency"`
+	}
+
+	// InMemoryGiftCardLedger keeps the gift cards and their bookings in memory
+	InMemoryGiftCardLedger struct {
+		mutex  sync.Mutex
+		logger flamingo.Logger
+		cards  map[string]*giftCardAccount
+	}
+
+	giftCardAccount struct {
+		value    priceDomain.Price
+		bookings map[string]*giftCardBooking
+	}
+
+	giftCardBooking struct {
+		amount   priceDomain.Price
+		captured bool
+	}
+)
+
+var (
+	_ domaincart.GiftCardService = &InMemoryGiftCardLedger{}
+
+	// ErrGiftCardAlreadyCaptured is returned if a captured gift card booking should be reserved again
+	ErrGiftCardAlreadyCaptured = errors.New("gift card booking is already captured")
+)
+
+// Inject dependencies and issue the configured gift cards
+func (l *InMemoryGiftCardLedger) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Cards config.Slice `inject:"config:commerce.cart.giftCardLedger.cards,optional"`
+	},
+) *InMemoryGiftCardLedger {
+	l.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "InMemoryGiftCardLedger")
+	l.cards = make(map[string]*giftCardAccount)
+
+	if cfg != nil {
+		for _, card := range mapGiftCardDefinitions(l.logger, cfg.Cards) {
+			l.Issue(card.Code, priceDomain.NewFromFloat(card.Amount, card.Currency))
+		}
+	}
+
+	return l
+}
+
+// Issue adds a gift card with the given value to the ledger, an existing gift card is left unchanged
+func (l *InMemoryGiftCardLedger) Issue(code string, value priceDomain.Price) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, found := l.cards[code]; found {
+		return
+	}
+
+	l.cards[code] = &giftCardAccount{value: value, bookings: make(map[string]*giftCardBooking)}
+}
+
+// Balance returns the current balance of the gift card
+func (l *InMemoryGiftCardLedger) Balance(_ context.Context, code string) (*domaincart.GiftCardBalance, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	account, found := l.cards[code]
+	if !found {
+		return nil, domaincart.ErrGiftCardNotFound
+	}
+
+	available := account.value
+	reserved := priceDomain.NewZero(account.value.Currency())
+	for _, booking := range account.bookings {
+		available = available.ForceAdd(booking.amount.Inverse())
+		if !booking.captured {
+			reserved = reserved.ForceAdd(booking.amount)
+		}
+	}
+
+	return &domaincart.GiftCardBalance{Code: code, Available: available, Reserved: reserved}, nil
+}
+
+// Reserve blocks the amount on the gift card for the reference
+func (l *InMemoryGiftCardLedger) Reserve(_ context.Context, code string, amount priceDomain.Price, reference string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	account, found := l.cards[code]
+	if !found {
+		return domaincart.ErrGiftCardNotFound
+	}
+
+	if booking, found := account.bookings[reference]; found && booking.captured {
+		return ErrGiftCardAlreadyCaptured
+	}
+
+	available := account.value
+	for bookingReference, booking := range account.bookings {
+		if bookingReference != reference {
+			available = available.ForceAdd(booking.amount.Inverse())
+		}
+	}
+
+	if err := checkGiftCardAmount(available, amount); err != nil {
+		return err
+	}
+
+	account.bookings[reference] = &giftCardBooking{amount: amount}
+
+	return nil
+}
+
+// Capture finally books the amount that is reserved for the reference
+func (l *InMemoryGiftCardLedger) Capture(_ context.Context, code string, reference string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	account, found := l.cards[code]
+	if !found {
+		return domaincart.ErrGiftCardNotFound
+	}
+
+	booking, found := account.bookings[reference]
+	if !found {
+		return domaincart.ErrGiftCardReservationNotFound
+	}
+
+	booking.captured = true
+
+	return nil
+}
+
+// Release frees the amount that is reserved or captured for the reference
+func (l *InMemoryGiftCardLedger) Release(_ context.Context, code string, reference string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	account, found := l.cards[code]
+	if !found {
+		return domaincart.ErrGiftCardNotFound
+	}
+
+	delete(account.bookings, reference)
+
+	return nil
+}
+
+// checkGiftCardAmount returns an error if the amount can't be booked from the available balance
+func checkGiftCardAmount(available priceDomain.Price, amount priceDomain.Price) error {
+	if amount.Currency() != available.Currency() {
+		return errors.Errorf("gift card currency %q doesn't match %q", available.Currency(), amount.Currency())
+	}
+
+	if amount.IsNegative() {
+		return errors.New("gift card amount must not be negative")
+	}
+
+	if amount.IsGreaterThen(available) {
+		return domaincart.ErrGiftCardInsufficientBalance
+	}
+
+	return nil
+}
+
+func mapGiftCardDefinitions(logger flamingo.Logger, cards config.Slice) []GiftCardDefinition {
+	var result []GiftCardDefinition
+	if cards == nil {
+		return result
+	}
+
+	if err := cards.MapInto(&result); err != nil {
+		logger.Error("gift cards could not be mapped: ", err)
+	}
+
+	return result
+}
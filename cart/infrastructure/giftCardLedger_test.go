@@ -0,0 +1,231 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+func newInMemoryGiftCardLedger() *InMemoryGiftCardLedger {
+	return new(InMemoryGiftCardLedger).Inject(flamingo.NullLogger{}, &struct {
+		Cards config.Slice `inject:"config:commerce.cart.giftCardLedger.cards,optional"`
+	}{
+		Cards: config.Slice{config.Map{"code": "gift-50", "amount": 50.0, "currency": "EUR"}},
+	})
+}
+
+func TestInMemoryGiftCardLedger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ledger := newInMemoryGiftCardLedger()
+
+	_, err := ledger.Balance(ctx, "unknown")
+	assert.Equal(t, domaincart.ErrGiftCardNotFound, err)
+
+	balance, err := ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, balance.Available.FloatAmount())
+	assert.True(t, balance.Reserved.IsZero())
+
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(20, "EUR"), "cart-1"))
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(25, "EUR"), "cart-2"))
+
+	// reserving again for the same reference replaces the previous reservation
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(10, "EUR"), "cart-1"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+	assert.Equal(t, 35.0, balance.Reserved.FloatAmount())
+
+	err = ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(20, "EUR"), "cart-3")
+	assert.Equal(t, domaincart.ErrGiftCardInsufficientBalance, err)
+
+	err = ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(5, "USD"), "cart-3")
+	assert.Error(t, err)
+
+	assert.Equal(t, domaincart.ErrGiftCardReservationNotFound, ledger.Capture(ctx, "gift-50", "cart-3"))
+	require.NoError(t, ledger.Capture(ctx, "gift-50", "cart-1"))
+	assert.Equal(t, ErrGiftCardAlreadyCaptured, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(5, "EUR"), "cart-1"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+	assert.Equal(t, 25.0, balance.Reserved.FloatAmount())
+
+	// issuing an existing gift card again keeps its value and bookings
+	ledger.Issue("gift-50", priceDomain.NewFromFloat(100, "EUR"))
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+
+	// releasing works for reserved and captured bookings
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-1"))
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-2"))
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-2"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, balance.Available.FloatAmount())
+}
+
+func TestDefaultGiftCardHandler_WithGiftCardService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ledger := newInMemoryGiftCardLedger()
+	ledger.Issue("gift-10", priceDomain.NewFromFloat(10, "EUR"))
+	ledger.Issue("empty", priceDomain.NewFromFloat(0, "EUR"))
+
+	handler := new(DefaultGiftCardHandler).Inject(&struct {
+		GiftCardService domaincart.GiftCardService `inject:",optional"`
+	}{GiftCardService: ledger})
+
+	cart := &domaincart.Cart{
+		ID: "cart",
+		Totalitems: []domaincart.Totalitem{
+			{Code: "total", Price: priceDomain.NewFromFloat(40, "EUR")},
+		},
+	}
+
+	_, err := handler.ApplyGiftCard(ctx, cart, "unknown")
+	assert.Equal(t, domaincart.ErrGiftCardNotFound, err)
+
+	_, err = handler.ApplyGiftCard(ctx, cart, "empty")
+	assert.Equal(t, domaincart.ErrGiftCardInsufficientBalance, err)
+
+	cart, err = handler.ApplyGiftCard(ctx, cart, "gift-10")
+	require.NoError(t, err)
+	cart, err = handler.ApplyGiftCard(ctx, cart, "gift-50")
+	require.NoError(t, err)
+	require.Len(t, cart.AppliedGiftCards, 2)
+
+	// the second gift card only pays what is left of the grand total
+	assert.Equal(t, 10.0, cart.AppliedGiftCards[0].Applied.FloatAmount())
+	assert.Equal(t, 30.0, cart.AppliedGiftCards[1].Applied.FloatAmount())
+	assert.Equal(t, 20.0, cart.AppliedGiftCards[1].Remaining.FloatAmount())
+
+	require.NoError(t, handler.ReserveGiftCards(ctx, cart))
+	balance, err := ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, balance.Available.FloatAmount())
+
+	require.NoError(t, handler.ReleaseGiftCards(ctx, cart))
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, balance.Available.FloatAmount())
+
+	// a failing reservation releases the gift cards that are already reserved
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(40, "EUR"), "other-cart"))
+	assert.Error(t, handler.ReserveGiftCards(ctx, cart))
+	balance, err = ledger.Balance(ctx, "gift-10")
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, balance.Available.FloatAmount())
+}
+
+func TestSQLGiftCardLedger_schema(t *testing.T) {
+	t.Parallel()
+
+	sqlite := &SQLGiftCardLedger{}
+	sqlite.SetDB(nil, "sqlite3", "")
+	schema := sqlite.schema()
+	require.Len(t, schema, 2)
+	assert.Contains(t, schema[0], "CREATE TABLE IF NOT EXISTS gift_cards (")
+	assert.Contains(t, schema[1], "CREATE TABLE IF NOT EXISTS gift_cards_bookings (")
+	assert.Equal(t, "DELETE FROM x WHERE code = ? AND reference = ?", sqlite.rebind("DELETE FROM x WHERE code = ? AND reference = ?"))
+
+	postgres := &SQLGiftCardLedger{}
+	postgres.SetDB(nil, "postgres", "shop_gift_cards")
+	assert.Equal(t, "shop_gift_cards_bookings", postgres.bookingTableName())
+	assert.Equal(t, "DELETE FROM x WHERE code = $1 AND reference = $2", postgres.rebind("DELETE FROM x WHERE code = ? AND reference = ?"))
+}
+
+func newSQLiteGiftCardLedger(t *testing.T) *SQLGiftCardLedger {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+
+	ledger := &SQLGiftCardLedger{logger: flamingo.NullLogger{}}
+	ledger.SetDB(db, "sqlite3", "")
+	require.NoError(t, ledger.Migrate(context.Background()))
+	require.NoError(t, ledger.Issue(context.Background(), "gift-50", priceDomain.NewFromFloat(50, "EUR")))
+
+	return ledger
+}
+
+func TestSQLGiftCardLedger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ledger := newSQLiteGiftCardLedger(t)
+	defer ledger.db.Close()
+
+	_, err := ledger.Balance(ctx, "unknown")
+	assert.Equal(t, domaincart.ErrGiftCardNotFound, err)
+	assert.Equal(t, domaincart.ErrGiftCardNotFound, ledger.Reserve(ctx, "unknown", priceDomain.NewFromFloat(5, "EUR"), "cart-1"))
+
+	balance, err := ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, balance.Available.FloatAmount())
+	assert.True(t, balance.Reserved.IsZero())
+
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(20, "EUR"), "cart-1"))
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(25, "EUR"), "cart-2"))
+
+	// reserving again for the same reference replaces the previous reservation
+	require.NoError(t, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(10, "EUR"), "cart-1"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+	assert.Equal(t, 35.0, balance.Reserved.FloatAmount())
+
+	err = ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(20, "EUR"), "cart-3")
+	assert.Equal(t, domaincart.ErrGiftCardInsufficientBalance, err)
+
+	err = ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(5, "USD"), "cart-3")
+	assert.Error(t, err)
+
+	assert.Equal(t, domaincart.ErrGiftCardReservationNotFound, ledger.Capture(ctx, "gift-50", "cart-3"))
+	require.NoError(t, ledger.Capture(ctx, "gift-50", "cart-1"))
+	assert.Equal(t, ErrGiftCardAlreadyCaptured, ledger.Reserve(ctx, "gift-50", priceDomain.NewFromFloat(5, "EUR"), "cart-1"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+	assert.Equal(t, 25.0, balance.Reserved.FloatAmount())
+
+	// issuing an existing gift card again keeps its value and bookings
+	require.NoError(t, ledger.Issue(ctx, "gift-50", priceDomain.NewFromFloat(100, "EUR")))
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, balance.Available.FloatAmount())
+
+	// releasing works for reserved and captured bookings
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-1"))
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-2"))
+	require.NoError(t, ledger.Release(ctx, "gift-50", "cart-2"))
+	assert.Equal(t, domaincart.ErrGiftCardNotFound, ledger.Release(ctx, "unknown", "cart-2"))
+
+	balance, err = ledger.Balance(ctx, "gift-50")
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, balance.Available.FloatAmount())
+}
+
+func TestGiftCardMinorUnits(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, int64(1999), toMinorUnits(priceDomain.NewFromFloat(19.99, "EUR")))
+	assert.InDelta(t, 19.99, fromMinorUnits(1999, "EUR").FloatAmount(), 0.0001)
+	assert.Equal(t, "EUR", fromMinorUnits(1999, "EUR").Currency())
+}
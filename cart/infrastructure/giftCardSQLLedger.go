@@ -0,0 +1,335 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/internal/sqlutil"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+type (
+	// SQLGiftCardLedger persists gift cards and their bookings in a SQL database using database/sql.
+	// Amounts are stored in the smallest currency unit (e.g. cents).
+	// The database driver (e.g. sqlite3 or postgres) must be registered by the project.
+	SQLGiftCardLedger struct {
+		db        *sql.DB
+		logger    flamingo.Logger
+		tableName string
+		postgres  bool
+	}
+)
+
+var (
+	_ domaincart.GiftCardService = &SQLGiftCardLedger{}
+	_ healthcheck.Status         = &SQLGiftCardLedger{}
+)
+
+// Inject dependencies, open the database and issue the configured gift cards that don't exist yet
+func (l *SQLGiftCardLedger) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Driver    string       `inject:"config:commerce.cart.giftCardLedger.sql.driver"`
+		DSN       string       `inject:"config:commerce.cart.giftCardLedger.sql.dsn"`
+		TableName string       `inject:"config:commerce.cart.giftCardLedger.sql.tableName"`
+		Migrate   bool         `inject:"config:commerce.cart.giftCardLedger.sql.migrate"`
+		Cards     config.Slice `inject:"config:commerce.cart.giftCardLedger.cards,optional"`
+	},
+) *SQLGiftCardLedger {
+	l.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "SQLGiftCardLedger")
+	if cfg == nil {
+		return l
+	}
+
+	db, err := sql.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		l.logger.Error(fmt.Sprintf("cart.infrastructure.SQLGiftCardLedger: cannot open database with driver %q: %s", cfg.Driver, err))
+		return l
+	}
+
+	l.SetDB(db, cfg.Driver, cfg.TableName)
+	if cfg.Migrate {
+		if err := l.Migrate(context.Background()); err != nil {
+			l.logger.Error("cart.infrastructure.SQLGiftCardLedger: migration failed: ", err)
+			return l
+		}
+	}
+
+	for _, card := range mapGiftCardDefinitions(l.logger, cfg.Cards) {
+		err := l.Issue(context.Background(), card.Code, priceDomain.NewFromFloat(card.Amount, card.Currency))
+		if err != nil {
+			l.logger.Error(fmt.Sprintf("cart.infrastructure.SQLGiftCardLedger: cannot issue gift card %q: %s", card.Code, err))
+		}
+	}
+
+	return l
+}
+
+// SetDB sets the database handle used by the ledger, the driver name decides on the SQL dialect
+func (l *SQLGiftCardLedger) SetDB(db *sql.DB, driver string, tableName string) {
+	l.db = db
+	l.postgres = sqlutil.IsPostgres(driver)
+	l.tableName = tableName
+	if l.tableName == "" {
+		l.tableName = "gift_cards"
+	}
+}
+
+// Migrate creates the gift card and booking tables if they do not exist yet
+func (l *SQLGiftCardLedger) Migrate(ctx context.Context) error {
+	if l.db == nil {
+		return errors.New("no database configured")
+	}
+
+	for _, statement := range l.schema() {
+		if _, err := l.db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *SQLGiftCardLedger) schema() []string {
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	code VARCHAR(255) NOT NULL PRIMARY KEY,
+	currency VARCHAR(3) NOT NULL,
+	amount BIGINT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+)`, l.tableName),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	code VARCHAR(255) NOT NULL,
+	reference VARCHAR(255) NOT NULL,
+	amount BIGINT NOT NULL,
+	captured BOOLEAN NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (code, reference)
+)`, l.bookingTableName()),
+	}
+}
+
+func (l *SQLGiftCardLedger) bookingTableName() string {
+	return l.tableName + "_bookings"
+}
+
+// rebind replaces the "?" placeholders of the query with numbered ones if the dialect requires it
+func (l *SQLGiftCardLedger) rebind(query string) string {
+	return sqlutil.Rebind(query, l.postgres)
+}
+
+// Issue adds a gift card with the given value to the ledger, an existing gift card is left unchanged
+// so that issuing the configured gift cards on every start doesn't reset their value
+func (l *SQLGiftCardLedger) Issue(ctx context.Context, code string, value priceDomain.Price) error {
+	if l.db == nil {
+		return errors.New("no database configured")
+	}
+
+	_, err := l.db.ExecContext(
+		ctx,
+		l.rebind(fmt.Sprintf("INSERT INTO %s (code, currency, amount, created_at) VALUES (?, ?, ?, ?) ON CONFLICT (code) DO NOTHING", l.tableName)),
+		code, value.Currency(), toMinorUnits(value), time.Now().UTC(),
+	)
+
+	return err
+}
+
+// Balance returns the current balance of the gift card
+func (l *SQLGiftCardLedger) Balance(ctx context.Context, code string) (*domaincart.GiftCardBalance, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLGiftCardLedger/Balance")
+	defer span.End()
+
+	if l.db == nil {
+		return nil, errors.New("no database configured")
+	}
+
+	currency, value, err := l.card(ctx, l.db, code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var booked, reserved int64
+	err = l.db.QueryRowContext(
+		ctx,
+		l.rebind(fmt.Sprintf("SELECT COALESCE(SUM(amount), 0), COALESCE(SUM(CASE WHEN captured THEN 0 ELSE amount END), 0) FROM %s WHERE code = ?", l.bookingTableName())),
+		code,
+	).Scan(&booked, &reserved)
+	if err != nil {
+		return nil, errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot load bookings")
+	}
+
+	return &domaincart.GiftCardBalance{
+		Code:      code,
+		Available: fromMinorUnits(value-booked, currency),
+		Reserved:  fromMinorUnits(reserved, currency),
+	}, nil
+}
+
+// Reserve blocks the amount on the gift card for the reference
+func (l *SQLGiftCardLedger) Reserve(ctx context.Context, code string, amount priceDomain.Price, reference string) error {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLGiftCardLedger/Reserve")
+	defer span.End()
+
+	if l.db == nil {
+		return errors.New("no database configured")
+	}
+
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// lock the gift card so that concurrent reservations can't exceed the balance
+	currency, value, err := l.card(ctx, tx, code, true)
+	if err != nil {
+		return err
+	}
+
+	var captured bool
+	err = tx.QueryRowContext(ctx, l.rebind(fmt.Sprintf("SELECT captured FROM %s WHERE code = ? AND reference = ?", l.bookingTableName())), code, reference).Scan(&captured)
+	if err != nil && err != sql.ErrNoRows {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot load booking")
+	}
+	if captured {
+		return ErrGiftCardAlreadyCaptured
+	}
+
+	var booked int64
+	err = tx.QueryRowContext(ctx, l.rebind(fmt.Sprintf("SELECT COALESCE(SUM(amount), 0) FROM %s WHERE code = ? AND reference <> ?", l.bookingTableName())), code, reference).Scan(&booked)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot load bookings")
+	}
+
+	if err := checkGiftCardAmount(fromMinorUnits(value-booked, currency), amount); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, l.rebind(fmt.Sprintf("DELETE FROM %s WHERE code = ? AND reference = ?", l.bookingTableName())), code, reference)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot replace reservation")
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		l.rebind(fmt.Sprintf("INSERT INTO %s (code, reference, amount, captured, created_at) VALUES (?, ?, ?, ?, ?)", l.bookingTableName())),
+		code, reference, toMinorUnits(amount), false, time.Now().UTC(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot insert reservation")
+	}
+
+	return tx.Commit()
+}
+
+// Capture finally books the amount that is reserved for the reference
+func (l *SQLGiftCardLedger) Capture(ctx context.Context, code string, reference string) error {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLGiftCardLedger/Capture")
+	defer span.End()
+
+	if l.db == nil {
+		return errors.New("no database configured")
+	}
+
+	result, err := l.db.ExecContext(ctx, l.rebind(fmt.Sprintf("UPDATE %s SET captured = ? WHERE code = ? AND reference = ?", l.bookingTableName())), true, code, reference)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot capture reservation")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected > 0 {
+		return err
+	}
+
+	if _, _, err := l.card(ctx, l.db, code, false); err != nil {
+		return err
+	}
+
+	return domaincart.ErrGiftCardReservationNotFound
+}
+
+// Release frees the amount that is reserved or captured for the reference
+func (l *SQLGiftCardLedger) Release(ctx context.Context, code string, reference string) error {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLGiftCardLedger/Release")
+	defer span.End()
+
+	if l.db == nil {
+		return errors.New("no database configured")
+	}
+
+	result, err := l.db.ExecContext(ctx, l.rebind(fmt.Sprintf("DELETE FROM %s WHERE code = ? AND reference = ?", l.bookingTableName())), code, reference)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot release booking")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil || affected > 0 {
+		return err
+	}
+
+	_, _, err = l.card(ctx, l.db, code, false)
+
+	return err
+}
+
+// Status handles the health check of the database
+func (l *SQLGiftCardLedger) Status() (alive bool, details string) {
+	if l.db == nil {
+		return false, "no database configured for gift card ledger"
+	}
+
+	err := l.db.Ping()
+	if err == nil {
+		return true, "database for gift card ledger replies to ping"
+	}
+
+	return false, err.Error()
+}
+
+type sqlQueryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// card loads the currency and the value of the gift card
+func (l *SQLGiftCardLedger) card(ctx context.Context, db sqlQueryRower, code string, forUpdate bool) (string, int64, error) {
+	query := fmt.Sprintf("SELECT currency, amount FROM %s WHERE code = ?", l.tableName)
+	if forUpdate && l.postgres {
+		query += " FOR UPDATE"
+	}
+
+	var (
+		currency string
+		value    int64
+	)
+	err := db.QueryRowContext(ctx, l.rebind(query), code).Scan(&currency, &value)
+	if err == sql.ErrNoRows {
+		return "", 0, domaincart.ErrGiftCardNotFound
+	}
+	if err != nil {
+		return "", 0, errors.Wrap(err, "cart.infrastructure.SQLGiftCardLedger: cannot load gift card")
+	}
+
+	return currency, value, nil
+}
+
+// toMinorUnits converts the price to the smallest currency unit
+func toMinorUnits(price priceDomain.Price) int64 {
+	return int64(math.Round(price.FloatAmount() * 100))
+}
+
+// fromMinorUnits converts an amount in the smallest currency unit to a price
+func fromMinorUnits(amount int64, currency string) priceDomain.Price {
+	return priceDomain.NewFromInt(amount, 100, currency)
+}
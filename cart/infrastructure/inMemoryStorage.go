@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 )
@@ -12,17 +13,29 @@ type (
 	// InMemoryCartStorage - for now the default implementation of GuestCartStorage
 	InMemoryCartStorage struct {
 		guestCarts map[string]*domaincart.Cart
+		activities map[string]cartActivity
 		locker     sync.Locker
 	}
+
+	// cartActivity holds the creation and last modification time of a stored cart
+	cartActivity struct {
+		createdAt time.Time
+		updatedAt time.Time
+	}
 )
 
-var _ CartStorage = &InMemoryCartStorage{}
+var (
+	_ CartStorage         = &InMemoryCartStorage{}
+	_ CustomerCartStorage = &InMemoryCartStorage{}
+	_ ActivityCartStorage = &InMemoryCartStorage{}
+)
 
 // Inject dependencies and prepare storage
 // Important: InMemoryStorage MUST be bound AsEagerSingleton, Inject MUST be called in tests to behave as expected
 func (s *InMemoryCartStorage) Inject() *InMemoryCartStorage {
 	s.locker = &sync.Mutex{}
 	s.guestCarts = make(map[string]*domaincart.Cart)
+	s.activities = make(map[string]cartActivity)
 
 	return s
 }
@@ -54,7 +67,15 @@ func (s *InMemoryCartStorage) StoreCart(_ context.Context, cart *domaincart.Cart
 	s.locker.Lock()
 	defer s.locker.Unlock()
 
+	now := time.Now()
+	activity, ok := s.activities[cart.ID]
+	if !ok {
+		activity.createdAt = now
+	}
+	activity.updatedAt = now
+
 	s.guestCarts[cart.ID] = cart
+	s.activities[cart.ID] = activity
 	return nil
 }
 
@@ -64,5 +85,36 @@ func (s *InMemoryCartStorage) RemoveCart(_ context.Context, cart *domaincart.Car
 	defer s.locker.Unlock()
 
 	delete(s.guestCarts, cart.ID)
+	delete(s.activities, cart.ID)
 	return nil
 }
+
+// GetCartsByCustomer returns all carts that belong to the given customer
+func (s *InMemoryCartStorage) GetCartsByCustomer(_ context.Context, customerID string) ([]*domaincart.Cart, error) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	var carts []*domaincart.Cart
+	for _, cart := range s.guestCarts {
+		if cart.BelongsToAuthenticatedUser && cart.AuthenticatedUserID == customerID {
+			carts = append(carts, cart)
+		}
+	}
+
+	return carts, nil
+}
+
+// GetCartsModifiedBetween returns all carts whose last modification is within [from, to)
+func (s *InMemoryCartStorage) GetCartsModifiedBetween(_ context.Context, from time.Time, to time.Time) ([]*domaincart.Cart, error) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	var carts []*domaincart.Cart
+	for id, activity := range s.activities {
+		if !activity.updatedAt.Before(from) && activity.updatedAt.Before(to) {
+			carts = append(carts, s.guestCarts[id])
+		}
+	}
+
+	return carts, nil
+}
@@ -0,0 +1,71 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// PromotionUsageStorage counts the carts a promotion code is applied to, it is used by the PromotionVoucherHandler
+	// to enforce the usage limits. Bind an implementation backed by a shared storage if more than one instance is running.
+	PromotionUsageStorage interface {
+		// Use registers the usage of the code by the cart, ErrPromotionUsageLimitReached is returned if the code is
+		// already used by limit other carts. A limit of 0 means unlimited, using a code twice for the same cart counts once.
+		Use(ctx context.Context, code string, cartID string, limit int) error
+		// Release removes the usage of the code by the cart
+		Release(ctx context.Context, code string, cartID string) error
+	}
+
+	// InMemoryPromotionUsageStorage is the default PromotionUsageStorage, usages are lost on restart
+	InMemoryPromotionUsageStorage struct {
+		usages map[string]map[string]struct{}
+		locker sync.Locker
+	}
+)
+
+var _ PromotionUsageStorage = &InMemoryPromotionUsageStorage{}
+
+// Inject dependencies and prepare storage
+// Important: InMemoryPromotionUsageStorage MUST be bound as singleton, Inject MUST be called in tests to behave as expected
+func (s *InMemoryPromotionUsageStorage) Inject() *InMemoryPromotionUsageStorage {
+	s.locker = &sync.Mutex{}
+	s.usages = make(map[string]map[string]struct{})
+
+	return s
+}
+
+// Use registers the usage of the code by the cart
+func (s *InMemoryPromotionUsageStorage) Use(_ context.Context, code string, cartID string, limit int) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	carts := s.usages[code]
+	if _, used := carts[cartID]; used {
+		return nil
+	}
+
+	if limit > 0 && len(carts) >= limit {
+		return ErrPromotionUsageLimitReached
+	}
+
+	if carts == nil {
+		carts = make(map[string]struct{})
+		s.usages[code] = carts
+	}
+	carts[cartID] = struct{}{}
+
+	return nil
+}
+
+// Release removes the usage of the code by the cart
+func (s *InMemoryPromotionUsageStorage) Release(_ context.Context, code string, cartID string) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	delete(s.usages[code], cartID)
+	if len(s.usages[code]) == 0 {
+		delete(s.usages, code)
+	}
+
+	return nil
+}
@@ -0,0 +1,583 @@
+package infrastructure
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+// Promotion types that can be configured for a PromotionRule, they are also used as type of the resulting AppliedDiscount
+const (
+	// PromotionTypePercentageOff reduces the matching items by Value percent
+	PromotionTypePercentageOff = "percentageOff"
+	// PromotionTypeFixedOff reduces every unit of the matching items by the amount Value
+	PromotionTypeFixedOff = "fixedOff"
+	// PromotionTypeBuyXGetY reduces GetQty units by Value percent (100 if not set) for every BuyQty+GetQty units of a matching item
+	PromotionTypeBuyXGetY = "buyXGetY"
+	// PromotionTypeFreeShipping removes the shipping costs of every delivery that contains a matching item
+	PromotionTypeFreeShipping = "freeShipping"
+	// PromotionTypeTieredCartDiscount reduces the matching items by the percentage of the highest tier reached by their total
+	PromotionTypeTieredCartDiscount = "tieredCartDiscount"
+)
+
+type (
+	// PromotionVoucherHandler is a rule based VoucherHandler for the DefaultCartBehaviour.
+	// The promotions are configured in commerce.cart.defaultCartAdapter.promotions and are redeemed by their coupon code.
+	PromotionVoucherHandler struct {
+		productService      domain.ProductService
+		itemBuilderProvider domaincart.ItemBuilderProvider
+		usageStorage        PromotionUsageStorage
+		logger              flamingo.Logger
+		useGrossPrice       bool
+		promotions          []promotion
+		now                 func() time.Time
+	}
+
+	// PromotionRule describes a single promotion
+	PromotionRule struct {
+		// Code is the coupon code that activates the promotion
+		Code string `json:"code"`
+		// CampaignCode is used for the resulting discounts, defaults to Code
+		CampaignCode string `json:"campaignCode"`
+		Label        string `json:"label"`
+		// Type is one of the PromotionType constants
+		Type string `json:"type"`
+		// Value is the percentage or the fixed amount depending on the Type
+		Value  float64         `json:"value"`
+		BuyQty int             `json:"buyQty"`
+		GetQty int             `json:"getQty"`
+		Tiers  []PromotionTier `json:"tiers"`
+		// Conditions restrict the items the promotion applies to
+		Conditions PromotionConditions `json:"conditions"`
+		// ValidFrom and ValidTo are optional RFC3339 dates that limit the period in which the promotion is active
+		ValidFrom string `json:"validFrom"`
+		ValidTo   string `json:"validTo"`
+		// UsageLimit is the number of carts the code can be applied to, 0 means unlimited
+		UsageLimit int `json:"usageLimit"`
+		// SortOrder defines the order in which the promotions are calculated
+		SortOrder int `json:"sortOrder"`
+	}
+
+	// PromotionTier is a step of a tiered cart discount
+	PromotionTier struct {
+		MinimumTotal float64 `json:"minimumTotal"`
+		Percentage   float64 `json:"percentage"`
+	}
+
+	// PromotionConditions restrict a promotion, all given conditions have to match
+	PromotionConditions struct {
+		MarketplaceCodes []string `json:"marketplaceCodes"`
+		// Categories match the main category and all other categories of a product including their parents
+		Categories []string `json:"categories"`
+		// Attributes match if the product attribute has the given value
+		Attributes       map[string]string `json:"attributes"`
+		MinimumCartTotal float64           `json:"minimumCartTotal"`
+	}
+
+	promotion struct {
+		PromotionRule
+		validFrom time.Time
+		validTo   time.Time
+	}
+)
+
+var (
+	// ErrPromotionCodeInvalid is returned if no promotion is configured for a coupon code
+	ErrPromotionCodeInvalid = errors.New("promotion code invalid")
+	// ErrPromotionNotActive is returned if a promotion is applied outside of its validity period
+	ErrPromotionNotActive = errors.New("promotion not active")
+	// ErrPromotionUsageLimitReached is returned if a promotion has already been applied as often as allowed
+	ErrPromotionUsageLimitReached = errors.New("promotion usage limit reached")
+
+	_ VoucherHandler       = (*PromotionVoucherHandler)(nil)
+	_ DiscountRecalculator = (*PromotionVoucherHandler)(nil)
+	_ VoucherReleaser      = (*PromotionVoucherHandler)(nil)
+)
+
+// Inject dependencies
+func (h *PromotionVoucherHandler) Inject(
+	productService domain.ProductService,
+	itemBuilderProvider domaincart.ItemBuilderProvider,
+	usageStorage PromotionUsageStorage,
+	logger flamingo.Logger,
+	cfg *struct {
+		Promotions    config.Slice `inject:"config:commerce.cart.defaultCartAdapter.promotions,optional"`
+		UseGrossPrice bool         `inject:"config:commerce.product.priceIsGross,optional"`
+	},
+) *PromotionVoucherHandler {
+	h.productService = productService
+	h.itemBuilderProvider = itemBuilderProvider
+	h.usageStorage = usageStorage
+	h.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "promotionVoucherHandler")
+	h.now = time.Now
+
+	if cfg != nil {
+		h.useGrossPrice = cfg.UseGrossPrice
+
+		var rules []PromotionRule
+		err := cfg.Promotions.MapInto(&rules)
+		if err != nil {
+			h.logger.Error("promotions could not be mapped: ", err)
+		}
+		h.setRules(rules)
+	}
+
+	return h
+}
+
+func (h *PromotionVoucherHandler) setRules(rules []PromotionRule) {
+	h.promotions = make([]promotion, 0, len(rules))
+	for _, rule := range rules {
+		p := promotion{PromotionRule: rule}
+		if p.CampaignCode == "" {
+			p.CampaignCode = p.Code
+		}
+
+		var err error
+		if p.ValidFrom != "" {
+			p.validFrom, err = time.Parse(time.RFC3339, p.ValidFrom)
+		}
+		if err == nil && p.ValidTo != "" {
+			p.validTo, err = time.Parse(time.RFC3339, p.ValidTo)
+		}
+		if err != nil {
+			h.logger.Error("promotion ", p.Code, " skipped, invalid validity period: ", err)
+			continue
+		}
+
+		h.promotions = append(h.promotions, p)
+	}
+
+	sort.SliceStable(h.promotions, func(i, j int) bool {
+		return h.promotions[i].SortOrder < h.promotions[j].SortOrder
+	})
+}
+
+// ApplyVoucher adds the coupon code of an active promotion to the cart and calculates the discounts
+func (h *PromotionVoucherHandler) ApplyVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, error) {
+	p, found := h.promotionByCode(couponCode)
+	if !found {
+		return nil, ErrPromotionCodeInvalid
+	}
+
+	if !p.isActive(h.now()) {
+		return nil, ErrPromotionNotActive
+	}
+
+	if !hasCouponCode(cart, couponCode) {
+		err := h.usageStorage.Use(ctx, p.Code, cart.ID, p.UsageLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		cart.AppliedCouponCodes = append(cart.AppliedCouponCodes, domaincart.CouponCode{Code: couponCode})
+	}
+
+	return h.RecalculateDiscounts(ctx, cart)
+}
+
+// RemoveVoucher removes the coupon code and its discounts from the cart
+func (h *PromotionVoucherHandler) RemoveVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, error) {
+	for i, coupon := range cart.AppliedCouponCodes {
+		if coupon.Code == couponCode {
+			cart.AppliedCouponCodes = append(cart.AppliedCouponCodes[:i], cart.AppliedCouponCodes[i+1:]...)
+			if h.isPromotionCode(couponCode) {
+				h.release(ctx, couponCode, cart.ID)
+			}
+			break
+		}
+	}
+
+	return h.RecalculateDiscounts(ctx, cart)
+}
+
+// RecalculateDiscounts replaces all promotion discounts of the cart with the ones resulting from the applied coupon codes
+func (h *PromotionVoucherHandler) RecalculateDiscounts(ctx context.Context, cart *domaincart.Cart) (*domaincart.Cart, error) {
+	now := h.now()
+	products := make(map[string]domain.BasicProduct)
+	cartTotal := h.itemsTotal(cart, func(domaincart.Item) bool { return true })
+
+	discounts := make(map[string]domaincart.AppliedDiscounts)
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			discounts[item.ID] = h.withoutPromotionDiscounts(item.AppliedDiscounts)
+		}
+	}
+
+	shippingDiscounts := make(map[string]domaincart.AppliedDiscounts)
+	for _, delivery := range cart.Deliveries {
+		shippingDiscounts[delivery.DeliveryInfo.Code] = h.withoutPromotionDiscounts(delivery.ShippingItem.AppliedDiscounts)
+	}
+
+	for _, p := range h.promotions {
+		if !hasCouponCode(cart, p.Code) || !p.isActive(now) {
+			continue
+		}
+
+		if p.Conditions.MinimumCartTotal > 0 && cartTotal.FloatAmount() < p.Conditions.MinimumCartTotal {
+			continue
+		}
+
+		matches := func(item domaincart.Item) bool {
+			return h.matchesItem(ctx, p, item, products)
+		}
+
+		switch p.Type {
+		case PromotionTypePercentageOff, PromotionTypeFixedOff, PromotionTypeBuyXGetY:
+			for _, delivery := range cart.Deliveries {
+				for _, item := range delivery.Cartitems {
+					if !matches(item) {
+						continue
+					}
+					discounts[item.ID] = h.addItemDiscount(item, discounts[item.ID], p, h.itemDiscountAmount(p, item), true)
+				}
+			}
+		case PromotionTypeTieredCartDiscount:
+			percentage := p.tierPercentage(h.itemsTotal(cart, matches).FloatAmount())
+			if percentage <= 0 {
+				continue
+			}
+			for _, delivery := range cart.Deliveries {
+				for _, item := range delivery.Cartitems {
+					if !matches(item) {
+						continue
+					}
+					base, _ := h.rowPrice(item).Add(itemRelatedDiscounts(discounts[item.ID]))
+					discounts[item.ID] = h.addItemDiscount(item, discounts[item.ID], p, percentageOf(base, percentage), false)
+				}
+			}
+		case PromotionTypeFreeShipping:
+			for _, delivery := range cart.Deliveries {
+				if !deliveryHasMatchingItem(delivery, matches) {
+					continue
+				}
+				shippingTotal, _ := delivery.ShippingItem.PriceNet.Add(delivery.ShippingItem.TaxAmount)
+				alreadyDiscounted, _ := shippingDiscounts[delivery.DeliveryInfo.Code].Sum()
+				remaining, _ := shippingTotal.Add(alreadyDiscounted)
+				if !remaining.GetPayable().IsPositive() {
+					continue
+				}
+				shippingDiscounts[delivery.DeliveryInfo.Code] = append(shippingDiscounts[delivery.DeliveryInfo.Code], p.appliedDiscount(remaining.GetPayable().Inverse(), false))
+			}
+		default:
+			h.logger.WithContext(ctx).Warn("promotion ", p.Code, " has unknown type ", p.Type)
+		}
+	}
+
+	for deliveryIndex, delivery := range cart.Deliveries {
+		for itemIndex, item := range delivery.Cartitems {
+			newItem, err := h.rebuildItem(item, discounts[item.ID])
+			if err != nil {
+				return nil, errors.Wrap(err, "cart.infrastructure.PromotionVoucherHandler: error on recalculating item")
+			}
+			cart.Deliveries[deliveryIndex].Cartitems[itemIndex] = *newItem
+		}
+		cart.Deliveries[deliveryIndex].ShippingItem.AppliedDiscounts = shippingDiscounts[delivery.DeliveryInfo.Code]
+	}
+
+	return cart, nil
+}
+
+func (h *PromotionVoucherHandler) promotionByCode(code string) (promotion, bool) {
+	for _, p := range h.promotions {
+		if p.Code == code {
+			return p, true
+		}
+	}
+
+	return promotion{}, false
+}
+
+func (h *PromotionVoucherHandler) isPromotionCode(code string) bool {
+	_, found := h.promotionByCode(code)
+	return found
+}
+
+// ReleaseVouchers releases the usages of all promotion codes applied to the cart, e.g. if the cart is deleted
+func (h *PromotionVoucherHandler) ReleaseVouchers(ctx context.Context, cart *domaincart.Cart) error {
+	for _, coupon := range cart.AppliedCouponCodes {
+		if h.isPromotionCode(coupon.Code) {
+			h.release(ctx, coupon.Code, cart.ID)
+		}
+	}
+
+	return nil
+}
+
+// release removes the usage of the code by the cart, a failure only leaves a usage that can't be redeemed anymore
+func (h *PromotionVoucherHandler) release(ctx context.Context, code string, cartID string) {
+	err := h.usageStorage.Release(ctx, code, cartID)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("usage of promotion ", code, " by cart ", cartID, " could not be released: ", err)
+	}
+}
+
+func (h *PromotionVoucherHandler) withoutPromotionDiscounts(discounts domaincart.AppliedDiscounts) domaincart.AppliedDiscounts {
+	var result domaincart.AppliedDiscounts
+	for _, discount := range discounts {
+		if h.isPromotionCode(discount.CouponCode) {
+			continue
+		}
+		result = append(result, discount)
+	}
+
+	return result
+}
+
+// rowPrice is the undiscounted row price the promotions are calculated on
+func (h *PromotionVoucherHandler) rowPrice(item domaincart.Item) priceDomain.Price {
+	if h.useGrossPrice {
+		return item.RowPriceGross
+	}
+
+	return item.RowPriceNet
+}
+
+func (h *PromotionVoucherHandler) singlePrice(item domaincart.Item) priceDomain.Price {
+	if h.useGrossPrice {
+		return item.SinglePriceGross
+	}
+
+	return item.SinglePriceNet
+}
+
+func (h *PromotionVoucherHandler) itemsTotal(cart *domaincart.Cart, filter func(domaincart.Item) bool) priceDomain.Price {
+	var prices []priceDomain.Price
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			if filter(item) {
+				prices = append(prices, h.rowPrice(item))
+			}
+		}
+	}
+
+	total, _ := priceDomain.SumAll(prices...)
+
+	return total
+}
+
+func (h *PromotionVoucherHandler) itemDiscountAmount(p promotion, item domaincart.Item) priceDomain.Price {
+	switch p.Type {
+	case PromotionTypePercentageOff:
+		return percentageOf(h.rowPrice(item), p.Value)
+	case PromotionTypeFixedOff:
+		return priceDomain.NewFromFloat(p.Value, h.rowPrice(item).Currency()).Multiply(item.Qty)
+	case PromotionTypeBuyXGetY:
+		if p.GetQty <= 0 || p.BuyQty < 0 {
+			return priceDomain.NewZero(h.rowPrice(item).Currency())
+		}
+		percentage := p.Value
+		if percentage <= 0 {
+			percentage = 100
+		}
+		freeQty := item.Qty / (p.BuyQty + p.GetQty) * p.GetQty
+		return percentageOf(h.singlePrice(item).Multiply(freeQty), percentage)
+	}
+
+	return priceDomain.NewZero(h.rowPrice(item).Currency())
+}
+
+// addItemDiscount adds the discount of the promotion, the sum of all discounts never exceeds the row price of the item
+func (h *PromotionVoucherHandler) addItemDiscount(item domaincart.Item, discounts domaincart.AppliedDiscounts, p promotion, amount priceDomain.Price, itemRelated bool) domaincart.AppliedDiscounts {
+	alreadyDiscounted, _ := discounts.Sum()
+	remaining, _ := h.rowPrice(item).Add(alreadyDiscounted)
+	if amount.IsGreaterThen(remaining) {
+		amount = remaining
+	}
+
+	amount = amount.GetPayable()
+	if !amount.IsPositive() {
+		return discounts
+	}
+
+	return append(discounts, p.appliedDiscount(amount.Inverse(), itemRelated))
+}
+
+func (h *PromotionVoucherHandler) matchesItem(ctx context.Context, p promotion, item domaincart.Item, products map[string]domain.BasicProduct) bool {
+	conditions := p.Conditions
+	if len(conditions.MarketplaceCodes) > 0 &&
+		!containsString(conditions.MarketplaceCodes, item.MarketplaceCode) &&
+		!containsString(conditions.MarketplaceCodes, item.VariantMarketPlaceCode) {
+		return false
+	}
+
+	if len(conditions.Categories) == 0 && len(conditions.Attributes) == 0 {
+		return true
+	}
+
+	product, err := h.product(ctx, item, products)
+	if err != nil {
+		h.logger.WithContext(ctx).Warn("promotion ", p.Code, " skipped for item ", item.ID, ": ", err)
+		return false
+	}
+
+	if len(conditions.Categories) > 0 && !inCategories(product.BaseData(), conditions.Categories) {
+		return false
+	}
+
+	for code, value := range conditions.Attributes {
+		if !product.BaseData().HasAttribute(code) {
+			return false
+		}
+		attribute := product.BaseData().Attribute(code)
+		if attribute.HasMultipleValues() {
+			if !containsString(attribute.Values(), value) {
+				return false
+			}
+			continue
+		}
+		if attribute.Value() != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *PromotionVoucherHandler) product(ctx context.Context, item domaincart.Item, products map[string]domain.BasicProduct) (domain.BasicProduct, error) {
+	key := item.MarketplaceCode + "/" + item.VariantMarketPlaceCode
+	if product, found := products[key]; found {
+		return product, nil
+	}
+
+	product, err := h.productService.Get(ctx, item.MarketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if configurable, ok := product.(domain.ConfigurableProduct); ok && item.VariantMarketPlaceCode != "" {
+		product, err = configurable.GetConfigurableWithActiveVariant(item.VariantMarketPlaceCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	products[key] = product
+
+	return product, nil
+}
+
+func (h *PromotionVoucherHandler) rebuildItem(item domaincart.Item, discounts domaincart.AppliedDiscounts) (*domaincart.Item, error) {
+	item.AppliedDiscounts = discounts
+
+	itemBuilder := h.itemBuilderProvider()
+	itemBuilder.SetFromItem(item).SetSourceID(item.SourceID).SetAdditionalData(item.AdditionalData)
+	for _, tax := range item.RowTaxes {
+		if tax.Rate != nil {
+			itemBuilder.AddTaxInfo(tax.Type, tax.Rate, nil)
+			continue
+		}
+		amount := tax.Amount
+		itemBuilder.AddTaxInfo(tax.Type, nil, &amount)
+	}
+	itemBuilder.CalculatePricesAndTax()
+
+	return itemBuilder.Build()
+}
+
+func (p promotion) isActive(now time.Time) bool {
+	if !p.validFrom.IsZero() && now.Before(p.validFrom) {
+		return false
+	}
+
+	return p.validTo.IsZero() || now.Before(p.validTo)
+}
+
+// tierPercentage returns the percentage of the highest tier reached by total
+func (p promotion) tierPercentage(total float64) float64 {
+	percentage := 0.0
+	reached := -1.0
+	for _, tier := range p.Tiers {
+		if total >= tier.MinimumTotal && tier.MinimumTotal > reached {
+			reached = tier.MinimumTotal
+			percentage = tier.Percentage
+		}
+	}
+
+	return percentage
+}
+
+func (p promotion) appliedDiscount(applied priceDomain.Price, itemRelated bool) domaincart.AppliedDiscount {
+	return domaincart.AppliedDiscount{
+		CampaignCode:  p.CampaignCode,
+		CouponCode:    p.Code,
+		Label:         p.Label,
+		Applied:       applied,
+		Type:          p.Type,
+		IsItemRelated: itemRelated,
+		SortOrder:     p.SortOrder,
+	}
+}
+
+func itemRelatedDiscounts(discounts domaincart.AppliedDiscounts) priceDomain.Price {
+	var prices []priceDomain.Price
+	for _, discount := range discounts {
+		if discount.IsItemRelated {
+			prices = append(prices, discount.Applied)
+		}
+	}
+
+	sum, _ := priceDomain.SumAll(prices...)
+
+	return sum
+}
+
+func deliveryHasMatchingItem(delivery domaincart.Delivery, matches func(domaincart.Item) bool) bool {
+	for _, item := range delivery.Cartitems {
+		if matches(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func inCategories(product domain.BasicProductData, codes []string) bool {
+	teasers := append([]domain.CategoryTeaser{product.MainCategory}, product.Categories...)
+	for _, teaser := range teasers {
+		if containsString(codes, teaser.Code) {
+			return true
+		}
+		for _, pathCode := range strings.Split(teaser.Path, "/") {
+			if pathCode != "" && containsString(codes, pathCode) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func hasCouponCode(cart *domaincart.Cart, code string) bool {
+	for _, coupon := range cart.AppliedCouponCodes {
+		if coupon.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(list []string, value string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// percentageOf returns percent of the price
+func percentageOf(price priceDomain.Price, percent float64) priceDomain.Price {
+	return price.Discounted(100 - percent)
+}
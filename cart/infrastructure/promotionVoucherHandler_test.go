@@ -0,0 +1,224 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type promotionTestProductService struct {
+	products map[string]domain.BasicProduct
+}
+
+func (s promotionTestProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	if product, found := s.products[marketplaceCode]; found {
+		return product, nil
+	}
+
+	return nil, errors.New("product not found")
+}
+
+func newPromotionVoucherHandler(rules ...PromotionRule) *PromotionVoucherHandler {
+	handler := new(PromotionVoucherHandler).Inject(
+		promotionTestProductService{products: map[string]domain.BasicProduct{
+			"shoe": domain.SimpleProduct{BasicProductData: domain.BasicProductData{
+				MarketPlaceCode: "shoe",
+				MainCategory:    domain.CategoryTeaser{Code: "sneaker", Path: "clothing/shoes/sneaker"},
+				Attributes:      domain.Attributes{"brand": {Code: "brand", RawValue: "acme"}},
+			}},
+			"shirt": domain.SimpleProduct{BasicProductData: domain.BasicProductData{
+				MarketPlaceCode: "shirt",
+				MainCategory:    domain.CategoryTeaser{Code: "shirts", Path: "clothing/shirts"},
+			}},
+		}},
+		func() *domaincart.ItemBuilder {
+			return &domaincart.ItemBuilder{}
+		},
+		new(InMemoryPromotionUsageStorage).Inject(),
+		flamingo.NullLogger{},
+		nil,
+	)
+	handler.setRules(rules)
+
+	return handler
+}
+
+func newPromotionTestItem(t *testing.T, marketplaceCode string, qty int, singlePrice float64) domaincart.Item {
+	t.Helper()
+
+	item, err := new(domaincart.ItemBuilder).
+		SetID(marketplaceCode).
+		SetProductData(marketplaceCode, "", marketplaceCode).
+		SetQty(qty).
+		SetSinglePriceNet(priceDomain.NewFromFloat(singlePrice, "EUR")).
+		AddTaxInfo("default", big.NewFloat(0), nil).
+		CalculatePricesAndTax().
+		Build()
+	require.NoError(t, err)
+
+	return *item
+}
+
+func newPromotionTestCart(t *testing.T) *domaincart.Cart {
+	t.Helper()
+
+	return &domaincart.Cart{
+		ID: "cart",
+		Deliveries: []domaincart.Delivery{
+			{
+				DeliveryInfo: domaincart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []domaincart.Item{
+					newPromotionTestItem(t, "shoe", 3, 50),
+					newPromotionTestItem(t, "shirt", 2, 25),
+				},
+				ShippingItem: domaincart.ShippingItem{
+					PriceNet:  priceDomain.NewFromFloat(4.95, "EUR"),
+					TaxAmount: priceDomain.NewFromFloat(0, "EUR"),
+				},
+			},
+		},
+	}
+}
+
+func TestPromotionVoucherHandler_ApplyVoucher(t *testing.T) {
+	t.Parallel()
+
+	handler := newPromotionVoucherHandler(
+		PromotionRule{Code: "limited", Type: PromotionTypePercentageOff, Value: 10, UsageLimit: 1},
+		PromotionRule{Code: "expired", Type: PromotionTypePercentageOff, Value: 10, ValidTo: "2020-01-01T00:00:00Z"},
+		PromotionRule{Code: "upcoming", Type: PromotionTypePercentageOff, Value: 10, ValidFrom: "2020-03-01T00:00:00Z"},
+	)
+	handler.now = func() time.Time {
+		return time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	_, err := handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "unknown")
+	assert.Equal(t, ErrPromotionCodeInvalid, err)
+
+	_, err = handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "expired")
+	assert.Equal(t, ErrPromotionNotActive, err)
+
+	_, err = handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "upcoming")
+	assert.Equal(t, ErrPromotionNotActive, err)
+
+	firstCart, err := handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "limited")
+	require.NoError(t, err)
+	assert.Equal(t, []domaincart.CouponCode{{Code: "limited"}}, firstCart.AppliedCouponCodes)
+
+	// applying the code again to the same cart doesn't count as another usage
+	firstCart, err = handler.ApplyVoucher(context.Background(), firstCart, "limited")
+	require.NoError(t, err)
+	assert.Len(t, firstCart.AppliedCouponCodes, 1)
+
+	otherCart := newPromotionTestCart(t)
+	otherCart.ID = "other-cart"
+	_, err = handler.ApplyVoucher(context.Background(), otherCart, "limited")
+	assert.Equal(t, ErrPromotionUsageLimitReached, err)
+
+	firstCart, err = handler.RemoveVoucher(context.Background(), firstCart, "limited")
+	require.NoError(t, err)
+	assert.Empty(t, firstCart.AppliedCouponCodes)
+	assert.Empty(t, firstCart.Deliveries[0].Cartitems[0].AppliedDiscounts)
+
+	otherCart, err = handler.ApplyVoucher(context.Background(), otherCart, "limited")
+	assert.NoError(t, err)
+
+	// releasing the vouchers of a deleted cart frees its usages
+	require.NoError(t, handler.ReleaseVouchers(context.Background(), otherCart))
+	_, err = handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "limited")
+	assert.NoError(t, err)
+}
+
+func TestPromotionVoucherHandler_RecalculateDiscounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		rule                 PromotionRule
+		wantShoeDiscount     float64
+		wantShirtDiscount    float64
+		wantShippingDiscount float64
+		wantItemRelated      bool
+	}{
+		{
+			name:             "percentage off items of a category",
+			rule:             PromotionRule{Code: "code", Type: PromotionTypePercentageOff, Value: 10, Conditions: PromotionConditions{Categories: []string{"shoes"}}},
+			wantShoeDiscount: -15,
+			wantItemRelated:  true,
+		},
+		{
+			name:              "fixed off per unit is limited to the row price",
+			rule:              PromotionRule{Code: "code", Type: PromotionTypeFixedOff, Value: 30},
+			wantShoeDiscount:  -90,
+			wantShirtDiscount: -50,
+			wantItemRelated:   true,
+		},
+		{
+			name:             "buy two get one for items with an attribute",
+			rule:             PromotionRule{Code: "code", Type: PromotionTypeBuyXGetY, BuyQty: 2, GetQty: 1, Conditions: PromotionConditions{Attributes: map[string]string{"brand": "acme"}}},
+			wantShoeDiscount: -50,
+			wantItemRelated:  true,
+		},
+		{
+			name: "highest reached tier of the cart total",
+			rule: PromotionRule{Code: "code", Type: PromotionTypeTieredCartDiscount, Tiers: []PromotionTier{
+				{MinimumTotal: 100, Percentage: 5},
+				{MinimumTotal: 200, Percentage: 10},
+				{MinimumTotal: 500, Percentage: 20},
+			}},
+			wantShoeDiscount:  -15,
+			wantShirtDiscount: -5,
+		},
+		{
+			name:                 "free shipping above a minimum cart total",
+			rule:                 PromotionRule{Code: "code", Type: PromotionTypeFreeShipping, Conditions: PromotionConditions{MinimumCartTotal: 150}},
+			wantShippingDiscount: -4.95,
+		},
+		{
+			name: "minimum cart total not reached",
+			rule: PromotionRule{Code: "code", Type: PromotionTypeFreeShipping, Conditions: PromotionConditions{MinimumCartTotal: 500}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newPromotionVoucherHandler(tt.rule)
+
+			cart, err := handler.ApplyVoucher(context.Background(), newPromotionTestCart(t), "code")
+			require.NoError(t, err)
+
+			assertPromotionDiscount := func(discounts domaincart.AppliedDiscounts, want float64, wantItemRelated bool) {
+				if want == 0 {
+					assert.Empty(t, discounts)
+					return
+				}
+				require.Len(t, discounts, 1)
+				assert.InDelta(t, want, discounts[0].Applied.FloatAmount(), 0.001)
+				assert.Equal(t, "code", discounts[0].CampaignCode)
+				assert.Equal(t, "code", discounts[0].CouponCode)
+				assert.Equal(t, tt.rule.Type, discounts[0].Type)
+				assert.Equal(t, wantItemRelated, discounts[0].IsItemRelated)
+			}
+
+			delivery := cart.Deliveries[0]
+			assertPromotionDiscount(delivery.Cartitems[0].AppliedDiscounts, tt.wantShoeDiscount, tt.wantItemRelated)
+			assertPromotionDiscount(delivery.Cartitems[1].AppliedDiscounts, tt.wantShirtDiscount, tt.wantItemRelated)
+			assertPromotionDiscount(delivery.ShippingItem.AppliedDiscounts, tt.wantShippingDiscount, false)
+
+			// recalculating must not add the discounts twice
+			cart, err = handler.RecalculateDiscounts(context.Background(), cart)
+			require.NoError(t, err)
+			assertPromotionDiscount(cart.Deliveries[0].Cartitems[0].AppliedDiscounts, tt.wantShoeDiscount, tt.wantItemRelated)
+		})
+	}
+}
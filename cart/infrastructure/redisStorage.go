@@ -0,0 +1,335 @@
+package infrastructure
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+type (
+	// RedisCartStorage stores carts in redis, guest carts expire after a configurable idle time
+	RedisCartStorage struct {
+		pool         *redis.Pool
+		logger       flamingo.Logger
+		keyPrefix    string
+		guestCartTTL time.Duration
+	}
+)
+
+const (
+	redisFieldVersion = "version"
+	redisFieldData    = "data"
+	// redisFieldCreatedAt holds the unix time the cart has been stored first
+	redisFieldCreatedAt = "createdAt"
+)
+
+var (
+	_ CartStorage         = &RedisCartStorage{}
+	_ CustomerCartStorage = &RedisCartStorage{}
+	_ ActivityCartStorage = &RedisCartStorage{}
+	_ healthcheck.Status  = &RedisCartStorage{}
+
+	// ErrNoRedisConnection is returned if the underlying connection is erroneous
+	ErrNoRedisConnection = errors.New("no redis connection, see healthcheck")
+)
+
+// Inject dependencies
+func (r *RedisCartStorage) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		MaxIdle                     int    `inject:"config:commerce.cart.defaultCartAdapter.redis.maxIdle"`
+		IdleTimeoutMilliseconds     int    `inject:"config:commerce.cart.defaultCartAdapter.redis.idleTimeoutMilliseconds"`
+		Network                     string `inject:"config:commerce.cart.defaultCartAdapter.redis.network"`
+		Address                     string `inject:"config:commerce.cart.defaultCartAdapter.redis.address"`
+		Database                    int    `inject:"config:commerce.cart.defaultCartAdapter.redis.database"`
+		KeyPrefix                   string `inject:"config:commerce.cart.defaultCartAdapter.redis.keyPrefix"`
+		GuestCartIdleTimeoutSeconds int    `inject:"config:commerce.cart.defaultCartAdapter.redis.guestCartIdleTimeoutSeconds"`
+	},
+) *RedisCartStorage {
+	r.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "RedisCartStorage")
+	if cfg != nil {
+		r.keyPrefix = cfg.KeyPrefix
+		r.guestCartTTL = time.Duration(cfg.GuestCartIdleTimeoutSeconds) * time.Second
+		r.pool = &redis.Pool{
+			MaxIdle:     cfg.MaxIdle,
+			IdleTimeout: time.Duration(cfg.IdleTimeoutMilliseconds) * time.Millisecond,
+			TestOnBorrow: func(c redis.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial(cfg.Network, cfg.Address, redis.DialDatabase(cfg.Database))
+			},
+		}
+		runtime.SetFinalizer(r, func(r *RedisCartStorage) { r.pool.Close() }) // close all connections on destruction
+	}
+
+	return r
+}
+
+func (r *RedisCartStorage) key(id string) string {
+	return r.keyPrefix + id
+}
+
+// customerKey is the key of the set holding the cart ids of a customer
+func (r *RedisCartStorage) customerKey(customerID string) string {
+	return r.keyPrefix + "customer-carts:" + customerID
+}
+
+// activityKey is the key of the sorted set holding all cart ids scored by the unix time of their last modification
+func (r *RedisCartStorage) activityKey() string {
+	return r.keyPrefix + "carts-by-activity"
+}
+
+// HasCart checks if the cart storage has a cart with a given id
+func (r *RedisCartStorage) HasCart(ctx context.Context, id string) bool {
+	_, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/HasCart")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/HasCart:", conn.Err())
+		return false
+	}
+
+	exists, err := redis.Bool(conn.Do("EXISTS", r.key(id)))
+	if err != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/HasCart:", err)
+		return false
+	}
+
+	return exists
+}
+
+// GetCart returns a cart with the given id from the cart storage, reading a cart does not reset its idle time
+func (r *RedisCartStorage) GetCart(ctx context.Context, id string) (*domaincart.Cart, error) {
+	_, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/GetCart")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/GetCart:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	return r.loadCart(conn, id)
+}
+
+// loadCart reads the cart without touching its expiry, a write would abort concurrent StoreCart transactions watching the key
+func (r *RedisCartStorage) loadCart(conn redis.Conn, id string) (*domaincart.Cart, error) {
+	values, err := redis.Values(conn.Do("HMGET", r.key(id), redisFieldVersion, redisFieldData))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		version int64
+		data    []byte
+	)
+	_, err = redis.Scan(values, &version, &data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cart %q is not readable", id)
+	}
+	if data == nil {
+		return nil, errors.New("no cart stored")
+	}
+
+	cart, err := decodeCart(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cart %q is not decodable", id)
+	}
+	cart.Version = version
+
+	return cart, nil
+}
+
+// StoreCart stores a cart in the storage, fails with ErrCartVersionConflict if the stored cart has a different version.
+// Storing a guest cart resets its idle time.
+func (r *RedisCartStorage) StoreCart(ctx context.Context, cart *domaincart.Cart) error {
+	_, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/StoreCart")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/StoreCart:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	key := r.key(cart.ID)
+	_, err := conn.Do("WATCH", key)
+	if err != nil {
+		return err
+	}
+
+	storedVersion, err := redis.Int64(conn.Do("HGET", key, redisFieldVersion))
+	if err != nil && err != redis.ErrNil {
+		_, _ = conn.Do("UNWATCH")
+		return err
+	}
+	if err == nil && storedVersion != cart.Version {
+		_, _ = conn.Do("UNWATCH")
+		return ErrCartVersionConflict
+	}
+
+	newVersion := cart.Version + 1
+	toStore := *cart
+	toStore.Version = newVersion
+	data, err := encodeCart(&toStore)
+	if err != nil {
+		_, _ = conn.Do("UNWATCH")
+		return errors.Wrap(err, "cart.infrastructure.RedisCartStorage: cannot encode cart")
+	}
+
+	now := time.Now()
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HSET", key, redisFieldVersion, newVersion, redisFieldData, data)
+	_ = conn.Send("HSETNX", key, redisFieldCreatedAt, now.Unix())
+	_ = conn.Send("ZADD", r.activityKey(), now.Unix(), cart.ID)
+	if cart.BelongsToAuthenticatedUser && cart.AuthenticatedUserID != "" {
+		_ = conn.Send("SADD", r.customerKey(cart.AuthenticatedUserID), cart.ID)
+	}
+	if !cart.BelongsToAuthenticatedUser && r.guestCartTTL > 0 {
+		_ = conn.Send("EXPIRE", key, int64(r.guestCartTTL.Seconds()))
+	} else {
+		_ = conn.Send("PERSIST", key)
+	}
+	reply, err := conn.Do("EXEC")
+	if err != nil {
+		return err
+	}
+	if reply == nil {
+		// transaction has been aborted since the watched key has been modified
+		return ErrCartVersionConflict
+	}
+
+	cart.Version = newVersion
+
+	return nil
+}
+
+// RemoveCart from storage
+func (r *RedisCartStorage) RemoveCart(ctx context.Context, cart *domaincart.Cart) error {
+	_, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/RemoveCart")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/RemoveCart:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	_, err := conn.Do("DEL", r.key(cart.ID))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("ZREM", r.activityKey(), cart.ID)
+	if err != nil {
+		return err
+	}
+
+	if cart.AuthenticatedUserID != "" {
+		_, err = conn.Do("SREM", r.customerKey(cart.AuthenticatedUserID), cart.ID)
+	}
+
+	return err
+}
+
+// GetCartsByCustomer returns all carts that belong to the given customer
+func (r *RedisCartStorage) GetCartsByCustomer(ctx context.Context, customerID string) ([]*domaincart.Cart, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/GetCartsByCustomer")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/GetCartsByCustomer:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	ids, err := redis.Strings(conn.Do("SMEMBERS", r.customerKey(customerID)))
+	if err != nil {
+		return nil, err
+	}
+
+	carts := make([]*domaincart.Cart, 0, len(ids))
+	for _, id := range ids {
+		if !r.HasCart(ctx, id) {
+			// the cart has been removed without the customer set being updated
+			_, _ = conn.Do("SREM", r.customerKey(customerID), id)
+			continue
+		}
+
+		cart, err := r.GetCart(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		carts = append(carts, cart)
+	}
+
+	return carts, nil
+}
+
+// GetCartsModifiedBetween returns all carts whose last modification is within [from, to), reading the carts does not reset their idle time
+func (r *RedisCartStorage) GetCartsModifiedBetween(ctx context.Context, from time.Time, to time.Time) ([]*domaincart.Cart, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/RedisCartStorage/GetCartsModifiedBetween")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("cart/infrastructure/RedisCartStorage/GetCartsModifiedBetween:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	// the score has a precision of seconds, "(" excludes the upper bound
+	ids, err := redis.Strings(conn.Do("ZRANGEBYSCORE", r.activityKey(), from.Unix(), "("+strconv.FormatInt(to.Unix(), 10)))
+	if err != nil {
+		return nil, err
+	}
+
+	carts := make([]*domaincart.Cart, 0, len(ids))
+	for _, id := range ids {
+		exists, err := redis.Bool(conn.Do("EXISTS", r.key(id)))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			// guest carts expire without the activity set being updated
+			_, _ = conn.Do("ZREM", r.activityKey(), id)
+			continue
+		}
+
+		cart, err := r.loadCart(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		carts = append(carts, cart)
+	}
+
+	return carts, nil
+}
+
+// Status handles the health check of redis
+func (r *RedisCartStorage) Status() (alive bool, details string) {
+	if r.pool == nil {
+		return false, "no redis configured for cart storage"
+	}
+
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("PING")
+	if err == nil {
+		return true, "redis for cart storage replies to PING"
+	}
+
+	return false, err.Error()
+}
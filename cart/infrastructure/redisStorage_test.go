@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stvp/tempredis"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+func getRedisCartStorage(network, address string) *RedisCartStorage {
+	return new(RedisCartStorage).Inject(
+		new(flamingo.NullLogger),
+		&struct {
+			MaxIdle                     int    `inject:"config:commerce.cart.defaultCartAdapter.redis.maxIdle"`
+			IdleTimeoutMilliseconds     int    `inject:"config:commerce.cart.defaultCartAdapter.redis.idleTimeoutMilliseconds"`
+			Network                     string `inject:"config:commerce.cart.defaultCartAdapter.redis.network"`
+			Address                     string `inject:"config:commerce.cart.defaultCartAdapter.redis.address"`
+			Database                    int    `inject:"config:commerce.cart.defaultCartAdapter.redis.database"`
+			KeyPrefix                   string `inject:"config:commerce.cart.defaultCartAdapter.redis.keyPrefix"`
+			GuestCartIdleTimeoutSeconds int    `inject:"config:commerce.cart.defaultCartAdapter.redis.guestCartIdleTimeoutSeconds"`
+		}{MaxIdle: 3, IdleTimeoutMilliseconds: 240000, Network: network, Address: address, Database: 0, KeyPrefix: "cart:", GuestCartIdleTimeoutSeconds: 60})
+}
+
+func startUpLocalRedis(t *testing.T) (*tempredis.Server, redis.Conn) {
+	t.Helper()
+	server, err := tempredis.Start(tempredis.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := redis.Dial("unix", server.Socket())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return server, conn
+}
+
+func TestRedisCartStorage(t *testing.T) {
+	if _, err := exec.LookPath("redis-server"); err != nil {
+		t.Skip("redis-server not installed")
+	}
+	server, conn := startUpLocalRedis(t)
+	defer func() { _ = server.Term() }()
+	storage := getRedisCartStorage("unix", server.Socket())
+
+	t.Run("guest cart expires", func(t *testing.T) {
+		cart := &domaincart.Cart{ID: "guest"}
+		require.NoError(t, storage.StoreCart(context.Background(), cart))
+		assert.Equal(t, int64(1), cart.Version)
+		assert.True(t, storage.HasCart(context.Background(), "guest"))
+
+		ttl, err := redis.Int(conn.Do("TTL", "cart:guest"))
+		require.NoError(t, err)
+		assert.True(t, ttl > 0 && ttl <= 60)
+
+		loaded, err := storage.GetCart(context.Background(), "guest")
+		require.NoError(t, err)
+		assert.Equal(t, "guest", loaded.ID)
+		assert.Equal(t, int64(1), loaded.Version)
+	})
+
+	t.Run("reading a guest cart does not reset its idle time", func(t *testing.T) {
+		_, err := conn.Do("EXPIRE", "cart:guest", 30)
+		require.NoError(t, err)
+
+		_, err = storage.GetCart(context.Background(), "guest")
+		require.NoError(t, err)
+
+		ttl, err := redis.Int(conn.Do("TTL", "cart:guest"))
+		require.NoError(t, err)
+		assert.True(t, ttl > 0 && ttl <= 30)
+	})
+
+	t.Run("customer cart does not expire", func(t *testing.T) {
+		cart := &domaincart.Cart{ID: "customer", BelongsToAuthenticatedUser: true, AuthenticatedUserID: "customer"}
+		require.NoError(t, storage.StoreCart(context.Background(), cart))
+
+		ttl, err := redis.Int(conn.Do("TTL", "cart:customer"))
+		require.NoError(t, err)
+		assert.Equal(t, -1, ttl)
+	})
+
+	t.Run("concurrent modification is rejected", func(t *testing.T) {
+		first, err := storage.GetCart(context.Background(), "guest")
+		require.NoError(t, err)
+		second, err := storage.GetCart(context.Background(), "guest")
+		require.NoError(t, err)
+
+		require.NoError(t, storage.StoreCart(context.Background(), first))
+		assert.Equal(t, ErrCartVersionConflict, storage.StoreCart(context.Background(), second))
+	})
+
+	t.Run("remove cart", func(t *testing.T) {
+		require.NoError(t, storage.RemoveCart(context.Background(), &domaincart.Cart{ID: "guest"}))
+		assert.False(t, storage.HasCart(context.Background(), "guest"))
+		_, err := storage.GetCart(context.Background(), "guest")
+		assert.Error(t, err)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		alive, _ := storage.Status()
+		assert.True(t, alive)
+	})
+}
+
+func TestRedisCartStorage_StatusWithoutConfiguration(t *testing.T) {
+	storage := new(RedisCartStorage).Inject(new(flamingo.NullLogger), nil)
+
+	alive, details := storage.Status()
+	assert.False(t, alive)
+	assert.Equal(t, "no redis configured for cart storage", details)
+}
@@ -0,0 +1,189 @@
+package infrastructure
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// RuleTaxCalculator determines the taxes by a table of rules keyed by country, region and tax class.
+	// The shipping item is taxed with the rates of the items in the delivery, weighted by their net prices.
+	RuleTaxCalculator struct {
+		logger         flamingo.Logger
+		rules          []TaxRule
+		defaultTaxRate float64
+	}
+
+	// TaxRule defines the taxes for a country / region and a tax class, empty fields match everything
+	TaxRule struct {
+		CountryCode string       `json:"countryCode"`
+		RegionCode  string       `json:"regionCode"`
+		TaxClass    string       `json:"taxClass"`
+		Taxes       []TaxRuleTax `json:"taxes"`
+	}
+
+	// TaxRuleTax is a single tax of a TaxRule, e.g. a VAT or a state tax
+	TaxRuleTax struct {
+		Type string  `json:"type"`
+		Rate float64 `json:"rate"`
+	}
+
+	// taxRateKey identifies the taxes of a delivery with the same type and rate
+	taxRateKey struct {
+		taxType string
+		rate    string
+	}
+)
+
+var (
+	_ domaincart.TaxCalculator = new(RuleTaxCalculator)
+)
+
+// Inject dependencies
+func (c *RuleTaxCalculator) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Rules          config.Slice `inject:"config:commerce.cart.defaultCartAdapter.taxRules,optional"`
+		DefaultTaxRate float64      `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
+	},
+) *RuleTaxCalculator {
+	c.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "RuleTaxCalculator")
+	if cfg != nil {
+		c.defaultTaxRate = cfg.DefaultTaxRate
+		if cfg.Rules != nil {
+			err := cfg.Rules.MapInto(&c.rules)
+			if err != nil {
+				c.logger.Error("tax rules could not be mapped: ", err)
+			}
+		}
+	}
+
+	return c
+}
+
+// ItemTaxes returns the taxes of the most specific rule matching the address and the tax class of the product
+func (c *RuleTaxCalculator) ItemTaxes(_ context.Context, address *domaincart.Address, product domain.BasicProduct) (domaincart.Taxes, error) {
+	return c.taxes(address, product.SaleableData().ActivePrice.TaxClass), nil
+}
+
+// ShippingTaxes applies the tax rates of the items to the shares of the shipping costs, the shares follow the net prices of the items.
+// A delivery without items is taxed with the rates of the rule without tax class.
+func (c *RuleTaxCalculator) ShippingTaxes(_ context.Context, address *domaincart.Address, delivery domaincart.Delivery) (domaincart.Taxes, error) {
+	shippingNet := delivery.ShippingItem.PriceNet
+	if discounts, err := delivery.ShippingItem.AppliedDiscounts.Sum(); err == nil {
+		shippingNet = shippingNet.ForceAdd(discounts)
+	}
+	if !shippingNet.IsPositive() {
+		return nil, nil
+	}
+
+	itemsNet := new(big.Float)
+	weights := make(map[taxRateKey]*big.Float)
+	rates := make(map[taxRateKey]*big.Float)
+	var keys []taxRateKey
+	for _, item := range delivery.Cartitems {
+		net := item.RowPriceNetWithDiscount().Amount()
+		if net.Sign() <= 0 {
+			continue
+		}
+		itemsNet.Add(itemsNet, net)
+		for _, tax := range item.RowTaxes {
+			if tax.Rate == nil {
+				continue
+			}
+			key := taxRateKey{taxType: tax.Type, rate: tax.Rate.String()}
+			if _, found := weights[key]; !found {
+				weights[key] = new(big.Float)
+				rates[key] = tax.Rate
+				keys = append(keys, key)
+			}
+			weights[key].Add(weights[key], net)
+		}
+	}
+
+	if itemsNet.Sign() == 0 {
+		taxes := c.taxes(address, "")
+		for i, tax := range taxes {
+			taxes[i].Amount = shippingNet.TaxFromNet(*tax.Rate).GetPayable()
+		}
+		return taxes, nil
+	}
+
+	taxes := make(domaincart.Taxes, 0, len(keys))
+	for _, key := range keys {
+		share := new(big.Float).Quo(new(big.Float).Mul(shippingNet.Amount(), weights[key]), itemsNet)
+		taxes = append(taxes, domaincart.Tax{
+			Type:   key.taxType,
+			Rate:   rates[key],
+			Amount: priceDomain.NewFromBigFloat(*share, shippingNet.Currency()).TaxFromNet(*rates[key]).GetPayable(),
+		})
+	}
+
+	return taxes, nil
+}
+
+// taxes returns the taxes of the most specific rule, the default tax rate is used if no rule matches
+func (c *RuleTaxCalculator) taxes(address *domaincart.Address, taxClass string) domaincart.Taxes {
+	var countryCode, regionCode string
+	if address != nil {
+		countryCode = address.CountryCode
+		regionCode = address.RegionCode
+	}
+
+	bestScore := -1
+	var best *TaxRule
+	for i, rule := range c.rules {
+		score := rule.score(countryCode, regionCode, taxClass)
+		if score > bestScore {
+			bestScore = score
+			best = &c.rules[i]
+		}
+	}
+
+	if best == nil {
+		return domaincart.Taxes{{Type: "default", Rate: big.NewFloat(c.defaultTaxRate)}}
+	}
+
+	taxes := make(domaincart.Taxes, 0, len(best.Taxes))
+	for _, tax := range best.Taxes {
+		taxes = append(taxes, domaincart.Tax{Type: tax.Type, Rate: big.NewFloat(tax.Rate)})
+	}
+
+	return taxes
+}
+
+// score returns how specific the rule matches, or -1 if the rule doesn't match.
+// A matching region outweighs a matching country, which outweighs a matching tax class
+func (r TaxRule) score(countryCode string, regionCode string, taxClass string) int {
+	score := 0
+	if r.CountryCode != "" {
+		if !strings.EqualFold(r.CountryCode, countryCode) {
+			return -1
+		}
+		score += 2
+	}
+
+	if r.RegionCode != "" {
+		if !strings.EqualFold(r.RegionCode, regionCode) {
+			return -1
+		}
+		score += 4
+	}
+
+	if r.TaxClass != "" {
+		if r.TaxClass != taxClass {
+			return -1
+		}
+		score++
+	}
+
+	return score
+}
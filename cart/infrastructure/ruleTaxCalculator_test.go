@@ -0,0 +1,141 @@
+package infrastructure
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+func newRuleTaxCalculator() *RuleTaxCalculator {
+	return new(RuleTaxCalculator).Inject(flamingo.NullLogger{}, &struct {
+		Rules          config.Slice `inject:"config:commerce.cart.defaultCartAdapter.taxRules,optional"`
+		DefaultTaxRate float64      `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
+	}{
+		DefaultTaxRate: 10,
+		Rules: config.Slice{
+			config.Map{"countryCode": "DE", "taxes": config.Slice{config.Map{"type": "vat", "rate": 19.0}}},
+			config.Map{"countryCode": "DE", "taxClass": "reduced", "taxes": config.Slice{config.Map{"type": "vat", "rate": 7.0}}},
+			config.Map{"countryCode": "US", "regionCode": "CA", "taxes": config.Slice{
+				config.Map{"type": "state", "rate": 6.0},
+				config.Map{"type": "county", "rate": 1.25},
+			}},
+			config.Map{"countryCode": "US", "taxes": config.Slice{config.Map{"type": "state", "rate": 4.0}}},
+		},
+	})
+}
+
+func taxProduct(taxClass string) domain.BasicProduct {
+	return domain.SimpleProduct{Saleable: domain.Saleable{ActivePrice: domain.PriceInfo{TaxClass: taxClass}}}
+}
+
+func TestRuleTaxCalculator_ItemTaxes(t *testing.T) {
+	t.Parallel()
+
+	calculator := newRuleTaxCalculator()
+
+	tests := []struct {
+		name      string
+		address   *domaincart.Address
+		taxClass  string
+		wantTaxes map[string]float64
+	}{
+		{
+			name:      "country rule",
+			address:   &domaincart.Address{CountryCode: "DE"},
+			wantTaxes: map[string]float64{"vat": 19},
+		},
+		{
+			name:      "reduced rate of the tax class",
+			address:   &domaincart.Address{CountryCode: "de"},
+			taxClass:  "reduced",
+			wantTaxes: map[string]float64{"vat": 7},
+		},
+		{
+			name:      "region rule with multiple taxes",
+			address:   &domaincart.Address{CountryCode: "US", RegionCode: "CA"},
+			wantTaxes: map[string]float64{"state": 6, "county": 1.25},
+		},
+		{
+			name:      "country rule for other regions",
+			address:   &domaincart.Address{CountryCode: "US", RegionCode: "NY"},
+			wantTaxes: map[string]float64{"state": 4},
+		},
+		{
+			name:      "default rate without matching rule",
+			address:   &domaincart.Address{CountryCode: "FR"},
+			wantTaxes: map[string]float64{"default": 10},
+		},
+		{
+			name:      "default rate without address",
+			wantTaxes: map[string]float64{"default": 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taxes, err := calculator.ItemTaxes(context.Background(), tt.address, taxProduct(tt.taxClass))
+			require.NoError(t, err)
+
+			got := make(map[string]float64)
+			for _, tax := range taxes {
+				got[tax.Type], _ = tax.Rate.Float64()
+			}
+			assert.Equal(t, tt.wantTaxes, got)
+		})
+	}
+}
+
+func TestRuleTaxCalculator_ShippingTaxes(t *testing.T) {
+	t.Parallel()
+
+	calculator := newRuleTaxCalculator()
+	address := &domaincart.Address{CountryCode: "DE"}
+
+	newItem := func(net float64, rate float64) domaincart.Item {
+		item, err := new(domaincart.ItemBuilder).
+			SetID("item").
+			SetQty(1).
+			SetSinglePriceNet(priceDomain.NewFromFloat(net, "EUR")).
+			AddTaxInfo("vat", big.NewFloat(rate), nil).
+			CalculatePricesAndTax().
+			Build()
+		require.NoError(t, err)
+		return *item
+	}
+
+	delivery := domaincart.Delivery{
+		Cartitems: []domaincart.Item{newItem(80, 19), newItem(20, 7)},
+		ShippingItem: domaincart.ShippingItem{
+			PriceNet: priceDomain.NewFromFloat(10, "EUR"),
+		},
+	}
+
+	taxes, err := calculator.ShippingTaxes(context.Background(), address, delivery)
+	require.NoError(t, err)
+	require.Len(t, taxes, 2)
+	assert.InDelta(t, 1.52, taxes[0].Amount.FloatAmount(), 0.001)
+	assert.InDelta(t, 0.14, taxes[1].Amount.FloatAmount(), 0.001)
+	assert.InDelta(t, 1.66, taxes.TotalAmount().FloatAmount(), 0.001)
+
+	// without items the shipping is taxed by the rule without tax class
+	delivery.Cartitems = nil
+	taxes, err = calculator.ShippingTaxes(context.Background(), address, delivery)
+	require.NoError(t, err)
+	require.Len(t, taxes, 1)
+	assert.InDelta(t, 1.9, taxes[0].Amount.FloatAmount(), 0.001)
+
+	// free shipping has no taxes
+	delivery.ShippingItem.AppliedDiscounts = domaincart.AppliedDiscounts{{Applied: priceDomain.NewFromFloat(-10, "EUR")}}
+	taxes, err = calculator.ShippingTaxes(context.Background(), address, delivery)
+	require.NoError(t, err)
+	assert.Empty(t, taxes)
+}
@@ -0,0 +1,347 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/internal/sqlutil"
+)
+
+type (
+	// SQLCartStorage persists carts in a SQL database using database/sql.
+	// The database driver (e.g. sqlite3 or postgres) must be registered by the project.
+	SQLCartStorage struct {
+		db        *sql.DB
+		logger    flamingo.Logger
+		tableName string
+		postgres  bool
+	}
+)
+
+var (
+	_ CartStorage         = &SQLCartStorage{}
+	_ CustomerCartStorage = &SQLCartStorage{}
+	_ ActivityCartStorage = &SQLCartStorage{}
+	_ healthcheck.Status  = &SQLCartStorage{}
+
+	// ErrCartVersionConflict is returned if a cart has been modified in the storage since it was loaded
+	ErrCartVersionConflict = errors.New("cart has been modified concurrently")
+)
+
+// Inject dependencies and open the database
+func (s *SQLCartStorage) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Driver    string `inject:"config:commerce.cart.defaultCartAdapter.sql.driver"`
+		DSN       string `inject:"config:commerce.cart.defaultCartAdapter.sql.dsn"`
+		TableName string `inject:"config:commerce.cart.defaultCartAdapter.sql.tableName"`
+		Migrate   bool   `inject:"config:commerce.cart.defaultCartAdapter.sql.migrate"`
+	},
+) *SQLCartStorage {
+	s.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "SQLCartStorage")
+	if cfg == nil {
+		return s
+	}
+
+	db, err := sql.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("cart.infrastructure.SQLCartStorage: cannot open database with driver %q: %s", cfg.Driver, err))
+		return s
+	}
+
+	s.SetDB(db, cfg.Driver, cfg.TableName)
+	if cfg.Migrate {
+		if err := s.Migrate(context.Background()); err != nil {
+			s.logger.Error("cart.infrastructure.SQLCartStorage: migration failed: ", err)
+		}
+	}
+
+	return s
+}
+
+// SetDB sets the database handle used by the storage, the driver name decides on the SQL dialect
+func (s *SQLCartStorage) SetDB(db *sql.DB, driver string, tableName string) {
+	s.db = db
+	s.postgres = sqlutil.IsPostgres(driver)
+	s.tableName = tableName
+	if s.tableName == "" {
+		s.tableName = "carts"
+	}
+}
+
+// Migrate creates the cart table if it does not exist yet
+func (s *SQLCartStorage) Migrate(ctx context.Context) error {
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	_, err := s.db.ExecContext(ctx, s.schema())
+
+	return err
+}
+
+func (s *SQLCartStorage) schema() string {
+	blobType := "BLOB"
+	if s.postgres {
+		blobType = "BYTEA"
+	}
+
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id VARCHAR(255) NOT NULL PRIMARY KEY,
+	version BIGINT NOT NULL,
+	authenticated_user_id VARCHAR(255) NOT NULL DEFAULT '',
+	data %s NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+)`, s.tableName, blobType)
+}
+
+// rebind replaces the "?" placeholders of the query with numbered ones if the dialect requires it
+func (s *SQLCartStorage) rebind(query string) string {
+	return sqlutil.Rebind(query, s.postgres)
+}
+
+// HasCart checks if the cart storage has a cart with a given id
+func (s *SQLCartStorage) HasCart(ctx context.Context, id string) bool {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/HasCart")
+	defer span.End()
+
+	if s.db == nil {
+		return false
+	}
+
+	var count int
+	err := s.db.QueryRowContext(ctx, s.rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE id = ?", s.tableName)), id).Scan(&count)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("cart.infrastructure.SQLCartStorage: HasCart failed: ", err)
+		return false
+	}
+
+	return count > 0
+}
+
+// GetCart returns a cart with the given id from the cart storage
+func (s *SQLCartStorage) GetCart(ctx context.Context, id string) (*domaincart.Cart, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/GetCart")
+	defer span.End()
+
+	if s.db == nil {
+		return nil, errors.New("no database configured")
+	}
+
+	var (
+		version int64
+		data    []byte
+	)
+	err := s.db.QueryRowContext(ctx, s.rebind(fmt.Sprintf("SELECT version, data FROM %s WHERE id = ?", s.tableName)), id).Scan(&version, &data)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("no cart stored")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot load cart")
+	}
+
+	cart, err := decodeCart(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cart.infrastructure.SQLCartStorage: cart %q is not decodable", id)
+	}
+	cart.Version = version
+
+	return cart, nil
+}
+
+// GetCartsByCustomer returns all carts that belong to the given customer
+func (s *SQLCartStorage) GetCartsByCustomer(ctx context.Context, customerID string) ([]*domaincart.Cart, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/GetCartsByCustomer")
+	defer span.End()
+
+	if s.db == nil {
+		return nil, errors.New("no database configured")
+	}
+	if customerID == "" {
+		return nil, errors.New("no customer id given")
+	}
+
+	carts, err := s.queryCarts(ctx, fmt.Sprintf("SELECT id, version, data FROM %s WHERE authenticated_user_id = ? ORDER BY created_at", s.tableName), customerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot load customer carts")
+	}
+
+	return carts, nil
+}
+
+// GetCartsModifiedBetween returns all carts whose last modification is within [from, to)
+func (s *SQLCartStorage) GetCartsModifiedBetween(ctx context.Context, from time.Time, to time.Time) ([]*domaincart.Cart, error) {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/GetCartsModifiedBetween")
+	defer span.End()
+
+	if s.db == nil {
+		return nil, errors.New("no database configured")
+	}
+
+	carts, err := s.queryCarts(ctx, fmt.Sprintf("SELECT id, version, data FROM %s WHERE updated_at >= ? AND updated_at < ? ORDER BY updated_at", s.tableName), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot load modified carts")
+	}
+
+	return carts, nil
+}
+
+// queryCarts decodes the carts of a query selecting id, version and data
+func (s *SQLCartStorage) queryCarts(ctx context.Context, query string, args ...interface{}) ([]*domaincart.Cart, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var carts []*domaincart.Cart
+	for rows.Next() {
+		var (
+			id      string
+			version int64
+			data    []byte
+		)
+		err = rows.Scan(&id, &version, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		cart, err := decodeCart(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cart %q is not decodable", id)
+		}
+		cart.Version = version
+		carts = append(carts, cart)
+	}
+
+	return carts, rows.Err()
+}
+
+// StoreCart stores a cart in the storage, fails with ErrCartVersionConflict if the stored cart has a different version
+func (s *SQLCartStorage) StoreCart(ctx context.Context, cart *domaincart.Cart) error {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/StoreCart")
+	defer span.End()
+
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	newVersion := cart.Version + 1
+	toStore := *cart
+	toStore.Version = newVersion
+	data, err := encodeCart(&toStore)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot encode cart")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now().UTC()
+	result, err := tx.ExecContext(
+		ctx,
+		s.rebind(fmt.Sprintf("UPDATE %s SET version = ?, authenticated_user_id = ?, data = ?, updated_at = ? WHERE id = ? AND version = ?", s.tableName)),
+		newVersion, cart.AuthenticatedUserID, data, now, cart.ID, cart.Version,
+	)
+	if err != nil {
+		return errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot update cart")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// a concurrent request may insert the same cart in between, the insert must not overwrite it
+		result, err = tx.ExecContext(
+			ctx,
+			s.rebind(fmt.Sprintf("INSERT INTO %s (id, version, authenticated_user_id, data, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING", s.tableName)),
+			cart.ID, newVersion, cart.AuthenticatedUserID, data, now, now,
+		)
+		if err != nil {
+			return errors.Wrap(err, "cart.infrastructure.SQLCartStorage: cannot insert cart")
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return ErrCartVersionConflict
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	cart.Version = newVersion
+
+	return nil
+}
+
+// RemoveCart from storage
+func (s *SQLCartStorage) RemoveCart(ctx context.Context, cart *domaincart.Cart) error {
+	ctx, span := trace.StartSpan(ctx, "cart/infrastructure/SQLCartStorage/RemoveCart")
+	defer span.End()
+
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	_, err := s.db.ExecContext(ctx, s.rebind(fmt.Sprintf("DELETE FROM %s WHERE id = ?", s.tableName)), cart.ID)
+
+	return err
+}
+
+// Status handles the health check of the database
+func (s *SQLCartStorage) Status() (alive bool, details string) {
+	if s.db == nil {
+		return false, "no database configured for cart storage"
+	}
+
+	err := s.db.Ping()
+	if err == nil {
+		return true, "database for cart storage replies to ping"
+	}
+
+	return false, err.Error()
+}
+
+func encodeCart(cart *domaincart.Cart) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	err := gob.NewEncoder(buffer).Encode(cart)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func decodeCart(data []byte) (*domaincart.Cart, error) {
+	cart := new(domaincart.Cart)
+	err := gob.NewDecoder(bytes.NewBuffer(data)).Decode(cart)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart, nil
+}
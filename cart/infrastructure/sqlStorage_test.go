@@ -0,0 +1,142 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincart "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+func TestSQLCartStorage_rebind(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE carts SET version = ? WHERE id = ? AND version = ?"
+
+	sqlite := &SQLCartStorage{}
+	sqlite.SetDB(nil, "sqlite3", "")
+	assert.Equal(t, query, sqlite.rebind(query))
+	assert.Equal(t, "carts", sqlite.tableName)
+	assert.Contains(t, sqlite.schema(), "data BLOB NOT NULL")
+
+	postgres := &SQLCartStorage{}
+	postgres.SetDB(nil, "postgres", "shop_carts")
+	assert.Equal(t, "UPDATE carts SET version = $1 WHERE id = $2 AND version = $3", postgres.rebind(query))
+	assert.Contains(t, postgres.schema(), "CREATE TABLE IF NOT EXISTS shop_carts")
+	assert.Contains(t, postgres.schema(), "data BYTEA NOT NULL")
+}
+
+func TestSQLCartStorage_encodeDecodeCart(t *testing.T) {
+	t.Parallel()
+
+	cart := &domaincart.Cart{
+		ID:                  "cart-1",
+		AuthenticatedUserID: "customer-1",
+		Deliveries: []domaincart.Delivery{
+			{
+				DeliveryInfo: domaincart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []domaincart.Item{
+					{ID: "item-1", MarketplaceCode: "product-1", Qty: 2, SinglePriceGross: priceDomain.NewFromInt(1000, 100, "EUR")},
+				},
+			},
+		},
+		AppliedCouponCodes: []domaincart.CouponCode{{Code: "summer"}},
+		AppliedGiftCards: []domaincart.AppliedGiftCard{
+			{Code: "gift", Applied: priceDomain.NewFromInt(500, 100, "EUR"), Remaining: priceDomain.NewZero("EUR")},
+		},
+		PaymentSelection: domaincart.DefaultPaymentSelection{GatewayProp: "offline"},
+		Version:          3,
+	}
+
+	data, err := encodeCart(cart)
+	require.NoError(t, err)
+
+	decoded, err := decodeCart(data)
+	require.NoError(t, err)
+	assert.Equal(t, "cart-1", decoded.ID)
+	assert.Equal(t, int64(3), decoded.Version)
+	assert.Equal(t, "product-1", decoded.Deliveries[0].Cartitems[0].MarketplaceCode)
+	assert.True(t, decoded.Deliveries[0].Cartitems[0].SinglePriceGross.Equal(priceDomain.NewFromInt(1000, 100, "EUR")))
+	assert.Equal(t, "summer", decoded.AppliedCouponCodes[0].Code)
+	assert.Equal(t, "gift", decoded.AppliedGiftCards[0].Code)
+	assert.Equal(t, "offline", decoded.PaymentSelection.Gateway())
+}
+
+func newSQLiteCartStorage(t *testing.T) *SQLCartStorage {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+
+	storage := &SQLCartStorage{}
+	storage.SetDB(db, "sqlite3", "")
+	require.NoError(t, storage.Migrate(context.Background()))
+
+	return storage
+}
+
+func TestSQLCartStorage_StoreCart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := newSQLiteCartStorage(t)
+	defer storage.db.Close()
+
+	cart := &domaincart.Cart{ID: "cart-1", AuthenticatedUserID: "customer-1"}
+	require.NoError(t, storage.StoreCart(ctx, cart))
+	assert.Equal(t, int64(1), cart.Version)
+	assert.True(t, storage.HasCart(ctx, "cart-1"))
+
+	loaded, err := storage.GetCart(ctx, "cart-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), loaded.Version)
+
+	require.NoError(t, storage.StoreCart(ctx, loaded))
+	assert.Equal(t, int64(2), loaded.Version)
+
+	carts, err := storage.GetCartsByCustomer(ctx, "customer-1")
+	require.NoError(t, err)
+	require.Len(t, carts, 1)
+	assert.Equal(t, int64(2), carts[0].Version)
+
+	require.NoError(t, storage.RemoveCart(ctx, loaded))
+	assert.False(t, storage.HasCart(ctx, "cart-1"))
+}
+
+func TestSQLCartStorage_StoreCartVersionConflict(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage := newSQLiteCartStorage(t)
+	defer storage.db.Close()
+
+	t.Run("stale version", func(t *testing.T) {
+		cart := &domaincart.Cart{ID: "stale"}
+		require.NoError(t, storage.StoreCart(ctx, cart))
+
+		stale := *cart
+		require.NoError(t, storage.StoreCart(ctx, cart))
+		assert.Equal(t, ErrCartVersionConflict, storage.StoreCart(ctx, &stale))
+
+		loaded, err := storage.GetCart(ctx, "stale")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), loaded.Version)
+	})
+
+	t.Run("new cart inserted concurrently", func(t *testing.T) {
+		first := &domaincart.Cart{ID: "new", AuthenticatedUserID: "first"}
+		second := &domaincart.Cart{ID: "new", AuthenticatedUserID: "second"}
+		require.NoError(t, storage.StoreCart(ctx, first))
+		assert.Equal(t, ErrCartVersionConflict, storage.StoreCart(ctx, second))
+		assert.Equal(t, int64(0), second.Version)
+
+		loaded, err := storage.GetCart(ctx, "new")
+		require.NoError(t, err)
+		assert.Equal(t, "first", loaded.AuthenticatedUserID)
+	})
+}
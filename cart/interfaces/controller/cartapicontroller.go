@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"net/url"
 	"strconv"
 
@@ -16,6 +18,8 @@ import (
 
 	"github.com/lunarforge/flamingo_commerce/cart/application"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	customerApplication "github.com/lunarforge/flamingo_commerce/customer/application"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
 )
 
 type (
@@ -51,6 +55,13 @@ type (
 		Code    string
 	} // @name cartResultError
 
+	customerCartInfo struct {
+		ID         string
+		Name       string
+		Active     bool
+		CartTeaser *cart.Teaser
+	} // @name cartCustomerCartInfo
+
 	messageCodeAvailable interface {
 		MessageCode() string
 	}
@@ -224,6 +235,7 @@ func (cc *CartAPIController) UpdateItemAction(ctx context.Context, r *web.Reques
 
 // ApplyVoucherAndGetAction applies the given voucher and returns the cart
 // @Summary Apply Voucher Code
+// @Description If the code is rejected by the coupon policy, Error.Code contains the reason and Data the conflicting coupon codes
 // @Tags Cart
 // @Produce json
 // @Success 200 {object} CartAPIResult
@@ -282,6 +294,7 @@ func (cc *CartAPIController) ApplyGiftCardAndGetAction(ctx context.Context, r *w
 // cartService and returns the cart
 // @Summary Apply Gift Card or Voucher (auto detected)
 // @Description Use this if you have one user input and that input can be used to either enter a voucher or a gift card
+// @Description If the code is rejected by the coupon policy, Error.Code contains the reason and Data the conflicting coupon codes
 // @Tags Cart
 // @Produce json
 // @Success 200 {object} CartAPIResult
@@ -313,6 +326,10 @@ func (cc *CartAPIController) handlePromotionAction(ctx context.Context, r *web.R
 	if err != nil {
 		cc.enrichResultWithCartInfos(ctx, &result)
 		result.SetError(err, errorCode)
+		if policyErr, ok := err.(*application.CouponPolicyError); ok {
+			// contains the reason and the conflicting coupon codes
+			result.Data = policyErr
+		}
 		response := cc.responder.Data(result)
 		response.Status(500)
 
@@ -475,6 +492,191 @@ func (cc *CartAPIController) UpdatePaymentSelectionAction(ctx context.Context, r
 	return cc.responder.Data(result)
 }
 
+// ListCartsAction returns all carts of the logged in customer
+// @Summary Get all carts of the logged in customer
+// @Description Customers can keep multiple named carts (e.g. "main" and "saved for later"), Data contains a list of cartCustomerCartInfo
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Router /api/v1/cart/carts [get]
+func (cc *CartAPIController) ListCartsAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	carts, err := cc.cartReceiverService.ListCustomerCarts(ctx)
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.listCarts: %v", err.Error())
+
+		result.SetError(err, "list_carts_error")
+		return cc.responder.Data(result).Status(500)
+	}
+
+	activeCart, err := cc.cartReceiverService.ViewCart(ctx, r.Session())
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.listCarts: %v", err.Error())
+
+		result.SetError(err, "view_cart_error")
+		return cc.responder.Data(result).Status(500)
+	}
+
+	infos := make([]customerCartInfo, 0, len(carts))
+	for _, customerCart := range carts {
+		infos = append(infos, customerCartInfo{
+			ID:         customerCart.ID,
+			Name:       customerCart.Name,
+			Active:     customerCart.ID == activeCart.ID,
+			CartTeaser: customerCart.GetCartTeaser(),
+		})
+	}
+	result.Data = infos
+
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// CreateCartAction creates a new named cart for the logged in customer
+// @Summary Create a new named cart for the logged in customer
+// @Description The active cart is not changed, Data contains the created cartCustomerCartInfo
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param name query string true "the name of the cart, must be unique for the customer"
+// @Router /api/v1/cart/carts [post]
+func (cc *CartAPIController) CreateCartAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	name, _ := r.Params["name"]
+
+	newCart, err := cc.cartReceiverService.CreateCustomerCart(ctx, name)
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.createCart: %v", err.Error())
+
+		result.SetError(err, "create_cart_error")
+		return cc.responder.Data(result).Status(500)
+	}
+	result.Data = customerCartInfo{
+		ID:         newCart.ID,
+		Name:       newCart.Name,
+		CartTeaser: newCart.GetCartTeaser(),
+	}
+
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// DeleteNamedCartAction deletes a cart of the logged in customer
+// @Summary Delete a cart of the logged in customer
+// @Description If the active cart is deleted the main cart becomes active
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param cartID path string true "the id of the cart"
+// @Router /api/v1/cart/carts/{cartID} [delete]
+func (cc *CartAPIController) DeleteNamedCartAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	cartID, _ := r.Params["cartID"]
+
+	err := cc.cartReceiverService.DeleteCustomerCart(ctx, r.Session(), cartID)
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.deleteNamedCart: %v", err.Error())
+
+		result.SetError(err, "delete_named_cart_error")
+		return cc.responder.Data(result).Status(500)
+	}
+
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// SwitchCartAction selects the active cart of the logged in customer
+// @Summary Switch the active cart of the logged in customer
+// @Description All following cart operations use the selected cart
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param cartID path string true "the id of the cart"
+// @Router /api/v1/cart/carts/{cartID}/activate [put]
+func (cc *CartAPIController) SwitchCartAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	cartID, _ := r.Params["cartID"]
+
+	_, err := cc.cartReceiverService.SwitchCart(ctx, r.Session(), cartID)
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.switchCart: %v", err.Error())
+
+		result.SetError(err, "switch_cart_error")
+		return cc.responder.Data(result).Status(500)
+	}
+
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// MoveItemAction moves an item of the current cart to another cart of the logged in customer
+// @Summary Move item to another cart of the logged in customer
+// @Description E.g. to save an item for later, the item is removed from the current cart
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param deliveryCode path string true "the identifier for the delivery in the cart"
+// @Param itemID query string true "the item that should be moved"
+// @Param targetCartID query string true "the id of the cart the item should be moved to"
+// @Router /api/v1/cart/delivery/{deliveryCode}/item/move [put]
+func (cc *CartAPIController) MoveItemAction(ctx context.Context, r *web.Request) web.Result {
+	itemID, _ := r.Params["itemID"]
+	targetCartID, _ := r.Params["targetCartID"]
+	deliveryCode, _ := r.Params["deliveryCode"]
+
+	err := cc.cartService.MoveItem(ctx, r.Session(), itemID, deliveryCode, targetCartID)
+
+	result := newResult()
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.moveItem: %v", err.Error())
+
+		result.SetError(err, "move_item_error")
+		return cc.responder.Data(result).Status(500)
+	}
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// AddFromOrderAction adds the still saleable items of a past order of the logged in customer to the current cart
+// @Summary Add the items of a past order to the cart
+// @Description Data contains the added items and the items that could not be added, e.g. because they are not saleable anymore
+// @Tags Cart
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 401 {object} CartAPIResult
+// @Failure 404 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param orderID path string true "the id of the order"
+// @Router /api/v1/cart/reorder/{orderID} [post]
+func (cc *CartAPIController) AddFromOrderAction(ctx context.Context, r *web.Request) web.Result {
+	orderID, _ := r.Params["orderID"]
+
+	reorderResult, err := cc.cartService.AddFromOrder(ctx, r.Session(), orderID)
+
+	result := newResult()
+	if err != nil {
+		result.SetError(err, "add_from_order_error")
+		switch {
+		case errors.Is(err, customerApplication.ErrNoIdentity):
+			return cc.responder.Data(result).Status(http.StatusUnauthorized)
+		case errors.Is(err, orderDomain.ErrOrderNotFound):
+			return cc.responder.Data(result).Status(http.StatusNotFound)
+		}
+
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.addFromOrder: %v", err.Error())
+		return cc.responder.Data(result).Status(http.StatusInternalServerError)
+	}
+
+	result.Data = reorderResult
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
 func (cc *CartAPIController) enrichResultWithCartInfos(ctx context.Context, result *CartAPIResult) {
 	session := web.SessionFromContext(ctx)
 	decoratedCart, err := cc.cartReceiverService.ViewDecoratedCart(ctx, session)
@@ -29,6 +29,7 @@ type (
 
 		applicationCartService         *application.CartService
 		applicationCartReceiverService *application.CartReceiverService
+		cartRecoveryService            *application.CartRecoveryService
 		router                         *web.Router
 		logger                         flamingo.Logger
 
@@ -52,6 +53,7 @@ func (cc *CartViewController) Inject(
 
 	applicationCartService *application.CartService,
 	applicationCartReceiverService *application.CartReceiverService,
+	cartRecoveryService *application.CartRecoveryService,
 	router *web.Router,
 	logger flamingo.Logger,
 	config *struct {
@@ -62,6 +64,7 @@ func (cc *CartViewController) Inject(
 	cc.responder = responder
 	cc.applicationCartService = applicationCartService
 	cc.applicationCartReceiverService = applicationCartReceiverService
+	cc.cartRecoveryService = cartRecoveryService
 	cc.router = router
 	cc.logger = logger.WithField(flamingo.LogKeyCategory, "cartcontroller").WithField(flamingo.LogKeyModule, "cart")
 
@@ -201,6 +204,18 @@ func (cc *CartViewController) DeleteAllAndViewAction(ctx context.Context, r *web
 	return cc.responder.RouteRedirect("cart.view", nil)
 }
 
+// RecoverAction restores an abandoned cart with the recovery token of the CartAbandonedEvent and shows it
+func (cc *CartViewController) RecoverAction(ctx context.Context, r *web.Request) web.Result {
+	token, _ := r.Params["token"]
+
+	_, err := cc.cartRecoveryService.RecoverCart(ctx, r.Session(), token)
+	if err != nil {
+		cc.logger.WithContext(ctx).Warn("cart.cartcontroller.recoveraction: Error %v", err)
+	}
+
+	return cc.responder.RouteRedirect("cart.view", nil)
+}
+
 // CleanAndViewAction empties the cart and shows it
 func (cc *CartViewController) CleanAndViewAction(ctx context.Context, r *web.Request) web.Result {
 	err := cc.applicationCartService.Clean(ctx, r.Session())
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/lunarforge/flamingo_commerce/cart/application"
+)
+
+// ReorderResult is the GraphQL representation of the result of adding the items of a past order to the cart
+type ReorderResult struct {
+	OrderID       string
+	Added         []application.ReorderedItem
+	NotAdded      []application.NotReorderedItem
+	DecoratedCart *DecoratedCart
+}
@@ -0,0 +1,48 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+// CommerceGiftCardQueryResolver resolves gift card queries
+type CommerceGiftCardQueryResolver struct {
+	giftCardService cart.GiftCardService
+}
+
+// Inject dependencies
+func (r *CommerceGiftCardQueryResolver) Inject(
+	optionals *struct {
+		GiftCardService cart.GiftCardService `inject:",optional"`
+	},
+) *CommerceGiftCardQueryResolver {
+	if optionals != nil {
+		r.giftCardService = optionals.GiftCardService
+	}
+
+	return r
+}
+
+// CommerceGiftCardBalance returns the current balance of the gift card
+func (r *CommerceGiftCardQueryResolver) CommerceGiftCardBalance(ctx context.Context, code string) (*cart.GiftCardBalance, error) {
+	if r.giftCardService == nil {
+		return nil, errors.New("no gift card service registered")
+	}
+
+	balance, err := r.giftCardService.Balance(ctx, code)
+	if err == cart.ErrGiftCardNotFound {
+		return nil, &gqlerror.Error{
+			Message:    err.Error(),
+			Extensions: map[string]interface{}{"code": "gift_card_not_found"},
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
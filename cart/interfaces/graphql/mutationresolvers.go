@@ -13,7 +13,9 @@ import (
 	"flamingo.me/form/domain"
 
 	"github.com/lunarforge/flamingo_commerce/cart/application"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
 	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // CommerceCartMutationResolver resolves cart mutations
@@ -46,10 +48,15 @@ func (r *CommerceCartMutationResolver) Inject(q *CommerceCartQueryResolver,
 }
 
 // CommerceAddToCart mutation for adding products to the current users cart
-func (r *CommerceCartMutationResolver) CommerceAddToCart(ctx context.Context, marketplaceCode string, qty int, deliveryCode string) (*dto.DecoratedCart, error) {
+func (r *CommerceCartMutationResolver) CommerceAddToCart(ctx context.Context, marketplaceCode string, qty int, deliveryCode string, bundleConfiguration []*productDomain.BundleComponentSelection) (*dto.DecoratedCart, error) {
 	req := web.RequestFromContext(ctx)
 
 	addRequest := r.cartService.BuildAddRequest(ctx, marketplaceCode, "", qty, nil)
+	for _, selection := range bundleConfiguration {
+		if selection != nil {
+			addRequest.BundleConfiguration = append(addRequest.BundleConfiguration, *selection)
+		}
+	}
 
 	_, err := r.cartService.AddProduct(ctx, req.Session(), deliveryCode, addRequest)
 	if err != nil {
@@ -138,6 +145,17 @@ func (r *CommerceCartMutationResolver) CommerceCartApplyCouponCodeOrGiftCard(ctx
 
 	_, err := r.cartService.ApplyAny(ctx, req.Session(), code)
 
+	if policyErr, ok := err.(*application.CouponPolicyError); ok {
+		return nil, &gqlerror.Error{
+			Message: policyErr.Error(),
+			Extensions: map[string]interface{}{
+				"code":             policyErr.MessageCode(),
+				"couponCode":       policyErr.CouponCode,
+				"conflictingCodes": policyErr.ConflictingCodes,
+			},
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -252,6 +270,62 @@ func (r *CommerceCartMutationResolver) CartClean(ctx context.Context) (bool, err
 	return true, nil
 }
 
+// CommerceCartCreateCustomerCart creates a new named cart for the logged in customer
+func (r *CommerceCartMutationResolver) CommerceCartCreateCustomerCart(ctx context.Context, name string) (*cartDomain.Cart, error) {
+	return r.cartReceiverService.CreateCustomerCart(ctx, name)
+}
+
+// CommerceCartDeleteCustomerCart deletes a cart of the logged in customer
+func (r *CommerceCartMutationResolver) CommerceCartDeleteCustomerCart(ctx context.Context, cartID string) (bool, error) {
+	req := web.RequestFromContext(ctx)
+	err := r.cartReceiverService.DeleteCustomerCart(ctx, req.Session(), cartID)
+
+	return err == nil, err
+}
+
+// CommerceCartSwitchCart switches the active cart of the logged in customer
+func (r *CommerceCartMutationResolver) CommerceCartSwitchCart(ctx context.Context, cartID string) (*dto.DecoratedCart, error) {
+	req := web.RequestFromContext(ctx)
+	_, err := r.cartReceiverService.SwitchCart(ctx, req.Session(), cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.q.CommerceCart(ctx)
+}
+
+// CommerceCartMoveItem moves an item of the current cart to another cart of the logged in customer
+func (r *CommerceCartMutationResolver) CommerceCartMoveItem(ctx context.Context, itemID string, deliveryCode string, targetCartID string) (*dto.DecoratedCart, error) {
+	req := web.RequestFromContext(ctx)
+	err := r.cartService.MoveItem(ctx, req.Session(), itemID, deliveryCode, targetCartID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.q.CommerceCart(ctx)
+}
+
+// CommerceCartAddFromOrder adds the still saleable items of a past order of the logged in customer to the current cart
+func (r *CommerceCartMutationResolver) CommerceCartAddFromOrder(ctx context.Context, orderID string) (*dto.ReorderResult, error) {
+	req := web.RequestFromContext(ctx)
+	result, err := r.cartService.AddFromOrder(ctx, req.Session(), orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	decoratedCart, err := r.q.CommerceCart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReorderResult{
+		OrderID:       result.OrderID,
+		Added:         result.Added,
+		NotAdded:      result.NotAdded,
+		DecoratedCart: decoratedCart,
+	}, nil
+}
+
 func mapCommerceDeliveryAddressForm(form *domain.Form, success bool) (dto.DeliveryAddressForm, error) {
 	formData, ok := form.Data.(cartForms.DeliveryForm)
 	if !ok {
@@ -44,6 +44,11 @@ func (r *CommerceCartQueryResolver) CommerceCart(ctx context.Context) (*dto.Deco
 	return dto.NewDecoratedCart(dc), nil
 }
 
+// CommerceCartCustomerCarts returns all carts of the logged in customer
+func (r *CommerceCartQueryResolver) CommerceCartCustomerCarts(ctx context.Context) ([]*cart.Cart, error) {
+	return r.applicationCartReceiverService.ListCustomerCarts(ctx)
+}
+
 // CommerceCartValidator to trigger the cart validation service
 func (r *CommerceCartQueryResolver) CommerceCartValidator(ctx context.Context) (*validation.Result, error) {
 	session := web.SessionFromContext(ctx)
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x4d\x6f\xdc\x38\xd2\xbe\xfb\x57\xd0\x9e\x4b\x4f\x90\x77\x06\xef\x1e\x7d\x6b\x77\x27\x41\x63\xc6\x4e\x62\x3b\xd9\x43\x10\x18\xb4\x54\xdd\xcd\x0d\x45\xca\x24\x65\x5b\x58\xcc\x7f\x5f\xf0\x4b\x22\x29\xea\xc3\x09\x76\x80\xd9\xdd\x1c\x62\x4b\x2c\x16\x8b\xac\xa7\x8a\x0f\x8b\xb2\x6a\x6b\x40\x1b\x5e\x55\x20\x0a\xb8\xdb\x42\xc1\x05\x56\x50\x6e\xb0\x50\xe8\x9f\x27\x08\x21\x54\x60\xa1\xce\x7b\x11\xdd\x72\x6a\x1a\x4a\x2f\xbc\x05\x4a\x1e\x41\x10\x90\xe7\xe8\x4b\x24\xb8\x4d\x44\xda\xd3\xaf\xa6\xeb\x01\x86\x4d\x17\xed\x86\x97\xb0\x2a\xdd\xa3\x7e\x38\x47\x37\x4a\x10\x76\x38\xfd\x39\x31\x60\xd0\xd9\x6b\x5d\x53\xfa\x01\xb7\x15\x30\x75\x0d\x0f\x0d\x11\x50\xee\x14\x54\x32\xe9\x7e\xf7\x41\x90\xc2\x35\x9d\x76\x93\xbc\x69\xaa\x0a\x8b\x36\x95\x75\xaf\x4f\x4f\xfe\x38\x39\x89\x57\x2b\x6c\x76\x8b\x55\x12\x59\xf0\x86\xa9\x74\xc4\x75\x5d\x53\x02\xe5\xd6\x37\xdb\x51\x65\x53\xa5\x0d\x41\x3f\x63\x64\x22\xf7\x8e\xec\xd5\x06\x8b\x72\x54\xee\x9d\xc0\xac\xbc\xe5\x0a\xd3\xbf\x13\x75\x9c\x15\x37\x92\x7e\xf0\xa8\xc7\xba\xd2\xaf\xb2\xfd\x8e\x58\x0e\xcd\xbe\xe0\x9c\x02\x66\xdd\xc4\x6e\xf1\x33\x0c\xd6\xdd\xbc\xf4\x12\xce\x51\x37\x40\xa1\x50\x84\x33\x2d\x71\x53\x53\xa2\x3e\x63\xda\x80\x1d\xff\xa2\xbd\x04\x75\xe4\xa5\x5c\x55\xf6\xe7\x39\xfa\xe2\x30\xf1\xf5\xe7\x81\x71\x59\x0f\x39\xcf\x90\xf2\x1c\xed\xb6\xd6\x3c\x60\x8a\xa8\x76\xb7\xed\xf0\x65\xde\xde\x13\x4a\x09\x3b\xac\xcb\x52\x80\x1c\x38\xd0\xbe\x35\x82\x75\x23\x8a\x23\x96\x20\x12\x99\x0f\x20\x24\x67\x2e\x36\xc6\x43\x22\x8a\x04\x5c\x96\x44\x4f\x1e\xd3\x2d\x56\x78\x38\x68\xd0\x68\xad\xac\x93\x55\x1b\x40\x3b\x69\xb7\x53\x03\xca\xd9\x41\xde\xf2\x75\xa3\x8e\x7a\xf6\x85\x0e\x9e\x4f\x66\x0a\x91\xe3\x70\xda\x9e\x2e\x12\xb6\x8e\xdf\xf0\xa6\xe6\x4c\xc7\xe8\x60\x82\x7d\x93\x9b\x62\x09\x7b\xdc\x50\xb5\x69\x84\x00\x56\xb4\xb1\x3e\xa5\x01\x48\x6c\x8c\xc6\x7a\x6e\x7d\x8b\x53\xa3\x7f\xdd\x58\x4c\xee\x98\x4b\x41\xb5\xe0\x65\x53\xa8\xf4\x35\x91\xd1\x2a\x40\x99\xcc\xf2\xd0\x05\x49\x0a\xa1\xd3\x28\x30\x6e\xf1\x73\x3e\x0c\xbc\xd8\xbd\x11\xbb\x82\x11\x01\x3c\x08\xda\x2f\xb9\xac\xe0\xdb\xc3\xe4\xf8\x3d\x39\x31\x4e\x85\xdb\xa0\x53\x18\x36\x27\x5e\xe0\x12\x13\x76\x73\x24\x75\x4d\xd8\xe1\xcd\x25\x26\x34\xf6\x0c\x91\x6f\xaa\x5a\xb5\xc9\xd2\x1d\xb1\xf4\x8a\xdf\x72\x31\x69\x5d\xd7\x6f\x38\x2b\x9d\x79\x77\xdb\x15\x31\x3f\x66\x67\x74\xea\x15\x2c\xed\xa8\xa5\xba\x4e\xc6\x45\x1f\x55\xbb\xaa\xb0\xf8\x06\xea\x03\xc5\x05\x44\xa6\xbe\x46\x8f\x58\x10\xcc\x54\x3a\x81\x1d\x53\xfd\xc8\x6f\x9e\x15\x08\x86\xe9\x35\xec\x41\xe3\x18\x56\x02\xf6\x33\x16\xf8\xde\x9f\x79\x53\x1c\x41\xdc\xe0\x47\xc2\x0e\x83\x5c\xdc\x59\x6a\x50\x0f\x99\xc4\x72\x67\xdf\x3a\x85\xb2\xa9\xbc\xdb\x46\x91\x17\xcb\xe8\xc4\x3e\xba\xc3\x0c\x3a\xbc\x13\x5c\xca\x99\x2e\x1e\x0b\xbe\xcf\x86\xcb\xc1\x26\x80\x29\xf5\xcd\xb7\x44\xd1\x0c\x08\x7d\x00\x99\x11\xa7\x63\x6c\x89\x51\x49\x4c\x2e\x9b\x75\xb4\x03\x4e\x47\x7b\x75\xc5\x99\x76\xec\x35\x50\xc3\x3d\x96\x75\x7a\x61\x8f\x7e\x73\xed\x13\x69\x26\x96\x3a\x96\xe3\xd0\x18\xc7\xae\x87\xbd\x61\x38\x17\xed\x6d\x5b\xc3\x4a\xef\x8c\x29\xc2\xa7\x33\x6e\x9f\x26\x37\x47\x2c\x0e\x30\x58\xc4\x3b\xf7\xbe\xc7\xc3\x90\xa6\xa4\xd9\xe3\x1a\x2a\x4c\x98\x86\x59\x46\x26\x4f\xb1\x02\xb6\x16\x70\x52\x47\xec\x92\x39\x38\xe1\x2e\x06\xed\x4c\xa4\xc3\xe1\x64\x9f\x9b\x40\xc8\xf5\x53\xdd\x22\xa6\x6b\xe5\xfa\x74\xab\x7c\xfa\x75\xd2\x78\x6f\x8f\xb3\x1f\x4f\x00\x20\xc9\x6d\x93\x6a\x43\x93\x17\xa8\xf6\x99\x7a\xc7\xf6\x3c\x82\xc2\xe4\x20\xdd\x1c\x17\x8c\x50\x2c\xd0\x7a\x8b\x9f\x17\x68\xd2\x1d\x63\x50\x6b\xc2\x7f\x8e\xde\x52\x8e\xd5\xb8\x66\xf0\x10\xc9\x72\x0a\x2d\xf1\x35\xd8\x4e\x6c\x60\xe0\xe7\xdb\x60\xb0\x34\x95\xeb\x3e\xa3\x53\x31\x79\xd9\x8d\x18\x93\x91\x6e\xf7\xd8\xf5\xbc\xc5\x3c\xba\xd7\xf9\xed\xd9\xa0\x88\x30\x05\x62\x8f\x8b\x81\x3b\x12\x6a\xe7\xc6\x3d\x60\x05\x4f\x38\xc7\xab\x0c\x91\x1e\x71\x94\x27\xdb\x43\x60\x27\xa3\xdc\x19\xb1\x71\x7c\x67\xc5\x9d\x69\x0f\x0d\xa6\x64\x4f\x86\x1b\x5a\xbe\xd7\x47\x2f\xee\x6c\x34\xd9\x65\x24\xe9\x8c\x62\x76\x5a\xb3\x33\x6c\x88\x2e\x7b\xbe\x48\x10\x37\xcc\xae\xf9\x41\xb7\x96\xe2\x0e\x1c\x44\xaa\x9a\x82\x7e\x25\xff\x02\xae\x1c\x1c\xaa\xfd\x99\xd6\x3d\x4e\xb2\xb3\xae\x18\x90\xcd\x96\xdb\xb0\x75\x7c\xfc\xec\xb0\x3a\x59\x8d\x0c\xad\x9b\xba\x25\xc8\x06\xfc\xc8\x1e\x90\xe8\x0b\xd3\xe8\x3c\x31\x99\x39\x42\x2c\x3b\x41\xcc\x1d\x20\x5e\x40\x4f\xbe\x87\x9d\xbc\x98\x9c\xbc\x90\x8c\x7d\x07\x17\x3b\x62\xe9\xb0\x33\x4d\x07\x42\xe7\x7b\x3a\x10\xed\x3a\xfa\xcd\x13\x17\xdf\xf6\x94\x3f\xcd\xc7\x78\x81\x85\x30\x09\x2a\x7c\xe9\xb1\xf7\x3b\x2f\x70\xe6\x90\xbd\x4d\x9a\x5d\x1f\x49\x04\x94\xb7\xa4\x82\x73\xa4\xff\xef\x6a\x52\xd1\x29\x7e\xf5\x0d\xda\x90\x82\x45\x87\xeb\x48\xf2\x37\x68\x23\xca\xac\x25\x7e\x4a\xc4\x82\xb5\x90\xe7\xa8\xc2\xf5\x17\x69\xf7\x91\x7f\x48\xce\x7e\xb9\xc6\x4f\x97\x20\x25\x3e\xc0\x82\xce\x97\xb8\xee\xa5\x62\xb3\x03\xc1\xd4\xfc\x4b\x5c\x0f\x6c\x0f\xc4\xd3\x39\x4c\x7a\xd4\x2f\x27\x1a\x4d\xd2\x78\xb6\x36\xd3\x48\xb8\x48\xea\x38\x11\x03\x5d\x40\x50\x32\xa4\x4a\xe9\xf3\x4b\x6c\x4a\xad\x91\x3b\x16\xb8\x6a\x32\xec\xf1\x78\xcd\x6f\xbc\x56\xa8\x7c\x4d\xcf\xbf\xdf\xb1\x42\xa7\x97\x11\xf6\x14\x35\xcc\xd0\x98\x74\xc0\x29\x06\x95\xc8\x3a\x58\xde\xb7\x1b\x5c\xd5\x98\x1c\xcc\x71\x65\x55\x04\x0f\x01\xad\x5a\x32\xcd\x7b\xcb\xc9\xf6\x84\x2a\x10\x53\xb4\x6c\xd8\x7d\xc9\xdc\xba\xf3\x43\x68\x60\x9c\x0f\x82\x53\x17\x8a\x9b\x28\xbe\x07\x6a\x59\x5c\xda\xe4\x5c\xea\x1b\xc7\x09\x6d\xb6\x37\x91\x41\x1e\x4e\x4b\xa9\x5c\xa8\xf7\xa2\xd4\x19\xca\xd1\xc7\xd1\xbc\x18\xec\xb1\x81\x0b\x33\x95\x89\x90\xae\x46\xf8\x31\x6f\xf2\xea\x43\xad\x61\x29\x35\x2d\x8b\x24\x19\xd7\xd4\x5c\xea\x41\xcd\xc5\x34\xba\xb2\xcb\xe5\x48\x5d\x26\xb4\xf2\x0a\x57\x49\x83\xe4\x8d\x28\x20\x2d\x4f\x3e\xa8\x36\xa8\x03\xce\xe7\xd3\x58\xc2\x90\xac\x81\xcc\xc2\x14\xde\x1d\x81\xd3\x41\x53\x71\xe7\x5e\x3b\x0b\xc2\x0e\x14\x0c\x4a\xa6\x8a\x20\xbd\xd4\x68\xc5\x47\xf0\xa7\x39\x35\x5e\x64\xae\x5e\xf9\xb2\xc4\xf4\x93\x53\x9d\x16\xfc\xcd\xf3\x58\x54\xda\xdc\xec\xf0\xf4\x88\x55\x10\x18\xf9\x10\xd9\x13\x21\x15\x33\x28\x18\x95\xa1\x38\x2b\x12\x03\x92\x94\x25\x85\xab\x81\x54\x44\xb8\x6d\xb6\x9f\xb4\x47\x62\xda\x28\xc7\x0d\x46\x65\x94\x00\xc8\x4c\x6d\x28\x73\x25\xa6\x6c\xee\x41\xea\xd6\xed\x77\xc2\x86\x30\x2d\x78\x55\x63\xd6\x0e\x86\x8b\x92\x1b\x51\x43\x81\x44\xa6\xe6\x52\x75\xe9\x6f\xd4\x6a\x73\x16\x9f\xd4\x23\xe0\x40\x82\x44\x9a\xb7\x47\xe3\x48\xcc\xd8\x6c\x65\x06\x8a\x22\x8f\x01\x85\xfa\xc8\xd9\x14\x3a\xa0\x32\x25\xee\x51\x9b\xb3\x40\xb5\x57\x3a\xbe\x5c\x31\x7f\x33\x64\xc4\x35\x05\x52\x98\xd0\x54\xf2\x43\xdc\xea\x13\x28\x91\x8a\xb0\xc3\xa6\x91\x8a\x57\x20\x32\xd7\x40\x6f\x32\x22\x79\x73\x73\x92\x49\xd2\x9e\x98\x66\x67\x99\x3f\x81\x61\x05\xef\xf7\x17\x44\xa8\x63\x92\x94\xb1\x94\x35\x17\xb6\xd4\x21\xda\x7c\xe3\x55\x53\xdd\xa7\xbc\x9a\x61\x8b\x63\x03\xc3\xc9\x85\x8f\xb3\xa8\x33\xc8\xa4\x9a\xc2\xcc\x6d\xad\x94\x20\xf7\x8d\x82\x80\xb9\x0a\x90\x20\x1e\xa1\x34\xdb\xe5\x6c\x09\xad\xab\x76\x8e\x1e\x22\xc6\x58\xdf\x92\x82\x55\x76\xc8\xbe\xa2\x9b\x1d\x73\x8a\xc0\xf8\x6a\xe9\xa8\xb1\x1d\x03\xc9\x66\x7e\x5f\x74\x1d\x3d\x7a\x5d\xf7\x12\x33\xd5\xd8\xcf\x98\x92\xd2\xf8\xf1\x1a\x64\x43\x3d\xa5\x3a\x62\xa9\xe5\x38\x7b\x23\x04\xef\xd3\x59\x42\xbe\x3b\x01\x77\x2e\xf9\x0d\x12\xf4\x10\x43\x84\xb4\x5e\x19\xc6\x6a\x52\xd8\xd0\x64\xa4\xb7\xc3\x28\x1c\x2d\x50\x65\x64\x03\x76\xa4\x61\x92\x4f\x17\x63\x56\xfe\x71\x92\x1d\xe6\xa3\x6a\xaf\x41\x1f\xbe\x8a\xc1\xd2\x10\xe9\x5b\x7a\x86\x18\x2f\x4c\x85\x9f\xd7\x94\xf2\xa7\xa0\x1d\xf5\x34\xa6\xf3\xde\x96\xec\x3b\x96\x15\xb4\x5a\xdd\x5c\x04\xbb\xda\x4c\x5d\x57\x73\x2d\x17\x26\xfd\x29\x9a\xeb\x67\x1f\xb5\xc9\x6a\xc4\x77\x7c\x73\xfa\xe3\x13\xd8\x5b\x2e\x7c\x8c\x9d\xb9\x16\x9f\x4a\xd1\x5e\xb7\x95\x58\xe1\x33\xbb\xcb\x73\x51\xd9\x04\x68\xff\xc5\x6a\x03\x7d\x56\x5b\xef\x56\xc4\xf7\x48\x36\x36\x04\xfc\x45\xbe\x1f\xe4\x35\x82\xaa\x56\x2d\x22\xfb\x6e\x58\x22\xd1\xa3\xee\x7b\xe6\xf8\x87\x57\x93\xa9\x35\xdd\xe9\xe1\x02\xd0\x77\x35\xa7\xb3\x9b\x23\x7f\x92\x5a\xab\x3a\x02\x12\xf0\xd0\x80\x54\xe8\x09\x4b\x24\x9b\xa2\x00\x29\xf7\x0d\xa5\xad\x26\xb0\xfa\x01\xdc\x58\xdd\x63\xcf\x03\x47\xbe\x2b\x71\x57\xd7\xdd\x45\x4f\x00\xa8\xef\x33\x78\xf1\xd0\x19\x05\xde\x7f\x6f\x09\xd0\x12\xc9\x1a\x0a\xb2\x27\x45\x60\x88\x8d\x17\xe9\xdc\xa8\xa5\x4c\xa4\x0d\x2b\xf0\x46\xf9\xdb\x4e\xc0\x91\x97\xb3\x77\xc0\x40\x60\x3a\xa6\xf1\x60\x9b\xa7\x74\x4e\x67\x81\x5e\xc4\x4f\x65\x8d\xbe\x41\xab\x71\xa3\xdd\x67\xc6\x42\x95\x0d\xf7\x5f\xd0\xfb\xbd\x02\x86\x1a\x09\xa5\x86\x24\x52\x02\x33\x49\x8d\x55\x67\xae\x90\x94\xcf\x5e\x67\x6b\xbd\x36\xf8\x9b\x46\x9f\x55\x69\xce\x8c\x91\x42\xc5\x91\x3c\xf2\x27\xfd\x13\x58\xa9\xdf\x09\xf4\x7f\x88\x30\x54\x60\x09\x88\xf1\x70\x34\xcb\x0e\xdc\x1a\xb8\xef\x28\x7e\xb7\xa7\xd0\xe9\x08\x4c\x56\xf9\x3f\x6c\xce\x66\xd8\x5d\x09\x4c\xd9\x9a\xbe\xb6\x17\xdb\x5c\x62\xa0\x17\xa0\x30\x3e\x38\xe6\x17\x6b\x98\xa7\xfe\x77\x22\x99\x3d\x91\xf8\x73\xc8\xff\x9f\xcf\xcb\xfc\x6d\x4c\xe6\xbf\xf9\xcc\x62\xce\x2b\xc1\x76\x9b\x93\x59\x70\x66\x21\xac\x6e\xd4\x38\xa0\x77\xa6\x79\x09\xaa\xff\x44\x50\x2f\xc0\xf4\x02\x48\x2f\x40\xf4\x02\x40\x2f\xc0\xf3\x02\x38\x2f\x40\xf3\x02\x30\x2f\xc0\xf2\x02\x28\x2f\x40\xf2\x02\x20\x2f\xc0\xf1\x02\x18\xff\x08\x8a\xfd\xb5\x80\x43\x73\x88\xe4\xb3\x4f\x8c\x3c\x34\xd0\xd1\x52\x73\x1e\xd2\xbb\x0b\xb1\x9b\x42\x6b\x36\x38\xdf\x7a\x96\xa1\xb0\xd1\x56\xd2\xdd\x3c\x8e\xd0\xd2\x32\xb6\x24\x25\x5c\x69\xb8\x75\xf4\xb0\xa1\xa5\x35\x44\x1f\x61\xdd\xae\x9b\x90\x53\x74\x0f\xc1\x9e\x7b\x24\x32\xb1\x7a\xee\x3e\xe3\xec\x7d\x6d\x8f\xc9\xc8\x5f\x5b\x20\xfb\xc5\xac\xdf\xb4\xc3\x1b\xaf\x25\x3d\x92\xfb\xb0\xa4\x8b\xbb\xe4\xea\x17\xbe\xc4\x0a\xd0\xaf\x48\x91\x0a\xfc\x5a\xa5\xd7\x60\x63\x57\xe6\xd1\x9a\x86\x87\x84\x3f\xd7\xb9\x2f\x3c\x73\x44\xac\x7f\xca\xb1\xd2\xfa\xff\x47\xfd\xbb\xd8\xad\x9d\xe0\xc6\x7a\xf0\xdf\xe5\xce\xc9\xa3\x57\x99\x2c\xf6\x5f\xe0\xec\x35\x95\x7a\xfc\x9a\xda\x05\x5b\x8c\x4f\xcc\xd0\xab\x57\xbe\xb0\xf7\xea\xd5\x72\xac\x2e\x70\x76\x2a\x39\xe5\x6d\x93\x5a\xe1\x59\x69\xce\x6d\x42\xf0\x63\xd3\x7f\x5f\x11\xcd\x38\x58\xf8\xe8\x6f\x40\x4e\x87\xa2\xde\x1f\x7c\xf0\x51\x4f\x5a\x19\x72\xa6\x4e\xd5\x49\x90\x00\xd5\x08\xd6\xb9\xd2\x5d\xf7\x68\x90\x88\xae\x66\xa2\x0f\x0a\x0a\x44\x25\x7d\xa8\x61\x5b\x2b\x41\x0f\x0d\x36\x5f\xf2\xbb\xe0\x02\x54\x98\x6f\xcc\x95\xf9\x1e\x04\x61\x66\xb3\xef\x81\x3c\x02\x4b\x7c\x30\x65\xd3\x6a\xec\xd2\x2a\xfb\xa1\xf0\xeb\xbc\x3f\x07\x5f\x91\xe5\xea\x43\x03\xff\x5c\x3a\xba\x93\xba\x68\x5d\x96\xb7\x5c\xab\x19\xda\xb6\xdb\x9e\xbe\xee\xaf\xbd\x16\x58\x33\xe5\xdf\x2d\x50\x50\x10\xde\xc9\xcf\x7f\x7b\x3e\xaf\x6f\xa7\xa0\xea\x3e\xd9\x36\xf6\xfe\x90\xd2\x4f\xb5\xce\x52\x5a\xe9\x47\xd5\x2e\xd0\x1b\x2c\xcf\xcc\x10\x67\xeb\xb2\x94\xbf\x5a\xfd\xd2\x60\xc7\xd7\xab\xfc\x85\x95\x03\x60\x88\xb3\x1c\xa0\xac\x8a\x38\xb1\xaf\x70\xbf\x95\xcc\x31\x88\x01\x7a\x86\x15\xb5\x5c\x64\xda\x61\x93\xca\xd1\x2a\xfd\xa8\xec\x75\x9a\x51\x06\xa3\x65\x6b\x4f\xb9\x01\xd7\x75\x4d\xdb\xbe\xaa\xfd\x5e\xf8\x32\xf5\xaa\x58\xe4\xd9\x8c\xca\x6b\xa8\xf8\x23\x74\x7a\x0e\xee\x97\x65\x48\x19\xd5\xd7\xdb\xb8\x0a\x2f\xf8\x17\xe9\x8b\x51\xc1\x19\xfc\x5a\x35\x54\x91\x9a\x42\xf7\x11\xa7\xc7\x07\xc8\x71\x34\x24\x84\x07\xe4\xaa\xa7\x95\xf6\xc5\xa0\xae\x95\x63\xc0\xe6\xef\x9e\xa6\xe5\x34\x3c\xbe\xe6\x10\xfd\xc3\xb6\xc7\xfb\xa1\x5c\xc9\xf8\x79\xd4\xb0\xb8\xdf\x0b\xa7\xb0\xd1\x3b\xb5\x9c\x8d\x39\x23\x16\xdd\x5e\xfc\x2b\x00\x00\xff\xff\x08\x97\xfb\x93\xdc\x38\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x4b\x73\xdb\x38\x12\xbe\xe7\x57\x50\x9a\x8b\x9c\xf2\x66\x6a\xf7\xa8\x9b\x2d\x27\x29\xd7\x8c\x9d\xc4\xf6\xcc\x1e\x52\x29\x15\x44\x42\x12\xd6\x14\xa1\x00\xa0\x3d\xaa\xad\xfd\xef\xdb\x78\x91\x78\x92\x74\xb2\x33\x55\xb3\xbb\x73\x88\x45\xa2\xd1\xdd\x00\xfa\xf1\xa1\x01\x8e\x38\x1d\x71\xb1\xa2\x87\x03\x66\x25\x5e\x5f\xe1\x92\x32\x24\x70\xb5\x42\x4c\x14\xff\x7c\x55\xc0\x7f\x25\xfc\x5c\xf6\x24\xb2\x65\xa6\x1a\x2a\x4b\x7c\x85\x6b\xf2\x84\x19\xc1\x7c\x59\x7c\xf6\x08\xaf\x02\x92\xd3\xec\x8b\xea\xba\xc3\x71\xd3\xe5\x69\x45\x2b\xbc\xa8\xcc\xa3\x7c\x58\x16\xf7\x82\x91\x66\x37\x3b\x0b\x14\x88\x3a\x5b\xae\x17\x75\xfd\x11\x9d\x0e\xb8\x11\x77\xf8\x6b\x4b\x18\xae\xae\x05\x3e\xf0\xa0\xfb\xfa\x23\x23\xa5\x69\x9a\x75\x83\xbc\x6f\x0f\x07\xc4\x4e\x21\xad\x79\x3d\x7b\xf5\xaf\x57\xaf\x84\x37\x5b\x6e\xb3\x99\xac\x8a\xf0\x92\xb6\x8d\x08\x25\x5e\x1c\x8f\x35\x01\x75\x6d\xb3\x96\xca\xdb\x43\xd8\xe0\xf4\x53\x4a\x06\x74\xef\xc9\x56\x00\xbf\x2a\x4b\xf7\x9e\xa1\xa6\x7a\xa0\x02\xd5\x7f\x27\x62\x3f\x4a\xae\x28\xad\x70\xaf\xc7\xc5\x41\xbe\x4a\xf6\xdb\x23\x1e\xab\x7d\x49\x69\x8d\x51\xd3\x0d\xec\x01\xfd\x86\xa3\x79\x57\x2f\x2d\x85\x59\xa8\x7b\x5c\xe3\x52\x10\xda\x48\x8a\x7b\x60\x2b\x7e\x45\x75\x8b\xb5\xfc\xcb\xd3\x0d\x16\x7b\x5a\xf1\xc5\x41\xff\x05\x0b\x33\x36\xf1\xe5\x2c\x52\x2e\xb9\x42\x66\x65\x48\xb5\x2c\xae\xaf\xb4\x7a\x20\x95\x88\xd3\xf5\x55\x67\x5f\xea\xed\xfc\x16\x1d\x70\x41\xb7\x85\xd8\x63\x65\x10\xe7\x45\xcb\x71\x55\x08\x2a\x97\x55\x00\x5d\x4b\xf8\xbe\x38\xb4\xb5\x20\xc7\x5a\x93\x70\x49\x8f\x8a\xb2\xe5\x82\x82\xc8\x62\x81\xdf\xec\xde\x14\x07\x44\x9a\x82\xb2\x82\xa3\x27\xe8\xbf\x85\x5f\x35\x18\x2b\x3b\x9b\x2b\x39\x0d\x88\xf1\x25\x6f\x48\x5d\xc3\xc3\x45\x55\x31\xcc\x23\xd3\xd1\x6f\x15\xe1\xb1\x65\x25\xcc\x3e\x66\x01\xcd\x47\xcc\x38\x6d\x8c\x57\xe6\x9d\xd1\xf3\x41\x54\x55\x44\x4e\x3b\xac\x3f\x12\x28\x16\xea\x34\x6a\x2d\x8f\xc1\x7a\x45\x4e\x15\xb4\xeb\xa1\xe1\x9a\x36\x3b\xfe\x40\x2f\x5a\x98\x56\x98\xf7\x52\xba\xed\x2f\x6a\x08\x9e\xc9\xa0\xb0\x3d\x5c\x1e\xa4\x4d\x6e\x45\xdb\x23\xd8\x0a\x44\x87\x68\x80\x7d\x93\x19\x62\x85\xb7\x08\x56\x6b\xd5\x32\x86\x9b\xf2\xe4\xf3\x13\xd2\xf4\x89\x8e\x0e\x3e\x9f\x07\xdb\x62\xd8\xc8\x9f\x2b\xed\x0d\xd7\x8d\x09\x7e\x47\x46\xab\xb6\x14\xe1\x6b\xc2\xbd\x59\xc0\x55\x30\xca\x5d\xe7\x9e\xa1\xf1\xce\x3c\x97\x04\x47\x49\x3b\xa0\x25\xdb\x28\xb2\x5b\x9c\x21\x40\x51\xb8\xf8\x9c\x8a\x47\xb6\xdd\x0d\xcb\xdf\x12\x8d\xfd\x20\x7c\xe5\x74\x72\x1d\xf6\x95\x25\xb8\x01\xff\xb8\xdf\x93\xe3\x11\x5e\xbf\x85\x87\xda\x5f\x19\xc2\xdf\x1e\x8e\xe2\x14\x4c\x1d\xd8\xbd\x65\xfc\x8e\xb2\x41\xed\xba\x7e\xf1\xa8\x64\xcc\xbf\xbe\x5a\x10\xf5\x67\x74\x44\x33\xcb\x60\x6a\x47\x49\xd5\x75\x52\x4b\xf4\x49\x9c\x16\x90\x20\x1e\xb1\xf8\x58\xa3\x12\x7b\xaa\x9e\x17\x4f\x88\x11\xd4\x88\x70\x00\x60\x4f\xbd\xe4\xb7\xbf\x41\xec\x00\x4f\xbc\xc3\x5b\x2c\xed\x18\x2f\x18\xde\x8e\x68\x60\x7b\xff\x4a\xdb\x72\x8f\xd9\x3d\x7a\x02\x5a\x9e\xb6\x15\x20\x53\x56\x8f\x13\x81\x65\xad\xdf\x1a\x86\x60\x9d\x76\xd9\xb2\x96\xe7\xd3\xc8\x94\x92\xcd\x6d\x51\x87\xf7\x8c\x72\x3e\xd2\xc5\xda\x82\xed\xb3\xa2\x3c\x4a\x3f\xa8\xae\x6d\xf3\x03\x11\x75\xc2\x08\xad\x03\x29\x89\xc3\x3e\x36\x45\xa9\xc0\x27\xa7\x8d\xda\xcb\xbd\xc3\xde\x7e\xb8\xa5\x8d\x5c\xd8\x3b\x5c\x2b\xd4\x33\xad\xd3\x0b\x7b\xf4\x69\xbd\x0f\xa4\x09\x5f\xea\xf0\x95\xb1\x46\xdf\x77\xad\xd9\x2b\x6c\x75\x79\x7a\x80\x74\xbc\x90\x39\x39\xb4\xf0\xe1\x88\xdb\x87\xc9\xd5\x1e\xb1\x1d\x8e\x26\x71\x6d\xde\xf7\xf6\x10\x03\xa4\x30\x7a\xdc\x61\x99\x97\xa5\x99\x25\x68\xd2\xe0\xce\xc1\x89\x0e\x1a\x36\x90\x32\x18\x83\x21\xee\x7c\x50\x8f\x84\x1b\x3b\x1c\xec\x73\xef\x10\x99\x7e\xa2\x9b\xc4\x65\xba\x4f\x37\xcb\xd0\x61\x48\x79\xab\x8f\xd1\x1f\x0d\x18\x40\x10\xdb\x06\xd9\xba\x2a\x4f\x60\x6d\x23\xf5\x75\xb3\xa5\x9e\x29\x0c\x0a\xe9\xc6\x38\x41\x42\x39\x81\x2b\x64\xd5\x09\x9c\x64\x47\xdf\xa8\xe5\x56\x63\x59\xbc\xab\x29\x12\x79\xce\xd8\x9a\x48\x12\x53\x48\x8a\x2f\x4e\x3a\xd1\x8e\x81\x7e\x7b\x70\x84\x9d\x25\xe0\x72\x76\x28\x2a\x2e\x1b\x89\x3e\x18\xe9\xb2\xc7\x75\x8f\x5b\xd4\xa3\x79\x9d\x4e\xcf\xca\x8a\x48\x03\xa9\x66\x0b\x69\x6a\x04\xda\x19\xb9\x3b\x98\x97\x67\x94\xc2\x55\x0a\xc2\x67\x16\xca\xc2\xfc\xd8\xb0\x03\x29\x6b\x45\x96\xb7\xef\x24\xb9\x51\xed\x6b\x0b\x01\x65\x4b\xe2\x84\x96\xee\xf5\xc9\x92\x1b\x1d\x55\x74\xc9\x04\x9d\xd9\x8b\xf4\xe9\x38\x1b\xc5\x62\xeb\xd2\x3b\x9b\xc0\xe2\xe2\xe8\x9a\x16\x7a\xa5\x21\x6e\xb4\x40\xe4\x00\x5b\x14\xf9\x8a\xff\x09\x96\x32\xda\xce\xdb\xdd\xb4\x79\x1c\x44\x67\x5d\x19\x22\x19\x2d\xaf\xdc\xd6\xbc\xfc\xa4\x58\x19\xac\x32\xa2\x65\x53\x37\x05\x49\x87\xcf\xe4\x80\x80\x9f\x1b\x46\xc7\x81\xc9\xc8\x16\x62\xda\x0e\x62\x6c\x03\xf1\x02\x78\xf2\x2d\xe8\xe4\xc5\xe0\xe4\x85\x60\xec\x1b\xb0\x18\x60\x03\x63\x3b\xc3\x70\xc0\x5d\x7c\x0b\x07\xbc\xac\x23\xdf\x3c\x53\xf6\xb8\xad\xe9\xf3\xb8\x8f\x83\xe9\x30\x15\xa0\xdc\x97\xd6\xf6\x7e\xa6\xb0\x11\x8e\x37\xd9\x57\x41\xb3\xe9\xc3\x65\xa5\xeb\x81\xc8\xba\x82\xfc\xb7\xab\x86\x79\xbb\xf8\xc5\x23\x3e\xb9\x10\xcc\xdb\x5c\x7b\x94\x3f\xe1\x93\x07\x99\x25\xc5\x0f\x01\x99\x33\x17\x40\x7b\x40\xc7\xcf\x5c\xe7\x91\x7f\x70\xda\xbc\xb9\x43\xcf\x37\x98\x73\xb4\xc3\x13\x3a\xdf\xa0\x63\x4f\xe5\xab\xed\x10\x86\xea\x43\xaf\x48\x77\x87\x3c\x1c\xc3\xe0\x8a\xda\xe9\x2c\xb2\x41\x1a\x8d\xd6\x66\x5a\x8e\x2f\x83\x3a\x8e\x87\x40\x27\x00\x94\x04\xa8\x12\x72\xff\xe2\xab\x72\x94\x96\x9b\x73\x5c\x31\xe8\xf6\x28\x5f\x6d\xcc\x57\x29\x85\xad\x26\xda\xf7\xd7\x4d\x29\xc3\x4b\x06\x3d\x79\x0d\x23\x30\x26\x14\x38\x84\xa0\x02\x5a\x63\x96\x9b\xd3\x0a\x1d\x8e\x88\xec\xd4\x76\x65\x51\x3a\x0f\x0e\xac\x9a\x32\xcc\x8d\xc6\x64\x5b\x52\x03\x06\x1a\x82\x65\x71\xf7\x29\x63\xeb\xf6\x0f\xae\x82\x7e\x3c\x70\x76\x5d\x85\xdf\x54\xa3\x0d\xae\x35\x8a\x0b\x9b\xcc\x92\xda\xc6\x3c\xa0\x4d\xf6\x26\xdc\x89\xc3\x61\x11\x97\x32\xf1\x81\x55\x32\x42\x19\xf8\x38\x1b\x49\xdf\x8e\xdd\x92\x38\xd7\x75\x39\xce\xc0\x55\xcf\x7e\xd4\x9b\x34\x7b\x97\xab\x5b\xc4\x0d\xcb\x22\x41\xc4\x55\x35\x97\x63\x54\x73\x51\x8d\xa6\xec\x72\x93\xa9\xcb\xb8\x5a\xde\x46\xd5\x5a\x4e\x5b\x50\x2d\x2c\x4f\x7e\x95\x05\xab\xae\x0e\x38\x1e\x4f\x7d\x0a\x05\xb2\x22\x9a\x89\x21\xbc\xdb\x02\x87\x42\x43\x72\xb3\xbc\x7a\x14\xf0\xae\xc6\xca\x4a\x86\x8a\x20\x3d\x55\xb6\xe2\xc3\xe8\xf3\x18\x1b\x4b\x32\x56\xaf\x7c\x59\x60\xfa\xc1\xb0\x0e\x8f\x1a\xd4\xb3\xa9\xe9\x3f\xc8\x5a\xfe\x9e\x72\xdc\x80\x7f\x1d\xc0\xbf\x14\x24\x26\xba\xc8\x2f\x8d\x14\x5c\xa0\x40\xc5\xa6\x6d\xaa\x1a\xdb\x35\xd7\x75\x7a\xfd\x6e\x45\x9b\x2d\xd9\xb5\xcc\x64\xe3\xcf\xa1\xcd\xae\x2f\x0d\x99\x61\xde\x41\xde\x5c\xce\x31\xd9\xc1\x58\xf4\x13\x12\x8e\x6b\xa6\x9d\x74\x4b\x18\x17\xfa\xd4\x20\x4b\x53\xa3\x24\x89\xef\x12\xa4\x02\x4d\x6f\x23\x2a\x0f\xf2\xeb\x7c\x33\xa8\x0f\x07\x63\x15\x66\x3e\xb2\x34\x82\x61\x9c\x18\x5a\x4c\x73\xcb\x86\x74\xee\xdd\xc4\xcc\xdb\xcf\xa4\x89\x1d\x45\x2e\x2d\x6a\x4e\xcb\x21\x69\x25\x11\xa7\xe5\xc8\x4c\x1f\x29\x17\x5d\x00\xce\x6a\xad\xaa\x01\x83\x7c\x18\xde\x11\x27\x94\xa7\xf5\x91\x96\xcc\x46\x74\xd6\x34\x11\x23\x6f\xc5\xc0\xe0\x8e\x7b\xb0\xbd\x01\xeb\x90\x95\xaf\x7a\x40\xe7\xa4\xa1\xea\x43\x25\x5b\x30\x19\x3f\x9b\x52\xe4\x12\x84\x09\x10\xc6\x93\x27\x54\x5d\xab\x0d\xe1\xfa\x5c\x6d\x65\x8e\xcf\x12\x07\x51\x6f\x13\x24\x69\x75\x53\x94\x41\xda\x18\x18\x66\xa7\x99\xdd\x03\xc2\x22\x7f\xd8\x5e\x12\x26\xf6\x41\x5a\x40\x9c\x1f\x29\xd3\xc5\x16\x76\x4a\x37\xde\xb6\x87\x4d\x88\xec\x1b\xa4\xed\x58\x99\xe1\xe0\xc4\xfb\x71\xdc\x28\xa4\x82\x9d\x3e\x67\xbc\x10\xd0\x7b\xd3\x0a\xec\x60\x67\x58\x06\xcc\x9e\x70\xa5\x12\xf6\x68\x11\xaf\xab\xb7\x66\xb7\x31\x39\xdc\x39\xa5\x64\x96\x14\xd9\xd7\x94\x93\x32\x87\x20\x94\xad\xd7\x66\x95\xed\x30\x50\x32\xf7\xd8\xb2\x6f\x76\xf3\x77\xd7\x53\x8c\xd4\x83\xad\x26\x97\xa8\x46\x80\x38\xb2\x0a\xcd\xd1\x13\xd8\x12\xda\x40\x4a\x81\xf4\x22\x53\x8d\x2e\x38\xda\xd3\xe5\x1d\xf0\x91\xfb\xbf\x0a\x9e\x90\xfc\xd5\x40\x58\x81\xcd\x43\xb1\xc1\xea\xcc\x59\x27\xa0\x8e\x49\x5a\xf3\xb9\x5d\xf3\x49\x32\x80\x66\x53\xd3\xf2\x11\xc8\x37\x27\x73\x76\x4d\x20\x29\xee\x71\xf9\x48\x5b\x93\xf2\x2c\xc7\x58\x60\x7a\x3e\x00\xb6\x90\x4a\xd9\xf5\x1d\xe6\x6d\x6d\x41\x2e\xcc\xa9\xa4\xa3\xcd\x5b\xc6\x68\x1f\xde\x83\xed\x50\x47\x60\x76\x8a\x3f\xe1\xc0\x9b\x88\x82\xa6\x92\x2f\x77\x63\x57\x50\x6a\x92\xf0\xb0\xd7\x43\x31\xcc\x96\x0c\x13\xb4\x0e\x5e\x95\x6e\x93\x0e\x9f\x39\x2d\x41\x4a\x4a\xcc\x27\x71\x02\xbd\x81\xa6\x8c\xa6\x86\x70\xdb\xd2\x63\x76\x7f\x62\x0e\xb0\x83\xab\x6b\xfa\xec\xb4\x17\x3d\xb0\xec\xac\xf9\x8a\x6c\x3b\xdc\xeb\xb4\x6a\xde\x94\x39\x59\x7e\xb8\x80\x78\x87\xa9\x0c\x19\x9e\x92\xd4\x06\x11\x2b\xdd\x40\xee\xb9\x3e\x09\x31\x06\xa6\xa8\xb4\x71\xed\xd1\x13\x06\xdb\x05\x90\x05\x79\x42\x5f\x99\xb0\x97\x28\xe6\x36\x7d\x78\xe3\xf9\x9c\x54\xc2\x56\xe9\xf2\xd2\x16\xb0\x60\x08\xc2\x2c\xb3\x66\x4e\x98\x44\xde\x67\xc6\x8f\x68\x5b\x57\x45\x43\x85\xf4\xa3\x8c\x26\xd0\x7a\xe1\x2a\x13\x68\x72\x4b\x45\x52\x99\xca\xbd\x19\xe5\x6e\x28\xbd\x2b\x53\x23\x93\xec\xed\x90\xa2\x0d\x4a\xc2\xf0\xbc\x7d\x4a\x66\x2f\xe3\x1f\x82\xa7\x0d\xf8\x6b\x1a\xfa\x0c\xec\xe9\xa2\x89\xf8\x0f\x6b\x9d\xc2\xaa\x2f\xd2\x5a\x59\xc8\x87\x46\x5d\xd8\x31\xb0\x7d\x0d\x6b\xbb\xde\x42\x0c\xac\xce\xe5\x32\xaf\x01\xa7\x62\x19\x3e\xcf\x25\xa7\x35\xeb\xfc\xee\x5c\xda\xc6\x7a\x0b\xc1\xd5\x46\x5a\x86\x11\x0f\xd1\x6c\x84\x9e\xb5\xff\x2f\x5f\x84\xa1\xd6\x6a\x73\x69\xb2\x72\x5f\x36\x54\xf3\x6a\x41\x42\x20\x27\x5c\xcf\x61\xfe\x7e\xc9\xe9\x1d\x65\x76\xa1\xe6\xa6\xc5\x22\x37\x79\x0d\xe9\x20\x61\x0d\xd2\x43\x96\x8f\x1a\x6f\x05\x85\x03\xc5\xd6\xe1\xa7\xb9\xf5\x51\x53\x4e\x38\x6f\x75\xc6\xb5\x37\x97\xac\x90\x73\x40\x9a\x47\x71\x92\xfb\x2b\x2b\x16\xf2\xce\x93\xec\x3b\x37\xa6\x61\xd9\x24\x8a\xeb\x6b\x29\xce\xc9\x29\x5d\x91\x7d\x7e\xbf\xa7\xcf\xdd\xae\x8d\xe1\xaf\xb0\x57\x16\xc5\x33\xe2\xa0\x48\x59\x82\x94\x6d\x5b\xd7\x27\x69\x06\xf2\xc1\x2e\x6a\xf7\xd8\x6f\x7c\x33\x57\xf8\xcc\x5d\x9d\xee\x64\xdb\x09\x85\xdf\xa6\xf0\x64\xd1\x09\x06\x76\xfd\xde\x11\x0c\xc1\x8c\x1f\x71\x49\xb6\xa4\x74\x14\xd1\xe9\x88\x9b\x65\x94\x54\x2a\x91\xc5\x47\x8e\x8a\xf9\xbb\x8e\xc0\xec\x95\xe6\xef\x71\x83\x19\xaa\x73\x1c\x77\xba\x79\x88\xe7\x70\x92\xed\x49\xec\x50\x2e\x8a\x47\x7c\xb2\x81\x5c\xc9\xb2\xde\xf4\xa6\xf8\xb0\x15\x90\x36\xd4\x45\x3b\x79\x51\x4e\x30\xd4\xf0\x5a\x69\x35\x77\xdd\x2e\x02\x07\xc0\x14\xe6\x06\x3d\x4a\xeb\xd3\x2c\x55\x91\xcc\x63\x08\xc1\x9f\x83\xe5\xc8\xbf\xb8\xa9\xe4\x3b\x56\xfc\x45\xa1\x1e\xc4\x31\x84\x08\x57\x9a\xde\x8c\x98\x39\x30\x17\xc7\x7e\xd6\x65\xb7\x61\x0f\x0c\x66\xf9\xbf\x6c\xcc\x3a\x11\x57\xf2\x66\x9e\x3a\xc4\xdc\xaa\x04\xac\x62\x89\x32\x3d\xc7\x0a\xfd\x4a\x59\x7a\xb2\xe2\x38\xf5\xff\x02\xc8\x68\x01\xc4\x96\x3d\xfe\xba\x1c\xa7\xf9\xdb\x32\x5b\x4a\xf8\xdf\x2d\x91\xa8\xf2\x88\x93\x6e\xbf\xb1\x44\x42\x9a\x63\x2b\xf2\x06\x7d\xad\x9a\xa7\x58\xf5\x1f\x68\xd4\x13\x6c\x7a\x82\x49\x4f\xb0\xe8\x09\x06\x3d\xc1\x9e\x27\x98\xf3\x04\x6b\x9e\x60\xcc\x13\x6c\x79\x82\x29\x4f\xb0\xe4\x09\x86\x3c\xc1\x8e\x27\x98\xf1\xf7\x58\xb1\x3d\x07\x35\xd6\xec\x5a\xf2\xfc\x97\x86\x00\xde\xea\x60\xa9\xaa\x76\xc8\xec\x42\x74\x52\x38\xa9\x04\x67\x5b\xe7\x09\x08\xeb\xa5\x92\xee\xaa\x45\x06\x96\x56\xbe\x26\xcb\x11\x77\xeb\xe0\xa1\xdc\xf8\x29\x45\x64\xc5\xcc\x64\xdd\x00\x9c\xda\xe2\x8a\xce\xb9\x7b\x00\xa6\xbe\xd6\x63\x07\xb8\xf3\x0f\x47\x5d\x95\x2b\xec\x39\x6d\xa1\x3f\x4e\x98\x27\x8e\xf8\xa7\xf4\x08\x2e\x00\x04\x5d\xcc\xa9\x7e\x3f\xf1\xb2\x24\x59\xfc\x08\xce\x7c\xc0\xf3\xcc\xb9\x7f\xee\x8e\x90\x37\xa7\xee\x26\xe1\x8f\x5d\xdc\x17\xee\x39\x3c\xd4\x3f\xb4\xb0\x5c\xaf\xff\xf7\xae\xef\xe4\x65\xed\x08\x57\x7a\x05\x7f\xaf\xe5\x1c\xdc\x7a\x55\xc1\x64\xff\x09\xf6\x5e\x43\xa1\xc7\xce\xa9\x9e\xb0\xc9\xf6\x89\x9a\xe2\xf5\x6b\x7b\x8e\xf0\xfa\xf5\x74\x5b\x9d\xb0\xd8\xb3\x17\xac\xb6\x0a\xad\xf2\x48\x1a\x30\xb7\x72\xc1\x4f\x6d\x7f\xa1\xcc\x1b\xf1\x32\x5b\x3b\x8a\x5d\xc2\xac\x07\x8d\x6e\x31\x86\x85\x57\xa3\xea\x50\x19\x12\xd6\x4e\xb4\xac\xe9\x96\xd2\x14\x4d\xa4\x91\xf4\xa5\x11\xb9\x51\x10\x98\xf5\x65\x37\xa4\x4b\x91\xf2\x3e\xa5\xfa\x68\xca\x38\x17\x2e\x4a\xf5\x51\x8d\xaa\x30\x0b\x58\x85\xca\xd4\x9c\x9f\x60\x1f\xe2\xaf\xc1\x90\x4e\x8b\xdc\x29\x7d\xf2\xcb\x88\xf3\x62\xd2\xe7\x28\xc9\xf2\x6b\x72\x82\xec\x51\xd1\x4a\x55\xc3\xed\xfc\xc0\x90\xfb\x6f\xbb\xe4\xa8\x6a\xba\xdb\xe9\xa9\xb1\xdf\x79\x9d\xab\xf7\xea\x33\x2f\x35\x7e\x85\xe5\x52\xc3\xf5\x24\x84\x9b\xe9\xae\xd0\xd9\xbd\xb5\xe7\x0b\x6b\x7b\xc0\x60\x75\x72\x67\x7c\x63\xda\xd4\x97\x67\x7d\x95\x7f\x83\x61\x69\xe4\x99\xb6\x3a\xd1\x36\x41\x22\x2a\x7f\x66\x45\x2d\xca\xc1\x39\x0d\x4e\x3e\x22\x73\xbf\x31\xe8\xb1\xdb\xfe\x56\x95\x3c\x57\xb7\x46\xe6\xe8\x71\x1e\x1c\xb6\xf3\xa2\xc1\x58\x9b\x4f\xe2\xa0\xbe\x49\x1d\xc3\x07\x43\x01\x59\x0f\x54\x6a\x19\x9b\xd3\xf5\xd5\xec\xbc\xbf\x9a\x91\x31\xa0\xf3\xef\x3d\xea\x57\x50\xc4\xff\x20\x71\xc8\xb5\x21\xe4\x61\x81\xdd\xfb\x67\xe3\xdf\x59\x8d\xf3\x93\x45\xda\xee\xf3\x24\x35\xee\xef\x62\xfa\xcb\x51\x26\x28\xc9\x54\x7e\xc1\x34\xce\xd7\x99\xe6\x11\x11\xca\x36\x7e\xd4\xfc\xb5\x65\xdb\x52\xa5\xbd\x1a\x61\xbf\xc1\x74\x42\x4c\xca\xb9\x34\x0b\x3f\xa7\x2f\x50\x8f\x22\xc6\xc0\x63\x64\xe4\x71\x31\x75\x96\x15\x1b\x14\x0d\x17\xe1\x05\xea\xf3\x30\x99\x44\xd2\x92\x65\xc7\x94\x40\x79\x0c\x7a\xea\xcf\x4f\x3f\x30\xeb\x8c\x79\x9f\xf5\xa6\x3d\xc1\xf2\x0e\x1f\xe8\x13\xee\xf8\xec\xcc\x8f\xd5\xf7\xf1\xeb\x75\x5c\xb8\x97\xd9\x26\xf1\xf3\xad\x02\xfc\xeb\xc7\xee\x43\xdb\x0e\x56\x9a\x85\xc1\x3c\x6f\x0d\x01\xd6\xc5\x7c\xd1\xef\x28\xf4\x8b\xa8\xa4\x99\xda\xfc\x28\x67\xfe\x3c\x0a\xa3\xbf\xcc\x7e\x0f\xdd\x7d\x28\xc4\x17\xdc\x7f\xce\x2a\xe6\xf7\x7b\xe1\x10\x56\x12\xa4\xf1\x51\x9f\x53\x64\x21\x70\x5e\x31\xac\x46\x8e\x20\x94\x3f\xab\xa3\x9d\xca\xe4\x45\x03\x16\x72\x19\x14\x41\xf8\x7c\xd2\x69\x41\x66\x2d\x79\x72\x57\xee\x51\xb3\xb3\x30\x32\x90\xad\xc4\xb8\x29\x75\xe1\x7d\x4b\x7d\x96\xfc\xdf\x14\xcc\x75\x78\x94\xda\x95\xfd\xb9\xe1\x84\xa4\xbe\x01\x1b\x3d\xc8\x7e\x5a\x49\x03\x9b\x02\x95\x2b\xc5\x3c\xa9\xae\x09\xf3\xae\xba\xea\xeb\x31\x1d\x46\xcf\xa2\xdd\xc7\x33\x11\xe5\xde\x44\x44\x57\x4a\x56\xe1\x94\x50\xcd\x25\x21\x6c\x30\x22\xdf\x80\xf3\x72\x89\xa5\xd5\x9d\x90\x44\xf8\x95\x09\x1c\xc1\xf2\xec\x31\xfb\x06\xa5\x24\xfb\x89\xe9\x09\x16\x41\x7e\x63\xa3\xbe\x48\x9d\xa8\xbc\x82\x1a\x52\x19\x7d\x85\xc2\x9e\xfa\xe9\x5b\xc4\x1a\x25\x1d\x11\xec\x59\xf4\x09\x72\x56\xf1\x0e\xa3\x8c\x78\x00\x88\x7b\xc7\xe8\x41\x1d\xe9\x2d\xba\x93\xf2\x40\xcf\xf8\x68\x5d\x22\xa6\x7f\x03\x50\x36\x86\x10\x6d\x43\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
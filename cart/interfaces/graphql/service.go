@@ -3,11 +3,13 @@ package graphql
 import (
 	"context"
 
+	"github.com/lunarforge/flamingo_commerce/cart/application"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
 	"github.com/lunarforge/flamingo_commerce/cart/interfaces/controller/forms"
 	"github.com/lunarforge/flamingo_commerce/cart/interfaces/graphql/dto"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
 	formDomain "flamingo.me/form/domain"
 	"flamingo.me/graphql"
 )
@@ -45,6 +47,7 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_CartDecoratedItem", dto.DecoratedCartItem{})
 	types.Map("Commerce_CartItem", cart.Item{})
 	types.Resolve("Commerce_CartItem", "appliedDiscounts", dto.CartAppliedDiscountsResolver{}, "ForItem")
+	types.Resolve("Commerce_CartItem", "bundleConfiguration", Resolver{}, "BundleConfiguration")
 	types.Map("Commerce_CartAddress", cart.Address{})
 	types.Map("Commerce_CartPerson", cart.Person{})
 	types.Map("Commerce_CartExistingCustomerData", cart.ExistingCustomerData{})
@@ -52,6 +55,7 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_CartAppliedDiscounts", dto.CartAppliedDiscounts{})
 	types.Map("Commerce_CartAppliedDiscount", cart.AppliedDiscount{})
 	types.Map("Commerce_CartAppliedGiftCard", cart.AppliedGiftCard{})
+	types.Map("Commerce_Cart_GiftCardBalance", cart.GiftCardBalance{})
 	types.Map("Commerce_Cart_PricedItems", dto.PricedItems{})
 	types.Map("Commerce_Cart_PricedCartItem", dto.PricedCartItem{})
 	types.Map("Commerce_Cart_PricedShippingItem", dto.PricedShippingItem{})
@@ -65,6 +69,9 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Cart_ValidationResult", validation.Result{})
 	types.Map("Commerce_Cart_ItemValidationError", validation.ItemValidationError{})
 	types.Map("Commerce_Cart_PlacedOrderInfo", placeorder.PlacedOrderInfo{})
+	types.Map("Commerce_Cart_ReorderResult", dto.ReorderResult{})
+	types.Map("Commerce_Cart_ReorderedItem", application.ReorderedItem{})
+	types.Map("Commerce_Cart_NotReorderedItem", application.NotReorderedItem{})
 	types.Map("Commerce_Cart_SelectedPaymentResult", dto.SelectedPaymentResult{})
 	types.Map("Commerce_Cart_PaymentSelection", new(cart.PaymentSelection))
 	types.Map("Commerce_Cart_DefaultPaymentSelection", cart.DefaultPaymentSelection{})
@@ -84,6 +91,8 @@ func (*Service) Types(types *graphql.Types) {
 	types.Resolve("Query", "Commerce_Cart", CommerceCartQueryResolver{}, "CommerceCart")
 	types.Resolve("Query", "Commerce_Cart_Validator", CommerceCartQueryResolver{}, "CommerceCartValidator")
 	types.Resolve("Query", "Commerce_Cart_QtyRestriction", CommerceCartQueryResolver{}, "CommerceCartQtyRestriction")
+	types.Resolve("Query", "Commerce_Cart_CustomerCarts", CommerceCartQueryResolver{}, "CommerceCartCustomerCarts")
+	types.Resolve("Query", "Commerce_GiftCard_Balance", CommerceGiftCardQueryResolver{}, "CommerceGiftCardBalance")
 
 	types.Resolve("Mutation", "Commerce_AddToCart", CommerceCartMutationResolver{}, "CommerceAddToCart")
 	types.Resolve("Mutation", "Commerce_DeleteCartDelivery", CommerceCartMutationResolver{}, "CommerceDeleteCartDelivery")
@@ -98,6 +107,11 @@ func (*Service) Types(types *graphql.Types) {
 	types.Resolve("Mutation", "Commerce_Cart_UpdateDeliveryAddresses", CommerceCartMutationResolver{}, "CommerceCartUpdateDeliveryAddresses")
 	types.Resolve("Mutation", "Commerce_Cart_UpdateDeliveryShippingOptions", CommerceCartMutationResolver{}, "CommerceCartUpdateDeliveryShippingOptions")
 	types.Resolve("Mutation", "Commerce_Cart_Clean", CommerceCartMutationResolver{}, "CartClean")
+	types.Resolve("Mutation", "Commerce_Cart_CreateCustomerCart", CommerceCartMutationResolver{}, "CommerceCartCreateCustomerCart")
+	types.Resolve("Mutation", "Commerce_Cart_DeleteCustomerCart", CommerceCartMutationResolver{}, "CommerceCartDeleteCustomerCart")
+	types.Resolve("Mutation", "Commerce_Cart_SwitchCart", CommerceCartMutationResolver{}, "CommerceCartSwitchCart")
+	types.Resolve("Mutation", "Commerce_Cart_MoveItem", CommerceCartMutationResolver{}, "CommerceCartMoveItem")
+	types.Resolve("Mutation", "Commerce_Cart_AddFromOrder", CommerceCartMutationResolver{}, "CommerceCartAddFromOrder")
 }
 
 // Resolver helper
@@ -107,3 +121,17 @@ type Resolver struct{}
 func (*Resolver) GetDeliveryByCodeWithoutBool(_ context.Context, cart *cart.Cart, code string) (*cart.Delivery, error) {
 	return cart.GetDeliveryByCodeWithoutBool(code), nil
 }
+
+// BundleConfiguration helper, returns the chosen components of a bundle item
+func (*Resolver) BundleConfiguration(_ context.Context, item *cart.Item) ([]*productDomain.BundleComponentSelection, error) {
+	if len(item.BundleConfiguration) == 0 {
+		return nil, nil
+	}
+
+	selections := make([]*productDomain.BundleComponentSelection, len(item.BundleConfiguration))
+	for i := range item.BundleConfiguration {
+		selections[i] = &item.BundleConfiguration[i]
+	}
+
+	return selections, nil
+}
@@ -0,0 +1,44 @@
+package templatefunctions
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/cart/application"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/events"
+)
+
+type (
+	// GetCartMergeResult is exported as a template function
+	GetCartMergeResult struct{}
+
+	// RemoveCartMergeResult is exported as a template function
+	RemoveCartMergeResult struct{}
+)
+
+// Func defines the GetCartMergeResult template function, it returns the result of the last guest cart merge (if any)
+func (g *GetCartMergeResult) Func(ctx context.Context) interface{} {
+	return func() *events.CartMergeResult {
+		session := web.SessionFromContext(ctx)
+
+		if sessionResult, found := session.Load(application.CartMergeResultSessionKey); found {
+			if result, ok := sessionResult.(events.CartMergeResult); ok {
+				return &result
+			}
+		}
+
+		return nil
+	}
+}
+
+// Func defines the RemoveCartMergeResult template function
+func (r *RemoveCartMergeResult) Func(ctx context.Context) interface{} {
+	return func() bool {
+		session := web.SessionFromContext(ctx)
+
+		session.Delete(application.CartMergeResultSessionKey)
+
+		return true
+	}
+}
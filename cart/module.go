@@ -2,6 +2,7 @@ package cart
 
 import (
 	"flamingo.me/dingo"
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/flamingo/v3/framework/web"
 	"flamingo.me/form"
@@ -27,8 +28,14 @@ type (
 	Module struct {
 		routerRegistry                *web.RouterRegistry
 		enableDefaultCartAdapter      bool
+		defaultCartAdapterStorage     string
+		defaultCartAdapterVoucher     string
+		defaultCartAdapterTax         string
+		enableGiftCardLedger          bool
+		giftCardLedgerStorage         string
 		enablePlaceOrderLoggerAdapter bool
 		enableCartCache               bool
+		cartMergeStrategy             string
 	}
 )
 
@@ -36,27 +43,71 @@ type (
 func (m *Module) Inject(
 	routerRegistry *web.RouterRegistry,
 	config *struct {
-		EnableDefaultCartAdapter      bool `inject:"config:commerce.cart.defaultCartAdapter.enabled,optional"`
-		EnableCartCache               bool `inject:"config:commerce.cart.enableCartCache,optional"`
-		EnablePlaceOrderLoggerAdapter bool `inject:"config:commerce.cart.placeOrderLogger.enabled,optional"`
+		EnableDefaultCartAdapter      bool   `inject:"config:commerce.cart.defaultCartAdapter.enabled,optional"`
+		DefaultCartAdapterStorage     string `inject:"config:commerce.cart.defaultCartAdapter.storage,optional"`
+		DefaultCartAdapterVoucher     string `inject:"config:commerce.cart.defaultCartAdapter.voucherHandler,optional"`
+		DefaultCartAdapterTax         string `inject:"config:commerce.cart.defaultCartAdapter.taxCalculator,optional"`
+		EnableGiftCardLedger          bool   `inject:"config:commerce.cart.giftCardLedger.enabled,optional"`
+		GiftCardLedgerStorage         string `inject:"config:commerce.cart.giftCardLedger.storage,optional"`
+		EnableCartCache               bool   `inject:"config:commerce.cart.enableCartCache,optional"`
+		EnablePlaceOrderLoggerAdapter bool   `inject:"config:commerce.cart.placeOrderLogger.enabled,optional"`
+		CartMergeStrategy             string `inject:"config:commerce.cart.mergeStrategy,optional"`
 	},
 ) {
 	m.routerRegistry = routerRegistry
 	if config != nil {
 		m.enableDefaultCartAdapter = config.EnableDefaultCartAdapter
+		m.defaultCartAdapterStorage = config.DefaultCartAdapterStorage
+		m.defaultCartAdapterVoucher = config.DefaultCartAdapterVoucher
+		m.defaultCartAdapterTax = config.DefaultCartAdapterTax
+		m.enableGiftCardLedger = config.EnableGiftCardLedger
+		m.giftCardLedgerStorage = config.GiftCardLedgerStorage
 		m.enableCartCache = config.EnableCartCache
 		m.enablePlaceOrderLoggerAdapter = config.EnablePlaceOrderLoggerAdapter
+		m.cartMergeStrategy = config.CartMergeStrategy
 	}
 }
 
 // Configure module
 func (m *Module) Configure(injector *dingo.Injector) {
 	if m.enableDefaultCartAdapter {
-		injector.Bind((*infrastructure.CartStorage)(nil)).To(infrastructure.InMemoryCartStorage{}).AsEagerSingleton()
+		switch m.defaultCartAdapterStorage {
+		case "sql":
+			injector.Bind(new(infrastructure.SQLCartStorage)).In(dingo.Singleton)
+			injector.Bind((*infrastructure.CartStorage)(nil)).To(new(infrastructure.SQLCartStorage))
+			injector.BindMap(new(healthcheck.Status), "cart.storage.sql").To(new(infrastructure.SQLCartStorage))
+		case "redis":
+			injector.Bind(new(infrastructure.RedisCartStorage)).In(dingo.Singleton)
+			injector.Bind((*infrastructure.CartStorage)(nil)).To(new(infrastructure.RedisCartStorage))
+			injector.BindMap(new(healthcheck.Status), "cart.storage.redis").To(new(infrastructure.RedisCartStorage))
+		default:
+			injector.Bind((*infrastructure.CartStorage)(nil)).To(infrastructure.InMemoryCartStorage{}).AsEagerSingleton()
+		}
 		injector.Bind((*infrastructure.GiftCardHandler)(nil)).To(infrastructure.DefaultGiftCardHandler{})
-		injector.Bind((*infrastructure.VoucherHandler)(nil)).To(infrastructure.DefaultVoucherHandler{})
+		if m.defaultCartAdapterVoucher == "promotion" {
+			injector.Bind(new(infrastructure.PromotionVoucherHandler)).In(dingo.Singleton)
+			injector.Bind((*infrastructure.PromotionUsageStorage)(nil)).To(infrastructure.InMemoryPromotionUsageStorage{}).In(dingo.Singleton)
+			injector.Bind((*infrastructure.VoucherHandler)(nil)).To(new(infrastructure.PromotionVoucherHandler))
+		} else {
+			injector.Bind((*infrastructure.VoucherHandler)(nil)).To(infrastructure.DefaultVoucherHandler{})
+		}
+		if m.defaultCartAdapterTax == "rules" {
+			injector.Bind((*cart.TaxCalculator)(nil)).To(infrastructure.RuleTaxCalculator{})
+		}
 		injector.Bind((*cart.GuestCartService)(nil)).To(infrastructure.DefaultGuestCartService{})
 		injector.Bind((*cart.CustomerCartService)(nil)).To(infrastructure.DefaultCustomerCartService{})
+		injector.Bind((*cart.AbandonedCartFinder)(nil)).To(infrastructure.DefaultAbandonedCartFinder{})
+	}
+	if m.enableGiftCardLedger {
+		switch m.giftCardLedgerStorage {
+		case "sql":
+			injector.Bind(new(infrastructure.SQLGiftCardLedger)).In(dingo.Singleton)
+			injector.Bind((*cart.GiftCardService)(nil)).To(new(infrastructure.SQLGiftCardLedger))
+			injector.BindMap(new(healthcheck.Status), "cart.giftCardLedger.sql").To(new(infrastructure.SQLGiftCardLedger))
+		default:
+			injector.Bind(new(infrastructure.InMemoryGiftCardLedger)).In(dingo.Singleton)
+			injector.Bind((*cart.GiftCardService)(nil)).To(new(infrastructure.InMemoryGiftCardLedger))
+		}
 	}
 	if m.enablePlaceOrderLoggerAdapter {
 		injector.Bind((*placeorder.Service)(nil)).To(placeorderAdapter.PlaceOrderLoggerAdapter{})
@@ -66,6 +117,21 @@ func (m *Module) Configure(injector *dingo.Injector) {
 
 	// Event
 	flamingo.BindEventSubscriber(injector).To(application.EventReceiver{})
+	injector.Bind(new(application.AbandonedCartScanner)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(application.AbandonedCartScanner))
+
+	switch m.cartMergeStrategy {
+	case "keepCustomer":
+		injector.Bind((*application.CartMergeStrategy)(nil)).To(application.CartMergeStrategyKeepCustomer{})
+	case "replace":
+		injector.Bind((*application.CartMergeStrategy)(nil)).To(application.CartMergeStrategyReplace{})
+	case "mergeRestricted":
+		injector.Bind((*application.CartMergeStrategy)(nil)).To(application.CartMergeStrategyMergeRestricted{})
+	case "dedupe":
+		injector.Bind((*application.CartMergeStrategy)(nil)).To(application.CartMergeStrategyDedupe{})
+	default:
+		injector.Bind((*application.CartMergeStrategy)(nil)).To(application.CartMergeStrategyMerge{})
+	}
 
 	// TemplateFunction
 	flamingo.BindTemplateFunc(injector, "getCart", new(templatefunctions.GetCart))
@@ -74,8 +140,11 @@ func (m *Module) Configure(injector *dingo.Injector) {
 	flamingo.BindTemplateFunc(injector, "getQuantityAdjustmentUpdatedItemsMessages", new(templatefunctions.GetQuantityAdjustmentUpdatedItemsMessage))
 	flamingo.BindTemplateFunc(injector, "getQuantityAdjustmentCouponCodesRemoved", new(templatefunctions.GetQuantityAdjustmentCouponCodesRemoved))
 	flamingo.BindTemplateFunc(injector, "removeQuantityAdjustmentMessages", new(templatefunctions.RemoveQuantityAdjustmentMessages))
+	flamingo.BindTemplateFunc(injector, "getCartMergeResult", new(templatefunctions.GetCartMergeResult))
+	flamingo.BindTemplateFunc(injector, "removeCartMergeResult", new(templatefunctions.RemoveCartMergeResult))
 
 	injector.Bind((*cart.DeliveryInfoBuilder)(nil)).To(cart.DefaultDeliveryInfoBuilder{})
+	injector.Bind((*application.CouponPolicy)(nil)).To(application.DefaultCouponPolicy{})
 
 	if m.enableCartCache {
 		injector.Bind((*application.CartCache)(nil)).To(application.CartSessionCache{})
@@ -95,11 +164,68 @@ func (m *Module) Configure(injector *dingo.Injector) {
 func (*Module) CueConfig() string {
 	return `
 commerce: {
+	CartTaxRule :: {
+		countryCode: string | *""
+		regionCode: string | *""
+		taxClass: string | *""
+		taxes: [...{
+			type: string
+			rate: number
+		}]
+	}
+	CartPromotion :: {
+		code: string
+		campaignCode?: string
+		label: string | *""
+		type: "percentageOff" | "fixedOff" | "buyXGetY" | "freeShipping" | "tieredCartDiscount"
+		value: number | *0
+		buyQty: number | *0
+		getQty: number | *0
+		tiers: [...{
+			minimumTotal: number
+			percentage: number
+		}] | *[]
+		conditions: {
+			marketplaceCodes: [...string] | *[]
+			categories: [...string] | *[]
+			attributes: {
+				[string]: string
+			}
+			minimumCartTotal: number | *0
+		}
+		validFrom?: string
+		validTo?: string
+		usageLimit: number | *0
+		sortOrder: number | *0
+	}
 	cart: {
 		defaultCartAdapter: {
 			enabled: bool | *true
-			storage: "inmemory"
+			storage: *"inmemory" | "sql" | "redis"
+			if storage == "sql" {
+				sql: {
+					driver:    string | *"sqlite3"
+					dsn:       string | *"file:carts.db?cache=shared"
+					tableName: string | *"carts"
+					migrate:   bool | *true
+				}
+			}
+			if storage == "redis" {
+				redis: {
+					maxIdle:                     number | *25
+					idleTimeoutMilliseconds:     number | *240000
+					network:                     string | *"tcp"
+					address:                     string | *"localhost:6379"
+					database:                    number | *0
+					keyPrefix:                   string | *"cart:"
+					guestCartIdleTimeoutSeconds: number | *172800
+				}
+			}
 			defaultTaxRate?: number
+			voucherHandler: *"default" | "promotion"
+			promotions: [...CartPromotion] | *[]
+			taxCalculator: *"default" | "rules"
+			taxRules: [...CartTaxRule] | *[]
 		}
 		placeOrderLogger: {
 			enabled: bool | *true
@@ -114,6 +240,39 @@ commerce: {
 		deleteEmptyDelivery: bool | *false
 		showEmptyCartPageIfNoItems?: bool
 		adjustItemsToRestrictedQty?: bool
+		mergeStrategy: *"merge" | "keepCustomer" | "replace" | "mergeRestricted" | "dedupe"
+		couponPolicy: {
+			maxCodes:                 number | *0
+			exclusiveGroups: {
+				[string]: [...string]
+			}
+			onConflict:               *"reject" | "replace"
+			respectDenyMoreDiscounts: bool | *false
+		}
+		abandonedCart: {
+			enabled:                      bool | *false
+			scanIntervalSeconds:          number | *900
+			abandonedAfterSeconds:        number | *86400
+			recoveryTokenSecret:          string | *""
+			recoveryTokenLifetimeSeconds: number | *604800
+		}
+		giftCardLedger: {
+			enabled: bool | *false
+			storage: *"inmemory" | "sql"
+			cards: [...{
+				code:     string
+				amount:   number
+				currency: string
+			}] | *[]
+			if storage == "sql" {
+				sql: {
+					driver:    string | *"sqlite3"
+					dsn:       string | *"file:giftcards.db?cache=shared"
+					tableName: string | *"gift_cards"
+					migrate:   bool | *true
+				}
+			}
+		}
 		personalDataForm: {
 			additionalFormFields: [...string] | *[]
 			dateOfBirthRequired: bool | *false
@@ -180,6 +339,9 @@ func (r *routes) Routes(registry *web.RouterRegistry) {
 
 	registry.HandleAny("cart.deleteItem", r.viewController.DeleteAndViewAction)
 	registry.MustRoute("/cart/delete/:id", `cart.deleteItem(id,deliveryCode?="")`)
+
+	registry.HandleGet("cart.recover", r.viewController.RecoverAction)
+	registry.MustRoute("/cart/recover/:token", `cart.recover(token)`)
 	r.apiRoutes(registry)
 }
 
@@ -207,6 +369,22 @@ func (r *routes) apiRoutes(registry *web.RouterRegistry) {
 	registry.HandleDelete("cart.api.item", r.apiController.DeleteItemAction)
 	registry.HandlePut("cart.api.item", r.apiController.UpdateItemAction)
 
+	registry.MustRoute("/api/v1/cart/delivery/:deliveryCode/item/move", `cart.api.item.move(itemID,targetCartID,deliveryCode?="")`)
+	registry.HandlePut("cart.api.item.move", r.apiController.MoveItemAction)
+
+	registry.MustRoute("/api/v1/cart/carts", `cart.api.carts(name?="")`)
+	registry.HandleGet("cart.api.carts", r.apiController.ListCartsAction)
+	registry.HandlePost("cart.api.carts", r.apiController.CreateCartAction)
+
+	registry.MustRoute("/api/v1/cart/carts/:cartID", `cart.api.carts.cart`)
+	registry.HandleDelete("cart.api.carts.cart", r.apiController.DeleteNamedCartAction)
+
+	registry.MustRoute("/api/v1/cart/carts/:cartID/activate", `cart.api.carts.activate`)
+	registry.HandlePut("cart.api.carts.activate", r.apiController.SwitchCartAction)
+
+	registry.MustRoute("/api/v1/cart/reorder/:orderID", `cart.api.reorder`)
+	registry.HandlePost("cart.api.reorder", r.apiController.AddFromOrderAction)
+
 	registry.MustRoute("/api/v1/cart/voucher", `cart.api.voucher(couponCode)`)
 	registry.HandlePost("cart.api.voucher", r.apiController.ApplyVoucherAndGetAction)
 	registry.HandleDelete("cart.api.voucher", r.apiController.RemoveVoucherAndGetAction)
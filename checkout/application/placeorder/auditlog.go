@@ -0,0 +1,63 @@
+package placeorder
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+type (
+	// AuditLog records every state transition of place order processes in the bound history store
+	AuditLog struct {
+		store  process.HistoryStore
+		logger flamingo.Logger
+	}
+)
+
+var (
+	// ErrAuditLogDisabled if no history store is bound
+	ErrAuditLogDisabled = errors.New("place order audit log is disabled")
+
+	// ErrHistoryNotAccessible if the history of a process is requested that doesn't belong to the current session
+	ErrHistoryNotAccessible = errors.New("place order history is not accessible")
+)
+
+// Inject dependencies
+func (a *AuditLog) Inject(
+	logger flamingo.Logger,
+	optionals *struct {
+		Store process.HistoryStore `inject:",optional"`
+	},
+) *AuditLog {
+	a.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "placeorder.auditlog")
+	if optionals != nil {
+		a.store = optionals.Store
+	}
+
+	return a
+}
+
+// Notify appends state changes of place order processes to the history
+func (a *AuditLog) Notify(ctx context.Context, event flamingo.Event) {
+	stateChanged, ok := event.(*process.PlaceOrderStateChangedEvent)
+	if !ok || a.store == nil {
+		return
+	}
+
+	// the transition already happened, a failing audit log must not interrupt the process
+	if err := a.store.Append(ctx, process.NewHistoryEntry(stateChanged)); err != nil {
+		a.logger.WithContext(ctx).Error("state change of place order process ", stateChanged.UUID, " not recorded: ", err)
+	}
+}
+
+// History returns the recorded state transitions of the place order process in chronological order
+func (a *AuditLog) History(ctx context.Context, uuid string) ([]process.HistoryEntry, error) {
+	if a.store == nil {
+		return nil, ErrAuditLogDisabled
+	}
+
+	return a.store.History(ctx, uuid)
+}
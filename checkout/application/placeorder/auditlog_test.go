@@ -0,0 +1,46 @@
+package placeorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/historystore"
+)
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled without history store", func(t *testing.T) {
+		auditLog := new(placeorder.AuditLog).Inject(flamingo.NullLogger{}, nil)
+		auditLog.Notify(ctx, &process.PlaceOrderStateChangedEvent{UUID: "uuid"})
+
+		_, err := auditLog.History(ctx, "uuid")
+		assert.Equal(t, placeorder.ErrAuditLogDisabled, err)
+	})
+
+	t.Run("records state changes", func(t *testing.T) {
+		auditLog := new(placeorder.AuditLog).Inject(flamingo.NullLogger{}, &struct {
+			Store process.HistoryStore `inject:",optional"`
+		}{Store: new(historystore.Memory).Inject(nil)})
+
+		now := time.Now()
+		auditLog.Notify(ctx, &flamingo.ServerStartEvent{})
+		auditLog.Notify(ctx, &process.PlaceOrderStateChangedEvent{UUID: "uuid", CartID: "cart", FromState: "New", ToState: "CreatePayment", Time: now})
+		auditLog.Notify(ctx, &process.PlaceOrderStateChangedEvent{UUID: "uuid", CartID: "cart", FromState: "CreatePayment", ToState: "Failed", FailedReason: process.ErrorOccurredReason{Error: "error"}, Time: now})
+		auditLog.Notify(ctx, &process.PlaceOrderStateChangedEvent{UUID: "other", FromState: "New", ToState: "CreatePayment", Time: now})
+
+		history, err := auditLog.History(ctx, "uuid")
+		require.NoError(t, err)
+		assert.Equal(t, []process.HistoryEntry{
+			{UUID: "uuid", CartID: "cart", FromState: "New", ToState: "CreatePayment", Time: now},
+			{UUID: "uuid", CartID: "cart", FromState: "CreatePayment", ToState: "Failed", FailedReason: "error", Time: now},
+		}, history)
+	})
+}
@@ -20,4 +20,10 @@ type (
 	// CancelPlaceOrderCommand cancels current running process
 	CancelPlaceOrderCommand struct {
 	}
+
+	// NotifyPlaceOrderCommand proceeds in the place order process with the given uuid, e.g. after a payment notification
+	NotifyPlaceOrderCommand struct {
+		// CorrelationID is the uuid of the place order process which is passed to the payment gateway
+		CorrelationID string
+	}
 )
@@ -63,6 +63,8 @@ var (
 	ErrNoPlaceOrderProcess = errors.New("ErrNoPlaceOrderProcess")
 	// ErrAnotherPlaceOrderProcessRunning if a process runs
 	ErrAnotherPlaceOrderProcessRunning = errors.New("ErrAnotherPlaceOrderProcessRunning")
+	// ErrNoUUIDContextStore if the bound context store can't load processes by their uuid
+	ErrNoUUIDContextStore = errors.New("context store does not support loading processes by uuid")
 
 	maxLockDuration = 2 * time.Minute
 
@@ -152,6 +154,7 @@ func (c *Coordinator) New(ctx context.Context, cart cartDomain.Cart, returnURL *
 			c.logger.Error(err)
 			return
 		}
+		newProcess.Started(ctx)
 
 		c.Run(ctx)
 	})
@@ -220,32 +223,23 @@ func (c *Coordinator) Cancel(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/Cancel")
 	defer span.End()
 
-	var returnErr error
-	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
-		{
-			// scope things here to avoid using old process later
-			p, err := c.LastProcess(ctx)
-			if err != nil {
-				returnErr = err
-				return
-			}
-			var unlock Unlock
-			err = ErrLockTaken
-			for err == ErrLockTaken {
-				unlock, err = c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
-				// todo: add proper throttling
-
-				time.Sleep(waitForLockThrottle)
-			}
-			if err != nil {
-				returnErr = err
-				return
-			}
-			defer func() {
-				_ = unlock()
-			}()
+	{
+		// scope things here to avoid using old process later, the lock is awaited as long as the caller waits
+		p, err := c.LastProcess(ctx)
+		if err != nil {
+			return err
+		}
+		unlock, err := c.waitForLock(ctx, p)
+		if err != nil {
+			return err
 		}
+		defer func() {
+			_ = unlock()
+		}()
+	}
 
+	var returnErr error
+	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
 		// lock acquired get fresh process state
 		p, err := c.LastProcess(ctx)
 		if err != nil {
@@ -333,15 +327,27 @@ func (c *Coordinator) Run(ctx context.Context) {
 }
 
 func (c *Coordinator) proceedInStateMachineUntilNoStateChange(ctx context.Context, p *process.Process) error {
+	return proceedUntilNoStateChange(ctx, p, func(ctx context.Context, pctx process.Context) error {
+		err := c.storeProcessContext(ctx, pctx)
+		if err != nil {
+			return err
+		}
+		c.forceSessionUpdate(ctx)
+
+		return nil
+	})
+}
+
+// proceedUntilNoStateChange runs the process until its state doesn't change anymore, the context is stored after every run.
+// The process fails if it still changes its state after maxRunCount runs.
+func proceedUntilNoStateChange(ctx context.Context, p *process.Process, store func(context.Context, process.Context) error) error {
 	stateBeforeRun := p.Context().CurrentStateName
 	for i := 0; i < maxRunCount; i++ {
-
 		p.Run(ctx)
-		err := c.storeProcessContext(ctx, p.Context())
+		err := store(ctx, p.Context())
 		if err != nil {
 			return err
 		}
-		c.forceSessionUpdate(ctx)
 		stateAfterRun := p.Context().CurrentStateName
 		if stateBeforeRun == stateAfterRun {
 			return nil
@@ -361,34 +367,26 @@ func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error)
 	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/RunBlocking")
 	defer span.End()
 
-	var pctx *process.Context
-	var returnErr error
-	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
-		{
-			// scope things here to avoid continuing with an old process state
-			p, err := c.LastProcess(ctx)
-			if err != nil {
-				returnErr = err
-				return
-			}
-
-			var unlock Unlock
-			err = ErrLockTaken
-			for err == ErrLockTaken {
-				unlock, err = c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
-				// todo: add proper throttling
-				time.Sleep(waitForLockThrottle)
-			}
-			if err != nil {
-				returnErr = err
-				return
-			}
+	{
+		// scope things here to avoid continuing with an old process state, the lock is awaited as long as the caller waits
+		p, err := c.LastProcess(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-			defer func() {
-				_ = unlock()
-			}()
+		unlock, err := c.waitForLock(ctx, p)
+		if err != nil {
+			return nil, err
 		}
 
+		defer func() {
+			_ = unlock()
+		}()
+	}
+
+	var pctx *process.Context
+	var returnErr error
+	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
 		// lock acquired fetch everything new
 		has, err := c.HasUnfinishedProcess(ctx)
 		if err != nil {
@@ -429,6 +427,99 @@ func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error)
 	return pctx, returnErr
 }
 
+// RunBlockingByUUID runs the process with the given uuid server side, e.g. when a payment gateway notifies about a payment.
+// The process is loaded independent of the current web session, it proceeds under the process lock until no further
+// state change is possible (e.g. a final state is reached) and the resulting context is returned.
+func (c *Coordinator) RunBlockingByUUID(ctx context.Context, uuid string) (*process.Context, error) {
+	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/RunBlockingByUUID")
+	defer span.End()
+
+	uuidContextStore, ok := c.contextStore.(process.UUIDContextStore)
+	if !ok {
+		return nil, ErrNoUUIDContextStore
+	}
+
+	key, poContext, found := uuidContextStore.GetByUUID(ctx, uuid)
+	if !found {
+		return nil, ErrNoPlaceOrderProcess
+	}
+
+	p, err := c.processFactory.NewFromProcessContext(poContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// the lock is awaited as long as the caller waits, e.g. until the gateway gives up on the notification
+	unlock, err := c.waitForLock(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = unlock()
+	}()
+
+	var pctx *process.Context
+	var returnErr error
+	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
+		// the context store key is the id of the session which started the process, the states and the cart need it
+		session, err := c.sessionStore.LoadByID(ctx, key)
+		if err != nil {
+			returnErr = err
+			return
+		}
+
+		ctx = web.ContextWithSession(ctx, session)
+		ctx = web.ContextWithRequest(ctx, web.CreateRequest(nil, session))
+
+		// lock acquired get fresh process state
+		p, err = c.LastProcess(ctx)
+		if err != nil {
+			returnErr = err
+			return
+		}
+
+		if p.Context().UUID != uuid {
+			returnErr = ErrNoPlaceOrderProcess
+			return
+		}
+
+		currentState, err := p.CurrentState()
+		if err != nil {
+			returnErr = err
+			return
+		}
+
+		if !currentState.IsFinal() {
+			err = c.proceedInStateMachineUntilNoStateChange(ctx, p)
+			if err != nil {
+				returnErr = err
+				return
+			}
+		}
+
+		runPctx := p.Context()
+		pctx = &runPctx
+	})
+
+	return pctx, returnErr
+}
+
+// waitForLock blocks until the lock of the process is acquired or the context is done
+func (c *Coordinator) waitForLock(ctx context.Context, p *process.Process) (Unlock, error) {
+	for {
+		unlock, err := c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
+		if err != ErrLockTaken {
+			return unlock, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(waitForLockThrottle):
+		}
+	}
+}
+
 func (c *Coordinator) forceSessionUpdate(ctx context.Context) {
 	session := web.SessionFromContext(ctx)
 	_, err := c.sessionStore.Save(ctx, session)
@@ -0,0 +1,105 @@
+package placeorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/contextstore"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/locker"
+)
+
+type (
+	// keyOnlyContextStore hides the uuid lookup of the wrapped store
+	keyOnlyContextStore struct {
+		process.ContextStore
+	}
+)
+
+func newCoordinator(store process.ContextStore, tryLocker placeorder.TryLocker) *placeorder.Coordinator {
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(
+				map[string]process.State{
+					states.New{}.Name():     &states.New{},
+					states.Success{}.Name(): states.Success{},
+					states.Failed{}.Name():  states.Failed{},
+				},
+				flamingo.NullLogger{},
+				nil,
+			)
+		},
+		&struct {
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
+		}{
+			StartState:  &states.New{},
+			FailedState: &states.Failed{},
+		},
+	)
+
+	coordinator := new(placeorder.Coordinator)
+	coordinator.Inject(tryLocker, flamingo.NullLogger{}, factory, store, nil, nil, nil)
+
+	return coordinator
+}
+
+func TestCoordinator_RunBlockingByUUID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := new(contextstore.Memory).Inject()
+	require.NoError(t, store.Store(ctx, "session", process.Context{
+		UUID:             "process",
+		CurrentStateName: states.New{}.Name(),
+		Cart:             cartDomain.Cart{ID: "cart"},
+	}))
+
+	t.Run("context store without uuid lookup", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newCoordinator(keyOnlyContextStore{store}, locker.NewMemory()).RunBlockingByUUID(ctx, "process")
+		assert.Equal(t, placeorder.ErrNoUUIDContextStore, err)
+	})
+
+	t.Run("unknown process", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newCoordinator(store, locker.NewMemory()).RunBlockingByUUID(ctx, "unknown")
+		assert.Equal(t, placeorder.ErrNoPlaceOrderProcess, err)
+	})
+
+	t.Run("waiting for the lock stops when the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		tryLocker := locker.NewMemory()
+		unlock, err := tryLocker.TryLock(ctx, "checkout_placeorder_lock_cart", time.Minute)
+		require.NoError(t, err)
+		defer func() {
+			_ = unlock()
+		}()
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err = newCoordinator(store, tryLocker).RunBlockingByUUID(timeoutCtx, "process")
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.True(t, time.Since(start) < 5*time.Second)
+
+		pctx, found := store.Get(ctx, "session")
+		require.True(t, found)
+		assert.Equal(t, states.New{}.Name(), pctx.CurrentStateName, "the process must not proceed without the lock")
+	})
+}
@@ -61,6 +61,11 @@ func (h *Handler) RefreshPlaceOrderBlocking(ctx context.Context, _ RefreshPlaceO
 	return h.coordinator.RunBlocking(ctx)
 }
 
+// NotifyPlaceOrder handles NotifyPlaceOrder command, the process is run server side independent of the web session (blocking)
+func (h *Handler) NotifyPlaceOrder(ctx context.Context, command NotifyPlaceOrderCommand) (*process.Context, error) {
+	return h.coordinator.RunBlockingByUUID(ctx, command.CorrelationID)
+}
+
 // HasUnfinishedProcess checks for processes not in final state
 func (h *Handler) HasUnfinishedProcess(ctx context.Context) (bool, error) {
 	return h.coordinator.HasUnfinishedProcess(ctx)
@@ -0,0 +1,345 @@
+package placeorder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+	"github.com/lunarforge/flamingo_commerce/payment/application"
+	paymentDomain "github.com/lunarforge/flamingo_commerce/payment/domain"
+)
+
+type (
+	// StaleProcessSweeper periodically looks for place order processes that did not reach a final state within the
+	// configured period. Processes with an approved or completed payment are finished, all others are failed which
+	// rolls back their states (e.g. cancels the placed order and the payment).
+	StaleProcessSweeper struct {
+		contextStore   process.ContextStore
+		processFactory *process.Factory
+		paymentService *application.PaymentService
+		locker         TryLocker
+		sessionStore   *web.SessionStore
+		logger         flamingo.Logger
+		enabled        bool
+		sweepInterval  time.Duration
+		staleAfter     time.Duration
+		area           string
+
+		mutex sync.Mutex
+		stop  chan struct{}
+	}
+
+	// SweepResult counts the outcomes of a sweep
+	SweepResult struct {
+		// Succeeded processes have been finished successfully as their payment was done
+		Succeeded int
+		// Failed processes have been rolled back
+		Failed int
+		// Errors occurred for processes that are retried with the next sweep, e.g. if the gateway was not reachable
+		Errors int
+	}
+)
+
+const (
+	sweepOutcomeSucceeded = "succeeded"
+	sweepOutcomeFailed    = "failed"
+	sweepOutcomeError     = "error"
+)
+
+var (
+	// sweptProcesses counts the stale processes handled by the sweeper
+	sweptProcesses = stats.Int64("flamingo-commerce/checkout/placeorder/swept", "Counts the stale place order processes handled by the sweeper", stats.UnitDimensionless)
+	keyOutcome, _  = tag.NewKey("outcome")
+)
+
+func init() {
+	err := opencensus.View("flamingo-commerce/checkout/placeorder/swept", sweptProcesses, view.Count(), keyOutcome)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Inject dependencies
+func (s *StaleProcessSweeper) Inject(
+	contextStore process.ContextStore,
+	processFactory *process.Factory,
+	paymentService *application.PaymentService,
+	locker TryLocker,
+	sessionStore *web.SessionStore,
+	logger flamingo.Logger,
+	cfg *struct {
+		Enabled           bool   `inject:"config:commerce.checkout.placeorder.sweeper.enabled"`
+		IntervalSeconds   int    `inject:"config:commerce.checkout.placeorder.sweeper.intervalSeconds"`
+		StaleAfterSeconds int    `inject:"config:commerce.checkout.placeorder.sweeper.staleAfterSeconds"`
+		Area              string `inject:"config:area"`
+	},
+) *StaleProcessSweeper {
+	s.contextStore = contextStore
+	s.processFactory = processFactory
+	s.paymentService = paymentService
+	s.locker = locker
+	s.sessionStore = sessionStore
+	s.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "placeorder.sweeper")
+	if cfg != nil {
+		s.enabled = cfg.Enabled
+		s.sweepInterval = time.Duration(cfg.IntervalSeconds) * time.Second
+		s.staleAfter = time.Duration(cfg.StaleAfterSeconds) * time.Second
+		s.area = cfg.Area
+	}
+
+	return s
+}
+
+// Notify starts the periodic sweep with the server and stops it on shutdown
+func (s *StaleProcessSweeper) Notify(ctx context.Context, event flamingo.Event) {
+	switch event.(type) {
+	case *flamingo.ServerStartEvent:
+		s.start()
+	case *flamingo.ServerShutdownEvent:
+		s.shutdown()
+	}
+}
+
+func (s *StaleProcessSweeper) start() {
+	if !s.enabled {
+		return
+	}
+
+	if _, ok := s.contextStore.(process.ListableContextStore); !ok {
+		s.logger.Warn("place order sweeper enabled but the context store can't list its contexts")
+		return
+	}
+
+	if s.sweepInterval <= 0 {
+		s.logger.Warn("place order sweeper enabled but intervalSeconds is not positive")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(s.sweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				_, err := s.Sweep(context.Background(), now)
+				if err != nil {
+					s.logger.Error("place order sweep failed: ", err)
+				}
+			}
+		}
+	}(s.stop)
+}
+
+func (s *StaleProcessSweeper) shutdown() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// Sweep handles all stored processes that are not final and older than the configured period.
+// Processes that are currently locked are running and therefore skipped.
+func (s *StaleProcessSweeper) Sweep(ctx context.Context, now time.Time) (SweepResult, error) {
+	result := SweepResult{}
+
+	listableStore, ok := s.contextStore.(process.ListableContextStore)
+	if !ok {
+		return result, nil
+	}
+
+	keys, err := listableStore.Keys(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	censusCtx, _ := tag.New(ctx, tag.Upsert(opencensus.KeyArea, s.area))
+	for _, key := range keys {
+		outcome := s.sweepProcess(ctx, key, now)
+		switch outcome {
+		case "":
+			continue
+		case sweepOutcomeSucceeded:
+			result.Succeeded++
+		case sweepOutcomeFailed:
+			result.Failed++
+		case sweepOutcomeError:
+			result.Errors++
+		}
+
+		outcomeCtx, _ := tag.New(censusCtx, tag.Upsert(keyOutcome, outcome))
+		stats.Record(outcomeCtx, sweptProcesses.M(1))
+	}
+
+	return result, nil
+}
+
+// sweepProcess handles the process stored with the key and returns the outcome, empty if the process is not stale
+func (s *StaleProcessSweeper) sweepProcess(ctx context.Context, key string, now time.Time) string {
+	p := s.staleProcess(ctx, key, now)
+	if p == nil {
+		return ""
+	}
+
+	unlock, err := s.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
+	if err != nil {
+		// the process is running right now
+		return ""
+	}
+	defer func() {
+		_ = unlock()
+	}()
+
+	// lock acquired get fresh process state
+	uuid := p.Context().UUID
+	p = s.staleProcess(ctx, key, now)
+	if p == nil || p.Context().UUID != uuid {
+		return ""
+	}
+
+	ctx, session := s.sessionContext(ctx, key)
+
+	outcome := s.finish(ctx, key, p)
+
+	err = s.contextStore.Store(ctx, key, p.Context())
+	if err != nil {
+		s.logger.WithContext(ctx).Error("storing swept process failed: ", err)
+		return sweepOutcomeError
+	}
+
+	if session != nil {
+		_, err = s.sessionStore.Save(ctx, session)
+		if err != nil {
+			s.logger.WithContext(ctx).Error(err)
+		}
+	}
+
+	return outcome
+}
+
+// staleProcess returns the stored process if it is not final and older than the configured period.
+// Processes without creation time (stored before the field was introduced) are skipped as their age is unknown.
+func (s *StaleProcessSweeper) staleProcess(ctx context.Context, key string, now time.Time) *process.Process {
+	pctx, found := s.contextStore.Get(ctx, key)
+	if !found || pctx.CreationTime.IsZero() || now.Sub(pctx.CreationTime) < s.staleAfter {
+		return nil
+	}
+
+	p, err := s.processFactory.NewFromProcessContext(pctx)
+	if err != nil {
+		return nil
+	}
+
+	currentState, err := p.CurrentState()
+	if err != nil || currentState.IsFinal() {
+		return nil
+	}
+
+	return p
+}
+
+// sessionContext adds the session which started the process to the context, the session is nil if it doesn't exist anymore
+func (s *StaleProcessSweeper) sessionContext(ctx context.Context, key string) (context.Context, *web.Session) {
+	var session *web.Session
+	if s.sessionStore != nil {
+		loaded, err := s.sessionStore.LoadByID(ctx, key)
+		if err == nil {
+			session = loaded
+		}
+	}
+
+	ctxSession := session
+	if ctxSession == nil {
+		ctxSession = web.EmptySession()
+	}
+
+	ctx = web.ContextWithSession(ctx, ctxSession)
+	ctx = web.ContextWithRequest(ctx, web.CreateRequest(nil, ctxSession))
+
+	return ctx, session
+}
+
+// finish asks the gateway for the payment status and proceeds the process to success for done payments or fails it for
+// finally failed payments, pending payments (e.g. waiting for the customer in a 3-D Secure redirect) are retried with the next sweep
+func (s *StaleProcessSweeper) finish(ctx context.Context, key string, p *process.Process) string {
+	if !hasPayment(p.Context()) {
+		p.Failed(ctx, process.ExpiredReason{})
+		return sweepOutcomeFailed
+	}
+
+	cart := p.Context().Cart
+	gateway, err := s.paymentService.PaymentGatewayByCart(cart)
+	if err != nil {
+		p.Failed(ctx, process.PaymentErrorOccurredReason{Error: err.Error()})
+		return sweepOutcomeFailed
+	}
+
+	flowStatus, err := gateway.FlowStatus(ctx, &cart, p.Context().UUID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("flow status of stale process unavailable: ", err)
+		return sweepOutcomeError
+	}
+
+	switch flowStatus.Status {
+	case paymentDomain.PaymentFlowStatusApproved, paymentDomain.PaymentFlowStatusCompleted:
+		// the payment is done, let the states complete it and finish the process like the coordinator does
+		err = proceedUntilNoStateChange(ctx, p, func(ctx context.Context, pctx process.Context) error {
+			return s.contextStore.Store(ctx, key, pctx)
+		})
+		if err != nil {
+			s.logger.WithContext(ctx).Error("storing swept process failed: ", err)
+			return sweepOutcomeError
+		}
+	case paymentDomain.PaymentFlowStatusFailed, paymentDomain.PaymentFlowStatusCancelled, paymentDomain.PaymentFlowStatusAborted:
+		p.Failed(ctx, process.ExpiredReason{PaymentStatus: flowStatus.Status})
+		return sweepOutcomeFailed
+	default:
+		s.logger.WithContext(ctx).Warn("stale process not finished, payment flow status is not final: ", flowStatus.Status)
+		return sweepOutcomeError
+	}
+
+	currentState, err := p.CurrentState()
+	if err != nil || p.Context().FailedReason != nil {
+		return sweepOutcomeFailed
+	}
+
+	if !currentState.IsFinal() {
+		return sweepOutcomeError
+	}
+
+	return sweepOutcomeSucceeded
+}
+
+// hasPayment checks if the process created a payment at the gateway
+func hasPayment(pctx process.Context) bool {
+	for _, reference := range pctx.RollbackReferences {
+		if _, ok := reference.Data.(states.CreatePaymentRollbackData); ok {
+			return true
+		}
+	}
+
+	return false
+}
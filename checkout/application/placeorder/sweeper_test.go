@@ -0,0 +1,110 @@
+package placeorder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/contextstore"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/locker"
+	"github.com/lunarforge/flamingo_commerce/payment/domain"
+	"github.com/lunarforge/flamingo_commerce/payment/interfaces/mocks"
+)
+
+func TestStaleProcessSweeper_Sweep(t *testing.T) {
+	now := time.Now()
+	paymentReference := []process.RollbackReference{{StateName: states.CreatePayment{}.Name(), Data: states.CreatePaymentRollbackData{PaymentID: "payment", Gateway: "test"}}}
+
+	gateway := &mocks.WebCartPaymentGateway{}
+	gateway.On("FlowStatus", mock.Anything, mock.Anything, "completed").Return(&domain.FlowStatus{Status: domain.PaymentFlowStatusCompleted}, nil)
+	gateway.On("FlowStatus", mock.Anything, mock.Anything, "unapproved").Return(&domain.FlowStatus{Status: domain.PaymentFlowStatusUnapproved}, nil)
+	gateway.On("FlowStatus", mock.Anything, mock.Anything, "failed").Return(&domain.FlowStatus{Status: domain.PaymentFlowStatusFailed}, nil)
+	gateway.On("CancelOrderPayment", mock.Anything, mock.Anything).Return(nil)
+	paymentService := paymentServiceHelper(t, gateway)
+
+	waitForCustomer := new(states.WaitForCustomer).Inject(paymentService, placeorder.PaymentValidator)
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(
+				map[string]process.State{
+					waitForCustomer.Name():        *waitForCustomer,
+					states.Success{}.Name():       states.Success{},
+					states.Failed{}.Name():        states.Failed{},
+					states.CreatePayment{}.Name(): new(states.CreatePayment).Inject(paymentService, nil),
+				},
+				flamingo.NullLogger{},
+				nil,
+			)
+		},
+		&struct {
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
+		}{
+			StartState:  &states.New{},
+			FailedState: &states.Failed{},
+		},
+	)
+
+	ctx := context.Background()
+	store := new(contextstore.Memory).Inject()
+	cart := provideCartWithPaymentSelection(t)
+	stored := map[string]process.Context{
+		"fresh":       {UUID: "fresh", CurrentStateName: waitForCustomer.Name(), CreationTime: now.Add(-time.Minute), Cart: cart},
+		"final":       {UUID: "final", CurrentStateName: states.Success{}.Name(), CreationTime: now.Add(-2 * time.Hour), Cart: cart},
+		"no-payment":  {UUID: "no-payment", CurrentStateName: waitForCustomer.Name(), CreationTime: now.Add(-2 * time.Hour), Cart: cart},
+		"completed":   {UUID: "completed", CurrentStateName: waitForCustomer.Name(), CreationTime: now.Add(-2 * time.Hour), Cart: cart, RollbackReferences: paymentReference},
+		"unapproved":  {UUID: "unapproved", CurrentStateName: waitForCustomer.Name(), CreationTime: now.Add(-2 * time.Hour), Cart: cart, RollbackReferences: paymentReference},
+		"failed":      {UUID: "failed", CurrentStateName: waitForCustomer.Name(), CreationTime: now.Add(-2 * time.Hour), Cart: cart, RollbackReferences: paymentReference},
+		"no-creation": {UUID: "no-creation", CurrentStateName: waitForCustomer.Name(), Cart: cart},
+	}
+	for key, pctx := range stored {
+		require.NoError(t, store.Store(ctx, key, pctx))
+	}
+
+	sweeper := new(placeorder.StaleProcessSweeper).Inject(
+		store,
+		factory,
+		paymentService,
+		locker.NewMemory(),
+		nil,
+		flamingo.NullLogger{},
+		&struct {
+			Enabled           bool   `inject:"config:commerce.checkout.placeorder.sweeper.enabled"`
+			IntervalSeconds   int    `inject:"config:commerce.checkout.placeorder.sweeper.intervalSeconds"`
+			StaleAfterSeconds int    `inject:"config:commerce.checkout.placeorder.sweeper.staleAfterSeconds"`
+			Area              string `inject:"config:area"`
+		}{Enabled: true, IntervalSeconds: 60, StaleAfterSeconds: 3600},
+	)
+
+	result, err := sweeper.Sweep(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, placeorder.SweepResult{Succeeded: 1, Failed: 2, Errors: 1}, result)
+
+	stateOf := func(key string) process.Context {
+		pctx, found := store.Get(ctx, key)
+		require.True(t, found)
+		return pctx
+	}
+
+	assert.Equal(t, waitForCustomer.Name(), stateOf("fresh").CurrentStateName)
+	assert.Equal(t, states.Success{}.Name(), stateOf("final").CurrentStateName)
+	assert.Equal(t, states.Success{}.Name(), stateOf("completed").CurrentStateName)
+	assert.Equal(t, process.ExpiredReason{}, stateOf("no-payment").FailedReason)
+	assert.Equal(t, waitForCustomer.Name(), stateOf("no-creation").CurrentStateName, "processes of unknown age are skipped")
+	assert.Equal(t, waitForCustomer.Name(), stateOf("unapproved").CurrentStateName, "pending payments are retried")
+	assert.Nil(t, stateOf("unapproved").FailedReason)
+	assert.Equal(t, states.Failed{}.Name(), stateOf("failed").CurrentStateName)
+	assert.Equal(t, process.ExpiredReason{PaymentStatus: domain.PaymentFlowStatusFailed}, stateOf("failed").FailedReason)
+	gateway.AssertCalled(t, "CancelOrderPayment", mock.Anything, mock.Anything)
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"testing"
 
+	"flamingo.me/flamingo/v3/framework/flamingo"
 	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
@@ -29,8 +30,10 @@ func provideProcessFactory(t *testing.T) *process.Factory {
 			return &process.Process{}
 		},
 		&struct {
-			StartState  process.State `inject:"startState"`
-			FailedState process.State `inject:"failedState"`
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
 		}{
 			StartState:  &states.New{},
 			FailedState: &states.Failed{},
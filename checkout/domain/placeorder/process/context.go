@@ -3,6 +3,7 @@ package process
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 	"github.com/lunarforge/flamingo_commerce/checkout/application"
@@ -19,6 +20,12 @@ type (
 		ReturnURL          *url.URL
 		RollbackReferences []RollbackReference
 		FailedReason       FailedReason
+		// CreationTime of the process, zero for processes started before it was recorded
+		CreationTime time.Time
+		// ResumeStateName is the state requested by the previous state while an inserted state runs, see Process.Resume
+		ResumeStateName string
+		// ResumeStateData is the state data for the ResumeStateName
+		ResumeStateData StateData
 	}
 	// StateData holding state relevant data
 	StateData interface{}
@@ -29,4 +36,19 @@ type (
 		Get(ctx context.Context, key string) (Context, bool)
 		Delete(ctx context.Context, key string) error
 	}
+
+	// UUIDContextStore is an optional extension of the ContextStore to find a stored Context by the process UUID,
+	// the UUID is the correlation id passed to the payment gateway and allows loading the Context without the web session
+	UUIDContextStore interface {
+		ContextStore
+		// GetByUUID returns the key and the stored Context of the process with the given UUID
+		GetByUUID(ctx context.Context, uuid string) (string, Context, bool)
+	}
+
+	// ListableContextStore is an optional extension of the ContextStore to enumerate all stored contexts, e.g. to find stale processes
+	ListableContextStore interface {
+		ContextStore
+		// Keys returns the keys of all stored contexts
+		Keys(ctx context.Context) ([]string, error)
+	}
 )
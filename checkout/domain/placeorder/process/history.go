@@ -0,0 +1,55 @@
+package process
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// PlaceOrderStateChangedEvent is dispatched after the state of a place order process changed
+	PlaceOrderStateChangedEvent struct {
+		UUID      string
+		FromState string
+		ToState   string
+		// FailedReason is set if the process switched to the failed state
+		FailedReason FailedReason
+		CartID       string
+		Time         time.Time
+	}
+
+	// HistoryEntry is a state transition of a place order process recorded by the audit log
+	HistoryEntry struct {
+		UUID      string
+		CartID    string
+		FromState string
+		ToState   string
+		// FailedReason is the human readable reason if the process switched to the failed state
+		FailedReason string
+		Time         time.Time
+	}
+
+	// HistoryStore persists the state transitions of place order processes - Secondary PORT
+	HistoryStore interface {
+		// Append an entry to the history of its process
+		Append(ctx context.Context, entry HistoryEntry) error
+		// History returns the entries of the process in chronological order, empty if the process is unknown
+		History(ctx context.Context, uuid string) ([]HistoryEntry, error)
+	}
+)
+
+// NewHistoryEntry creates the history entry of the event
+func NewHistoryEntry(event *PlaceOrderStateChangedEvent) HistoryEntry {
+	entry := HistoryEntry{
+		UUID:      event.UUID,
+		CartID:    event.CartID,
+		FromState: event.FromState,
+		ToState:   event.ToState,
+		Time:      event.Time,
+	}
+
+	if event.FailedReason != nil {
+		entry.FailedReason = event.FailedReason.Reason()
+	}
+
+	return entry
+}
@@ -0,0 +1,127 @@
+package process_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+)
+
+type recordingEventRouter struct {
+	events []flamingo.Event
+}
+
+func (r *recordingEventRouter) Dispatch(_ context.Context, event flamingo.Event) {
+	r.events = append(r.events, event)
+}
+
+type failingState struct{}
+
+func (failingState) Run(context.Context, *process.Process) process.RunResult {
+	return process.RunResult{Failed: process.ErrorOccurredReason{Error: "error"}}
+}
+
+func (failingState) Rollback(context.Context, process.RollbackData) error {
+	return nil
+}
+
+func (failingState) IsFinal() bool {
+	return false
+}
+
+func (failingState) Name() string {
+	return states.PrepareCart{}.Name()
+}
+
+func newDispatchingProcess(t *testing.T, router flamingo.EventRouter) *process.Process {
+	t.Helper()
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(map[string]process.State{
+				states.New{}.Name():         states.New{},
+				states.PrepareCart{}.Name(): failingState{},
+				states.Failed{}.Name():      states.Failed{},
+			}, flamingo.NullLogger{}, nil)
+		},
+		&struct {
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
+		}{
+			StartState:  states.New{},
+			FailedState: states.Failed{},
+			EventRouter: router,
+		},
+	)
+
+	p, err := factory.New(nil, cart.Cart{ID: "cart"})
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestProcess_RunDispatchesStateChangedEvent(t *testing.T) {
+	router := new(recordingEventRouter)
+	p := newDispatchingProcess(t, router)
+
+	p.Started(context.Background())
+	p.Run(context.Background())
+	p.Run(context.Background())
+	// the failed state keeps the process where it is, nothing to dispatch
+	p.Run(context.Background())
+
+	require.Len(t, router.events, 3)
+
+	changed := router.events[0].(*process.PlaceOrderStateChangedEvent)
+	assert.Equal(t, p.Context().UUID, changed.UUID)
+	assert.Equal(t, "cart", changed.CartID)
+	assert.Empty(t, changed.FromState, "the start state is recorded without a previous state")
+	assert.Equal(t, states.New{}.Name(), changed.ToState)
+
+	changed = router.events[1].(*process.PlaceOrderStateChangedEvent)
+	assert.Equal(t, p.Context().UUID, changed.UUID)
+	assert.Equal(t, "cart", changed.CartID)
+	assert.Equal(t, states.New{}.Name(), changed.FromState)
+	assert.Equal(t, states.PrepareCart{}.Name(), changed.ToState)
+	assert.Nil(t, changed.FailedReason)
+	assert.False(t, changed.Time.IsZero())
+
+	changed = router.events[2].(*process.PlaceOrderStateChangedEvent)
+	assert.Equal(t, states.PrepareCart{}.Name(), changed.FromState)
+	assert.Equal(t, states.Failed{}.Name(), changed.ToState)
+	assert.Equal(t, process.ErrorOccurredReason{Error: "error"}, changed.FailedReason)
+	assert.Equal(t, "error", process.NewHistoryEntry(changed).FailedReason)
+}
+
+func TestProcess_FailedDispatchesStateChangedEvent(t *testing.T) {
+	router := new(recordingEventRouter)
+	p := newDispatchingProcess(t, router)
+
+	// e.g. the customer cancels the process
+	p.Failed(context.Background(), process.CanceledByCustomerReason{})
+
+	require.Len(t, router.events, 1)
+	changed := router.events[0].(*process.PlaceOrderStateChangedEvent)
+	assert.Equal(t, p.Context().UUID, changed.UUID)
+	assert.Equal(t, states.New{}.Name(), changed.FromState)
+	assert.Equal(t, states.Failed{}.Name(), changed.ToState)
+	assert.Equal(t, process.CanceledByCustomerReason{}, changed.FailedReason)
+
+	t.Run("without event router", func(t *testing.T) {
+		p := newDispatchingProcess(t, nil)
+		assert.NotPanics(t, func() {
+			p.Started(context.Background())
+			p.Failed(context.Background(), process.CanceledByCustomerReason{})
+		})
+		assert.Equal(t, states.Failed{}.Name(), p.Context().CurrentStateName)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -30,6 +31,8 @@ type (
 		context     Context
 		allStates   map[string]State
 		failedState State
+		transitions *TransitionTable
+		eventRouter flamingo.EventRouter
 		logger      flamingo.Logger
 		area        string
 	}
@@ -39,6 +42,8 @@ type (
 		provider    Provider
 		startState  State
 		failedState State
+		transitions *TransitionTable
+		eventRouter flamingo.EventRouter
 	}
 
 	// RollbackReference a reference that can be used to trigger a rollback
@@ -71,6 +76,18 @@ type (
 	// PaymentCanceledByCustomerReason is used to signal that payment was canceled by customer
 	PaymentCanceledByCustomerReason struct{}
 
+	// ExpiredReason is used when a process did not reach a final state in time and has been failed by the sweeper
+	ExpiredReason struct {
+		// PaymentStatus is the flow status of the payment at the time of expiry, empty if no payment was created
+		PaymentStatus string
+	}
+
+	// RiskDeclinedReason is used when the risk assessment declined the order
+	RiskDeclinedReason struct {
+		// Reasons of the risk assessment, for internal use only as they may reveal the fraud rules
+		Reasons []string
+	}
+
 	// CartValidationErrorReason contains the ValidationResult
 	CartValidationErrorReason struct {
 		ValidationResult validation.Result
@@ -91,6 +108,8 @@ func init() {
 	gob.Register(PaymentCanceledByCustomerReason{})
 	gob.Register(CartValidationErrorReason{})
 	gob.Register(CanceledByCustomerReason{})
+	gob.Register(ExpiredReason{})
+	gob.Register(RiskDeclinedReason{})
 
 	if err := opencensus.View("flamingo-commerce/checkout/placeorder/state_run_count", processedState, view.Count(), keyState); err != nil {
 		panic(err)
@@ -120,6 +139,16 @@ func (e CanceledByCustomerReason) Reason() string {
 	return "Place order canceled by customer"
 }
 
+// Reason for the expiry
+func (e ExpiredReason) Reason() string {
+	return "Place order process expired"
+}
+
+// Reason for the decline
+func (e RiskDeclinedReason) Reason() string {
+	return "Order declined by risk assessment"
+}
+
 // Reason for failing
 func (e CartValidationErrorReason) Reason() string {
 	return "Cart invalid"
@@ -129,8 +158,10 @@ func (e CartValidationErrorReason) Reason() string {
 func (f *Factory) Inject(
 	provider Provider,
 	dep *struct {
-		StartState  State `inject:"startState"`
-		FailedState State `inject:"failedState"`
+		StartState      State                `inject:"startState"`
+		FailedState     State                `inject:"failedState"`
+		TransitionTable *TransitionTable     `inject:",optional"`
+		EventRouter     flamingo.EventRouter `inject:",optional"`
 	},
 ) {
 	f.provider = provider
@@ -138,6 +169,8 @@ func (f *Factory) Inject(
 	if dep != nil {
 		f.failedState = dep.FailedState
 		f.startState = dep.StartState
+		f.transitions = dep.TransitionTable
+		f.eventRouter = dep.EventRouter
 	}
 }
 
@@ -148,11 +181,14 @@ func (f *Factory) New(returnURL *url.URL, cart cart.Cart) (*Process, error) {
 	}
 	p := f.provider()
 	p.failedState = f.failedState
+	p.transitions = f.transitions
+	p.eventRouter = f.eventRouter
 	p.context = Context{
 		UUID:             uuid.New().String(),
 		CurrentStateName: f.startState.Name(),
 		Cart:             cart,
 		ReturnURL:        returnURL,
+		CreationTime:     time.Now(),
 	}
 
 	return p, nil
@@ -162,6 +198,8 @@ func (f *Factory) New(returnURL *url.URL, cart cart.Cart) (*Process, error) {
 func (f *Factory) NewFromProcessContext(pctx Context) (*Process, error) {
 	p := f.provider()
 	p.failedState = f.failedState
+	p.transitions = f.transitions
+	p.eventRouter = f.eventRouter
 	p.context = pctx
 
 	return p, nil
@@ -209,7 +247,10 @@ func (p *Process) Run(ctx context.Context) {
 	if runResult.Failed != nil {
 		stats.Record(censusCtx, failedStateTransition.M(1))
 		p.Failed(ctx, runResult.Failed)
+		return
 	}
+
+	p.stateChanged(ctx, currentState.Name())
 }
 
 // CurrentState of the process context
@@ -248,12 +289,62 @@ func (p *Process) Context() Context {
 	return p.context
 }
 
-// UpdateState updates the current state in the context and its related state data
+// UpdateState updates the current state in the context and its related state data.
+// If a state is inserted by the transition table it runs first, the requested state is kept until it calls Resume.
+// Switches that are not part of the transition table are logged, they are most likely caused by a missing process.Transition binding.
 func (p *Process) UpdateState(s string, stateData StateData) {
+	if !p.transitions.Allows(p.context.CurrentStateName, s) && p.logger != nil {
+		p.logger.Warn(fmt.Sprintf("state switch %q -> %q is not part of the place order transitions", p.context.CurrentStateName, s))
+	}
+
+	next := p.transitions.Next(p.context.CurrentStateName, s)
+	if next != s {
+		p.context.ResumeStateName = s
+		p.context.ResumeStateData = stateData
+		stateData = nil
+	}
+
+	p.setState(next, stateData)
+}
+
+// Resume switches to the state that has been requested before an inserted state took over, nop if there is none
+func (p *Process) Resume() {
+	s, stateData := p.context.ResumeStateName, p.context.ResumeStateData
+	if s == "" {
+		return
+	}
+
+	p.context.ResumeStateName = ""
+	p.context.ResumeStateData = nil
+	p.UpdateState(s, stateData)
+}
+
+func (p *Process) setState(s string, stateData StateData) {
 	p.context.CurrentStateName = s
 	p.context.CurrentStateData = stateData
 }
 
+// Started dispatches the PlaceOrderStateChangedEvent for the start state of a new process, its FromState is empty
+func (p *Process) Started(ctx context.Context) {
+	p.stateChanged(ctx, "")
+}
+
+// stateChanged dispatches the PlaceOrderStateChangedEvent if the current state differs from the given one
+func (p *Process) stateChanged(ctx context.Context, fromState string) {
+	if p.eventRouter == nil || p.context.CurrentStateName == fromState {
+		return
+	}
+
+	p.eventRouter.Dispatch(ctx, &PlaceOrderStateChangedEvent{
+		UUID:         p.context.UUID,
+		FromState:    fromState,
+		ToState:      p.context.CurrentStateName,
+		FailedReason: p.context.FailedReason,
+		CartID:       p.context.Cart.ID,
+		Time:         time.Now(),
+	})
+}
+
 // UpdateCart updates the cart in the current state context
 func (p *Process) UpdateCart(cartToStore cart.Cart) {
 	p.context.Cart = cartToStore
@@ -264,13 +355,24 @@ func (p *Process) UpdateOrderInfo(info *application.PlaceOrderInfo) {
 	p.context.PlaceOrderInfo = info
 }
 
-// Failed performs all collected rollbacks and switches to FailedState
+// Failed performs all collected rollbacks and switches to FailedState.
+// If a state is inserted for the reason by the transition table it runs instead and may recover the process.
 func (p *Process) Failed(ctx context.Context, reason FailedReason) {
+	fromState := p.context.CurrentStateName
+	if handler := p.transitions.FailureState(fromState, reason); handler != "" {
+		p.setState(handler, FailureStateData{StateName: fromState, Reason: reason})
+		p.stateChanged(ctx, fromState)
+		return
+	}
+
 	err := p.rollback(ctx)
 	if err != nil {
 		p.logger.WithContext(ctx).Error("fatal rollback error: ", err)
 	}
 
 	p.context.FailedReason = reason
-	p.UpdateState(p.failedState.Name(), nil)
+	p.context.ResumeStateName = ""
+	p.context.ResumeStateData = nil
+	p.setState(p.failedState.Name(), nil)
+	p.stateChanged(ctx, fromState)
 }
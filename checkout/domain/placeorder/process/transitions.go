@@ -0,0 +1,527 @@
+package process
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type (
+	// Transition is an edge of the state graph, the state From may switch to the state To
+	Transition struct {
+		From string
+		To   string
+	}
+
+	// TransitionRule inserts a project state into the state graph, exactly one of Before, After or OnFailure must be set.
+	// Multiple states inserted at the same point run in the order of their bindings.
+	TransitionRule struct {
+		// State is the name of the inserted state, the state must be bound to the state map
+		State string
+		// Before inserts the state in front of the named state, every switch to it runs the inserted state first
+		Before string
+		// After inserts the state behind the named state, every switch from it runs the inserted state first
+		After string
+		// OnFailure runs the state instead of failing the process for the named failed reasons, e.g. "PaymentErrorOccurredReason"
+		OnFailure []string
+	}
+
+	// TransitionTable is the state graph of the place order process, it consists of the default transitions
+	// and redirects state switches to the states inserted by the transition rules
+	TransitionTable struct {
+		transitions []Transition
+		// edges is the set of the default transitions
+		edges       map[Transition]bool
+		rules       []TransitionRule
+		allStates   map[string]State
+		startState  State
+		failedState State
+		// before lists the states inserted in front of a state
+		before map[string][]string
+		// after lists the states inserted behind a state
+		after map[string][]string
+		// anchors maps states inserted with After to the state they are inserted behind
+		anchors map[string]string
+		// onFailure maps failed reason names to the state that handles them
+		onFailure map[string]string
+	}
+
+	// FailureStateData is the state data of a state inserted with OnFailure, the state may recover the process
+	// or fail it with the Reason
+	FailureStateData struct {
+		// StateName of the state that failed
+		StateName string
+		Reason    FailedReason
+	}
+)
+
+const graphQLStatePrefix = "Commerce_Checkout_PlaceOrderState_State_"
+
+func init() {
+	gob.Register(FailureStateData{})
+}
+
+// Inject dependencies, an invalid configuration panics as the place order process can't run without a valid state graph
+func (t *TransitionTable) Inject(
+	allStates map[string]State,
+	dep *struct {
+		StartState  State            `inject:"startState"`
+		FailedState State            `inject:"failedState"`
+		Transitions []Transition     `inject:",optional"`
+		Rules       []TransitionRule `inject:",optional"`
+	},
+) *TransitionTable {
+	t.allStates = allStates
+	t.edges = make(map[Transition]bool)
+	t.before = make(map[string][]string)
+	t.after = make(map[string][]string)
+	t.anchors = make(map[string]string)
+	t.onFailure = make(map[string]string)
+
+	if dep != nil {
+		t.startState = dep.StartState
+		t.failedState = dep.FailedState
+		t.transitions = dep.Transitions
+		t.rules = dep.Rules
+	}
+
+	for _, transition := range t.transitions {
+		t.edges[transition] = true
+	}
+
+	for _, rule := range t.rules {
+		switch {
+		case rule.Before != "":
+			t.before[rule.Before] = append(t.before[rule.Before], rule.State)
+		case rule.After != "":
+			t.after[rule.After] = append(t.after[rule.After], rule.State)
+			t.anchors[rule.State] = rule.After
+		}
+
+		for _, reason := range rule.OnFailure {
+			if _, ok := t.onFailure[reason]; !ok {
+				t.onFailure[reason] = rule.State
+			}
+		}
+	}
+
+	if err := t.Validate(); err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+// Validate checks that the default transitions and the rules only reference bound states and that every rule has
+// a valid insertion point
+func (t *TransitionTable) Validate() error {
+	if t.startState == nil || t.failedState == nil {
+		return errors.New("place order transitions: start and failed state must be bound")
+	}
+
+	var errs []string
+	graphStates := make(map[string]bool)
+	for _, transition := range t.transitions {
+		for _, name := range []string{transition.From, transition.To} {
+			graphStates[name] = true
+			if _, ok := t.allStates[name]; !ok {
+				errs = append(errs, fmt.Sprintf("transition %s -> %s: state %q is not bound", transition.From, transition.To, name))
+			}
+		}
+	}
+
+	inserted := make(map[string]bool)
+	claimedReasons := make(map[string]string)
+	for _, rule := range t.rules {
+		prefix := fmt.Sprintf("rule for state %q: ", rule.State)
+		if _, ok := t.allStates[rule.State]; !ok {
+			errs = append(errs, prefix+"state is not bound")
+		}
+		if graphStates[rule.State] || rule.State == t.startState.Name() || rule.State == t.failedState.Name() {
+			errs = append(errs, prefix+"state is already part of the default transitions")
+		}
+		if inserted[rule.State] {
+			errs = append(errs, prefix+"state is inserted more than once")
+		}
+		inserted[rule.State] = true
+
+		insertionPoints := 0
+		for _, isSet := range []bool{rule.Before != "", rule.After != "", len(rule.OnFailure) > 0} {
+			if isSet {
+				insertionPoints++
+			}
+		}
+		if insertionPoints != 1 {
+			errs = append(errs, prefix+"exactly one of Before, After or OnFailure must be set")
+			continue
+		}
+
+		switch {
+		case rule.Before != "":
+			errs = append(errs, t.validateAnchor(prefix, rule.Before, graphStates)...)
+			if rule.Before == t.startState.Name() {
+				errs = append(errs, prefix+"state can't be inserted before the start state")
+			}
+		case rule.After != "":
+			errs = append(errs, t.validateAnchor(prefix, rule.After, graphStates)...)
+			if state, ok := t.allStates[rule.After]; ok && state.IsFinal() {
+				errs = append(errs, prefix+"state can't be inserted after a final state")
+			}
+		default:
+			for _, reason := range rule.OnFailure {
+				if other, ok := claimedReasons[reason]; ok {
+					errs = append(errs, fmt.Sprintf("%sfailed reason %q is already handled by state %q", prefix, reason, other))
+				}
+				claimedReasons[reason] = rule.State
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("place order transitions invalid: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (t *TransitionTable) validateAnchor(prefix string, anchor string, graphStates map[string]bool) []string {
+	if anchor == t.failedState.Name() {
+		return []string{prefix + "state can't be inserted before or after the failed state"}
+	}
+
+	if !graphStates[anchor] && anchor != t.startState.Name() {
+		return []string{fmt.Sprintf("%sstate %q is not part of the default transitions", prefix, anchor)}
+	}
+
+	return nil
+}
+
+// Allows checks if the state from may request a switch to the state to, this is the case for the default transitions,
+// switches to the failed state and all switches of the inserted states
+func (t *TransitionTable) Allows(from string, to string) bool {
+	if t == nil || from == to || (t.failedState != nil && to == t.failedState.Name()) {
+		return true
+	}
+
+	if t.edges[Transition{From: from, To: to}] {
+		return true
+	}
+
+	for _, rule := range t.rules {
+		if rule.State == from {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns the state the process switches to if the state from requests a switch to the state to
+func (t *TransitionTable) Next(from string, to string) string {
+	if t == nil || from == to || (t.failedState != nil && to == t.failedState.Name()) {
+		return to
+	}
+
+	anchor := from
+	if insertedBehind, ok := t.anchors[from]; ok {
+		anchor = insertedBehind
+	}
+
+	if next, ok := nextInChain(t.after[anchor], from); ok {
+		return next
+	}
+
+	if next, ok := nextInChain(t.before[to], from); ok {
+		return next
+	}
+
+	return to
+}
+
+// nextInChain returns the state following the current one in the chain of inserted states, the first one if the current
+// state is not part of the chain and false if the current state is the last one of the chain
+func nextInChain(chain []string, current string) (string, bool) {
+	for i, name := range chain {
+		if name == current {
+			if i == len(chain)-1 {
+				return "", false
+			}
+			return chain[i+1], true
+		}
+	}
+
+	if len(chain) == 0 {
+		return "", false
+	}
+
+	return chain[0], true
+}
+
+// FailureState returns the state that handles the failed reason instead of failing the process, empty if there is none
+func (t *TransitionTable) FailureState(from string, reason FailedReason) string {
+	if t == nil || reason == nil {
+		return ""
+	}
+
+	state := t.onFailure[ReasonName(reason)]
+	if state == from {
+		// the handling state itself gave up
+		return ""
+	}
+
+	return state
+}
+
+// ReasonName returns the name of a failed reason as used by TransitionRule.OnFailure, e.g. "PaymentErrorOccurredReason"
+func ReasonName(reason FailedReason) string {
+	reasonType := reflect.TypeOf(reason)
+	for reasonType.Kind() == reflect.Ptr {
+		reasonType = reasonType.Elem()
+	}
+
+	return reasonType.Name()
+}
+
+// PlantUML renders the effective state graph as PlantUML diagram, graphQLStates maps the state names to the
+// GraphQL state type names (e.g. "Wait") shown below the internal names.
+// States looping with each other (e.g. the payment states) are grouped in a composite state behind the state entering the loop.
+func (t *TransitionTable) PlantUML(graphQLStates map[string]string) string {
+	var edges []Transition
+	seen := make(map[Transition]bool)
+	addEdge := func(from string, to string) {
+		edge := Transition{From: from, To: to}
+		if from == to || seen[edge] {
+			return
+		}
+		seen[edge] = true
+		edges = append(edges, edge)
+	}
+
+	addPath := func(from string, to string) {
+		current := from
+		for i := 0; i <= len(t.rules); i++ {
+			next := t.Next(current, to)
+			addEdge(current, next)
+			if next == to {
+				return
+			}
+			current = next
+		}
+	}
+
+	startName := t.startState.Name()
+	failedName := t.failedState.Name()
+	for _, transition := range t.transitions {
+		addPath(transition.From, transition.To)
+	}
+
+	var names []string
+	known := make(map[string]bool)
+	addName := func(name string) {
+		if !known[name] {
+			known[name] = true
+			names = append(names, name)
+		}
+	}
+	addName(startName)
+	for _, edge := range edges {
+		addName(edge.From)
+		addName(edge.To)
+	}
+	for _, rule := range t.rules {
+		if len(rule.OnFailure) > 0 {
+			addName(rule.State)
+		}
+	}
+	addName(failedName)
+
+	loops := stateLoops(names, edges)
+	// node returns the name of the diagram node of a state, states grouped in a loop are represented by the composite state
+	node := func(name string) string {
+		if head, ok := loops[name]; ok {
+			return head + "Loop"
+		}
+		return name
+	}
+
+	stateLine := func(name string) string {
+		if graphQLState, ok := graphQLStates[name]; ok {
+			return fmt.Sprintf("state %s: %s%s\n", name, graphQLStatePrefix, graphQLState)
+		}
+		return fmt.Sprintf("state %s\n", name)
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString("@startuml\nscale max 1024 width\nhide empty description\n\n")
+	sb.WriteString("title\n\t= PlaceOrder state transitions\n\t---\n\t//top: internal state//\n\t//bottom: exposed GraphQL state//\nend title\n\n")
+
+	var heads []string
+	members := make(map[string][]string)
+	for _, name := range names {
+		head, ok := loops[name]
+		if !ok {
+			sb.WriteString(stateLine(name))
+			continue
+		}
+		if len(members[head]) == 0 {
+			heads = append(heads, head)
+		}
+		members[head] = append(members[head], name)
+	}
+
+	for _, head := range heads {
+		fmt.Fprintf(sb, "\nstate %s {\n", node(members[head][0]))
+		for i, name := range members[head] {
+			if i > 0 {
+				sb.WriteString("\t--\n")
+			}
+			sb.WriteString("\t" + stateLine(name))
+		}
+		sb.WriteString("}\n")
+
+		exits := []string{failedName}
+		exitSeen := map[string]bool{failedName: true}
+		for _, edge := range edges {
+			if (edge.From == head || loops[edge.From] == head) && edge.To != head && loops[edge.To] != head && !exitSeen[edge.To] {
+				exitSeen[edge.To] = true
+				exits = append(exits, edge.To)
+			}
+		}
+		fmt.Fprintf(sb, "\nnote top of %s\n  Loops with %s\n  until %s is reached\nend note\n", node(members[head][0]), head, strings.Join(exits, " or "))
+	}
+
+	var failureRules []TransitionRule
+	for _, rule := range t.rules {
+		if len(rule.OnFailure) > 0 {
+			failureRules = append(failureRules, rule)
+		}
+	}
+	sort.Slice(failureRules, func(i, j int) bool { return failureRules[i].State < failureRules[j].State })
+	for _, rule := range failureRules {
+		fmt.Fprintf(sb, "\nnote right of %s\n  Runs instead of %s on\n  %s\nend note\n", rule.State, failedName, strings.Join(rule.OnFailure, "\n  "))
+	}
+
+	fmt.Fprintf(sb, "\n[*] --> %s\n", node(startName))
+	written := make(map[Transition]bool)
+	writeEdge := func(from string, to string) {
+		edge := Transition{From: node(from), To: node(to)}
+		if edge.From == edge.To || written[edge] {
+			return
+		}
+		written[edge] = true
+		fmt.Fprintf(sb, "%s --> %s\n", edge.From, edge.To)
+	}
+	for _, edge := range edges {
+		writeEdge(edge.From, edge.To)
+	}
+
+	sb.WriteString("\n")
+	for _, name := range names {
+		state, ok := t.allStates[name]
+		if name == failedName || (ok && state.IsFinal()) {
+			continue
+		}
+		writeEdge(name, failedName)
+	}
+
+	sb.WriteString("\n")
+	for _, name := range names {
+		if state, ok := t.allStates[name]; ok && state.IsFinal() {
+			fmt.Fprintf(sb, "%s --> [*]\n", name)
+		}
+	}
+	sb.WriteString("\n@enduml\n")
+
+	return sb.String()
+}
+
+// stateLoops finds the states that loop with each other (strongly connected components of the graph) and maps them to
+// the state entering the loop, the entering state itself is not part of the map
+func stateLoops(names []string, edges []Transition) map[string]string {
+	successors := make(map[string][]string)
+	for _, edge := range edges {
+		successors[edge.From] = append(successors[edge.From], edge.To)
+	}
+
+	// Tarjan's algorithm
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+	var visit func(name string)
+	visit = func(name string) {
+		index[name] = len(index)
+		lowLink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, successor := range successors[name] {
+			if _, visited := index[successor]; !visited {
+				visit(successor)
+				if lowLink[successor] < lowLink[name] {
+					lowLink[name] = lowLink[successor]
+				}
+			} else if onStack[successor] && index[successor] < lowLink[name] {
+				lowLink[name] = index[successor]
+			}
+		}
+
+		if lowLink[name] != index[name] {
+			return
+		}
+
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == name {
+				break
+			}
+		}
+		if len(component) > 1 {
+			components = append(components, component)
+		}
+	}
+
+	for _, name := range names {
+		if _, visited := index[name]; !visited {
+			visit(name)
+		}
+	}
+
+	position := make(map[string]int, len(names))
+	for i, name := range names {
+		position[name] = i
+	}
+
+	loops := make(map[string]string)
+	for _, component := range components {
+		inComponent := make(map[string]bool, len(component))
+		for _, name := range component {
+			inComponent[name] = true
+		}
+		sort.Slice(component, func(i, j int) bool { return position[component[i]] < position[component[j]] })
+
+		head := component[0]
+		for _, edge := range edges {
+			if inComponent[edge.To] && !inComponent[edge.From] {
+				head = edge.To
+				break
+			}
+		}
+
+		for _, name := range component {
+			if name != head {
+				loops[name] = head
+			}
+		}
+	}
+
+	return loops
+}
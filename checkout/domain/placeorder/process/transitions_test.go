@@ -0,0 +1,331 @@
+package process_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+)
+
+type insertedState struct {
+	name string
+}
+
+func (s insertedState) Run(_ context.Context, p *process.Process) process.RunResult {
+	p.Resume()
+	return process.RunResult{}
+}
+
+func (s insertedState) Rollback(context.Context, process.RollbackData) error {
+	return nil
+}
+
+func (s insertedState) IsFinal() bool {
+	return false
+}
+
+func (s insertedState) Name() string {
+	return s.name
+}
+
+var testTransitions = []process.Transition{
+	{From: states.New{}.Name(), To: states.PrepareCart{}.Name()},
+	{From: states.PrepareCart{}.Name(), To: states.ValidateCart{}.Name()},
+	{From: states.ValidateCart{}.Name(), To: states.Success{}.Name()},
+}
+
+// injectTransitionTable returns the error the transition table panics with on an invalid configuration
+func injectTransitionTable(transitions []process.Transition, rules ...process.TransitionRule) (table *process.TransitionTable, err error) {
+	allStates := map[string]process.State{
+		states.New{}.Name():          states.New{},
+		states.PrepareCart{}.Name():  states.PrepareCart{},
+		states.ValidateCart{}.Name(): states.ValidateCart{},
+		states.Success{}.Name():      states.Success{},
+		states.Failed{}.Name():       states.Failed{},
+		"FraudCheck":                 insertedState{name: "FraudCheck"},
+		"AgeVerification":            insertedState{name: "AgeVerification"},
+		"ManualReview":               insertedState{name: "ManualReview"},
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return new(process.TransitionTable).Inject(allStates, &struct {
+		StartState  process.State            `inject:"startState"`
+		FailedState process.State            `inject:"failedState"`
+		Transitions []process.Transition     `inject:",optional"`
+		Rules       []process.TransitionRule `inject:",optional"`
+	}{
+		StartState:  states.New{},
+		FailedState: states.Failed{},
+		Transitions: transitions,
+		Rules:       rules,
+	}), nil
+}
+
+func newTransitionTable(t *testing.T, rules ...process.TransitionRule) *process.TransitionTable {
+	t.Helper()
+
+	table, err := injectTransitionTable(testTransitions, rules...)
+	require.NoError(t, err)
+
+	return table
+}
+
+func newProcess(t *testing.T, table *process.TransitionTable) *process.Process {
+	t.Helper()
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(map[string]process.State{}, flamingo.NullLogger{}, nil)
+		},
+		&struct {
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
+		}{
+			StartState:      states.New{},
+			FailedState:     states.Failed{},
+			TransitionTable: table,
+		},
+	)
+
+	p, err := factory.New(nil, cart.Cart{})
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestTransitionTable_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rules   []process.TransitionRule
+		wantErr string
+	}{
+		{
+			name: "valid rules",
+			rules: []process.TransitionRule{
+				{State: "FraudCheck", Before: states.ValidateCart{}.Name()},
+				{State: "AgeVerification", After: states.New{}.Name()},
+				{State: "ManualReview", OnFailure: []string{"PaymentErrorOccurredReason"}},
+			},
+		},
+		{
+			name:    "unbound state",
+			rules:   []process.TransitionRule{{State: "Unknown", Before: states.ValidateCart{}.Name()}},
+			wantErr: `rule for state "Unknown": state is not bound`,
+		},
+		{
+			name:    "no insertion point",
+			rules:   []process.TransitionRule{{State: "FraudCheck"}},
+			wantErr: "exactly one of Before, After or OnFailure must be set",
+		},
+		{
+			name:    "multiple insertion points",
+			rules:   []process.TransitionRule{{State: "FraudCheck", Before: states.ValidateCart{}.Name(), After: states.New{}.Name()}},
+			wantErr: "exactly one of Before, After or OnFailure must be set",
+		},
+		{
+			name:    "unknown anchor",
+			rules:   []process.TransitionRule{{State: "FraudCheck", Before: "Unknown"}},
+			wantErr: `state "Unknown" is not part of the default transitions`,
+		},
+		{
+			name:    "before start state",
+			rules:   []process.TransitionRule{{State: "FraudCheck", Before: states.New{}.Name()}},
+			wantErr: "state can't be inserted before the start state",
+		},
+		{
+			name:    "after final state",
+			rules:   []process.TransitionRule{{State: "FraudCheck", After: states.Success{}.Name()}},
+			wantErr: "state can't be inserted after a final state",
+		},
+		{
+			name:    "default state inserted",
+			rules:   []process.TransitionRule{{State: states.PrepareCart{}.Name(), Before: states.ValidateCart{}.Name()}},
+			wantErr: "state is already part of the default transitions",
+		},
+		{
+			name: "state inserted twice",
+			rules: []process.TransitionRule{
+				{State: "FraudCheck", Before: states.ValidateCart{}.Name()},
+				{State: "FraudCheck", After: states.New{}.Name()},
+			},
+			wantErr: "state is inserted more than once",
+		},
+		{
+			name: "failed reason handled twice",
+			rules: []process.TransitionRule{
+				{State: "FraudCheck", OnFailure: []string{"PaymentErrorOccurredReason"}},
+				{State: "ManualReview", OnFailure: []string{"PaymentErrorOccurredReason"}},
+			},
+			wantErr: `failed reason "PaymentErrorOccurredReason" is already handled by state "FraudCheck"`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := injectTransitionTable(testTransitions, tt.rules...)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTransitionTable_Next(t *testing.T) {
+	t.Parallel()
+
+	table := newTransitionTable(t,
+		process.TransitionRule{State: "FraudCheck", Before: states.ValidateCart{}.Name()},
+		process.TransitionRule{State: "AgeVerification", Before: states.ValidateCart{}.Name()},
+		process.TransitionRule{State: "ManualReview", After: states.New{}.Name()},
+	)
+	require.NoError(t, table.Validate())
+
+	assert.Equal(t, "ManualReview", table.Next(states.New{}.Name(), states.PrepareCart{}.Name()))
+	assert.Equal(t, states.PrepareCart{}.Name(), table.Next("ManualReview", states.PrepareCart{}.Name()))
+	assert.Equal(t, "FraudCheck", table.Next(states.PrepareCart{}.Name(), states.ValidateCart{}.Name()))
+	assert.Equal(t, "AgeVerification", table.Next("FraudCheck", states.ValidateCart{}.Name()))
+	assert.Equal(t, states.ValidateCart{}.Name(), table.Next("AgeVerification", states.ValidateCart{}.Name()))
+	assert.Equal(t, states.Success{}.Name(), table.Next(states.ValidateCart{}.Name(), states.Success{}.Name()))
+	assert.Equal(t, states.Failed{}.Name(), table.Next(states.New{}.Name(), states.Failed{}.Name()))
+
+	var noTable *process.TransitionTable
+	assert.Equal(t, states.PrepareCart{}.Name(), noTable.Next(states.New{}.Name(), states.PrepareCart{}.Name()))
+}
+
+func TestTransitionTable_Allows(t *testing.T) {
+	t.Parallel()
+
+	table := newTransitionTable(t, process.TransitionRule{State: "FraudCheck", Before: states.ValidateCart{}.Name()})
+
+	assert.True(t, table.Allows(states.New{}.Name(), states.PrepareCart{}.Name()))
+	assert.True(t, table.Allows(states.PrepareCart{}.Name(), states.ValidateCart{}.Name()), "inserted states don't change the requested switch")
+	assert.True(t, table.Allows("FraudCheck", states.ValidateCart{}.Name()))
+	assert.True(t, table.Allows(states.ValidateCart{}.Name(), states.Failed{}.Name()))
+	assert.True(t, table.Allows(states.ValidateCart{}.Name(), states.ValidateCart{}.Name()))
+	assert.False(t, table.Allows(states.New{}.Name(), states.Success{}.Name()))
+	assert.False(t, table.Allows(states.New{}.Name(), "FraudCheck"))
+
+	var noTable *process.TransitionTable
+	assert.True(t, noTable.Allows(states.New{}.Name(), states.Success{}.Name()))
+}
+
+func TestProcess_UpdateStateWithInsertedStates(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inserted state runs before the requested state", func(t *testing.T) {
+		t.Parallel()
+
+		table := newTransitionTable(t, process.TransitionRule{State: "FraudCheck", Before: states.PrepareCart{}.Name()})
+		p := newProcess(t, table)
+
+		p.UpdateState(states.PrepareCart{}.Name(), "data")
+		assert.Equal(t, "FraudCheck", p.Context().CurrentStateName)
+		assert.Nil(t, p.Context().CurrentStateData)
+		assert.Equal(t, states.PrepareCart{}.Name(), p.Context().ResumeStateName)
+
+		p.Resume()
+		assert.Equal(t, states.PrepareCart{}.Name(), p.Context().CurrentStateName)
+		assert.Equal(t, "data", p.Context().CurrentStateData)
+		assert.Empty(t, p.Context().ResumeStateName)
+	})
+
+	t.Run("failure state handles the failed reason", func(t *testing.T) {
+		t.Parallel()
+
+		table := newTransitionTable(t, process.TransitionRule{State: "ManualReview", OnFailure: []string{"PaymentErrorOccurredReason"}})
+		p := newProcess(t, table)
+
+		reason := process.PaymentErrorOccurredReason{Error: "declined"}
+		p.Failed(context.Background(), reason)
+		assert.Equal(t, "ManualReview", p.Context().CurrentStateName)
+		assert.Equal(t, process.FailureStateData{StateName: states.New{}.Name(), Reason: reason}, p.Context().CurrentStateData)
+		assert.Nil(t, p.Context().FailedReason)
+
+		// the failure state gives up
+		p.Failed(context.Background(), reason)
+		assert.Equal(t, states.Failed{}.Name(), p.Context().CurrentStateName)
+		assert.Equal(t, reason, p.Context().FailedReason)
+	})
+
+	t.Run("other failed reasons fail the process", func(t *testing.T) {
+		t.Parallel()
+
+		table := newTransitionTable(t, process.TransitionRule{State: "ManualReview", OnFailure: []string{"PaymentErrorOccurredReason"}})
+		p := newProcess(t, table)
+
+		p.Failed(context.Background(), process.CanceledByCustomerReason{})
+		assert.Equal(t, states.Failed{}.Name(), p.Context().CurrentStateName)
+	})
+}
+
+func TestTransitionTable_PlantUML(t *testing.T) {
+	t.Parallel()
+
+	table := newTransitionTable(t,
+		process.TransitionRule{State: "FraudCheck", Before: states.ValidateCart{}.Name()},
+		process.TransitionRule{State: "ManualReview", OnFailure: []string{"PaymentErrorOccurredReason"}},
+	)
+
+	diagram := table.PlantUML(map[string]string{states.New{}.Name(): "Wait", "FraudCheck": "Wait"})
+
+	assert.Contains(t, diagram, "state New: Commerce_Checkout_PlaceOrderState_State_Wait\n")
+	assert.Contains(t, diagram, "state FraudCheck: Commerce_Checkout_PlaceOrderState_State_Wait\n")
+	assert.Contains(t, diagram, "state PrepareCart\n")
+	assert.Contains(t, diagram, "[*] --> New\n")
+	assert.Contains(t, diagram, "PrepareCart --> FraudCheck\n")
+	assert.Contains(t, diagram, "FraudCheck --> ValidateCart\n")
+	assert.NotContains(t, diagram, "PrepareCart --> ValidateCart\n")
+	assert.Contains(t, diagram, "FraudCheck --> Failed\n")
+	assert.Contains(t, diagram, "ManualReview --> Failed\n")
+	assert.NotContains(t, diagram, "Success --> Failed\n")
+	assert.Contains(t, diagram, "note right of ManualReview\n  Runs instead of Failed on\n  PaymentErrorOccurredReason\nend note\n")
+	assert.Contains(t, diagram, "Success --> [*]\n")
+	assert.Contains(t, diagram, "Failed --> [*]\n")
+}
+
+func TestTransitionTable_PlantUMLGroupsLoops(t *testing.T) {
+	t.Parallel()
+
+	table, err := injectTransitionTable([]process.Transition{
+		{From: states.New{}.Name(), To: states.PrepareCart{}.Name()},
+		{From: states.PrepareCart{}.Name(), To: states.ValidateCart{}.Name()},
+		{From: states.ValidateCart{}.Name(), To: states.PrepareCart{}.Name()},
+		{From: states.ValidateCart{}.Name(), To: states.Success{}.Name()},
+	})
+	require.NoError(t, err)
+
+	diagram := table.PlantUML(map[string]string{states.ValidateCart{}.Name(): "Wait"})
+
+	assert.Contains(t, diagram, "state PrepareCartLoop {\n\tstate ValidateCart: Commerce_Checkout_PlaceOrderState_State_Wait\n}\n")
+	assert.Contains(t, diagram, "note top of PrepareCartLoop\n  Loops with PrepareCart\n  until Failed or Success is reached\nend note\n")
+	assert.Contains(t, diagram, "New --> PrepareCart\n")
+	assert.Contains(t, diagram, "PrepareCart --> PrepareCartLoop\n")
+	assert.Contains(t, diagram, "PrepareCartLoop --> PrepareCart\n")
+	assert.Contains(t, diagram, "PrepareCartLoop --> Success\n")
+	assert.Contains(t, diagram, "PrepareCartLoop --> Failed\n")
+	assert.NotContains(t, diagram, "ValidateCart -->")
+}
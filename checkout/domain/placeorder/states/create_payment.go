@@ -8,13 +8,15 @@ import (
 	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
 	"github.com/lunarforge/flamingo_commerce/payment/application"
+	sourcingApplication "github.com/lunarforge/flamingo_commerce/sourcing/application"
 	"go.opencensus.io/trace"
 )
 
 type (
 	// CreatePayment state
 	CreatePayment struct {
-		paymentService *application.PaymentService
+		paymentService   *application.PaymentService
+		stockReservation sourcingApplication.StockReservationApplication
 	}
 
 	// CreatePaymentRollbackData needed for rollback
@@ -33,8 +35,14 @@ func init() {
 // Inject dependencies
 func (c *CreatePayment) Inject(
 	paymentService *application.PaymentService,
+	optionals *struct {
+		StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+	},
 ) *CreatePayment {
 	c.paymentService = paymentService
+	if optionals != nil {
+		c.stockReservation = optionals.StockReservation
+	}
 
 	return c
 }
@@ -50,6 +58,17 @@ func (c CreatePayment) Run(ctx context.Context, p *process.Process) process.RunR
 	defer span.End()
 
 	cart := p.Context().Cart
+
+	// renew the stock reservation of ValidateCart, so that it lasts while the customer pays
+	if c.stockReservation != nil {
+		err := c.stockReservation.ReserveCart(ctx, p.Context().UUID, cart)
+		if err != nil {
+			return process.RunResult{
+				Failed: process.ErrorOccurredReason{Error: err.Error()},
+			}
+		}
+	}
+
 	paymentGateway, err := c.paymentService.PaymentGatewayByCart(cart)
 	if err != nil {
 		return process.RunResult{
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"testing"
 
+	"flamingo.me/flamingo/v3/framework/flamingo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -36,7 +37,7 @@ func TestCreatePayment_Run(t *testing.T) {
 		gateway.On("OrderPaymentFromFlow", mock.Anything, mock.Anything, p.Context().UUID).Return(expectedPayment, nil).Once()
 		paymentService := paymentServiceHelper(t, gateway)
 
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 
 		expectedResult := process.RunResult{
 			RollbackData: states.CreatePaymentRollbackData{Gateway: expectedPayment.Gateway, PaymentID: expectedPayment.PaymentID},
@@ -58,7 +59,7 @@ func TestCreatePayment_Run(t *testing.T) {
 
 		paymentService := paymentServiceHelper(t, nil)
 
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 
 		result := state.Run(context.Background(), p)
 		assert.NotNil(t, result.Failed, "Missing PaymentSelection in cart should lead to an error")
@@ -78,7 +79,7 @@ func TestCreatePayment_Run(t *testing.T) {
 		gateway := &mocks.WebCartPaymentGateway{}
 		gateway.On("StartFlow", mock.Anything, mock.Anything, p.Context().UUID, p.Context().ReturnURL).Return(nil, expectedError).Once()
 		paymentService := paymentServiceHelper(t, gateway)
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 
 		expectedResult := process.RunResult{
 			Failed: process.PaymentErrorOccurredReason{Error: expectedError.Error()},
@@ -103,7 +104,7 @@ func TestCreatePayment_Run(t *testing.T) {
 		gateway.On("OrderPaymentFromFlow", mock.Anything, mock.Anything, p.Context().UUID).Return(nil, expectedError).Once()
 
 		paymentService := paymentServiceHelper(t, gateway)
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 
 		expectedResult := process.RunResult{
 			Failed: process.PaymentErrorOccurredReason{Error: expectedError.Error()},
@@ -121,8 +122,10 @@ func provideProcessFactory(t *testing.T) *process.Factory {
 			return &process.Process{}
 		},
 		&struct {
-			StartState  process.State `inject:"startState"`
-			FailedState process.State `inject:"failedState"`
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
 		}{
 			StartState: &states.New{},
 		},
@@ -174,7 +177,7 @@ func TestCreatePayment_Rollback(t *testing.T) {
 		gateway := &mocks.WebCartPaymentGateway{}
 		gateway.On("CancelOrderPayment", mock.Anything, payment).Return(nil).Once()
 		paymentService := paymentServiceHelper(t, gateway)
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 
 		result := state.Rollback(context.Background(), data)
 		assert.Nil(t, result)
@@ -199,7 +202,7 @@ func TestCreatePayment_Rollback(t *testing.T) {
 		data = states.CreatePaymentRollbackData{Gateway: payment.Gateway, PaymentID: payment.PaymentID}
 
 		paymentService := paymentServiceHelper(t, nil)
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 		assert.Error(t, state.Rollback(context.Background(), data), "Missing payment selection / gateway should lead to an error")
 	})
 
@@ -221,7 +224,7 @@ func TestCreatePayment_Rollback(t *testing.T) {
 		expectedError := errors.New("generic payment error")
 		gateway.On("CancelOrderPayment", mock.Anything, payment).Return(expectedError).Once()
 		paymentService := paymentServiceHelper(t, gateway)
-		state.Inject(paymentService)
+		state.Inject(paymentService, nil)
 		assert.EqualError(t, state.Rollback(context.Background(), data), expectedError.Error())
 		gateway.AssertExpectations(t)
 	})
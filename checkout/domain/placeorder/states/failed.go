@@ -4,28 +4,48 @@ import (
 	"context"
 
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	sourcingApplication "github.com/lunarforge/flamingo_commerce/sourcing/application"
 	"go.opencensus.io/trace"
 )
 
 type (
 	// Failed state
 	Failed struct {
-		Reason process.FailedReason
+		Reason           process.FailedReason
+		stockReservation sourcingApplication.StockReservationApplication
 	}
 )
 
 var _ process.State = Failed{}
 
+// Inject dependencies
+func (f *Failed) Inject(
+	optionals *struct {
+		StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+	},
+) *Failed {
+	if optionals != nil {
+		f.stockReservation = optionals.StockReservation
+	}
+
+	return f
+}
+
 // Name get state name
 func (f Failed) Name() string {
 	return "Failed"
 }
 
-// Run the state operations
-func (f Failed) Run(ctx context.Context, _ *process.Process) process.RunResult {
-	_, span := trace.StartSpan(ctx, "placeorder/state/Failed/Run")
+// Run the state operations, releases the stock reserved by the process
+func (f Failed) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/Failed/Run")
 	defer span.End()
 
+	if f.stockReservation != nil {
+		// the state is final, so a failing reservation update is only logged by the reservation service
+		_ = f.stockReservation.ReleaseReservation(ctx, p.Context().UUID)
+	}
+
 	return process.RunResult{}
 }
 
@@ -2,11 +2,15 @@ package states_test
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
 )
 
 func TestFailed_IsFinal(t *testing.T) {
@@ -28,3 +32,14 @@ func TestFailed_Run(t *testing.T) {
 	s := states.Failed{}
 	assert.Equal(t, s.Run(context.Background(), &process.Process{}), process.RunResult{})
 }
+
+func TestFailed_RunReleasesStockReservation(t *testing.T) {
+	stockReservation := new(recordingStockReservation)
+	state := new(states.Failed).Inject(withStockReservation(stockReservation))
+	p, err := provideProcessFactory(t).New(&url.URL{}, cartDomain.Cart{})
+	require.NoError(t, err)
+
+	assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+	assert.Equal(t, []string{p.Context().UUID}, stockReservation.released)
+	assert.Empty(t, stockReservation.reserved)
+}
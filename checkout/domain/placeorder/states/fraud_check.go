@@ -0,0 +1,96 @@
+package states
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"go.opencensus.io/trace"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+type (
+	// FraudCheck state assesses the fraud risk of the order, it is inserted behind the ValidateCart state if enabled,
+	// so declined orders don't create a payment
+	FraudCheck struct {
+		riskAssessmentService domain.RiskAssessmentService
+		logger                flamingo.Logger
+		trustedProxies        int
+	}
+)
+
+var _ process.State = FraudCheck{}
+
+// Inject dependencies
+func (fc *FraudCheck) Inject(
+	riskAssessmentService domain.RiskAssessmentService,
+	logger flamingo.Logger,
+	cfg *struct {
+		TrustedProxies int `inject:"config:commerce.checkout.placeorder.fraudCheck.trustedProxies,optional"`
+	},
+) *FraudCheck {
+	fc.riskAssessmentService = riskAssessmentService
+	fc.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "FraudCheck")
+	if cfg != nil {
+		fc.trustedProxies = cfg.TrustedProxies
+	}
+
+	return fc
+}
+
+// Name get state name
+func (FraudCheck) Name() string {
+	return "FraudCheck"
+}
+
+// Run the state operations, declined orders fail the process and orders to review are held by the place order service
+func (fc FraudCheck) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/FraudCheck/Run")
+	defer span.End()
+
+	cart := p.Context().Cart
+	assessment, err := fc.riskAssessmentService.Assess(ctx, domain.NewRiskAssessmentRequest(ctx, cart, fc.trustedProxies))
+	if err != nil {
+		// don't lose the order if the risk provider is not available, let somebody have a look at it instead
+		fc.logger.WithContext(ctx).Error("risk assessment failed, order is held for review: ", err)
+		assessment = &domain.RiskAssessment{Decision: domain.RiskDecisionReview}
+	}
+
+	switch assessment.Decision {
+	case domain.RiskDecisionDecline:
+		return process.RunResult{
+			Failed: process.RiskDeclinedReason{Reasons: assessment.Reasons},
+		}
+	case domain.RiskDecisionReview:
+		customAttributes := make(map[string]string, len(cart.AdditionalData.CustomAttributes)+1)
+		for key, value := range cart.AdditionalData.CustomAttributes {
+			customAttributes[key] = value
+		}
+		customAttributes[placeorder.HoldForReviewAttribute] = "true"
+		cart.AdditionalData.CustomAttributes = customAttributes
+		p.UpdateCart(cart)
+	}
+
+	switch {
+	case p.Context().ResumeStateName != "":
+		p.Resume()
+	case cart.GrandTotal().IsZero():
+		p.UpdateState(CompleteCart{}.Name(), nil)
+	default:
+		p.UpdateState(ValidatePaymentSelection{}.Name(), nil)
+	}
+
+	return process.RunResult{}
+}
+
+// Rollback the state operations
+func (fc FraudCheck) Rollback(ctx context.Context, _ process.RollbackData) error {
+	return nil
+}
+
+// IsFinal if state is a final state
+func (fc FraudCheck) IsFinal() bool {
+	return false
+}
@@ -0,0 +1,108 @@
+package states_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+type riskAssessmentService struct {
+	assessment *domain.RiskAssessment
+	err        error
+}
+
+func (r *riskAssessmentService) Assess(context.Context, domain.RiskAssessmentRequest) (*domain.RiskAssessment, error) {
+	return r.assessment, r.err
+}
+
+func TestFraudCheck_IsFinal(t *testing.T) {
+	assert.False(t, states.FraudCheck{}.IsFinal())
+}
+
+func TestFraudCheck_Name(t *testing.T) {
+	assert.Equal(t, "FraudCheck", states.FraudCheck{}.Name())
+}
+
+func TestFraudCheck_Rollback(t *testing.T) {
+	s := states.FraudCheck{}
+	assert.Nil(t, s.Rollback(context.Background(), nil))
+}
+
+func TestFraudCheck_Run(t *testing.T) {
+	tests := []struct {
+		name           string
+		service        *riskAssessmentService
+		expectedResult process.RunResult
+		expectedState  string
+		expectedHeld   bool
+	}{
+		{
+			name:           "approve",
+			service:        &riskAssessmentService{assessment: &domain.RiskAssessment{Decision: domain.RiskDecisionApprove}},
+			expectedResult: process.RunResult{},
+			expectedState:  states.ValidatePaymentSelection{}.Name(),
+		},
+		{
+			name:           "review",
+			service:        &riskAssessmentService{assessment: &domain.RiskAssessment{Decision: domain.RiskDecisionReview}},
+			expectedResult: process.RunResult{},
+			expectedState:  states.ValidatePaymentSelection{}.Name(),
+			expectedHeld:   true,
+		},
+		{
+			name:           "risk assessment error",
+			service:        &riskAssessmentService{err: errors.New("provider not available")},
+			expectedResult: process.RunResult{},
+			expectedState:  states.ValidatePaymentSelection{}.Name(),
+			expectedHeld:   true,
+		},
+		{
+			name:    "decline",
+			service: &riskAssessmentService{assessment: &domain.RiskAssessment{Decision: domain.RiskDecisionDecline, Reasons: []string{"velocity_email"}}},
+			expectedResult: process.RunResult{
+				Failed: process.RiskDeclinedReason{Reasons: []string{"velocity_email"}},
+			},
+			expectedState: states.New{}.Name(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := provideProcessFactory(t)
+			p, _ := factory.New(&url.URL{}, cart.Cart{
+				AdditionalData: cart.AdditionalData{CustomAttributes: map[string]string{"key": "value"}},
+				Totalitems:     []cart.Totalitem{{Code: "total", Price: priceDomain.NewFromInt(100, 1, "EUR")}},
+			})
+
+			s := states.FraudCheck{}
+			s.Inject(tt.service, flamingo.NullLogger{}, nil)
+
+			result := s.Run(context.Background(), p)
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedState, p.Context().CurrentStateName)
+			processCart := p.Context().Cart
+			assert.Equal(t, tt.expectedHeld, placeorder.IsHeldForReview(&processCart))
+			assert.Equal(t, "value", processCart.AdditionalData.CustomAttributes["key"])
+		})
+	}
+}
+
+func TestFraudCheck_RunWithoutPayment(t *testing.T) {
+	p, _ := provideProcessFactory(t).New(&url.URL{}, cart.Cart{})
+
+	s := states.FraudCheck{}
+	s.Inject(&riskAssessmentService{assessment: &domain.RiskAssessment{Decision: domain.RiskDecisionApprove}}, flamingo.NullLogger{}, nil)
+
+	assert.Equal(t, process.RunResult{}, s.Run(context.Background(), p))
+	assert.Equal(t, states.CompleteCart{}.Name(), p.Context().CurrentStateName)
+}
@@ -4,26 +4,47 @@ import (
 	"context"
 
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	sourcingApplication "github.com/lunarforge/flamingo_commerce/sourcing/application"
 	"go.opencensus.io/trace"
 )
 
 type (
 	// Success state
-	Success struct{}
+	Success struct {
+		stockReservation sourcingApplication.StockReservationApplication
+	}
 )
 
 var _ process.State = Success{}
 
+// Inject dependencies
+func (s *Success) Inject(
+	optionals *struct {
+		StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+	},
+) *Success {
+	if optionals != nil {
+		s.stockReservation = optionals.StockReservation
+	}
+
+	return s
+}
+
 // Name get state name
 func (s Success) Name() string {
 	return "Success"
 }
 
-// Run the state operations
-func (s Success) Run(ctx context.Context, _ *process.Process) process.RunResult {
-	_, span := trace.StartSpan(ctx, "placeorder/state/Success/Run")
+// Run the state operations, keeps the stock reserved for the placed order
+func (s Success) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/Success/Run")
 	defer span.End()
 
+	if s.stockReservation != nil {
+		// the state is final, so a failing reservation update is only logged by the reservation service
+		_ = s.stockReservation.CommitReservation(ctx, p.Context().UUID)
+	}
+
 	return process.RunResult{}
 }
 
@@ -0,0 +1,44 @@
+package states
+
+import (
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+// DefaultTransitions returns the transitions between the states of this package, the Failed state is reachable from
+// every state that is not final and therefore not listed
+func DefaultTransitions() []process.Transition {
+	transitions := []process.Transition{
+		{From: New{}.Name(), To: PrepareCart{}.Name()},
+		{From: PrepareCart{}.Name(), To: ValidateCart{}.Name()},
+		{From: ValidateCart{}.Name(), To: ValidatePaymentSelection{}.Name()},
+		{From: ValidateCart{}.Name(), To: CompleteCart{}.Name()},
+		{From: ValidatePaymentSelection{}.Name(), To: CreatePayment{}.Name()},
+		{From: CreatePayment{}.Name(), To: CompleteCart{}.Name()},
+		{From: CompleteCart{}.Name(), To: PlaceOrder{}.Name()},
+		{From: PlaceOrder{}.Name(), To: ValidatePayment{}.Name()},
+		{From: PlaceOrder{}.Name(), To: Success{}.Name()},
+		{From: CompletePayment{}.Name(), To: ValidatePayment{}.Name()},
+	}
+
+	// all payment states decide over the next state with the payment validator
+	paymentStates := []string{
+		ValidatePayment{}.Name(),
+		PostRedirect{}.Name(),
+		ShowWalletPayment{}.Name(),
+		Redirect{}.Name(),
+		ShowHTML{}.Name(),
+		ShowIframe{}.Name(),
+		WaitForCustomer{}.Name(),
+	}
+	paymentTargets := []string{CompletePayment{}.Name(), Success{}.Name()}
+	paymentTargets = append(paymentTargets, paymentStates[1:]...)
+	for _, from := range paymentStates {
+		for _, to := range paymentTargets {
+			if from != to {
+				transitions = append(transitions, process.Transition{From: from, To: to})
+			}
+		}
+	}
+
+	return transitions
+}
@@ -2,28 +2,48 @@ package states
 
 import (
 	"context"
+	"encoding/gob"
+	"fmt"
 
 	"flamingo.me/flamingo/v3/framework/web"
 	"go.opencensus.io/trace"
 
 	"github.com/lunarforge/flamingo_commerce/cart/application"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	sourcingApplication "github.com/lunarforge/flamingo_commerce/sourcing/application"
+	sourcingDomain "github.com/lunarforge/flamingo_commerce/sourcing/domain"
 )
 
 type (
 	// ValidateCart state
 	ValidateCart struct {
-		cartService *application.CartService
+		cartService      *application.CartService
+		stockReservation sourcingApplication.StockReservationApplication
+	}
+
+	// StockReservationRollbackData needed to release the reserved stock on rollback
+	StockReservationRollbackData struct {
+		Reference string
 	}
 )
 
 var _ process.State = ValidateCart{}
 
+func init() {
+	gob.Register(StockReservationRollbackData{})
+}
+
 // Inject dependencies
 func (v *ValidateCart) Inject(
 	cartService *application.CartService,
+	optionals *struct {
+		StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+	},
 ) *ValidateCart {
 	v.cartService = cartService
+	if optionals != nil {
+		v.stockReservation = optionals.StockReservation
+	}
 
 	return v
 }
@@ -38,6 +58,9 @@ func (v ValidateCart) Run(ctx context.Context, p *process.Process) process.RunRe
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/ValidateCart/Run")
 	defer span.End()
 
+	// stock already reserved by this process belongs to the cart and must not restrict the validation
+	ctx = sourcingDomain.ContextWithStockReservationReference(ctx, p.Context().UUID)
+
 	result, err := v.cartService.ValidateCurrentCart(ctx, web.SessionFromContext(ctx))
 	if err != nil {
 		return process.RunResult{
@@ -53,18 +76,38 @@ func (v ValidateCart) Run(ctx context.Context, p *process.Process) process.RunRe
 		}
 	}
 
+	var rollbackData process.RollbackData
+	if v.stockReservation != nil {
+		err = v.stockReservation.ReserveCart(ctx, p.Context().UUID, p.Context().Cart)
+		if err != nil {
+			return process.RunResult{
+				Failed: process.ErrorOccurredReason{Error: err.Error()},
+			}
+		}
+		rollbackData = StockReservationRollbackData{Reference: p.Context().UUID}
+	}
+
 	if p.Context().Cart.GrandTotal().IsZero() {
 		p.UpdateState(CompleteCart{}.Name(), nil)
-		return process.RunResult{}
+		return process.RunResult{RollbackData: rollbackData}
 	}
 
 	p.UpdateState(ValidatePaymentSelection{}.Name(), nil)
-	return process.RunResult{}
+	return process.RunResult{RollbackData: rollbackData}
 }
 
-// Rollback the state operations
-func (v ValidateCart) Rollback(context.Context, process.RollbackData) error {
-	return nil
+// Rollback releases the reserved stock
+func (v ValidateCart) Rollback(ctx context.Context, data process.RollbackData) error {
+	if data == nil || v.stockReservation == nil {
+		return nil
+	}
+
+	rollbackData, ok := data.(StockReservationRollbackData)
+	if !ok {
+		return fmt.Errorf("rollback data not of expected type 'StockReservationRollbackData', but %T", data)
+	}
+
+	return v.stockReservation.ReleaseReservation(ctx, rollbackData.Reference)
 }
 
 // IsFinal if state is a final state
@@ -2,6 +2,8 @@ package states_test
 
 import (
 	"context"
+	"errors"
+	"net/url"
 	"testing"
 
 	"flamingo.me/flamingo/v3/framework/flamingo"
@@ -9,6 +11,7 @@ import (
 	"github.com/go-test/deep"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/lunarforge/flamingo_commerce/cart/application"
 	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
@@ -16,17 +19,104 @@ import (
 	"github.com/lunarforge/flamingo_commerce/cart/domain/decorator"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
+	checkoutDomain "github.com/lunarforge/flamingo_commerce/checkout/domain"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
+	orderDomain "github.com/lunarforge/flamingo_commerce/order/domain"
 	"github.com/lunarforge/flamingo_commerce/price/domain"
+	sourcingApplication "github.com/lunarforge/flamingo_commerce/sourcing/application"
 )
 
 type (
 	validator struct {
 		Valid bool
 	}
+
+	// recordingStockReservation records the references of the reserved, committed and released stock
+	recordingStockReservation struct {
+		reserveErr error
+		reserved   []string
+		committed  []string
+		released   []string
+	}
 )
 
+var _ sourcingApplication.StockReservationApplication = new(recordingStockReservation)
+
+func (r *recordingStockReservation) ReserveCart(_ context.Context, reference string, _ cartDomain.Cart) error {
+	if r.reserveErr != nil {
+		return r.reserveErr
+	}
+	r.reserved = append(r.reserved, reference)
+	return nil
+}
+
+func (r *recordingStockReservation) CommitReservation(_ context.Context, reference string) error {
+	r.committed = append(r.committed, reference)
+	return nil
+}
+
+func (r *recordingStockReservation) ReleaseReservation(_ context.Context, reference string) error {
+	r.released = append(r.released, reference)
+	return nil
+}
+
+func withStockReservation(stockReservation sourcingApplication.StockReservationApplication) *struct {
+	StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+} {
+	return &struct {
+		StockReservation sourcingApplication.StockReservationApplication `inject:",optional"`
+	}{StockReservation: stockReservation}
+}
+
+// newValidatingCartService returns a cart service whose validation result is valid or not
+func newValidatingCartService(valid bool) *application.CartService {
+	cartReceiverService := &application.CartReceiverService{}
+	guestCartService := new(mocks.GuestCartService)
+	guestCartService.On("GetNewCart", mock.Anything).Return(&cartDomain.Cart{ID: "mock_guest_cart"}, nil)
+	cartReceiverService.Inject(
+		guestCartService,
+		new(mocks.CustomerCartService),
+		func() *decorator.DecoratedCartFactory {
+			result := &decorator.DecoratedCartFactory{}
+			result.Inject(
+				nil,
+				flamingo.NullLogger{},
+				nil,
+			)
+
+			return result
+		}(),
+		nil,
+		new(flamingo.NullLogger),
+		nil,
+		nil,
+	)
+
+	cartService := &application.CartService{}
+	cartService.Inject(
+		cartReceiverService,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		new(flamingo.NullLogger),
+		nil,
+		&struct {
+			CartValidator     validation.Validator                     `inject:",optional"`
+			ItemValidator     validation.ItemValidator                 `inject:",optional"`
+			CartCache         application.CartCache                    `inject:",optional"`
+			PlaceOrderService placeorder.Service                       `inject:",optional"`
+			CouponPolicy      application.CouponPolicy                 `inject:",optional"`
+			OrderService      orderDomain.CustomerIdentityOrderService `inject:",optional"`
+		}{CartValidator: &validator{Valid: valid}},
+	)
+
+	return cartService
+}
+
 func (v *validator) Validate(_ context.Context, _ *web.Session, _ *decorator.DecoratedCart) validation.Result {
 	return validation.Result{HasCommonError: !v.Valid}
 }
@@ -39,6 +129,17 @@ func TestValidateCart_Name(t *testing.T) {
 func TestValidateCart_Rollback(t *testing.T) {
 	s := states.ValidateCart{}
 	assert.Nil(t, s.Rollback(context.Background(), nil))
+
+	t.Run("rollback releases the reserved stock", func(t *testing.T) {
+		stockReservation := new(recordingStockReservation)
+		state := new(states.ValidateCart).Inject(nil, withStockReservation(stockReservation))
+
+		assert.NoError(t, state.Rollback(context.Background(), states.StockReservationRollbackData{Reference: "process-uuid"}))
+		assert.Equal(t, []string{"process-uuid"}, stockReservation.released)
+
+		assert.Error(t, state.Rollback(context.Background(), "unexpected"))
+		assert.Len(t, stockReservation.released, 1)
+	})
 }
 
 func TestValidateCart_Run(t *testing.T) {
@@ -79,49 +180,9 @@ func TestValidateCart_Run(t *testing.T) {
 		},
 	}
 
-	// global service setup
-	cartReceiverService := &application.CartReceiverService{}
-	guestCartService := new(mocks.GuestCartService)
-	guestCartService.On("GetNewCart", mock.Anything).Return(&cartDomain.Cart{ID: "mock_guest_cart"}, nil)
-	cartReceiverService.Inject(
-		guestCartService,
-		new(mocks.CustomerCartService),
-		func() *decorator.DecoratedCartFactory {
-			result := &decorator.DecoratedCartFactory{}
-			result.Inject(
-				nil,
-				flamingo.NullLogger{},
-			)
-
-			return result
-		}(),
-		nil,
-		new(flamingo.NullLogger),
-		nil,
-		nil,
-	)
-
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cartService := application.CartService{}
-			cartService.Inject(
-				cartReceiverService,
-				nil,
-				nil,
-				nil,
-				nil,
-				nil,
-				nil,
-				new(flamingo.NullLogger),
-				nil,
-				&struct {
-					CartValidator     validation.Validator     `inject:",optional"`
-					ItemValidator     validation.ItemValidator `inject:",optional"`
-					CartCache         application.CartCache    `inject:",optional"`
-					PlaceOrderService placeorder.Service       `inject:",optional"`
-				}{CartValidator: &validator{Valid: tt.isValid}, ItemValidator: nil, CartCache: nil, PlaceOrderService: nil},
-			)
-			state := new(states.ValidateCart).Inject(&cartService)
+			state := new(states.ValidateCart).Inject(newValidatingCartService(tt.isValid), nil)
 			p := &process.Process{}
 			cart := cartDomain.Cart{
 				ID:       "cart-id",
@@ -168,3 +229,143 @@ func TestValidateCart_IsFinal(t *testing.T) {
 	state := states.ValidateCart{}
 	assert.False(t, state.IsFinal())
 }
+
+func TestValidateCart_RunReservesStock(t *testing.T) {
+	cart := cartDomain.Cart{
+		ID: "cart-id",
+		Deliveries: []cartDomain.Delivery{
+			{
+				Cartitems: []cartDomain.Item{
+					{
+						ID:               "1",
+						Qty:              1,
+						SinglePriceGross: domain.NewFromInt(1, 1, "EUR"),
+						RowPriceGross:    domain.NewFromInt(1, 1, "EUR"),
+						RowPriceNet:      domain.NewFromInt(1, 1, "EUR"),
+						SinglePriceNet:   domain.NewFromInt(1, 1, "EUR"),
+					},
+				},
+			},
+		},
+	}
+	ctx := web.ContextWithSession(context.Background(), web.EmptySession())
+
+	t.Run("valid cart", func(t *testing.T) {
+		stockReservation := new(recordingStockReservation)
+		state := new(states.ValidateCart).Inject(newValidatingCartService(true), withStockReservation(stockReservation))
+		p, err := provideProcessFactory(t).New(&url.URL{}, cart)
+		require.NoError(t, err)
+
+		result := state.Run(ctx, p)
+
+		assert.Nil(t, result.Failed)
+		assert.Equal(t, states.StockReservationRollbackData{Reference: p.Context().UUID}, result.RollbackData)
+		assert.Equal(t, []string{p.Context().UUID}, stockReservation.reserved)
+		assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().CurrentStateName)
+	})
+
+	t.Run("invalid cart is not reserved", func(t *testing.T) {
+		stockReservation := new(recordingStockReservation)
+		state := new(states.ValidateCart).Inject(newValidatingCartService(false), withStockReservation(stockReservation))
+		p, err := provideProcessFactory(t).New(&url.URL{}, cart)
+		require.NoError(t, err)
+
+		result := state.Run(ctx, p)
+
+		assert.NotNil(t, result.Failed)
+		assert.Empty(t, stockReservation.reserved)
+	})
+
+	t.Run("failing reservation", func(t *testing.T) {
+		stockReservation := &recordingStockReservation{reserveErr: errors.New("insufficient stock")}
+		state := new(states.ValidateCart).Inject(newValidatingCartService(true), withStockReservation(stockReservation))
+		p, err := provideProcessFactory(t).New(&url.URL{}, cart)
+		require.NoError(t, err)
+
+		result := state.Run(ctx, p)
+
+		assert.Equal(t, process.ErrorOccurredReason{Error: "insufficient stock"}, result.Failed)
+		assert.Nil(t, result.RollbackData)
+	})
+}
+
+func TestValidateCart_RunWithFraudCheck(t *testing.T) {
+	fraudCheck := new(states.FraudCheck).Inject(
+		&riskAssessmentService{assessment: &checkoutDomain.RiskAssessment{Decision: checkoutDomain.RiskDecisionApprove}},
+		flamingo.NullLogger{},
+		nil,
+	)
+
+	allStates := map[string]process.State{fraudCheck.Name(): fraudCheck}
+	for _, state := range []process.State{
+		states.New{}, states.PrepareCart{}, states.ValidateCart{}, states.ValidatePaymentSelection{}, states.CreatePayment{},
+		states.CompleteCart{}, states.PlaceOrder{}, states.ValidatePayment{}, states.CompletePayment{}, states.PostRedirect{},
+		states.ShowWalletPayment{}, states.Redirect{}, states.ShowHTML{}, states.ShowIframe{}, states.WaitForCustomer{},
+		states.Success{}, states.Failed{},
+	} {
+		allStates[state.Name()] = state
+	}
+
+	table := new(process.TransitionTable).Inject(allStates, &struct {
+		StartState  process.State            `inject:"startState"`
+		FailedState process.State            `inject:"failedState"`
+		Transitions []process.Transition     `inject:",optional"`
+		Rules       []process.TransitionRule `inject:",optional"`
+	}{
+		StartState:  states.New{},
+		FailedState: states.Failed{},
+		Transitions: states.DefaultTransitions(),
+		Rules:       []process.TransitionRule{{State: fraudCheck.Name(), After: states.ValidateCart{}.Name()}},
+	})
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return &process.Process{}
+		},
+		&struct {
+			StartState      process.State            `inject:"startState"`
+			FailedState     process.State            `inject:"failedState"`
+			TransitionTable *process.TransitionTable `inject:",optional"`
+			EventRouter     flamingo.EventRouter     `inject:",optional"`
+		}{
+			StartState:      states.New{},
+			FailedState:     states.Failed{},
+			TransitionTable: table,
+		},
+	)
+
+	cart := cartDomain.Cart{
+		ID: "cart-id",
+		Deliveries: []cartDomain.Delivery{
+			{
+				Cartitems: []cartDomain.Item{
+					{
+						ID:               "1",
+						Qty:              1,
+						SinglePriceGross: domain.NewFromInt(1, 1, "EUR"),
+						RowPriceGross:    domain.NewFromInt(1, 1, "EUR"),
+						RowPriceNet:      domain.NewFromInt(1, 1, "EUR"),
+						SinglePriceNet:   domain.NewFromInt(1, 1, "EUR"),
+					},
+				},
+			},
+		},
+	}
+	p, err := factory.New(&url.URL{}, cart)
+	require.NoError(t, err)
+	p.UpdateState(states.ValidateCart{}.Name(), nil)
+
+	ctx := web.ContextWithSession(context.Background(), web.EmptySession())
+	result := new(states.ValidateCart).Inject(newValidatingCartService(true), nil).Run(ctx, p)
+	require.Nil(t, result.Failed)
+
+	// the fraud check runs before the payment selection is validated
+	assert.Equal(t, fraudCheck.Name(), p.Context().CurrentStateName)
+	assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().ResumeStateName)
+
+	result = fraudCheck.Run(ctx, p)
+	require.Nil(t, result.Failed)
+	assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().CurrentStateName)
+	assert.Empty(t, p.Context().ResumeStateName)
+}
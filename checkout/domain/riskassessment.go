@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+type (
+	// RiskAssessmentService assesses the fraud risk of an order before it is placed - Secondary PORT
+	RiskAssessmentService interface {
+		Assess(ctx context.Context, request RiskAssessmentRequest) (*RiskAssessment, error)
+	}
+
+	// RiskAssessmentRequest contains the order data to assess
+	RiskAssessmentRequest struct {
+		Cart              cartDomain.Cart
+		BillingAddress    *cartDomain.Address
+		DeliveryAddresses []cartDomain.Address
+		PaymentSelection  cartDomain.PaymentSelection
+		Metadata          RequestMetadata
+	}
+
+	// RequestMetadata of the customer request, empty if the process runs without a customer request (e.g. on payment notifications)
+	RequestMetadata struct {
+		IP             string
+		UserAgent      string
+		AcceptLanguage string
+	}
+
+	// RiskDecision is the outcome of a risk assessment
+	RiskDecision string
+
+	// RiskAssessment is the result of the RiskAssessmentService
+	RiskAssessment struct {
+		Decision RiskDecision
+		// Reasons explain the decision, e.g. the triggered rules
+		Reasons []string
+		// Reference of the assessment at the risk provider, optional
+		Reference string
+	}
+)
+
+const (
+	// RiskDecisionApprove the order can be placed
+	RiskDecisionApprove RiskDecision = "approve"
+	// RiskDecisionReview the order is placed but held for a manual review
+	RiskDecisionReview RiskDecision = "review"
+	// RiskDecisionDecline the order must not be placed
+	RiskDecisionDecline RiskDecision = "decline"
+)
+
+// NewRiskAssessmentRequest collects the data of the cart and the current customer request, trustedProxies is the number
+// of proxies in front of the application whose X-Forwarded-For entries are trusted
+func NewRiskAssessmentRequest(ctx context.Context, cart cartDomain.Cart, trustedProxies int) RiskAssessmentRequest {
+	request := RiskAssessmentRequest{
+		Cart:             cart,
+		BillingAddress:   cart.BillingAddress,
+		PaymentSelection: cart.PaymentSelection,
+	}
+
+	for _, delivery := range cart.Deliveries {
+		if delivery.DeliveryInfo.DeliveryLocation.Address != nil {
+			request.DeliveryAddresses = append(request.DeliveryAddresses, *delivery.DeliveryInfo.DeliveryLocation.Address)
+		}
+	}
+
+	if r := web.RequestFromContext(ctx); r != nil && r.Request() != nil {
+		httpRequest := r.Request()
+		request.Metadata = RequestMetadata{
+			IP:             clientIP(httpRequest.Header["X-Forwarded-For"], httpRequest.RemoteAddr, trustedProxies),
+			UserAgent:      httpRequest.UserAgent(),
+			AcceptLanguage: httpRequest.Header.Get("Accept-Language"),
+		}
+	}
+
+	return request
+}
+
+// clientIP returns the address of the client as seen by the outermost trusted proxy. Every proxy appends the address
+// it received the request from to the X-Forwarded-For header, so only the last trustedProxies entries are reliable,
+// entries in front of them may be forged by the client.
+func clientIP(forwardedFor []string, remoteAddr string, trustedProxies int) string {
+	var hops []string
+	for _, header := range forwardedFor {
+		for _, hop := range strings.Split(header, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	hops = append(hops, host)
+
+	if trustedProxies < 0 {
+		trustedProxies = 0
+	}
+	index := len(hops) - 1 - trustedProxies
+	if index < 0 {
+		index = 0
+	}
+
+	return hops[index]
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		forwardedFor   []string
+		remoteAddr     string
+		trustedProxies int
+		want           string
+	}{
+		{
+			name:       "no proxy",
+			remoteAddr: "192.0.2.1:1234",
+			want:       "192.0.2.1",
+		},
+		{
+			name:         "forwarded header is ignored without trusted proxies",
+			forwardedFor: []string{"198.51.100.7"},
+			remoteAddr:   "192.0.2.1:1234",
+			want:         "192.0.2.1",
+		},
+		{
+			name:           "one trusted proxy",
+			forwardedFor:   []string{"198.51.100.7"},
+			remoteAddr:     "10.0.0.1:1234",
+			trustedProxies: 1,
+			want:           "198.51.100.7",
+		},
+		{
+			name:           "forged entries in front of the trusted proxy",
+			forwardedFor:   []string{"203.0.113.9, 198.51.100.7"},
+			remoteAddr:     "10.0.0.1:1234",
+			trustedProxies: 1,
+			want:           "198.51.100.7",
+		},
+		{
+			name:           "two trusted proxies with multiple headers",
+			forwardedFor:   []string{"203.0.113.9", "198.51.100.7, 10.0.0.2"},
+			remoteAddr:     "10.0.0.1:1234",
+			trustedProxies: 2,
+			want:           "198.51.100.7",
+		},
+		{
+			name:           "more trusted proxies than hops",
+			forwardedFor:   []string{"198.51.100.7"},
+			remoteAddr:     "10.0.0.1:1234",
+			trustedProxies: 5,
+			want:           "198.51.100.7",
+		},
+		{
+			name:       "remote address without port",
+			remoteAddr: "192.0.2.1",
+			want:       "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientIP(tt.forwardedFor, tt.remoteAddr, tt.trustedProxies))
+		})
+	}
+}
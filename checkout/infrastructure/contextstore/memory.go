@@ -12,14 +12,19 @@ type (
 	Memory struct {
 		mx      sync.RWMutex
 		storage map[string]process.Context
+		uuids   map[string]string
 	}
 )
 
-var _ process.ContextStore = new(Memory)
+var (
+	_ process.UUIDContextStore     = new(Memory)
+	_ process.ListableContextStore = new(Memory)
+)
 
 // Inject dependencies
 func (m *Memory) Inject() *Memory {
 	m.storage = make(map[string]process.Context)
+	m.uuids = make(map[string]string)
 
 	return m
 }
@@ -28,7 +33,11 @@ func (m *Memory) Inject() *Memory {
 func (m *Memory) Store(_ context.Context, key string, value process.Context) error {
 	m.mx.Lock()
 	defer m.mx.Unlock()
+	if previous, ok := m.storage[key]; ok && previous.UUID != value.UUID {
+		delete(m.uuids, previous.UUID)
+	}
 	m.storage[key] = value
+	m.uuids[value.UUID] = key
 
 	return nil
 }
@@ -42,10 +51,38 @@ func (m *Memory) Get(_ context.Context, key string) (process.Context, bool) {
 	return value, ok
 }
 
+// GetByUUID returns the key and the stored context of the process with the given uuid
+func (m *Memory) GetByUUID(_ context.Context, uuid string) (string, process.Context, bool) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	key, ok := m.uuids[uuid]
+	if !ok {
+		return "", process.Context{}, false
+	}
+	value, ok := m.storage[key]
+
+	return key, value, ok
+}
+
+// Keys returns the keys of all stored contexts
+func (m *Memory) Keys(_ context.Context) ([]string, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	keys := make([]string, 0, len(m.storage))
+	for key := range m.storage {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
 // Delete a stored context, nop if it doesn't exist
 func (m *Memory) Delete(_ context.Context, key string) error {
 	m.mx.Lock()
 	defer m.mx.Unlock()
+	if value, ok := m.storage[key]; ok {
+		delete(m.uuids, value.UUID)
+	}
 	delete(m.storage, key)
 
 	return nil
@@ -0,0 +1,48 @@
+package contextstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/contextstore"
+)
+
+func TestMemory_GetByUUID(t *testing.T) {
+	ctx := context.Background()
+	store := new(contextstore.Memory).Inject()
+
+	require.NoError(t, store.Store(ctx, "session", process.Context{UUID: "first"}))
+
+	key, pctx, found := store.GetByUUID(ctx, "first")
+	assert.True(t, found)
+	assert.Equal(t, "session", key)
+	assert.Equal(t, "first", pctx.UUID)
+
+	// a new process for the same key replaces the old uuid
+	require.NoError(t, store.Store(ctx, "session", process.Context{UUID: "second"}))
+	_, _, found = store.GetByUUID(ctx, "first")
+	assert.False(t, found)
+	key, _, found = store.GetByUUID(ctx, "second")
+	assert.True(t, found)
+	assert.Equal(t, "session", key)
+
+	require.NoError(t, store.Delete(ctx, "session"))
+	_, _, found = store.GetByUUID(ctx, "second")
+	assert.False(t, found)
+}
+
+func TestMemory_Keys(t *testing.T) {
+	ctx := context.Background()
+	store := new(contextstore.Memory).Inject()
+
+	require.NoError(t, store.Store(ctx, "first", process.Context{UUID: "first"}))
+	require.NoError(t, store.Store(ctx, "second", process.Context{UUID: "second"}))
+
+	keys, err := store.Keys(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"first", "second"}, keys)
+}
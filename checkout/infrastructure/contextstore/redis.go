@@ -22,12 +22,18 @@ type (
 	Redis struct {
 		pool   *redis.Pool
 		logger flamingo.Logger
+		// uuidExpiration of the uuid mappings, refreshed with every store of the context
+		uuidExpiration time.Duration
 	}
 )
 
+// uuidKeyPrefix prefixes the keys which map a process uuid to the key of the stored context
+const uuidKeyPrefix = "placeorder_uuid_"
+
 var (
-	_ process.ContextStore = new(Redis)
-	_ healthcheck.Status   = &Redis{}
+	_ process.UUIDContextStore     = new(Redis)
+	_ process.ListableContextStore = new(Redis)
+	_ healthcheck.Status           = &Redis{}
 	// ErrNoRedisConnection is returned if the underlying connection is erroneous
 	ErrNoRedisConnection = errors.New("no redis connection, see healthcheck")
 )
@@ -45,9 +51,11 @@ func (r *Redis) Inject(
 		Network                 string `inject:"config:commerce.checkout.placeorder.contextstore.redis.network"`
 		Address                 string `inject:"config:commerce.checkout.placeorder.contextstore.redis.address"`
 		Database                int    `inject:"config:commerce.checkout.placeorder.contextstore.redis.database"`
+		UUIDExpirationSeconds   int    `inject:"config:commerce.checkout.placeorder.contextstore.uuidExpirationSeconds"`
 	}) *Redis {
 	r.logger = logger
 	if cfg != nil {
+		r.uuidExpiration = time.Duration(cfg.UUIDExpirationSeconds) * time.Second
 		r.pool = &redis.Pool{
 			MaxIdle:     cfg.MaxIdle,
 			IdleTimeout: time.Duration(cfg.IdleTimeoutMilliseconds) * time.Millisecond,
@@ -81,11 +89,20 @@ func (r *Redis) Store(ctx context.Context, key string, placeOrderContext process
 	if err != nil {
 		return err
 	}
-	_, err = conn.Do(
-		"SET",
-		key,
-		buffer,
-	)
+	// the key may contain the context of a previous process whose uuid mapping is outdated now
+	previous, hasPrevious := r.get(conn, key)
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", key, buffer)
+	if r.uuidExpiration > 0 {
+		_ = conn.Send("SET", uuidKeyPrefix+placeOrderContext.UUID, key, "EX", int(r.uuidExpiration.Seconds()))
+	} else {
+		_ = conn.Send("SET", uuidKeyPrefix+placeOrderContext.UUID, key)
+	}
+	if hasPrevious && previous.UUID != placeOrderContext.UUID {
+		_ = conn.Send("DEL", uuidKeyPrefix+previous.UUID)
+	}
+	_, err = conn.Do("EXEC")
 
 	return err
 }
@@ -100,6 +117,80 @@ func (r *Redis) Get(ctx context.Context, key string) (process.Context, bool) {
 		r.logger.Error("placeorder/contextstore/Get:", conn.Err())
 	}
 
+	return r.get(conn, key)
+}
+
+// GetByUUID returns the key and the stored context of the process with the given uuid
+func (r *Redis) GetByUUID(ctx context.Context, uuid string) (string, process.Context, bool) {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/GetByUUID")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/GetByUUID:", conn.Err())
+	}
+
+	key, err := redis.String(conn.Do("GET", uuidKeyPrefix+uuid))
+	if err != nil {
+		return "", process.Context{}, false
+	}
+
+	pctx, found := r.get(conn, key)
+	// the key may already contain the context of a newer process
+	if !found || pctx.UUID != uuid {
+		return "", process.Context{}, false
+	}
+
+	return key, pctx, true
+}
+
+// Keys returns the keys of all stored contexts, the keys are found via the uuid mapping
+// so contexts stored before the mapping was introduced or not stored again within the uuid expiration are not listed
+func (r *Redis) Keys(ctx context.Context) ([]string, error) {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/Keys")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/Keys:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	var uuidKeys []string
+	cursor := 0
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", uuidKeyPrefix+"*", "COUNT", 100))
+		if err != nil {
+			return nil, err
+		}
+
+		var batch []string
+		_, err = redis.Scan(values, &cursor, &batch)
+		if err != nil {
+			return nil, err
+		}
+		uuidKeys = append(uuidKeys, batch...)
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	seen := make(map[string]bool, len(uuidKeys))
+	keys := make([]string, 0, len(uuidKeys))
+	for _, uuidKey := range uuidKeys {
+		key, err := redis.String(conn.Do("GET", uuidKey))
+		if err != nil || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (r *Redis) get(conn redis.Conn, key string) (process.Context, bool) {
 	content, err := redis.Bytes(conn.Do("GET", key))
 	if err != nil {
 		return process.Context{}, false
@@ -127,6 +218,11 @@ func (r *Redis) Delete(ctx context.Context, key string) error {
 		return ErrNoRedisConnection
 	}
 
+	if pctx, found := r.get(conn, key); found {
+		_, err := conn.Do("DEL", key, uuidKeyPrefix+pctx.UUID)
+		return err
+	}
+
 	_, err := conn.Do("DEL", key)
 
 	return err
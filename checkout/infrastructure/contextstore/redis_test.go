@@ -40,7 +40,8 @@ func getRedisStore(network, address string) *contextstore.Redis {
 			Network                 string `inject:"config:commerce.checkout.placeorder.contextstore.redis.network"`
 			Address                 string `inject:"config:commerce.checkout.placeorder.contextstore.redis.address"`
 			Database                int    `inject:"config:commerce.checkout.placeorder.contextstore.redis.database"`
-		}{MaxIdle: 3, IdleTimeoutMilliseconds: 240000, Network: network, Address: address, Database: 0})
+			UUIDExpirationSeconds   int    `inject:"config:commerce.checkout.placeorder.contextstore.uuidExpirationSeconds"`
+		}{MaxIdle: 3, IdleTimeoutMilliseconds: 240000, Network: network, Address: address, Database: 0, UUIDExpirationSeconds: 3600})
 }
 
 func prepareData(t *testing.T, conn redis.Conn) {
@@ -156,6 +157,54 @@ func TestRedis_Get(t *testing.T) {
 
 }
 
+func TestRedis_GetByUUID(t *testing.T) {
+	runTestCases := func(t *testing.T, store *contextstore.Redis, conn redis.Conn) {
+		ctx := context.Background()
+		require.NoError(t, store.Store(ctx, "uuid_key", process.Context{UUID: "first"}))
+
+		key, got, ok := store.GetByUUID(ctx, "first")
+		assert.True(t, ok)
+		assert.Equal(t, "uuid_key", key)
+		assert.Equal(t, "first", got.UUID)
+
+		ttl, err := redis.Int(conn.Do("TTL", "placeorder_uuid_first"))
+		require.NoError(t, err)
+		assert.True(t, ttl > 0 && ttl <= 3600, "the uuid mapping expires")
+
+		require.NoError(t, store.Store(ctx, "uuid_key", process.Context{UUID: "second"}))
+		_, _, ok = store.GetByUUID(ctx, "first")
+		assert.False(t, ok, "outdated uuid must not be found")
+		exists, err := redis.Bool(conn.Do("EXISTS", "placeorder_uuid_first"))
+		require.NoError(t, err)
+		assert.False(t, exists, "the mapping of the replaced process is deleted")
+
+		require.NoError(t, store.Delete(ctx, "uuid_key"))
+		_, _, ok = store.GetByUUID(ctx, "second")
+		assert.False(t, ok, "uuid of deleted context must not be found")
+
+		_, _, ok = store.GetByUUID(ctx, "non")
+		assert.False(t, ok)
+	}
+
+	t.Run("local-redis", func(t *testing.T) {
+		if _, err := exec.LookPath("redis-server"); err != nil {
+			t.Skip("redis-server not installed")
+		}
+		server, conn := startUpLocalRedis(t)
+		store := getRedisStore("unix", server.Socket())
+		runTestCases(t, store, conn)
+	})
+	t.Run("docker-redis", func(t *testing.T) {
+		if _, err := exec.LookPath("docker"); err != nil {
+			t.Skip("docker not installed")
+		}
+		shutdown, address, conn := startUpDockerRedis(t)
+		defer shutdown()
+		store := getRedisStore("tcp", address)
+		runTestCases(t, store, conn)
+	})
+}
+
 func TestRedis_Store(t *testing.T) {
 	runTestCases := func(t *testing.T, store *contextstore.Redis, conn redis.Conn) {
 		tests := []struct {
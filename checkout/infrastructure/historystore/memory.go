@@ -0,0 +1,100 @@
+package historystore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+type (
+	// Memory saves the history of all processes in a simple map, the history of a process expires like in the redis
+	// store after the configured time without new entries
+	Memory struct {
+		mx         sync.RWMutex
+		entries    map[string][]process.HistoryEntry
+		expiration time.Duration
+		now        func() time.Time
+		// lastAppend is the time of the latest entry per process
+		lastAppend map[string]time.Time
+		// appends lists the processes in the order of their entries, used to find expired processes without scanning all of them
+		appends []memoryAppend
+	}
+
+	memoryAppend struct {
+		uuid string
+		time time.Time
+	}
+)
+
+var _ process.HistoryStore = new(Memory)
+
+// Inject dependencies
+func (m *Memory) Inject(
+	cfg *struct {
+		ExpirationSeconds int `inject:"config:commerce.checkout.placeorder.auditlog.expirationSeconds"`
+	},
+) *Memory {
+	m.entries = make(map[string][]process.HistoryEntry)
+	m.lastAppend = make(map[string]time.Time)
+	m.now = time.Now
+
+	if cfg != nil {
+		m.expiration = time.Duration(cfg.ExpirationSeconds) * time.Second
+	}
+
+	return m
+}
+
+// Append an entry to the history of its process, the expiration of the history is renewed with every entry
+func (m *Memory) Append(_ context.Context, entry process.HistoryEntry) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	now := m.now()
+	m.expire(now)
+
+	m.entries[entry.UUID] = append(m.entries[entry.UUID], entry)
+	if m.expiration > 0 {
+		m.lastAppend[entry.UUID] = now
+		m.appends = append(m.appends, memoryAppend{uuid: entry.UUID, time: now})
+	}
+
+	return nil
+}
+
+// History returns the entries of the process in chronological order
+func (m *Memory) History(_ context.Context, uuid string) ([]process.HistoryEntry, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.expire(m.now())
+	entries := make([]process.HistoryEntry, len(m.entries[uuid]))
+	copy(entries, m.entries[uuid])
+
+	return entries, nil
+}
+
+// expire drops the history of processes without entries within the expiration, the caller must hold the lock
+func (m *Memory) expire(now time.Time) {
+	if m.expiration <= 0 {
+		return
+	}
+
+	expired := 0
+	for _, appended := range m.appends {
+		if now.Sub(appended.time) < m.expiration {
+			break
+		}
+		expired++
+
+		// later entries renewed the expiration of the process
+		if m.lastAppend[appended.uuid].Equal(appended.time) {
+			delete(m.entries, appended.uuid)
+			delete(m.lastAppend, appended.uuid)
+		}
+	}
+
+	m.appends = m.appends[expired:]
+}
@@ -0,0 +1,38 @@
+package historystore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/historystore"
+)
+
+func TestMemory_History(t *testing.T) {
+	ctx := context.Background()
+	store := new(historystore.Memory).Inject(nil)
+
+	require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: "first", FromState: "New", ToState: "CreatePayment"}))
+	require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: "second", FromState: "New", ToState: "CreatePayment"}))
+	require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: "first", FromState: "CreatePayment", ToState: "Failed", FailedReason: "error"}))
+
+	history, err := store.History(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, []process.HistoryEntry{
+		{UUID: "first", FromState: "New", ToState: "CreatePayment"},
+		{UUID: "first", FromState: "CreatePayment", ToState: "Failed", FailedReason: "error"},
+	}, history)
+
+	// the returned history is a copy
+	history[0].ToState = "changed"
+	history, err = store.History(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, "CreatePayment", history[0].ToState)
+
+	history, err = store.History(ctx, "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
@@ -0,0 +1,54 @@
+package historystore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+func TestMemory_Expiration(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+	now := start
+	store := new(Memory).Inject(&struct {
+		ExpirationSeconds int `inject:"config:commerce.checkout.placeorder.auditlog.expirationSeconds"`
+	}{ExpirationSeconds: 3600})
+	store.now = func() time.Time { return now }
+
+	appendAt := func(uuid string, at time.Duration) {
+		now = start.Add(at)
+		require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: uuid, ToState: "New"}))
+	}
+
+	appendAt("expired", 0)
+	appendAt("renewed", 0)
+	appendAt("renewed", 30*time.Minute)
+	appendAt("recent", 50*time.Minute)
+
+	now = start.Add(61 * time.Minute)
+	history, err := store.History(ctx, "expired")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	history, err = store.History(ctx, "renewed")
+	require.NoError(t, err)
+	assert.Len(t, history, 2, "every entry renews the expiration of the process")
+
+	now = start.Add(91 * time.Minute)
+	history, err = store.History(ctx, "renewed")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	history, err = store.History(ctx, "recent")
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+
+	assert.NotContains(t, store.entries, "expired")
+	assert.NotContains(t, store.entries, "renewed")
+	assert.Len(t, store.appends, 1, "only the append of the recent process is kept")
+}
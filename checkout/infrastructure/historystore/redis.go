@@ -0,0 +1,137 @@
+package historystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"runtime"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/gomodule/redigo/redis"
+	"go.opencensus.io/trace"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+)
+
+type (
+	// Redis saves the history of each process in a redis list
+	Redis struct {
+		pool       *redis.Pool
+		logger     flamingo.Logger
+		expiration time.Duration
+	}
+)
+
+// keyPrefix prefixes the keys of the history lists, followed by the process uuid
+const keyPrefix = "placeorder_history_"
+
+var (
+	_ process.HistoryStore = new(Redis)
+	_ healthcheck.Status   = &Redis{}
+	// ErrNoRedisConnection is returned if the underlying connection is erroneous
+	ErrNoRedisConnection = errors.New("no redis connection, see healthcheck")
+)
+
+// Inject dependencies
+func (r *Redis) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		MaxIdle                 int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.maxIdle"`
+		IdleTimeoutMilliseconds int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.idleTimeoutMilliseconds"`
+		Network                 string `inject:"config:commerce.checkout.placeorder.auditlog.redis.network"`
+		Address                 string `inject:"config:commerce.checkout.placeorder.auditlog.redis.address"`
+		Database                int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.database"`
+		ExpirationSeconds       int    `inject:"config:commerce.checkout.placeorder.auditlog.expirationSeconds"`
+	}) *Redis {
+	r.logger = logger
+	if cfg != nil {
+		r.expiration = time.Duration(cfg.ExpirationSeconds) * time.Second
+		r.pool = &redis.Pool{
+			MaxIdle:     cfg.MaxIdle,
+			IdleTimeout: time.Duration(cfg.IdleTimeoutMilliseconds) * time.Millisecond,
+			TestOnBorrow: func(c redis.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial(cfg.Network, cfg.Address, redis.DialDatabase(cfg.Database))
+			},
+		}
+		runtime.SetFinalizer(r, func(r *Redis) { r.pool.Close() }) // close all connections on destruction
+	}
+
+	return r
+}
+
+// Append an entry to the history of its process, the expiration of the history is renewed with every entry
+func (r *Redis) Append(ctx context.Context, entry process.HistoryEntry) error {
+	_, span := trace.StartSpan(ctx, "placeorder/historystore/Append")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/historystore/Append:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	buffer := new(bytes.Buffer)
+	err := gob.NewEncoder(buffer).Encode(entry)
+	if err != nil {
+		return err
+	}
+
+	key := keyPrefix + entry.UUID
+	_ = conn.Send("MULTI")
+	_ = conn.Send("RPUSH", key, buffer.Bytes())
+	if r.expiration > 0 {
+		_ = conn.Send("EXPIRE", key, int(r.expiration.Seconds()))
+	}
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+// History returns the entries of the process in chronological order, entries that can't be decoded are skipped
+func (r *Redis) History(ctx context.Context, uuid string) ([]process.HistoryEntry, error) {
+	_, span := trace.StartSpan(ctx, "placeorder/historystore/History")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/historystore/History:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	values, err := redis.ByteSlices(conn.Do("LRANGE", keyPrefix+uuid, 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]process.HistoryEntry, 0, len(values))
+	for _, value := range values {
+		var entry process.HistoryEntry
+		if err := gob.NewDecoder(bytes.NewBuffer(value)).Decode(&entry); err != nil {
+			r.logger.Error("placeorder/historystore/History: entry not decodable: ", err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Status handles the health check of redis
+func (r *Redis) Status() (alive bool, details string) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("PING")
+	if err == nil {
+		return true, "redis for place order audit log replies to PING"
+	}
+
+	return false, err.Error()
+}
@@ -0,0 +1,63 @@
+package historystore_test
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stvp/tempredis"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/historystore"
+)
+
+func getRedisStore(network, address string) *historystore.Redis {
+	return new(historystore.Redis).Inject(
+		new(flamingo.NullLogger),
+		&struct {
+			MaxIdle                 int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.maxIdle"`
+			IdleTimeoutMilliseconds int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.idleTimeoutMilliseconds"`
+			Network                 string `inject:"config:commerce.checkout.placeorder.auditlog.redis.network"`
+			Address                 string `inject:"config:commerce.checkout.placeorder.auditlog.redis.address"`
+			Database                int    `inject:"config:commerce.checkout.placeorder.auditlog.redis.database"`
+			ExpirationSeconds       int    `inject:"config:commerce.checkout.placeorder.auditlog.expirationSeconds"`
+		}{MaxIdle: 3, IdleTimeoutMilliseconds: 240000, Network: network, Address: address, Database: 0, ExpirationSeconds: 60})
+}
+
+func TestRedis_History(t *testing.T) {
+	if _, err := exec.LookPath("redis-server"); err != nil {
+		t.Skip("redis-server not installed")
+	}
+	server, err := tempredis.Start(tempredis.Config{})
+	require.NoError(t, err)
+	defer func() { _ = server.Term() }()
+
+	ctx := context.Background()
+	store := getRedisStore("unix", server.Socket())
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: "first", CartID: "cart", FromState: "New", ToState: "CreatePayment", Time: now}))
+	require.NoError(t, store.Append(ctx, process.HistoryEntry{UUID: "first", CartID: "cart", FromState: "CreatePayment", ToState: "Failed", FailedReason: "error", Time: now}))
+
+	history, err := store.History(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, []process.HistoryEntry{
+		{UUID: "first", CartID: "cart", FromState: "New", ToState: "CreatePayment", Time: now},
+		{UUID: "first", CartID: "cart", FromState: "CreatePayment", ToState: "Failed", FailedReason: "error", Time: now},
+	}, history)
+
+	conn, err := redis.Dial("unix", server.Socket())
+	require.NoError(t, err)
+	ttl, err := redis.Int(conn.Do("TTL", "placeorder_history_first"))
+	require.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= 60, "history must expire")
+
+	history, err = store.History(ctx, "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
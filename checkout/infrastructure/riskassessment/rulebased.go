@@ -0,0 +1,192 @@
+package riskassessment
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain"
+)
+
+type (
+	// RuleBased is a simple RiskAssessmentService which checks the velocity of orders per email and IP,
+	// mismatching billing and delivery countries and the cart value.
+	// The velocity is counted in memory, so it is only suited for single node applications.
+	RuleBased struct {
+		logger                flamingo.Logger
+		velocityWindow        time.Duration
+		maxPerEmail           int
+		maxPerIP              int
+		reviewAboveAmount     float64
+		declineAboveAmount    float64
+		reviewAddressMismatch bool
+		now                   func() time.Time
+
+		mutex    sync.Mutex
+		attempts map[string][]time.Time
+		// lastCleanup is the time the attempts of all keys have been checked for expiry
+		lastCleanup time.Time
+	}
+)
+
+const (
+	// ReasonVelocityEmail too many orders with the same email within the velocity window
+	ReasonVelocityEmail = "velocity_email"
+	// ReasonVelocityIP too many orders from the same IP within the velocity window
+	ReasonVelocityIP = "velocity_ip"
+	// ReasonAddressMismatch the billing country differs from a delivery country
+	ReasonAddressMismatch = "address_mismatch"
+	// ReasonCartValueReview the cart value exceeds the review threshold
+	ReasonCartValueReview = "cart_value_review"
+	// ReasonCartValueDecline the cart value exceeds the decline threshold
+	ReasonCartValueDecline = "cart_value_decline"
+)
+
+var _ domain.RiskAssessmentService = new(RuleBased)
+
+// Inject dependencies
+func (r *RuleBased) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		VelocityWindowSeconds int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.windowSeconds"`
+		MaxPerEmail           int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.maxPerEmail"`
+		MaxPerIP              int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.maxPerIP"`
+		ReviewAboveAmount     float64 `inject:"config:commerce.checkout.placeorder.fraudCheck.reviewAboveAmount"`
+		DeclineAboveAmount    float64 `inject:"config:commerce.checkout.placeorder.fraudCheck.declineAboveAmount"`
+		ReviewAddressMismatch bool    `inject:"config:commerce.checkout.placeorder.fraudCheck.reviewAddressMismatch"`
+	},
+) *RuleBased {
+	r.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "riskassessment")
+	r.now = time.Now
+	r.attempts = make(map[string][]time.Time)
+
+	if cfg != nil {
+		r.velocityWindow = time.Duration(cfg.VelocityWindowSeconds) * time.Second
+		r.maxPerEmail = cfg.MaxPerEmail
+		r.maxPerIP = cfg.MaxPerIP
+		r.reviewAboveAmount = cfg.ReviewAboveAmount
+		r.declineAboveAmount = cfg.DeclineAboveAmount
+		r.reviewAddressMismatch = cfg.ReviewAddressMismatch
+	}
+
+	return r
+}
+
+// Assess the order with the configured rules, the most severe decision of all triggered rules wins
+func (r *RuleBased) Assess(ctx context.Context, request domain.RiskAssessmentRequest) (*domain.RiskAssessment, error) {
+	assessment := &domain.RiskAssessment{Decision: domain.RiskDecisionApprove}
+	trigger := func(decision domain.RiskDecision, reason string) {
+		assessment.Reasons = append(assessment.Reasons, reason)
+		if severity(decision) > severity(assessment.Decision) {
+			assessment.Decision = decision
+		}
+	}
+
+	email := strings.ToLower(strings.TrimSpace(request.Cart.GetContactMail()))
+	if r.exceedsVelocity("email:"+email, email != "", r.maxPerEmail) {
+		trigger(domain.RiskDecisionDecline, ReasonVelocityEmail)
+	}
+	if r.exceedsVelocity("ip:"+request.Metadata.IP, request.Metadata.IP != "", r.maxPerIP) {
+		trigger(domain.RiskDecisionDecline, ReasonVelocityIP)
+	}
+
+	if r.reviewAddressMismatch && addressMismatch(request.BillingAddress, request.DeliveryAddresses) {
+		trigger(domain.RiskDecisionReview, ReasonAddressMismatch)
+	}
+
+	amount := request.Cart.GrandTotal().FloatAmount()
+	if r.declineAboveAmount > 0 && amount > r.declineAboveAmount {
+		trigger(domain.RiskDecisionDecline, ReasonCartValueDecline)
+	} else if r.reviewAboveAmount > 0 && amount > r.reviewAboveAmount {
+		trigger(domain.RiskDecisionReview, ReasonCartValueReview)
+	}
+
+	if assessment.Decision != domain.RiskDecisionApprove {
+		r.logger.WithContext(ctx).Info("risk assessment of cart ", request.Cart.ID, ": ", assessment.Decision, " ", assessment.Reasons)
+	}
+
+	return assessment, nil
+}
+
+// exceedsVelocity records an attempt for the key and checks if the attempts within the window exceed the maximum
+func (r *RuleBased) exceedsVelocity(key string, valid bool, max int) bool {
+	if !valid || max <= 0 || r.velocityWindow <= 0 {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.now()
+	attempts := make([]time.Time, 0, len(r.attempts[key])+1)
+	for _, attempt := range r.attempts[key] {
+		if now.Sub(attempt) < r.velocityWindow {
+			attempts = append(attempts, attempt)
+		}
+	}
+	attempts = append(attempts, now)
+	r.attempts[key] = attempts
+
+	r.cleanup(now)
+
+	return len(attempts) > max
+}
+
+// cleanup drops the keys of other customers whose attempts are outside of the window to keep the memory bounded,
+// it scans the keys at most once per window
+func (r *RuleBased) cleanup(now time.Time) {
+	if now.Sub(r.lastCleanup) < r.velocityWindow {
+		return
+	}
+	r.lastCleanup = now
+
+	for key, attempts := range r.attempts {
+		if len(attempts) > 0 && now.Sub(attempts[len(attempts)-1]) >= r.velocityWindow {
+			delete(r.attempts, key)
+		}
+	}
+}
+
+// addressMismatch checks if the country of a delivery address differs from the billing country
+func addressMismatch(billingAddress *cartDomain.Address, deliveryAddresses []cartDomain.Address) bool {
+	if billingAddress == nil {
+		return false
+	}
+
+	billingCountry := country(*billingAddress)
+	if billingCountry == "" {
+		return false
+	}
+
+	for _, deliveryAddress := range deliveryAddresses {
+		deliveryCountry := country(deliveryAddress)
+		if deliveryCountry != "" && deliveryCountry != billingCountry {
+			return true
+		}
+	}
+
+	return false
+}
+
+func country(address cartDomain.Address) string {
+	if address.CountryCode != "" {
+		return strings.ToUpper(address.CountryCode)
+	}
+
+	return strings.ToUpper(address.Country)
+}
+
+func severity(decision domain.RiskDecision) int {
+	switch decision {
+	case domain.RiskDecisionDecline:
+		return 2
+	case domain.RiskDecisionReview:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,149 @@
+package riskassessment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+func newRuleBased() *RuleBased {
+	return new(RuleBased).Inject(flamingo.NullLogger{}, &struct {
+		VelocityWindowSeconds int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.windowSeconds"`
+		MaxPerEmail           int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.maxPerEmail"`
+		MaxPerIP              int     `inject:"config:commerce.checkout.placeorder.fraudCheck.velocity.maxPerIP"`
+		ReviewAboveAmount     float64 `inject:"config:commerce.checkout.placeorder.fraudCheck.reviewAboveAmount"`
+		DeclineAboveAmount    float64 `inject:"config:commerce.checkout.placeorder.fraudCheck.declineAboveAmount"`
+		ReviewAddressMismatch bool    `inject:"config:commerce.checkout.placeorder.fraudCheck.reviewAddressMismatch"`
+	}{
+		VelocityWindowSeconds: 3600,
+		MaxPerEmail:           2,
+		MaxPerIP:              3,
+		ReviewAboveAmount:     500,
+		DeclineAboveAmount:    5000,
+		ReviewAddressMismatch: true,
+	})
+}
+
+func riskRequest(email string, ip string, amount int64, deliveryCountry string) domain.RiskAssessmentRequest {
+	billingAddress := &cart.Address{Email: email, CountryCode: "DE"}
+	deliveryAddress := cart.Address{CountryCode: deliveryCountry}
+
+	return domain.RiskAssessmentRequest{
+		Cart: cart.Cart{
+			ID:             "cart",
+			BillingAddress: billingAddress,
+			Totalitems: []cart.Totalitem{
+				{Code: "total", Price: priceDomain.NewFromInt(amount, 1, "EUR")},
+			},
+		},
+		BillingAddress:    billingAddress,
+		DeliveryAddresses: []cart.Address{deliveryAddress},
+		Metadata:          domain.RequestMetadata{IP: ip},
+	}
+}
+
+func TestRuleBased_Assess(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("inconspicuous order is approved", func(t *testing.T) {
+		t.Parallel()
+
+		assessment, err := newRuleBased().Assess(ctx, riskRequest("customer@example.com", "127.0.0.1", 100, "DE"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionApprove, assessment.Decision)
+		assert.Empty(t, assessment.Reasons)
+	})
+
+	t.Run("address mismatch is reviewed", func(t *testing.T) {
+		t.Parallel()
+
+		assessment, err := newRuleBased().Assess(ctx, riskRequest("customer@example.com", "127.0.0.1", 100, "fr"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionReview, assessment.Decision)
+		assert.Equal(t, []string{ReasonAddressMismatch}, assessment.Reasons)
+	})
+
+	t.Run("cart value thresholds", func(t *testing.T) {
+		t.Parallel()
+
+		ruleBased := newRuleBased()
+		assessment, err := ruleBased.Assess(ctx, riskRequest("customer@example.com", "", 1000, "DE"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionReview, assessment.Decision)
+		assert.Equal(t, []string{ReasonCartValueReview}, assessment.Reasons)
+
+		assessment, err = ruleBased.Assess(ctx, riskRequest("other@example.com", "", 10000, "FR"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionDecline, assessment.Decision)
+		assert.Equal(t, []string{ReasonAddressMismatch, ReasonCartValueDecline}, assessment.Reasons)
+	})
+
+	t.Run("velocity per email and ip within the window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		ruleBased := newRuleBased()
+		ruleBased.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			assessment, err := ruleBased.Assess(ctx, riskRequest("Customer@example.com", "10.0.0.1", 100, "DE"))
+			require.NoError(t, err)
+			assert.Equal(t, domain.RiskDecisionApprove, assessment.Decision)
+		}
+
+		assessment, err := ruleBased.Assess(ctx, riskRequest("customer@example.com", "10.0.0.1", 100, "DE"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionDecline, assessment.Decision)
+		assert.Equal(t, []string{ReasonVelocityEmail}, assessment.Reasons)
+
+		assessment, err = ruleBased.Assess(ctx, riskRequest("other@example.com", "10.0.0.1", 100, "DE"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{ReasonVelocityIP}, assessment.Reasons)
+
+		// attempts outside of the window are not counted anymore
+		now = now.Add(2 * time.Hour)
+		assessment, err = ruleBased.Assess(ctx, riskRequest("customer@example.com", "10.0.0.1", 100, "DE"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.RiskDecisionApprove, assessment.Decision)
+	})
+
+	t.Run("stale velocity keys are dropped once per window", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+		now := start
+		ruleBased := newRuleBased()
+		ruleBased.now = func() time.Time { return now }
+		assess := func(email string, at time.Duration) {
+			now = start.Add(at)
+			_, err := ruleBased.Assess(ctx, riskRequest(email, "", 100, "DE"))
+			require.NoError(t, err)
+		}
+
+		assess("a@example.com", 0)
+		assess("b@example.com", 30*time.Minute)
+		assess("c@example.com", 70*time.Minute)
+		assert.NotContains(t, ruleBased.attempts, "email:a@example.com")
+		assert.Contains(t, ruleBased.attempts, "email:b@example.com")
+
+		// b is stale but the keys have been checked within the window
+		assess("d@example.com", 100*time.Minute)
+		assert.Contains(t, ruleBased.attempts, "email:b@example.com")
+
+		assess("e@example.com", 130*time.Minute)
+		assert.NotContains(t, ruleBased.attempts, "email:b@example.com")
+		assert.NotContains(t, ruleBased.attempts, "email:c@example.com")
+		assert.Contains(t, ruleBased.attempts, "email:d@example.com")
+		assert.Contains(t, ruleBased.attempts, "email:e@example.com")
+	})
+}
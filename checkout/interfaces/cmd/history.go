@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
+)
+
+// HistoryCmd prints the recorded state transitions of a place order process for support tooling
+func HistoryCmd(auditLog *placeorder.AuditLog) *cobra.Command {
+	return &cobra.Command{
+		Use:   "checkout:placeorder:history [uuid]",
+		Short: "Print the recorded state transitions of a place order process",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			history, err := auditLog.History(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range history {
+				line := fmt.Sprintf("%s\t%s -> %s", entry.Time.Format(time.RFC3339), entry.FromState, entry.ToState)
+				if entry.FailedReason != "" {
+					line += "\t" + entry.FailedReason
+				}
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), line); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
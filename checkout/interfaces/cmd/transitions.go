@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/spf13/cobra"
+
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
+	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql/dto"
+)
+
+// TransitionsCmd prints the place order state graph of the current configuration as PlantUML diagram
+func TransitionsCmd(transitionTable *process.TransitionTable, graphQLStates map[string]dto.State) *cobra.Command {
+	return &cobra.Command{
+		Use:   "checkout:placeorder:transitions",
+		Short: "Print the place order state transitions as PlantUML diagram",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			labels := make(map[string]string, len(graphQLStates))
+			for name, state := range graphQLStates {
+				stateType := reflect.TypeOf(state)
+				for stateType.Kind() == reflect.Ptr {
+					stateType = stateType.Elem()
+				}
+				labels[name] = stateType.Name()
+			}
+
+			_, err := fmt.Fprint(cmd.OutOrStdout(), transitionTable.PlantUML(labels))
+			return err
+		},
+	}
+}
@@ -33,6 +33,12 @@ type (
 		UUID string
 	}
 
+	// notifyPlaceOrderResult result of a payment notification, contains no customer data as the caller is not authenticated
+	notifyPlaceOrderResult struct {
+		UUID  string
+		State string
+	}
+
 	// placeOrderContext infos
 	placeOrderContext struct {
 		Cart         *cart.Cart
@@ -229,3 +235,34 @@ func (c *APIController) RefreshPlaceOrderBlockingAction(ctx context.Context, r *
 	}
 	return c.responder.Data(c.getPlaceOrderContext(ctx, pctx))
 }
+
+// NotifyPlaceOrderAction proceeds the place order process with the given correlation id server side (blocking)
+// @Summary Payment notification webhook, proceeds the place order process independent of the customer session
+// @Description Payment gateways call this endpoint with the uuid of the place order process (the correlation id passed to the gateway), e.g. after an asynchronous payment was completed.
+// @Description The process proceeds until no further state change is possible, the payment status is always fetched from the gateway.
+// @Tags Checkout
+// @Produce json
+// @Success 200 {object} notifyPlaceOrderResult
+// @Failure 404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Param correlationID path string true "the uuid of the place order process"
+// @Router /api/v1/checkout/placeorder/notify/{correlationID} [post]
+func (c *APIController) NotifyPlaceOrderAction(ctx context.Context, r *web.Request) web.Result {
+	correlationID, _ := r.Params["correlationID"]
+
+	pctx, err := c.placeorderHandler.NotifyPlaceOrder(ctx, placeorder.NotifyPlaceOrderCommand{CorrelationID: correlationID})
+	if err == placeorder.ErrNoPlaceOrderProcess {
+		response := c.responder.Data(errorResponse{Code: "404", Message: err.Error()})
+		response.Status(http.StatusNotFound)
+		return response
+	}
+	if err != nil {
+		// the caller is not authenticated, details of the error are only logged
+		c.logger.WithContext(ctx).Error("notify place order: ", err)
+		response := c.responder.Data(errorResponse{Code: "500", Message: "place order process could not proceed"})
+		response.Status(http.StatusInternalServerError)
+		return response
+	}
+
+	return c.responder.Data(notifyPlaceOrderResult{UUID: pctx.UUID, State: pctx.CurrentStateName})
+}
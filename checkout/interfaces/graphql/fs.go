@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\xdb\x72\x13\x39\x13\xbe\xcf\x53\x74\xe0\xe2\x87\x2a\x78\x81\xb9\x0b\x06\xfe\xcd\x16\x6c\xbc\x09\x87\x0b\x8a\x72\xb5\x47\x6d\x8f\x0a\x8d\x7a\x90\x7a\x62\xa6\xb6\xf6\xdd\xb7\x74\xf0\x78\x7c\xc4\x49\x0c\xec\xe6\x82\xc2\x3a\x7c\xfd\x75\x4b\xdd\xfa\xa6\xa5\x6b\x08\x46\x5c\xd7\xe4\x4a\x9a\x8c\x2a\x2a\xbf\x70\x2b\x93\x1b\x41\x27\x63\x83\x25\x5d\x39\x45\x6e\x72\x4d\xbe\x35\x02\x7f\x9d\x01\x00\xb4\xad\x56\x05\xdc\x88\xd3\x76\x7e\x7e\xf6\xf7\xd9\xe3\x1d\x00\xab\xbd\x23\xb6\x42\xdf\x04\x1c\x35\x8e\x3c\x59\xf1\x20\x15\x81\x4b\x88\x3c\x8b\xbf\xca\xd6\x39\xb2\x02\x4f\x5c\x6b\xad\xb6\xf3\xa7\xd0\x04\x00\xe0\x80\x00\x75\x2b\x28\x9a\xed\xd9\x1e\xb6\xdb\xc6\x12\xd1\xc7\xf0\xae\x22\x18\xa1\x13\x90\x0a\x05\xb4\x87\x39\x6b\x3b\x07\x61\x98\x52\x32\xa1\xe2\xca\x12\x9d\x14\x2b\xe4\x97\x54\xb2\x43\x21\x15\xf6\x0e\xa0\xd2\x8e\xcc\x4a\x5b\x28\xd1\x2f\x39\x6a\x0f\x68\x1c\xa1\xea\x86\xb8\x71\xee\xd2\xce\xd8\x17\xfb\x78\xab\xab\x7e\x4d\xb6\x74\x23\x28\x04\x8a\x1a\xb2\x2a\xb0\x65\x1b\x63\xe4\xe3\x30\xcf\xa0\xc1\xae\x0e\xc1\x42\xab\xd6\xc2\xf4\x3c\x2f\xa9\xb1\x83\x92\xad\xa0\xb6\x80\x4a\xe9\x10\x3a\x34\xa0\x7b\x13\x71\xd9\x5e\x42\x91\x4f\xe4\x30\x89\xff\x9e\x67\x5a\x17\xd0\x5a\xfd\xb5\x25\xd0\x0a\x66\xec\x22\xa7\xc6\x71\x49\xde\xef\xbc\x16\x67\xfb\x2f\xc6\xc0\x67\x78\x9e\x88\x01\x4e\xb9\x95\x04\x3a\x88\x72\x98\x97\xae\xd1\x25\x1a\xd3\x81\xaf\x78\x61\x43\x3c\x10\x7c\x5b\x12\x79\x0f\x0d\xce\xe9\xe0\xbd\x18\xda\x4a\xd7\x22\xc7\x2f\x1f\x4b\xfe\xfb\x74\x28\x1a\xe3\xd5\x8e\xf3\xcf\x09\x63\x03\xba\xd8\xc0\x40\xb7\x65\x3e\xef\xa4\x1a\xb5\xe9\xcd\xe6\xbf\x41\xd4\xa2\x2f\x07\xcf\x66\xc0\x26\x7b\x34\x47\xa1\x05\x76\xc5\x16\xde\xc0\xdd\xb1\xe3\x5b\xad\xc8\x15\x6b\x93\x35\x49\xc5\xaa\xd8\x66\x12\xfe\x8f\x35\xb7\x56\x06\x93\x3d\xab\xb1\xd3\x65\xbe\x18\xa2\xc5\x50\xb1\xdb\x97\x33\x6d\x85\xdc\x2c\x5c\xd1\x23\x2f\x5b\x76\xc8\x62\x4d\xc5\x56\x54\x8e\xc4\x98\x7c\x44\x2d\xa0\xeb\xc6\x50\x1d\xeb\xcd\xcf\xb6\xfd\x9a\xdd\xa8\xf5\xc2\x75\xa8\x0b\xbf\x86\xc6\x4d\x5b\x86\xc4\xfc\x55\xe6\x5f\xa3\x36\xa4\x4e\x65\x3d\x8c\x38\x42\xcf\xf6\xe8\xa2\x95\x19\x5c\xc7\x5d\xf7\x08\x5f\xc5\x8b\xcb\x99\xc3\x9a\x4e\xe9\xc3\xfb\xeb\x37\x0f\x38\xd1\x8a\x17\xbf\xbd\x7b\xfb\xe6\x94\x84\x02\xde\xfd\x19\x5d\x93\xd2\x8e\xca\x93\xa5\xda\x49\x42\xf4\x11\x8d\x21\xc9\x25\xf2\x94\xcc\x1e\x25\xe4\xfe\xf5\x4d\x95\x33\x09\x8b\x05\x7a\x28\x2b\xf6\x64\xa1\x71\x74\xab\xb9\xf5\xa6\x7b\x34\xac\xbe\x6f\x73\x9d\x5d\x43\x0c\x15\xdc\xd5\x51\xda\x80\x25\x52\xa4\x82\x32\x29\x1d\x05\x1e\xd8\x5b\x6a\x7d\xd4\x2c\x15\x41\x76\xeb\x9a\xbe\xb6\xe4\x05\x2e\xc6\x97\x6b\x46\xf2\xf8\xc5\xf8\xf2\x98\x3c\x19\x6f\x6e\xba\x4b\xc0\xb7\x36\xe7\xb8\x3d\x1a\x25\xe1\x91\x44\xde\xef\x37\x57\x7f\x00\xd9\x92\x83\x6b\x39\x60\x0a\x05\x7b\xfd\xb0\xcf\xa1\xb4\xf6\x25\x0a\x6e\x84\x6c\x3f\xbc\x22\x41\x6d\xfc\x77\xa1\xf3\xba\xa3\x71\xb9\x09\xe7\xf3\x7d\xdc\xbc\x6e\x03\xf7\xaa\xc9\xea\x8b\xac\x6a\x58\xc7\xd3\xa4\xa4\x9f\x78\x1a\x2c\x86\xa3\x45\x08\xd1\xae\xd0\x0a\x78\xf2\x5e\xb3\x7d\x06\x96\xc3\x0f\x01\x9d\x04\xf2\x62\xfd\xf2\x29\x26\x6f\xff\x17\x44\xf5\xd7\x56\x3b\x1a\x22\xdc\xa2\xd1\x2a\xde\xa9\x65\x28\xd3\xc4\x87\x7e\x7c\x90\x63\x89\xe4\xab\x25\x37\x61\x08\x0a\x1d\x4a\x0e\x79\x23\xa4\x7a\x83\xc2\x09\x6d\x39\xf3\xa0\x3c\x1d\xb3\x97\x1f\x5e\x3c\xc2\xc0\x18\x43\x09\x17\x72\xbe\x38\x2c\xf0\xf2\x9b\xc1\xae\x9e\xf4\x7b\xce\x3f\x07\xc7\xee\xac\x60\xd6\x5e\x9e\x4c\x75\xf9\x78\xe5\xa0\x07\x65\x74\x8f\xe7\x34\x41\x4e\x5e\x39\xc7\xf7\xd1\x15\xc7\x11\xbb\x3f\xaf\x9c\x18\xff\x56\x7a\x23\xb4\x25\x19\x52\x2f\xba\x07\x68\xb3\x9f\x14\xc3\xff\x04\xd7\xf0\x8d\xb3\x2a\x2a\x3f\xf0\xd8\xc3\xd0\xaa\xaa\xa5\x56\xc4\xf0\x81\x0b\xdf\x5a\x1f\x36\xe6\xef\x52\x94\xd6\xd3\x3e\x73\xf8\x42\xdd\x7a\x2d\xb9\x45\xd3\x52\x01\x9f\xf2\x58\x2c\x0e\xf4\x4d\xc8\x2a\x88\x76\xfe\x6c\xc9\x75\x7d\xe3\xe1\x32\xbe\x24\xb1\x32\x63\x29\xfa\x96\xd6\xbe\xd4\x87\x9f\xcd\xdb\xfc\x2e\xe2\x86\x15\xcb\x02\x5e\x30\x1b\x42\x7b\xbe\x67\xc3\x28\x75\x4f\x72\xf3\xe3\xf0\xdb\x9f\x17\x9d\x6f\xd2\x7f\x9b\x7b\x2c\xbd\x07\xb1\x05\xe4\x01\xc1\xd2\x62\x49\x38\xb6\x1c\x16\xda\x18\x70\x94\xfc\xa1\x6f\xda\x4b\x6a\x52\xd0\x3e\x7f\x36\x9a\x49\x4f\x1c\x49\xeb\xec\x7b\x67\xfa\x00\x3f\xdd\xc5\x79\x77\x0f\x6a\xd9\x8d\x48\x29\xe2\xa3\x60\xca\xcd\xa3\xdc\x3b\xda\x15\xe9\x67\xd0\xb0\xf7\x7a\x6a\x28\x3c\xa8\xa9\x4d\xa2\x7d\x7c\x64\x67\xda\xa2\xd9\x17\xd8\x68\x64\xef\x49\x3c\x86\x91\x21\x74\x49\x34\x18\xf4\x02\x5e\xd8\x91\xba\xc3\x59\x47\x80\x03\x06\xfe\x4f\x72\x18\x3e\xf9\x12\xce\x85\xac\x6f\x1d\xf9\xa4\x48\x57\x0d\xa3\x1a\xcb\x4a\xdb\xdc\xaa\x21\xe5\x83\xb4\xb0\x30\x35\x5c\x7e\x59\x26\xd7\x36\xad\x6b\x9a\x39\xf2\xd5\x90\xd8\x51\x97\x6a\x83\x74\xcd\x3e\x68\x94\x32\x9c\xce\x36\xe9\x69\x07\x0b\xd4\xf1\xf6\x2c\xc5\xd5\x3a\x65\xe1\x25\xeb\x67\x29\x9b\x66\xec\xe8\xce\xd4\x5f\xe4\xf5\x47\xe7\xc5\x3f\x01\x00\x00\xff\xff\x7d\xf9\x5e\xe4\x14\x15\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x58\xdb\x6e\xdb\x38\x10\x7d\xf7\x57\xd0\xc9\xc3\xa6\x40\xf6\x07\xf4\x96\x38\xbd\x64\xd1\x6c\xbc\x4e\xda\x3e\x04\x85\x41\x8b\xe3\x98\x88\x44\xba\x24\x15\x47\x58\xe4\xdf\x77\x86\xa4\x64\xc9\x77\x3b\x49\xdb\xf5\x83\x01\xf1\x32\x73\x38\x33\x9c\x39\x1c\x57\x4e\x81\xf5\x74\x9e\x83\x49\x61\xd8\x9b\x40\xfa\xa0\x0b\x37\xbc\x71\xdc\xb8\x7e\xc6\x53\xb8\x36\x02\xcc\x70\x00\xb6\xc8\x1c\xfb\xb7\xc3\xf0\x57\x14\x52\x24\xec\xc6\x19\xa9\xee\xbb\x9d\xe7\xce\xf1\x0a\x01\xf3\xbd\x3d\xad\x1c\x3c\x39\x66\x60\x6a\xc0\x82\x72\x96\xb9\x09\xe0\xa7\x97\xa8\xc7\xfe\x2b\x2d\x8c\xc1\x29\x76\x62\x0a\xa5\x50\xec\x3b\x36\x25\x01\x4c\x93\x04\x96\x17\x8e\x3b\xa9\x55\xc7\xad\x46\xbb\xac\x2c\x00\x3d\x66\xb7\x28\xbb\x87\x47\x41\x25\xdc\x31\x69\xd9\xbd\x46\xe9\xcc\x69\x36\x82\xa0\x42\xf8\x95\x29\xae\x49\xe6\x92\x2f\x20\xd5\x86\x3b\x10\xb4\xb7\x21\x2a\xec\x88\xa8\xa4\xc2\x6d\xb6\xc2\x88\xb2\x79\x66\x80\x8b\xb2\x29\xd7\xcf\x5d\xaa\xb1\xb6\xc9\x3a\xdc\xe2\xba\x5e\x13\x35\xa1\xf1\x1d\x30\x01\x53\x50\x82\xd0\x6a\xe5\x6d\x64\xfd\x30\x1a\x6c\xca\xcb\x9c\x8c\xc5\x95\x68\x99\xe9\xcf\xb8\x24\xe7\x25\x4b\xd1\x10\x1c\x11\x72\x21\x24\x99\x8e\x67\x88\xb7\x52\xe1\x97\x25\x1b\x0d\xe9\x31\x0c\xfd\x7f\x37\xc2\x3a\x63\x85\x92\x3f\x0a\x60\x52\xb0\xb1\x36\x1e\xd3\xd4\xe8\x14\xac\x5d\x19\x16\x9d\xf5\x81\xd1\x38\x33\xa2\xf6\xc0\x18\x1f\xe1\x74\x10\xda\xb0\x32\xcd\xa3\xd7\x65\xca\xb3\xac\x64\x76\xa2\x67\x8a\xec\xc1\x99\x2d\x52\x40\xcd\x68\x8c\x7b\xd8\x18\x17\x4d\x5d\x21\x2c\xa2\xfd\xa2\x5b\xe2\xef\x6e\x93\x35\xfa\xf3\x1d\xdd\xef\x41\xc6\x82\xe8\x64\x41\x06\xc6\xcd\xa2\xfa\xb8\x13\x72\x2e\xb3\x5a\x6d\xfc\x35\xac\xe6\xcf\xc2\x76\x44\x13\x4f\x74\x8f\x6e\x9a\xf1\x32\x59\x92\xd7\x38\x6e\xdf\xe8\x47\x89\xbb\x93\xd6\x64\x0e\x6e\xa2\x45\xc2\x56\xee\xe4\xb9\x2e\x94\x6b\x4c\xd6\xa8\xfa\x46\xa6\x31\x30\x9c\x74\x19\x24\xab\xcf\xd2\x91\x78\x1d\xcd\x98\x42\x74\xc7\x60\x8b\x07\x52\x3c\x87\x64\xc9\x2a\x3b\xca\x18\x7e\xe3\x12\x2f\x7b\x3e\xcd\x20\xf7\xf9\xe6\x67\xeb\xfe\xa0\x4d\xaf\xb0\x4e\xe7\x94\x17\x7e\x0d\x8c\x9b\x22\xa5\x8b\xf9\xab\xd4\x7f\xc0\x18\xc7\x2b\xfc\x4a\xda\x69\x04\x53\xab\xd5\x2a\xd9\x13\xc1\xc0\xef\x3a\xc0\x7c\x98\x68\x2e\xc7\x06\x51\xbc\xe6\x19\xbe\x0c\x3e\xbf\xc0\xa3\x08\xe9\xd3\xed\xd5\xe7\xd7\x04\x44\xf2\x0e\x47\x34\x00\x21\x0d\xa4\xee\xb7\x32\xd1\x37\x2c\x14\xe0\x62\x8a\x7c\x4d\x64\x47\x41\x72\x5d\x7d\x43\xe6\x0c\xc4\x62\xc6\x2d\x4b\x27\x1a\xd9\x0d\x56\x44\x78\x94\xba\xb0\x59\x79\xd4\xcc\xbe\x57\x31\xcf\xb6\x24\x52\x06\x37\xb9\xa7\x36\x4c\x01\x08\xbc\x31\xc8\x4c\x52\x0c\x75\xc4\xc1\x6b\x4d\x85\xf5\x9c\x05\x2b\x63\x3c\xd6\x00\xb0\x0c\x5b\xc7\xce\xfa\x97\x2d\x25\x71\x1c\x87\x77\xb9\x27\xfd\xc5\x4d\xfb\x18\x7c\x69\x73\xb4\xdb\x51\x2f\x10\x8f\x40\xf2\xfe\xba\xb9\xfe\x9b\x81\x4a\x35\x1d\x2d\x1a\x4c\x70\xc7\x6b\xfe\xb0\xee\x40\x61\xed\x05\x2e\x5d\x30\xd9\x7a\xf1\x02\x70\x22\xb3\x5b\x45\xc7\x75\x3b\xcb\xd5\x53\xf2\xcf\x76\xb9\x71\xdd\x82\xdc\xeb\x69\x64\x5f\xc8\xe5\xa6\xc8\x3d\xc9\x9b\x10\xf8\x93\x1e\x91\x46\x72\x2d\x67\x64\xed\x09\xc7\x59\x8b\x49\x1b\x37\x9c\x32\xa5\xe9\x03\x23\x38\x10\xe4\x59\x3b\xf8\x84\x06\xab\xfe\x20\x52\xfd\xa3\xc0\x3b\xd8\x94\xf0\xc8\x33\x29\x7c\x4c\x55\xa6\x0c\x13\x5f\xeb\xf1\xc6\x1d\x0b\x20\xdf\x57\xd8\x30\xfa\x88\xa1\x23\x7b\xa4\x7b\x83\xfc\xb7\x56\xe8\x74\x90\x56\xcd\xbc\xe8\x9e\xf6\xb5\x75\x6f\x9e\x3c\x68\xa0\xcf\x29\x85\x23\x05\x41\xb7\xdc\x6d\x97\x8e\x75\x3b\x1f\xd6\x7b\x90\xaf\x3d\x1f\xc0\x60\x5a\x95\x27\x42\xad\x8a\x57\x34\x3a\x31\xa3\x03\xca\x69\x10\x39\x7c\x6f\x8c\x3e\x84\x57\xec\x06\xec\x70\x5c\xf1\x62\xfc\xae\xf0\x7a\x5c\xa5\x80\x9f\xe7\xe5\x0b\xb8\xd9\x4f\xb2\xe1\xff\x02\xeb\x40\xda\x07\x7c\x28\x67\x52\x1d\xc4\xf4\xde\xde\xdf\xa6\x91\xf3\xde\x30\x2a\x69\x68\x9e\x74\x43\xa7\xa4\x59\x7f\xe9\x29\xf8\x75\x61\xde\xe7\xcc\xcd\x7d\x93\x5b\xc3\x95\xf5\x6f\x77\xdf\x5b\x88\x6f\x7b\x37\x1f\xd5\x63\xe2\x08\x8d\xf7\x7f\x7c\x8c\x23\xbe\x94\x06\x04\x1b\x95\xbe\x76\xf0\x42\xe0\x7b\x28\xd3\xf7\xdb\xad\xd9\x50\xba\xaa\xd7\x53\xb5\x4a\x2e\x2f\xda\x63\x63\xa3\xf3\x9b\xd0\x54\x68\x0e\x3b\xbd\x62\xf0\x98\x45\x63\xc6\xca\x56\xa1\xb6\x33\xe9\xd2\x49\xa0\x40\x34\x3e\x0e\x4f\x08\x7f\xec\xa0\xa4\xe1\x89\x96\xf1\x9d\xa4\x42\x70\x8b\xff\xfb\x94\xa2\x76\xb2\x8f\xc7\x7d\x80\xb2\x8d\x16\x1d\x5b\xa0\xf0\xbb\x38\xe6\x4b\x02\x3c\x39\xac\xe6\xcc\xeb\xf9\xa7\x00\x53\xd6\xed\xa6\x4b\xcf\x1f\x7c\x3d\xe6\xa9\x93\x8f\xb0\xca\x3f\x9d\xd6\x4b\xba\xc6\x77\xe6\x37\xcc\x51\x26\xec\x5c\xeb\x0c\xb8\xea\xae\xd9\xd0\x0b\x3d\xb3\xd8\xf2\x4a\x76\xe9\x8b\x75\x5b\x1d\xa6\x79\x2c\xd9\xaa\x0f\xb7\x2a\x9c\x16\x5a\x74\x91\xa1\xf8\xf6\xd7\xc4\x68\xa5\x31\xb0\xa8\x3d\x13\x76\x9d\x56\x94\xc4\xb6\x43\x2f\xb6\xdc\x40\xf1\x51\x16\x7b\x63\x9b\xf0\x7e\x92\x98\xf6\x4c\x79\xd2\x0a\xbf\x77\x5b\x8a\xf8\x3c\x78\xbb\xdf\xbb\x8b\x8e\xba\x8a\x3d\xc4\xda\x57\xbe\xc5\x49\x17\x4b\xc1\xac\x3e\x2b\xb5\xd4\x66\x32\xcb\xa8\x5b\xe9\x4d\x01\x4f\x88\x24\x34\xe1\x60\x9d\xe7\x16\x9a\xa5\x27\x06\x5c\x61\xd4\x17\x93\x35\x91\xef\xda\x63\xad\x5c\x14\x4a\x80\xf5\x0f\x82\x68\xf9\xd8\x1b\x5d\xe5\xa4\x53\x36\xd5\xe8\x16\xb4\x2d\x5d\xab\x90\x2a\x30\x6b\x10\x89\x1c\x4b\xa4\x9f\xeb\x42\xc8\x2b\x59\x1b\x73\x88\x02\x3f\x4c\x70\x65\xc6\x91\xed\x92\x57\x40\xec\x11\xd5\x5e\xc0\x06\x05\x1f\xc1\x6d\x16\x1f\xce\x42\x7e\x01\x65\x8b\x10\x57\xdc\x35\x1a\xa2\x39\x4f\x27\x58\x82\x02\x0e\x10\x96\xa8\xb3\x62\xa3\x4c\xa7\x0f\x55\x82\x58\x86\x35\x80\x31\x8a\x9a\x34\x81\xed\x71\x7d\x6a\xd0\x39\x12\x59\x4a\xb7\xe4\x9d\x65\xd0\x98\x7e\x67\x5c\xfa\xe8\xa9\x1e\x0f\x6d\xc8\xe8\xdb\x88\xfa\x34\xe4\x0d\x5c\x06\x7b\x43\x3f\x8f\xeb\x77\x3c\xc2\x73\xe7\x3f\x3a\x47\x6d\x4c\xf4\x17\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
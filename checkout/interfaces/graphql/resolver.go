@@ -6,6 +6,7 @@ import (
 	"github.com/lunarforge/flamingo_commerce/cart/domain/decorator"
 	graphqlDto "github.com/lunarforge/flamingo_commerce/cart/interfaces/graphql/dto"
 	"github.com/lunarforge/flamingo_commerce/checkout/application/placeorder"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
 	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql/dto"
 )
 
@@ -14,6 +15,7 @@ type CommerceCheckoutQueryResolver struct {
 	placeOrderHandler    *placeorder.Handler
 	decoratedCartFactory *decorator.DecoratedCartFactory
 	stateMapper          *dto.StateMapper
+	auditLog             *placeorder.AuditLog
 }
 
 // Inject dependencies
@@ -21,10 +23,12 @@ func (r *CommerceCheckoutQueryResolver) Inject(
 	placeOrderHandler *placeorder.Handler,
 	decoratedCartFactory *decorator.DecoratedCartFactory,
 	stateMapper *dto.StateMapper,
+	auditLog *placeorder.AuditLog,
 ) {
 	r.placeOrderHandler = placeOrderHandler
 	r.decoratedCartFactory = decoratedCartFactory
 	r.stateMapper = stateMapper
+	r.auditLog = auditLog
 }
 
 // CommerceCheckoutActivePlaceOrder checks if there is an order in unfinished state
@@ -63,3 +67,24 @@ func (r *CommerceCheckoutQueryResolver) CommerceCheckoutCurrentContext(ctx conte
 		UUID:       pctx.UUID,
 	}, nil
 }
+
+// CommerceCheckoutPlaceOrderHistory returns the recorded state transitions of the place order process of the current session,
+// the history of other processes is only available to support tooling via the checkout:placeorder:history command
+func (r *CommerceCheckoutQueryResolver) CommerceCheckoutPlaceOrderHistory(ctx context.Context, uuid string) ([]*process.HistoryEntry, error) {
+	pctx, err := r.placeOrderHandler.CurrentContext(ctx)
+	if err != nil || pctx.UUID != uuid {
+		return nil, placeorder.ErrHistoryNotAccessible
+	}
+
+	entries, err := r.auditLog.History(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*process.HistoryEntry, len(entries))
+	for i := range entries {
+		transitions[i] = &entries[i]
+	}
+
+	return transitions, nil
+}
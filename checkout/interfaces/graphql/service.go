@@ -37,15 +37,18 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Checkout_PlaceOrderState_State_Redirect", dto.Redirect{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_PostRedirect", dto.PostRedirect{})
 	types.Map("Commerce_Checkout_PlaceOrderState_Form_Parameter", dto.FormParameter{})
+	types.Map("Commerce_Checkout_PlaceOrderTransition", process.HistoryEntry{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason", new(process.FailedReason))
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_Error", process.ErrorOccurredReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_PaymentError", process.PaymentErrorOccurredReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_CartValidationError", process.CartValidationErrorReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_CanceledByCustomer", process.CanceledByCustomerReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_PaymentCanceledByCustomer", process.PaymentCanceledByCustomerReason{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_RiskDeclined", process.RiskDeclinedReason{})
 
 	types.Resolve("Query", "Commerce_Checkout_ActivePlaceOrder", CommerceCheckoutQueryResolver{}, "CommerceCheckoutActivePlaceOrder")
 	types.Resolve("Query", "Commerce_Checkout_CurrentContext", CommerceCheckoutQueryResolver{}, "CommerceCheckoutCurrentContext")
+	types.Resolve("Query", "Commerce_Checkout_PlaceOrderHistory", CommerceCheckoutQueryResolver{}, "CommerceCheckoutPlaceOrderHistory")
 	types.Resolve("Mutation", "Commerce_Checkout_StartPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutStartPlaceOrder")
 	types.Resolve("Mutation", "Commerce_Checkout_CancelPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutCancelPlaceOrder")
 	types.Resolve("Mutation", "Commerce_Checkout_ClearPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutClearPlaceOrder")
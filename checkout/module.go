@@ -7,8 +7,10 @@ import (
 	"flamingo.me/flamingo/v3/framework/web"
 	flamingographql "flamingo.me/graphql"
 	"github.com/go-playground/form"
+	"github.com/spf13/cobra"
 
 	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/contextstore"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/historystore"
 	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql/dto"
 	"github.com/lunarforge/flamingo_commerce/payment"
 
@@ -19,6 +21,8 @@ import (
 	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/states"
 	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure"
 	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/locker"
+	"github.com/lunarforge/flamingo_commerce/checkout/infrastructure/riskassessment"
+	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/cmd"
 	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/controller"
 	"github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql"
 )
@@ -29,6 +33,9 @@ type (
 		UseFakeSourcingService bool   `inject:"config:commerce.checkout.useFakeSourcingService,optional"`
 		PlaceOrderLockType     string `inject:"config:commerce.checkout.placeorder.lock.type"`
 		PlaceOrderContextStore string `inject:"config:commerce.checkout.placeorder.contextstore.type"`
+		FraudCheckEnabled      bool   `inject:"config:commerce.checkout.placeorder.fraudCheck.enabled,optional"`
+		AuditLogEnabled        bool   `inject:"config:commerce.checkout.placeorder.auditlog.enabled,optional"`
+		AuditLogStore          string `inject:"config:commerce.checkout.placeorder.auditlog.type,optional"`
 	}
 )
 
@@ -55,8 +62,23 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.Bind(new(process.ContextStore)).To(new(contextstore.Memory)).In(dingo.Singleton)
 	}
 
+	if m.AuditLogEnabled {
+		if m.AuditLogStore == "redis" {
+			injector.Bind(new(historystore.Redis)).In(dingo.Singleton)
+			injector.Bind(new(process.HistoryStore)).To(new(historystore.Redis))
+			injector.BindMap(new(healthcheck.Status), "placeorder.auditlog.redis").To(new(historystore.Redis))
+		} else {
+			injector.Bind(new(process.HistoryStore)).To(new(historystore.Memory)).In(dingo.Singleton)
+		}
+	}
+	injector.Bind(new(placeorder.AuditLog)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(placeorder.AuditLog))
+
 	injector.Bind(new(process.PaymentValidatorFunc)).ToInstance(placeorder.PaymentValidator)
 
+	injector.Bind(new(placeorder.StaleProcessSweeper)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(placeorder.StaleProcessSweeper))
+
 	injector.Bind(new(process.State)).AnnotatedWith("startState").To(states.New{})
 	injector.Bind(new(process.State)).AnnotatedWith("failedState").To(states.Failed{})
 	injector.BindMap(new(process.State), new(states.New).Name()).To(states.New{})
@@ -77,6 +99,21 @@ func (m *Module) Configure(injector *dingo.Injector) {
 	injector.BindMap(new(process.State), new(states.Redirect).Name()).To(states.Redirect{})
 	injector.BindMap(new(process.State), new(states.PostRedirect).Name()).To(states.PostRedirect{})
 
+	for _, transition := range states.DefaultTransitions() {
+		injector.BindMulti(new(process.Transition)).ToInstance(transition)
+	}
+	injector.Bind(new(domain.RiskAssessmentService)).To(new(riskassessment.RuleBased))
+	injector.Bind(new(riskassessment.RuleBased)).In(dingo.Singleton)
+	if m.FraudCheckEnabled {
+		injector.BindMap(new(process.State), new(states.FraudCheck).Name()).To(states.FraudCheck{})
+		injector.BindMap(new(dto.State), new(states.FraudCheck).Name()).To(dto.Wait{})
+		injector.BindMulti(new(process.TransitionRule)).ToInstance(process.TransitionRule{State: new(states.FraudCheck).Name(), After: new(states.ValidateCart).Name()})
+	}
+
+	injector.Bind(new(process.TransitionTable)).In(dingo.Singleton)
+	injector.BindMulti(new(cobra.Command)).ToProvider(cmd.TransitionsCmd)
+	injector.BindMulti(new(cobra.Command)).ToProvider(cmd.HistoryCmd)
+
 	// bind internal states to graphQL states
 	injector.BindMap(new(dto.State), new(states.New).Name()).To(dto.Wait{})
 	injector.BindMap(new(dto.State), new(states.PrepareCart).Name()).To(dto.Wait{})
@@ -130,7 +167,33 @@ commerce: checkout: {
 			}
 		}
 		contextstore: {
-			type: *"memory" | "redis"
+			type:                  *"memory" | "redis"
+			uuidExpirationSeconds: number | *604800
+			if type == "redis" {
+				redis: Redis
+			}
+		}
+		sweeper: {
+			enabled:           bool | *false
+			intervalSeconds:   number | *300
+			staleAfterSeconds: number | *3600
+		}
+		fraudCheck: {
+			enabled:        bool | *false
+			trustedProxies: number | *0
+			velocity: {
+				windowSeconds: number | *3600
+				maxPerEmail:   number | *5
+				maxPerIP:      number | *10
+			}
+			reviewAboveAmount:     number | *0
+			declineAboveAmount:    number | *0
+			reviewAddressMismatch: bool | *true
+		}
+		auditlog: {
+			enabled:           bool | *false
+			type:              *"memory" | "redis"
+			expirationSeconds: number | *2592000
 			if type == "redis" {
 				redis: Redis
 			}
@@ -218,4 +281,7 @@ func (r *apiRoutes) Routes(registry *web.RouterRegistry) {
 
 	registry.MustRoute("/api/v1/checkout/placeorder/refresh-blocking", "checkout.api.placeorder.refreshblocking")
 	registry.HandlePost("checkout.api.placeorder.refreshblocking", r.apiController.RefreshPlaceOrderBlockingAction)
+
+	registry.MustRoute("/api/v1/checkout/placeorder/notify/:correlationID", "checkout.api.placeorder.notify")
+	registry.HandlePost("checkout.api.placeorder.notify", r.apiController.NotifyPlaceOrderAction)
 }
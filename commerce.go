@@ -25,3 +25,7 @@ package commerce
 // @tag.description All Product related APIs endpoints.
 // @tag.name Checkout
 // @tag.description  All Checkout related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.
+// @tag.name Wishlist
+// @tag.description All Wishlist related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.
+// @tag.name Order
+// @tag.description All Order related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.
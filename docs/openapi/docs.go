@@ -238,6 +238,135 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/cart/carts": {
+            "get": {
+                "description": "Customers can keep multiple named carts (e.g. \"main\" and \"saved for later\"), Data contains a list of cartCustomerCartInfo",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Get all carts of the logged in customer",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The active cart is not changed, Data contains the created cartCustomerCartInfo",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Create a new named cart for the logged in customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the cart, must be unique for the customer",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/cart/carts/{cartID}": {
+            "delete": {
+                "description": "If the active cart is deleted the main cart becomes active",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Delete a cart of the logged in customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the cart",
+                        "name": "cartID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/cart/carts/{cartID}/activate": {
+            "put": {
+                "description": "All following cart operations use the selected cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Switch the active cart of the logged in customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the cart",
+                        "name": "cartID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/cart/deliveries/items": {
             "delete": {
                 "produces": [
@@ -630,6 +759,55 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/cart/delivery/{deliveryCode}/item/move": {
+            "put": {
+                "description": "E.g. to save an item for later, the item is removed from the current cart",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Move item to another cart of the logged in customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the identifier for the delivery in the cart",
+                        "name": "deliveryCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the item that should be moved",
+                        "name": "itemID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the id of the cart the item should be moved to",
+                        "name": "targetCartID",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/cart/gift-card": {
             "post": {
                 "produces": [
@@ -737,6 +915,53 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/cart/reorder/{orderID}": {
+            "post": {
+                "description": "Data contains the added items and the items that could not be added, e.g. because they are not saleable anymore",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "Add the items of a past order to the cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the order",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controller.CartAPIResult"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/cart/voucher": {
             "post": {
                 "produces": [
@@ -768,7 +993,8 @@ var doc = `{
                             "$ref": "#/definitions/controller.CartAPIResult"
                         }
                     }
-                }
+                },
+                "description": "If the code is rejected by the coupon policy, Error.Code contains the reason and Data the conflicting coupon codes"
             },
             "delete": {
                 "produces": [
@@ -805,7 +1031,7 @@ var doc = `{
         },
         "/api/v1/cart/voucher-gift-card": {
             "post": {
-                "description": "Use this if you have one user input and that input can be used to either enter a voucher or a gift card",
+                "description": "Use this if you have one user input and that input can be used to either enter a voucher or a gift card\nIf the code is rejected by the coupon policy, Error.Code contains the reason and Data the conflicting coupon codes",
                 "produces": [
                     "application/json"
                 ],
@@ -949,20 +1175,36 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/checkout/placeorder/refresh": {
+        "/api/v1/checkout/placeorder/notify/{correlationID}": {
             "post": {
+                "description": "Payment gateways call this endpoint with the uuid of the place order process (the correlation id passed to the gateway), e.g. after an asynchronous payment was completed.\nThe process proceeds until no further state change is possible, the payment status is always fetched from the gateway.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Checkout"
                 ],
-                "summary": "Returns the current place order context and proceeds the process in a non blocking way",
+                "summary": "Payment notification webhook, proceeds the place order process independent of the customer session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the uuid of the place order process",
+                        "name": "correlationID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.placeOrderContext"
+                            "$ref": "#/definitions/controller.notifyPlaceOrderResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/checkoutError"
                         }
                     },
                     "500": {
@@ -974,16 +1216,15 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/checkout/placeorder/refresh-blocking": {
+        "/api/v1/checkout/placeorder/refresh": {
             "post": {
-                "description": "This is useful to get the most recent place order context, for example after returning from an external payment provider",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Checkout"
                 ],
-                "summary": "Proceeds the process and returns the place order context afterwards (blocking)",
+                "summary": "Returns the current place order context and proceeds the process in a non blocking way",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1000,57 +1241,180 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/payment/status": {
-            "get": {
+        "/api/v1/checkout/placeorder/refresh-blocking": {
+            "post": {
+                "description": "This is useful to get the most recent place order context, for example after returning from an external payment provider",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payment"
+                    "Checkout"
                 ],
-                "summary": "Get the payment status of current cart (or last placed cart)",
+                "summary": "Proceeds the process and returns the place order context afterwards (blocking)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/domain.FlowStatus"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/cart.Cart"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/controller.placeOrderContext"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/paymentResultError"
+                            "$ref": "#/definitions/checkoutError"
                         }
                     }
                 }
             }
         },
-        "/api/v1/products/{marketplacecode}": {
+        "/api/v1/customer/orders": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Product"
+                    "Order"
                 ],
-                "summary": "Gets the requested product",
+                "summary": "Get the orders of the logged in customer",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "optional the page, starts with 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "optional the number of orders per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "the marketplace code (idendifier) for the product",
-                        "name": "marketplacecode",
+                        "description": "optional comma separated statuses of the orders, e.g. paid,shipped",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderListAPIResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/orderListAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/orderListAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/customer/orders/{orderID}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get an order of the logged in customer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the order",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderAPIResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/orderAPIResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/orderAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/orderAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payment/status": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "Get the payment status of current cart (or last placed cart)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/domain.FlowStatus"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/cart.Cart"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/paymentResultError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/products/{marketplacecode}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Gets the requested product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the marketplace code (idendifier) for the product",
+                        "name": "marketplacecode",
                         "in": "path",
                         "required": true
                     }
@@ -1088,6 +1452,150 @@ var doc = `{
                     }
                 }
             }
+        },
+        "/api/v1/wishlist": {
+            "get": {
+                "description": "Changes contains the items whose price dropped or that are back in stock since they have been added",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlist"
+                ],
+                "summary": "Get the current wishlist",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/wishlist/item": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlist"
+                ],
+                "summary": "Add a product to the wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the product identifier that should be added",
+                        "name": "marketplaceCode",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "optional the product identifier of the variant (for configurable products) that should be added",
+                        "name": "variantMarketplaceCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "optional the qty that should be added",
+                        "name": "qty",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlist"
+                ],
+                "summary": "Remove an item from the wishlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the item that should be removed",
+                        "name": "itemID",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/wishlist/item/move-to-cart": {
+            "put": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wishlist"
+                ],
+                "summary": "Move an item from the wishlist to the cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the item that should be moved",
+                        "name": "itemID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "optional the identifier for the delivery in the cart",
+                        "name": "deliveryCode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/wishlistAPIResult"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -1348,6 +1856,10 @@ var doc = `{
                     "description": "ID is the main identifier of the cart",
                     "type": "string"
                 },
+                "Name": {
+                    "description": "Name distinguishes the carts of a customer that keeps multiple carts (e.g. \"main\" or \"saved for later\")",
+                    "type": "string"
+                },
                 "PaymentSelection": {
                     "description": "PaymentSelection is used to store information on \"how\" the customer wants to pay",
                     "$ref": "#/definitions/cart.PaymentSelection"
@@ -1633,6 +2145,23 @@ var doc = `{
                 }
             }
         },
+        "cartCustomerCartInfo": {
+            "type": "object",
+            "properties": {
+                "Active": {
+                    "type": "boolean"
+                },
+                "CartTeaser": {
+                    "$ref": "#/definitions/cart.Teaser"
+                },
+                "ID": {
+                    "type": "string"
+                },
+                "Name": {
+                    "type": "string"
+                }
+            }
+        },
         "cartResultError": {
             "type": "object",
             "properties": {
@@ -1704,6 +2233,17 @@ var doc = `{
                 }
             }
         },
+        "controller.notifyPlaceOrderResult": {
+            "type": "object",
+            "properties": {
+                "State": {
+                    "type": "string"
+                },
+                "UUID": {
+                    "type": "string"
+                }
+            }
+        },
         "controller.placeOrderContext": {
             "type": "object",
             "properties": {
@@ -1828,6 +2368,27 @@ var doc = `{
                 }
             }
         },
+        "domain.Delivery": {
+            "type": "object",
+            "properties": {
+                "DeliveryInfo": {
+                    "$ref": "#/definitions/cart.DeliveryInfo"
+                },
+                "Items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.OrderItem"
+                    }
+                },
+                "ShippedAt": {
+                    "description": "ShippedAt is set when the delivery is shipped",
+                    "type": "string"
+                },
+                "ShippingItem": {
+                    "$ref": "#/definitions/cart.ShippingItem"
+                }
+            }
+        },
         "domain.Error": {
             "type": "object",
             "properties": {
@@ -1896,6 +2457,26 @@ var doc = `{
                 }
             }
         },
+        "domain.Item": {
+            "type": "object",
+            "properties": {
+                "AddedAt": {
+                    "type": "string"
+                },
+                "CartItem": {
+                    "description": "CartItem holds the product reference and quantity using the cart item model,\nwhich allows moving the item to the cart without mapping",
+                    "$ref": "#/definitions/cart.Item"
+                },
+                "PriceInfo": {
+                    "description": "PriceInfo is the active price of the product when it was added or last checked",
+                    "$ref": "#/definitions/domain.PriceInfo"
+                },
+                "StockLevel": {
+                    "description": "StockLevel of the product when it was added or last checked",
+                    "type": "string"
+                }
+            }
+        },
         "domain.LoyaltyEarningInfo": {
             "type": "object",
             "properties": {
@@ -1937,6 +2518,125 @@ var doc = `{
                 }
             }
         },
+        "domain.Order": {
+            "type": "object",
+            "properties": {
+                "Attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "BillingAddress": {
+                    "description": "BillingAddress of the customer",
+                    "$ref": "#/definitions/cart.Address"
+                },
+                "CreationTime": {
+                    "type": "string"
+                },
+                "Deliveries": {
+                    "description": "Deliveries contain the ordered items grouped by delivery, like in the cart",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Delivery"
+                    }
+                },
+                "GrandTotal": {
+                    "description": "GrandTotal is the total amount the customer has to pay for the order",
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "ID": {
+                    "type": "string"
+                },
+                "Payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Payment"
+                    }
+                },
+                "Status": {
+                    "description": "Status is the current status, use TransitionTo to change it",
+                    "type": "string"
+                },
+                "StatusHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.StatusTransition"
+                    }
+                },
+                "UpdateTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.OrderItem": {
+            "type": "object",
+            "properties": {
+                "Attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "ID": {
+                    "type": "string"
+                },
+                "MarketplaceCode": {
+                    "type": "string"
+                },
+                "Name": {
+                    "type": "string"
+                },
+                "Qty": {
+                    "type": "integer"
+                },
+                "RowPriceGross": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "RowPriceNet": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "RowTaxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cart.Tax"
+                    }
+                },
+                "SinglePriceGross": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "SinglePriceNet": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "Sku": {
+                    "description": "DEPRECATED",
+                    "type": "string"
+                },
+                "SourceID": {
+                    "description": "Source Id where the item should be picked",
+                    "type": "string"
+                },
+                "TotalDiscountAmount": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "VariantMarketplaceCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Payment": {
+            "type": "object",
+            "properties": {
+                "Amount": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "Gateway": {
+                    "type": "string"
+                },
+                "Method": {
+                    "type": "string"
+                },
+                "PaymentID": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.PaymentRequestAPI": {
             "type": "object",
             "properties": {
@@ -2133,6 +2833,23 @@ var doc = `{
                 }
             }
         },
+        "domain.StatusTransition": {
+            "type": "object",
+            "properties": {
+                "Comment": {
+                    "type": "string"
+                },
+                "From": {
+                    "type": "string"
+                },
+                "Time": {
+                    "type": "string"
+                },
+                "To": {
+                    "type": "string"
+                }
+            }
+        },
         "domain.TeaserData": {
             "type": "object",
             "properties": {
@@ -2202,6 +2919,77 @@ var doc = `{
                 }
             }
         },
+        "domain.Wishlist": {
+            "type": "object",
+            "properties": {
+                "ID": {
+                    "description": "ID is the main identifier of the wishlist, e.g. the customer id",
+                    "type": "string"
+                },
+                "Items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Item"
+                    }
+                }
+            }
+        },
+        "orderAPIResult": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "description": "Contains details if success is false",
+                    "$ref": "#/definitions/orderResultError"
+                },
+                "Order": {
+                    "$ref": "#/definitions/domain.Order"
+                },
+                "Success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "orderListAPIResult": {
+            "type": "object",
+            "properties": {
+                "Error": {
+                    "description": "Contains details if success is false",
+                    "$ref": "#/definitions/orderResultError"
+                },
+                "Orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Order"
+                    }
+                },
+                "Page": {
+                    "type": "integer"
+                },
+                "PageSize": {
+                    "type": "integer"
+                },
+                "Success": {
+                    "type": "boolean"
+                },
+                "TotalCount": {
+                    "type": "integer"
+                },
+                "TotalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "orderResultError": {
+            "type": "object",
+            "properties": {
+                "Code": {
+                    "type": "string"
+                },
+                "Message": {
+                    "type": "string"
+                }
+            }
+        },
         "paymentResultError": {
             "type": "object",
             "properties": {
@@ -2282,6 +3070,59 @@ var doc = `{
                     }
                 }
             }
+        },
+        "wishlistAPIResult": {
+            "type": "object",
+            "properties": {
+                "Changes": {
+                    "description": "Changes contains the items with a dropped price or that are back in stock",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wishlistItemChange"
+                    }
+                },
+                "Error": {
+                    "description": "Contains details if success is false",
+                    "$ref": "#/definitions/wishlistResultError"
+                },
+                "Success": {
+                    "type": "boolean"
+                },
+                "Wishlist": {
+                    "$ref": "#/definitions/domain.Wishlist"
+                }
+            }
+        },
+        "wishlistItemChange": {
+            "type": "object",
+            "properties": {
+                "BackInStock": {
+                    "type": "boolean"
+                },
+                "CurrentPrice": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "ItemID": {
+                    "type": "string"
+                },
+                "PreviousPrice": {
+                    "$ref": "#/definitions/domain.Price"
+                },
+                "PriceDropped": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "wishlistResultError": {
+            "type": "object",
+            "properties": {
+                "Code": {
+                    "type": "string"
+                },
+                "Message": {
+                    "type": "string"
+                }
+            }
         }
     },
     "tags": [
@@ -2300,6 +3141,14 @@ var doc = `{
         {
             "description": "All Checkout related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.",
             "name": "Checkout"
+        },
+        {
+            "description": "All Wishlist related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.",
+            "name": "Wishlist"
+        },
+        {
+            "description": "All Order related APIs endpoints, most suitable to be called from a browser, because they rely on the session and cookie headers.",
+            "name": "Order"
         }
     ]
 }`
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _docsOpenapiSwaggerJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5b\x73\x1b\xb9\xb1\xf0\x7b\x7e\x05\x4a\xf9\xaa\x22\x57\xe9\xb2\xbb\xc9\x57\x75\xce\x3e\x1d\x59\xb2\x1d\x55\x2c\x5b\x47\x97\xcd\x43\x9c\x72\x41\x33\x4d\x12\xd1\x0c\x30\x06\x30\xa4\x99\xd4\xfe\xf7\x53\xb8\x0d\x87\x73\xc5\x0c\x67\x24\x92\xa6\x1f\xb6\xb4\x24\xd0\x44\x37\xba\x1b\x8d\xee\x46\xf7\x7f\xfe\x80\x10\x42\x47\x62\x81\xa7\x53\xe0\x47\xbf\xa2\xa3\x5f\xce\x7e\x3a\x3a\x31\x9f\x12\x3a\x61\x47\xbf\x22\x33\x46\x7f\x12\x82\x08\x38\x49\x24\x61\x54\x8d\xbd\x37\xd3\xd0\xf1\xe7\x04\xe8\xc5\xed\xf5\x1b\x74\x9f\x40\x80\xd8\x04\xe1\x28\x42\xef\x23\x1c\x13\x3a\x65\xe8\x92\xc5\x31\xf0\x00\x50\xcc\xc2\x34\x02\x61\xe1\x6b\x88\x92\xc8\x08\x14\xac\xf2\xe8\x8b\xdb\x6b\x0d\x2f\x3f\x3c\x60\x54\xe2\x40\xae\xad\x4a\x7f\x41\x71\xbc\x06\x26\x37\x49\x7f\x9f\xf2\x48\x7d\x3d\x93\x32\x11\xbf\x9e\x9f\x4f\x89\x94\xc0\xcf\x48\x7c\x4e\x4e\x23\x36\x87\xd3\x89\x9d\x77\x1e\xb0\x38\x4e\x29\x91\xcb\x3f\x16\x41\x40\x8c\x89\x06\xe2\xc6\xfe\x0f\x66\x70\x16\xb0\xf8\x28\x1b\xf7\x7b\x6e\xa9\x11\x09\x80\x0a\xa8\x5f\xea\xcd\xf5\x43\xf5\xcc\x39\x70\x61\x29\xfc\xf3\xd9\x4f\x66\x8c\xfd\xfe\x28\xc1\x72\x26\xd6\x37\xe5\x1c\x27\xe4\x7c\xfe\xf3\x79\x80\x79\x05\x61\xa6\x50\xfe\xd0\x40\xe2\x2c\x4c\x03\x50\xc0\xfe\x51\xfa\x56\x8f\xc0\x49\x12\x91\x00\xab\xdd\x3e\xff\x97\x60\xf4\xa8\x34\xec\x9f\x27\x65\xb8\x12\x4f\x1b\x60\x5e\xaa\x45\x7a\xc1\x11\x69\x1c\x63\xbe\x54\x54\xf8\x00\x12\xc9\x19\xa0\x20\xe5\x1c\xa8\x44\x1a\xd3\x8a\x29\x1c\x44\xc2\xa8\x00\x51\x89\xb1\x1e\xf2\xcb\x4f\x3f\xd5\x7e\x89\x2a\x58\xfc\xf3\xdf\x2a\x7e\x68\xb5\xc6\x60\x06\x31\x6e\x04\xa8\xc7\xfd\x3f\x0e\x13\x05\xed\x8f\xe7\x21\x4c\x08\x25\x0a\xba\x38\x57\xcc\xcc\x59\x14\x01\x3f\x9b\x82\x54\x94\xb9\x03\x91\x46\x15\xf4\x71\xff\x7e\xaf\xfc\xe6\xf7\xea\x15\x1e\xfd\xff\x8e\xb8\x5e\x53\x09\x9c\xe2\x08\xdd\x03\x9f\x03\x47\xef\x38\x67\xfc\x45\xd0\x57\xb8\x5f\xdc\x5e\xf7\x44\xbf\xf4\xe9\xfa\x27\x05\xf2\x1c\x85\x10\x81\x2c\xcb\x25\xda\x31\xa1\xb8\x83\x98\xcd\x41\x6b\x5a\x21\x19\x87\x50\x8b\x05\x52\x4a\x9b\xc7\x7a\x7d\x08\xce\xa6\x67\x88\x48\x88\xc5\x09\x0a\x21\x22\x73\xe0\x04\xc4\x09\x7a\x22\x51\x44\xe8\x14\xe1\x30\xe4\x20\x04\xc2\x34\x44\x1c\x64\xca\xa9\xd0\x72\x06\x71\x22\x97\x1a\xdc\xd9\x7e\x89\xd9\x66\x7c\x76\x10\xb3\xa6\x4f\x7e\xaf\x3c\xcb\xf2\x87\xd3\xb9\xe5\xbb\xf2\x21\x95\xa4\x35\x87\x54\xc0\xa8\x48\x63\x5f\x79\xfc\x7e\xba\x58\x2c\x4e\x15\xff\x9f\xa6\x3c\x02\x1a\xb0\x10\x42\x3f\xc1\xda\x21\xc1\xbf\x08\x43\x91\x89\xb0\x12\x77\x81\x24\xf3\x3a\x1f\x13\xcc\x71\x0c\x12\x78\xfd\x92\x1a\xd8\x58\x2e\x13\x6d\xb7\x08\xc9\xd5\x26\x36\x30\x6c\x81\xe1\xe7\xb8\x6a\x35\xd9\x68\x67\x0f\xb5\x0c\x23\x1a\x96\xda\xdc\x2b\x2c\x71\x35\xf7\xd6\x88\xe8\x18\x48\x4d\x08\x17\x52\x2f\xdd\x03\x35\xaf\xc1\x05\x04\x1b\x46\x72\xf8\x96\x12\x0e\xe1\xd1\xaf\x48\xf2\x14\x5e\x9b\x14\x11\xf6\xa7\x84\xcf\xd8\x9d\x25\x44\x4c\xc2\x30\x02\x5f\x52\xf8\x8d\xde\x32\xb6\x37\xf7\x35\x0f\xec\x5a\x07\x6e\x19\x62\x02\x47\xa9\xd4\xda\xdd\x07\x3b\xbf\xd1\xdb\x86\xa2\xe4\x00\x5e\xaa\xb8\x7d\xe4\x56\xa2\xf6\xa9\xd1\x8c\x5a\x47\xae\x79\xec\x96\xa1\x67\x0d\xf5\x8f\x84\xc2\xcf\x3e\x28\xfa\x8e\xdf\x5e\x34\x7f\xe9\x88\x66\xe3\xf8\x2d\x43\x33\x60\x71\x82\xe9\xd2\x07\x43\x8f\xa1\x5b\x86\x5c\xc2\x84\xbc\x64\xa1\xd7\x19\xe1\x33\x76\xcb\xd0\x0b\x88\xf4\xdb\xb8\x96\x71\x5b\x86\x96\x90\x58\x7a\x6d\x59\xeb\xc0\x2d\x43\x8c\xc3\x94\x30\xea\xcb\x90\x7e\xa3\xb7\x0c\xc5\x80\xa5\x54\x72\x4f\x75\xd2\x3a\x74\x3b\x91\xf3\xdd\x40\xcf\xe1\x5b\x86\x64\x32\x63\x14\x2e\x38\x60\x6f\xc5\xe9\x3b\x61\x1b\x11\xbd\xec\xb6\xa5\x5d\xe6\x6c\x23\xba\x9f\xd2\xf8\x09\xbc\x0c\x53\xcf\xe1\x5b\x86\xa4\x09\x8b\x79\xa0\xd7\x3a\x70\xe0\x7b\x7f\xe9\xd3\x2a\xbf\xda\xc1\x97\xbd\xf6\xab\x07\x5f\x76\xe1\xff\x6a\x7d\xd9\xab\x78\xca\xb9\x8e\xb0\x94\x9d\xda\xfb\x17\x67\x32\x01\x26\x85\x2d\x9a\x70\x16\xeb\xcf\x56\x74\xc8\x85\x91\x8c\x37\x1a\x73\x79\x82\x9e\x01\x12\x13\x55\xb2\x23\x97\xda\x67\x8d\x52\x2a\x59\x1a\xcc\x20\x3c\x84\x98\x72\x9f\x1e\xc4\xb2\xe9\x13\x7f\xb1\x5c\x9e\xff\xc7\xfd\xa5\x6c\x86\xdf\x0f\x21\x27\xf7\x6d\xff\x90\xd3\x9a\xfc\x8a\x13\x24\xd2\x60\x86\xb0\x40\x62\x46\x92\x24\x1f\x4e\xb6\xd1\xa8\x6c\xfc\x84\xf1\x4c\x21\x6c\x51\x58\x4a\x2d\x89\x84\x40\x25\x99\x10\xe0\xd9\x2a\x73\x68\x36\xad\x3a\x83\xea\xcc\x9b\x3c\xbf\xb5\x5b\x39\x09\x96\xb3\x1d\x8a\x6c\x78\x86\xf0\x1c\x0d\x2e\x0c\x27\x9c\xed\x71\x48\xaf\x88\xea\x21\xc4\x57\x47\x99\x43\xc8\xaf\x96\x34\xfb\x1d\x02\x2c\x62\xbb\xdf\x21\xc1\x22\xb6\x7b\x1e\x22\x2c\xa1\xbb\xcf\x21\xc3\x6a\x64\xf7\x3a\x84\x58\x44\xf9\x07\x09\x29\x36\xa0\xbd\xa7\x21\xc6\x22\xc6\xfb\x1d\x72\x2c\x62\xbb\xc7\x21\xc8\xd2\xc6\xee\x69\x48\xb2\xac\x9c\xf7\x39\x44\x59\xc4\x76\xdf\x43\x96\x65\xf5\xb4\xe7\x21\xcc\x1a\x84\x77\x6c\x87\x3b\x87\x34\x4b\x8a\xf9\x07\x0a\x71\x56\xe2\xfe\x43\x84\x3c\x2b\x31\xdf\xdf\x10\x68\x11\xdd\x97\x0e\x89\xf6\x26\xc9\x13\x63\x11\xe0\xc6\x9b\x6c\x81\x26\xa9\x80\xb7\xe6\x8d\x82\x45\xd6\x87\x3e\x9d\x26\x6d\x19\x13\x38\x37\xf8\x0d\xc8\x19\x0b\xbd\x72\xa9\xbc\x67\x6c\x29\xaa\x97\x98\x73\xe2\x27\xe8\x1d\xa6\x6c\x19\xb2\x11\x33\x11\x18\x5f\x65\xee\x3b\xfe\xb5\xd1\x34\xcf\x04\xb5\x56\xc2\x12\x4e\x25\x69\x76\x42\x16\xa8\x12\x82\x50\xda\x06\xa9\xb9\xe7\x6a\x2e\x22\x14\xdd\xbd\xbf\xfc\xf3\x9f\xff\xfc\xdf\x7e\x9a\x50\xcf\x7f\x68\xf9\x55\x2f\x22\x95\x3e\x3d\x24\x7a\xac\x3e\x3d\x44\x94\x9b\x3e\xd9\xd3\xb7\xc1\x97\xea\xb0\x36\x59\x17\x53\x32\x07\x9a\x8b\xc5\x72\x16\x1f\x82\xb1\xd9\xf8\x01\x83\xb1\x07\x2d\x84\x0e\x5a\xe8\xa5\xf3\x5a\x74\xee\x59\x87\xe4\x96\x1d\xd2\x61\x8f\x89\xb2\x2d\x74\xba\x59\x8b\xf4\x1f\x74\x56\xc5\xa8\x2d\x8b\x93\x6b\x5a\xa9\xad\x94\x33\x2c\x91\x98\xb1\x34\x0a\xd1\x13\xa0\x54\xef\xb2\xd7\x75\x49\x4d\xbf\xbe\x6a\xa7\xcc\xb7\x14\x9a\xfd\x83\xe3\x92\x86\x50\x09\xd3\xe6\x1b\x4e\x05\x6d\x28\x2c\xd0\x37\x3f\xbf\x7e\xcb\xb0\x21\x89\x70\x38\xd3\xd0\xe1\x4c\x1b\xc6\xb2\x4e\x98\xd8\xfd\x33\xe9\x22\x0c\xd1\xb5\xd6\x62\xec\x70\x18\xa1\x9d\x3e\x8c\x0c\xd7\xc9\x3c\xcd\x0a\x47\x13\x0e\x43\xbf\x83\x29\xc6\xfc\x19\x64\x12\xe1\x00\xfc\xc8\xf5\xca\x27\x54\x67\x7a\x31\xfd\x17\x8e\x50\x0d\xe1\xd8\x44\x7f\x33\xc7\x9c\x60\x2a\xd1\xb1\x62\xbe\x80\xd1\x09\x99\xa6\x1c\x3f\x45\xd9\x1c\xf1\xa6\x37\x8d\x2d\xec\x9b\x7e\xa4\x7e\xf5\x23\x7e\x8d\x82\xdf\xe4\xb2\x37\x1d\x3a\x1c\xfe\x87\x23\xfd\x70\xa4\x77\x43\xbf\xe3\x91\xbe\x27\xce\xb2\x2b\x8d\x86\xb9\x9d\x68\xf7\xd8\xe1\x64\xdf\xe9\x93\xbd\xea\x9a\x69\x58\x75\x0b\xaf\x99\x07\x75\x8c\x0e\xea\x78\x34\xaf\xe1\x94\x4c\xe4\x69\x80\x79\x58\xe1\x22\xdc\x8b\xfb\x58\x92\x44\x4b\xf4\x81\x4c\x24\xba\x54\x58\x6e\x97\xd2\x56\xd4\x57\x6a\x39\x44\x81\x7f\xf9\x8a\xc4\x37\x72\x7c\xd0\x3f\x07\xfd\x73\x30\x07\x47\x7b\xef\xbe\xad\x4a\x65\xa5\x22\x6a\x6c\x1c\x84\xc5\x4a\xf3\x1c\x94\xce\x41\xe9\xec\x97\xd2\x69\x37\x7a\x12\xbc\x8c\x81\xca\x53\x01\x11\x04\x96\x1c\x7b\x1b\x1f\x3d\x17\xb6\x37\xc2\xad\xc1\xfa\xde\x21\xbd\x7a\x75\xbf\x9d\x45\xa1\x95\xf6\x71\x8e\x43\xbb\x63\x68\x8a\x25\x2c\xf0\x12\x9d\x9a\xca\xf5\x7f\x62\x93\x49\x44\x28\xfc\xc9\x47\x8b\xd9\xb9\xfb\xe7\x7c\xad\x22\x54\xac\x33\x46\x8b\x74\xb2\xdf\x7e\x0d\xb0\x98\x31\xea\xee\xf9\x5e\xd4\x8b\x3d\x53\x50\x0f\xfa\xff\xa0\xff\xb7\x5d\xff\xcf\x75\x5d\x21\xbe\xd7\x57\xde\xdf\x0c\x8e\xa8\xc6\x6a\xdb\x4e\x03\x55\xd3\xd0\xcf\x09\x77\xb0\x49\xcd\xe0\x2d\x11\xca\x83\x4e\x6a\xfa\x64\xbf\x2f\xc2\x07\x55\x73\x50\x35\xf9\x71\x07\x55\xb3\x13\xe6\xcf\x69\x2f\xdf\x7f\x81\x8a\x8f\x42\x49\x16\x11\x88\x4c\xd0\x92\xa5\x68\x86\xe7\x80\x18\x05\x94\x0a\xe0\x88\xd0\x24\x95\xba\xea\xa3\x96\x3e\xf3\xbf\x01\xa6\x3a\xaf\x53\x40\x88\x24\x43\x40\xa4\x52\x1e\xa0\xb6\x03\x61\x64\x17\x87\x98\xfa\x9f\x26\x2f\xd5\x2e\x29\xca\x42\x14\x42\x21\xe7\x94\xe6\x31\x4e\x25\x43\x21\x48\x08\x24\x84\x6f\x76\x4d\x7f\xae\xf9\x12\x15\x5e\xce\xb6\x3e\xe8\xd5\x83\x5e\xfd\x81\xf4\xea\x0c\x82\x67\x96\xca\x73\x9d\x78\xc6\x78\x58\x75\xbb\xdc\xb6\x46\xab\x76\xcd\x3d\xcc\xbe\x55\x23\xc8\x08\x0b\x89\x04\x9e\x43\x88\xd4\x4e\xc0\xf7\x3d\x6b\xbb\xaa\xf7\xf3\xb3\xda\xcf\x4b\x8b\xde\x0f\x22\x56\x96\x39\xcc\xcf\x8c\x7c\x1d\xda\x36\x67\x7b\x5f\xc1\xb8\x97\x98\x4b\x23\x17\x9a\x6f\x90\x56\x04\x28\xe1\x2c\x00\x21\x4e\xd0\x62\x46\x82\x19\x22\x02\x61\xf4\x84\x83\xe7\x29\x67\x29\x0d\xdd\xd7\x68\x86\x69\xa8\x3b\x36\x3a\x1f\xae\xae\x96\xcd\xa2\x48\x8d\xd5\x06\x96\x3b\xe3\x2a\x0b\x62\xbf\xa2\x95\x60\x4a\x7a\x3f\xde\x7d\x2c\xbd\xdd\x51\x36\x1e\x9e\x68\xcb\x8e\x22\xf8\x6e\x59\xd8\x21\x38\x89\xd8\xc2\xc7\x50\xc8\xe0\xef\x9e\x9d\xf0\x73\x27\x21\xff\xe5\xa7\x9f\xd5\x3e\x53\x58\x64\x5c\xb1\xc0\x02\x09\xc5\x57\xcd\x77\xd5\xc1\x14\x9e\xfe\xad\xdb\x4c\xeb\x0d\x6b\x4b\xfc\xa5\xa3\xd2\x7b\x8b\x43\x74\x07\xdf\x52\x10\x8d\x89\x8b\xaf\xa9\xea\x0e\xea\x7d\x17\xbd\x5d\x7d\x35\xfc\x65\x04\x98\xe7\x2c\x1f\xad\xe6\x43\xab\xe7\xc9\x04\x11\x8a\x26\x44\x6d\x63\x5d\x89\xb9\xed\x35\x85\x8a\x85\x7c\x0e\x82\xd0\x8e\xf3\xd0\x77\x86\xf3\x00\xd3\x00\xa2\x1d\x08\x4c\xf5\x16\x20\x8d\xa0\xb2\x80\x78\x4a\xa9\xb6\x76\xca\x96\xd2\x41\x6e\x0e\x72\x63\xff\xf2\x94\x1b\x0e\x13\x0e\x62\xb6\xc7\x82\x93\xbf\x74\xbb\x84\x9d\xbc\xe8\xd8\xdb\xb7\xbe\x34\x68\x31\x82\x50\xb8\xd7\x78\xda\x90\x24\x14\x61\x44\x19\x45\x4f\x11\x0b\x9e\x95\xe4\x55\x27\xc3\x6c\xaf\xa4\x1d\x2e\xeb\x3b\x25\x8c\xa7\x8e\xd1\x36\x08\x2f\x3c\xe8\xd0\x82\x50\x17\xc9\x49\x1a\x21\xc9\xd0\xd4\x66\xb3\xc5\x4c\x48\xc4\x21\xa8\x91\x83\x13\x9d\xdd\x06\xdf\x71\x9c\x44\x60\xaf\xa0\xe6\x1a\xa9\x38\xdf\xf4\xa6\xaa\xb8\x91\x26\x9c\xcd\x49\x58\xe9\xbe\xde\x35\x85\x71\x5b\xa5\x04\x56\x0d\xb8\xca\x6e\x8a\x4c\x83\x28\x62\x2d\x30\x0f\x05\x3a\x76\x5b\x58\x19\x97\x38\x28\x8a\xd2\x2f\x1f\x14\x05\xaa\x51\x14\x56\xc2\xce\xd5\xc5\x28\xad\xe8\x85\xb7\x65\xce\x71\x9b\x2c\xdb\x59\xea\x3e\x58\xf5\xe4\x14\x8a\x41\x17\xb1\xc9\x5a\x96\x2d\x3a\x66\x7c\xed\x02\xa9\x3e\xdc\x2d\x19\xc3\x51\xf4\x79\x52\x4b\xbf\xfc\xbf\x66\x40\x19\xc0\x6a\x8e\x0d\x59\x8c\x09\x3d\x7b\x1f\xb1\xc5\xbd\xe1\x9c\x56\x68\x35\xb2\xd9\x63\x49\xce\xb4\x67\x4f\xff\x82\xa0\xc9\x03\xb5\x36\x2b\xe1\x2c\x01\x2e\x49\xc3\x9e\x55\xce\x0b\xb1\x6c\xa7\x7b\x69\x56\x8d\xa0\x63\x2e\xcf\xaa\x43\xd5\x75\xff\xaa\x85\xbe\xfb\xa8\xe6\x11\xff\xfc\x31\x14\xad\x15\x7f\xe3\x3d\x7d\x0d\x6d\x6b\xcb\x69\x9c\xff\x27\x57\x7a\x24\xa8\xee\x73\xb8\x6d\x9a\xd7\x2c\xbd\x8f\xe6\x35\x36\x0d\x37\x0e\x63\x08\x5d\x51\x91\x2d\x8b\x96\xe4\x76\x44\x3f\xfb\x44\xc7\x24\x04\x1a\xea\xb7\xfb\x6f\xb2\x47\x19\xf5\x6b\xcf\x60\x57\x54\x97\x69\x7b\x46\x7a\xa8\x66\x69\xc7\xbd\xd0\xf1\x95\xb3\x39\x3d\x32\x13\xdc\xbf\x5d\x3d\xc1\x1c\xcf\x0e\x74\x88\xd9\xb3\xff\x9e\xa8\x3b\x5c\xad\x5a\xa8\xfb\xb7\x5b\xe7\xd9\x5f\x7e\xfa\x4b\x27\x29\xf8\xc4\x24\x7a\xcf\x52\xfa\x32\x41\xc1\x1f\x38\xa9\xe8\x25\x12\x8a\xf4\x5f\x96\x54\x47\xb9\x75\xac\x2d\xdf\x1d\x8c\x17\x52\x72\xf2\x94\x56\x04\xd4\x9a\xa5\xbd\x4d\xaa\x8f\x74\x22\x5e\xad\x9a\x2e\x6c\x88\x4e\x09\x24\xe6\xc0\x25\x6e\x73\xb0\x5b\x5a\xae\x1a\x4d\xcd\x26\x15\x8f\xd0\x32\xb1\x2a\x4e\x13\xf5\xa3\x1f\xf1\x53\x45\x80\xa4\x69\x99\x7a\x86\x5b\xeb\x2c\x8d\x31\x45\x1c\x70\xa8\xcb\x8e\x1d\x27\xc0\x67\x38\x11\x28\x62\x01\x8e\xc8\xbf\x21\x7c\x93\xc3\xa2\xa1\xed\x66\xaf\xf5\x77\x5b\x7b\xff\x75\xcf\x71\x94\x0e\xba\xf0\x3b\xbc\xf8\x4d\xc3\xf4\x5d\xbb\x9b\xe0\x96\x9f\xf5\x72\x47\x8c\x93\xa9\x8e\x90\xea\x45\xba\x67\x8b\xd9\xda\xdb\x56\x6d\x59\xdb\x6b\xd5\x8f\x94\xc8\x4e\x4c\xed\x26\x94\x18\x5b\x9b\x69\xc5\xa5\x1a\x0c\x04\x8a\x01\x8b\x54\xd1\x12\xa5\x94\xd4\x9d\xb1\xed\x64\xf7\xb0\xee\x8b\x1a\xa0\xc2\x8d\xd2\xac\x02\x70\x18\x12\x53\x66\xee\xb6\x45\x19\x54\xeb\xc5\x92\x0a\xf2\x5f\xf4\x0d\x84\xa4\xac\x8f\x37\x54\x59\x37\x24\x86\x07\x03\xa1\x66\x87\x7b\x71\x3b\x4c\x80\x03\x0d\x06\x06\xfb\xa0\x9b\xdb\x0e\x0b\x72\x70\xdc\x1f\x05\x9e\x6e\x02\xd2\x83\x21\x72\x97\xc6\xb3\x55\xa2\x91\x75\xb8\x5d\xd3\x09\x1b\x9a\x4b\x2e\x62\x96\xd2\x7a\xab\xb4\xd9\xfa\xbc\xe5\x24\x00\xcf\xe3\x89\x43\x48\xe4\x25\xe6\x61\x25\x16\x2d\xbf\xb7\x0a\xa0\x9c\x15\x00\x79\xfd\xf8\x07\xfb\xba\x7d\x50\x6e\xb0\x7d\x87\x06\x85\x69\x37\xfa\xd6\x45\x5a\xb6\x4a\xc6\x3c\xd8\x57\xbb\xd6\x2e\x32\x45\x7a\x55\xe5\xb5\xdb\xd4\x12\x4b\x85\x64\x71\x83\x9a\xcf\x46\x16\xcd\x9d\xc2\x44\x14\x11\x21\xd5\xc1\xf5\x0c\x4b\x7b\x5c\x79\x1e\xb0\x35\xa3\xbc\x0f\x90\x12\xdc\x3a\x92\xa3\x6a\x53\xb9\x52\x2d\x0b\x65\xfc\x87\x5a\x5d\x5c\x5f\xf9\xdb\x22\xeb\xf3\x74\xfe\x2d\x45\xd7\x57\x08\x47\xca\x9e\x5a\xa2\x67\xca\x16\x14\x3d\x2d\xf5\xf1\x7e\x89\xb9\x74\x47\xfd\x24\x95\x29\x77\xe1\xb1\xda\x8a\x7f\x83\x72\x95\x6e\x69\x36\xb4\xfe\xcb\x36\xed\x62\xd5\xab\xb8\x81\xa9\xdc\xaf\x61\xce\x6b\xcb\x65\xe8\x22\x88\x2f\xb6\xf5\x97\x44\x0e\xac\xd9\x2e\x6d\x0f\xe3\x81\x81\x9a\xce\xa3\x63\x00\x6d\x36\x64\xfb\x00\x7e\xa7\x5b\x0b\x0e\x0a\xf2\x3d\xe1\x42\x5a\xb7\xe4\x80\x60\x3f\xe2\x31\xa0\xde\x90\x30\x8c\xe0\xd3\xe0\x70\x6f\x5d\xc3\xe8\x81\x8d\xd2\xac\x8b\xef\xa0\x70\xef\x71\x94\x4a\x5c\x59\x04\x69\x33\xb8\x3a\x01\x76\x60\x90\x1c\x6a\x62\x15\x1b\xc2\xfc\x34\xb4\x19\x02\x11\xe8\x46\xa5\xdb\x65\xdd\x54\x80\xfc\x0d\x6f\x42\x50\xef\x83\xcd\x3c\x16\xbd\x22\x22\xa8\x34\xc5\x37\x3d\xe0\xec\x5b\x7e\x5f\x8b\x60\xc6\x16\x28\x4e\x83\x99\x3b\xe7\x43\xbb\x2e\x34\xc3\x02\x3d\x01\x50\x24\xd2\x27\xc9\x71\x20\x21\x5c\x15\x7c\x46\x89\xba\x0a\x9c\xa0\xeb\x9b\xdb\xcf\x77\x0f\x17\x9f\x1e\x7e\x45\x38\x5a\xe0\xa5\x40\x14\xa6\x58\x92\x79\xad\xf3\x62\xb0\x0b\x06\x8e\x13\x4c\xa6\x2d\x5a\xa0\xd8\x66\x95\x92\x6f\x29\xac\xb9\x30\x52\x1a\x02\x8f\x96\x84\x4e\x51\x60\x41\x22\xc6\x11\x4f\x23\x30\xf5\xa2\xbe\xe8\xc0\x1a\xf0\x53\xf7\xf5\xe9\x2f\x3f\xfd\xfc\x5f\x5f\x8e\x86\x75\xe6\x65\xaf\x7f\x7d\x51\x71\x38\x64\xfb\xa5\x17\x6b\x73\xb6\x42\x65\xc1\xe9\x57\xe7\x6e\xf5\xc3\xaf\xf9\x5a\x5c\x4b\x88\xef\x20\xd2\x1d\x89\x7c\x97\x3d\x89\xf0\x14\x11\x1a\xea\x4b\x2f\x9d\x22\x52\xe0\x3a\x65\x90\xda\xd7\xd4\x61\x0a\x48\xb2\xac\xa5\x55\x43\xc5\xee\xf6\xbc\xe3\xcd\x3d\x90\x99\xbb\xd1\x55\x18\xcb\x96\xfc\xe5\xe8\x3e\x4d\x80\xa3\x7b\x4d\x68\x74\x8f\x23\x40\xc3\x93\xfb\x9e\x71\xf9\xb9\xf2\x2d\x6d\xdd\x8a\x2d\x95\x41\xa7\xbb\x9a\xe7\x76\xc6\x7a\xcf\x89\xf8\x1c\x8c\x8c\x5b\x9a\x9f\xa0\x88\x2d\xac\x37\x32\x93\x7f\xb7\x1f\x4f\x30\x61\x1c\xd0\x8c\x4c\x67\x7e\x5e\x55\xd7\xf8\xc1\x4f\x9f\x37\xba\x6f\x8a\xa1\x63\xa6\x90\x50\x0c\x94\x12\x31\x43\x4f\x20\x17\x6a\xa5\x0e\xb1\xd6\x8b\xdd\x50\x4a\xfc\x03\x99\x68\xd7\xc4\xb6\x29\xf1\x55\x15\x02\x0f\x2d\x3e\xba\xa6\x1e\xdc\x4e\xeb\xed\x13\x58\x5d\xd9\x51\xa0\x61\xac\x3c\xd8\xe3\x38\x03\x2a\x53\x06\xaa\x4d\x5a\x45\xd6\xaa\x04\xe1\x3a\x5c\xea\xf7\x9c\x08\x24\x24\x89\x22\x84\xe7\x98\x44\x4a\x69\x0d\xba\xc5\xbe\x32\xa2\x53\xae\x46\xbb\xbe\x57\xfa\x9a\xea\x68\xb5\x3e\x6d\xad\xea\xca\x84\x71\x74\xe9\xcb\x0b\x0d\x09\x66\x85\x95\x79\x6d\xba\x15\xf3\xd5\xe9\xef\xcf\xc9\xe5\xa9\x68\xc6\xa2\x10\xad\x0a\x95\x08\x65\xc6\x64\xaa\x3e\xd0\x43\xf4\x93\x64\xcc\x57\x15\x4b\x24\x6b\xeb\x86\x31\x98\x07\xa4\x29\x39\x6f\x65\xfe\x6c\xe0\x18\x29\xe8\xe4\xce\xb4\xcc\x26\x9a\x57\xe9\xce\x5d\xe8\x48\x95\xc9\x57\xab\xa6\x18\x91\x52\xc5\x73\x67\x13\x72\xa5\x72\x06\x54\x6a\x13\x21\x7c\x14\x9d\xdc\x88\x15\x73\x35\xfb\xd9\x44\x7a\x26\xd5\x97\x99\x92\xdd\xcc\x61\x58\xb1\xf4\xb7\x10\x31\x3a\x15\x0f\xac\xb4\x0e\x6f\x0c\xea\x41\x28\x99\x49\x22\x75\x95\xb1\xb6\xa9\x3e\x29\x89\x40\xd3\x14\x84\x4b\x59\x9e\xe0\x48\xc0\x1b\x25\x61\xee\xf9\x04\xce\xc3\x31\xb6\xf7\xb1\xd2\xfe\x55\x99\xcc\xa8\xb7\xcd\xfa\x96\x44\x11\xa1\xd3\x3a\xe7\x68\x2d\xb6\x6b\xd3\x5c\x48\x57\x69\x79\xf4\x64\xbe\x42\xd8\x7e\x77\xcc\x21\x82\x39\xa6\x52\x6b\x46\x1c\x65\xef\x41\xc4\x39\xa1\x73\x46\x02\x10\xb5\x28\x35\xab\x47\xbd\x62\x2f\x2c\xaf\x60\x82\xd3\x48\x5e\xea\x2c\xf1\x60\x60\x1f\xe2\x95\xa9\xf4\xdb\xe4\xac\x2f\x92\x6f\x35\x45\x3f\x0c\xc1\x84\xe6\x15\x84\x6b\xee\x9f\x1b\x75\xcc\x38\xba\x9f\x91\x44\xd3\xed\x0d\x52\x84\x8b\xe6\x10\x9a\xe6\x43\x44\xbc\xb6\xbe\xb5\x2b\xf5\x6e\x7a\x56\xe9\x31\xa5\x92\xc8\x65\x07\x9d\xe1\x26\x18\xed\x2a\x20\x60\x34\x2c\xf5\x13\x8c\xf1\x32\x3b\x99\x9f\x96\x48\xb0\x18\x74\x79\x10\xa0\xed\x6a\xb7\xd3\xe5\xd5\x7f\xdd\x66\xc5\x99\xbc\x94\x1b\xf9\xf9\x6c\x66\x8f\xa0\xe0\x7d\x6d\x41\xf6\xba\x95\x96\x8a\x9a\x9b\xc7\x62\xfa\x94\x17\x52\xdd\xdd\x08\x9d\x30\x1e\x6b\x0f\x27\x62\x14\x7d\x51\x46\xe4\x97\x23\xfb\x8e\xd2\xaa\xea\x05\xa6\x52\xa8\x29\x49\x3d\xfb\x35\xf0\x56\x69\xf5\x7e\x38\xa7\x3c\x98\xe1\x2e\x0a\x3c\x9b\x61\xec\x9e\x9c\x65\xaf\x90\x14\x59\xee\x70\x04\x53\x9d\xb4\x42\x25\x0e\x24\x4a\x80\x0b\x45\x18\x2b\x87\xa6\x38\x55\x0f\x24\x35\x18\xcf\x8b\x2d\x93\x38\x6a\x96\xd7\x7a\x73\x55\x3f\x52\x95\xf8\xbb\xf6\x3c\x48\x05\xe9\x35\xad\x8f\x0c\x15\x6f\xc5\xe1\x7d\x57\xa8\x77\x80\x8d\x9a\xc9\xf7\xa2\xb7\xcf\x52\x44\x5a\xdd\x43\x8a\xdc\x1b\xaa\x0b\x8a\x69\x09\xaa\x48\x62\x7c\x95\xa7\xe8\x19\x96\xe6\xc5\xa2\x4d\xb4\x52\x46\x7c\xc2\x99\xa2\x08\x12\x09\x04\x64\x42\x82\x17\xbc\xc1\xfa\xee\x6b\x76\xd2\x0c\xbd\xab\x98\xcb\x6e\x32\x95\xcd\x70\xda\xdc\x1d\xdf\xe6\xc3\x27\x6d\x0f\x2a\x43\x48\x5f\x89\x88\xc8\x3a\x06\xbe\xa2\xbc\x5d\x77\x11\xb5\x7a\x53\x67\xd9\x9c\x82\x53\x6d\xec\xe8\x49\x2b\x73\x27\x04\x89\x49\xe4\xd4\x6a\x8e\x3c\xc6\xef\x3c\x63\x0b\xcd\x9d\x8b\x19\x70\x58\x6f\xb8\xb8\xd6\x72\x47\x7d\xa4\x0f\xa4\x1e\x4a\x77\x0d\x1b\x3f\x9f\xe9\x8c\x24\x09\xa1\x53\x4d\x47\x5f\xfc\xf3\x93\xbe\x48\x0e\x09\x07\x01\xd4\xbc\x64\x14\xf6\x3b\x14\x30\x21\xd7\x2c\x96\x92\x89\xd7\xc6\x3e\x0d\x88\xae\x2d\x7b\x73\xd9\x1b\x25\x8d\x6c\x70\x3f\x4c\x66\xa3\x14\xb5\x5a\xd1\x68\xc9\x33\xd7\xee\x26\xf0\x5c\x62\xce\x49\x07\x73\xc7\x8e\x47\x59\x6b\xe4\x7c\xa3\x13\xf7\x65\xa1\xe2\x9c\x7d\x70\x44\x94\xed\x60\x2a\x00\x40\x90\xea\xa8\x4b\x17\x1a\x76\x4d\x4a\xef\x9c\x36\x8f\x2b\xb6\xbc\xdc\xc0\xd5\x71\x33\x3a\xd5\xb5\x95\x29\x28\x59\x93\xd9\xd7\x17\x61\xf8\xc0\x74\xbe\x92\xad\x91\x96\xaf\x79\xf0\x85\x1a\xb7\x58\x08\x9a\xe5\x26\x2c\x8a\xd8\xc2\xba\xca\xe8\x5c\xfd\x94\xb3\x09\x01\xdd\x01\x0e\x63\xf8\x15\x3d\x2c\x13\xf8\x6a\x72\xfe\xbe\x7e\x64\x26\x45\x53\x7f\xe6\xfe\xa7\xa1\x56\xef\x26\xf7\xd2\xa5\x83\xdf\x59\x61\xbb\x89\xee\x80\x93\x98\x4f\x41\xea\xac\x78\x99\x6f\x71\xb4\x81\x66\x2a\xad\xd1\x13\x33\x7d\x41\x7e\x20\x4d\xd9\x25\x25\xa4\xb2\x39\x36\x49\x2d\xe3\x7c\x77\xdf\x96\xea\x3b\x5f\xa4\xc6\xc8\xf8\x2c\x2c\xd9\x0c\x77\xd4\xcf\x8e\x0a\xdb\x7d\x48\x5d\x65\xe5\xcc\x08\x1f\xd6\xfe\xa4\x12\xd7\x67\xb5\xc1\x15\x93\xc6\x80\x29\x0a\xc9\x44\xa7\x5f\xcb\xd5\x71\xfa\x2d\x55\x26\x38\x01\x81\x16\x44\xce\xaa\x46\xa8\xb3\x69\xd0\xcb\xf2\xdf\x19\x7f\xd6\xe5\x29\x7d\x29\xe1\x26\x38\xfd\x74\xb5\x66\x2b\x64\x0b\x65\x1c\x25\x24\x78\x4e\x93\x13\x24\x60\x35\xca\xcd\xbe\xb5\xdf\x15\x3f\xbf\xca\xcd\x2f\x7e\xf7\x48\x2d\x35\x6b\x2b\x44\x0e\x17\x03\x6c\x15\xd8\xcd\xcf\xd8\x4e\x4e\x3e\xe7\xdd\xcb\x6c\x36\xfd\x42\xc3\x7e\xe8\xb2\x3b\x1c\xed\x9c\x56\x38\x51\x06\xcc\x13\x20\xca\x24\xca\x3c\x7f\xd6\xf1\xa9\x96\xaf\x18\x55\x7d\x67\xe1\x8c\xed\xf9\xeb\x77\x80\xe4\x94\x43\xd1\x3d\x43\x44\x86\xea\x79\x08\x42\x12\x6a\xf6\x6a\x40\xf1\xe8\x14\xb9\x7e\xb0\x34\xcd\xe8\x5b\xdc\x98\x53\x65\x0e\x19\xc7\x97\xf3\x2f\x31\x2a\x24\xd6\x12\x3e\x21\xd4\x99\x98\x80\x12\x1c\x3c\xe3\xa9\xba\xc8\x3c\xaf\x84\x27\xca\x1d\x55\x17\xcd\x7b\xd6\xef\x49\x04\xf4\x74\x41\x97\x66\x3a\x2e\x73\x1c\xba\xb2\x5b\x24\x7e\x06\x6a\x1c\xeb\xce\x3b\x7d\xcc\x68\xb4\x44\x6b\xae\x69\x4d\x3d\x3b\x79\x03\x27\xbb\xaf\xb4\xbf\xfb\x6e\x12\x0e\x2e\xad\x9f\x6c\x8c\x5c\xf7\x6e\x5e\x49\xc7\x1e\x76\x41\xa3\xeb\xbb\xca\x5b\xd4\x0b\xdd\x23\xb6\xdf\xa0\x2f\xa4\xf5\x75\x8e\x3e\x66\x13\xd7\xf5\xb7\xbb\x77\xe3\x27\x96\xca\xb5\x54\x29\xb1\x1e\xc4\x25\xc2\x64\x4a\x9d\xaa\x41\x4b\x77\xb5\xfa\xa2\x3d\x10\xdc\x24\x69\x7d\x39\x52\x47\x26\x65\xaf\x19\x7a\x28\x66\x3f\x6e\x40\xf1\x77\xb6\x60\x99\xc7\x13\xb5\x62\x28\xa2\x38\x73\xed\x26\xfa\xb4\x34\x41\x3e\x01\x7c\x4e\x02\x40\xfa\xc9\x7d\x0c\xd4\x64\x06\x6b\xd7\xb8\x80\x04\x73\x2c\xc1\xd6\xd8\xb0\x2e\x02\xec\xee\x11\xb9\x6a\x6a\x5f\xe8\x1a\x2c\xad\xd4\x4c\xda\xa1\xce\x44\xd4\x5b\x36\x6c\x70\xb4\x97\x12\xb1\xac\x43\x4d\x91\x34\xa6\x69\x61\x56\xc8\xe6\x60\x2c\xec\xc5\x8c\x45\xc3\x07\x3b\x6e\x56\xf5\x3a\x86\xf7\xd4\xda\xe7\x96\xc3\xa7\xb5\xff\xaf\xcf\x13\x8c\x4e\xe9\x6f\x77\x6c\xa1\xf3\x6d\x3e\x70\xd6\xe1\x50\x5d\x9f\x35\x72\x1e\x97\xfb\xb1\x4f\x4d\x79\xe7\x35\x0b\x54\x73\xc6\x5f\xde\x03\xfe\xde\xc1\x1d\xef\x45\xbc\x97\x88\xaa\xe0\xef\x9b\xa8\xc1\x7b\x42\xa7\x11\xf4\x60\x9e\xe2\x44\x34\xd5\xff\xd5\x39\x81\xe8\x98\xd0\x20\x3a\x43\x52\x51\xd4\x14\xe2\xc1\x48\xe8\x09\x2d\xd5\x78\x06\xdb\xcf\xdc\xf2\xba\x70\xdc\xfa\x34\x44\x41\x3a\x8c\xe0\xfb\x6b\x63\xc4\x52\x1e\x40\x07\xfd\x6c\x26\xa0\xeb\x50\xa9\xe9\x95\x6b\xdd\x44\x2c\x56\xf6\xb2\x5e\x12\x8e\xf4\x65\x1a\x42\x74\x8a\x5c\xa5\x50\x01\xd2\x3d\xe5\xd3\xa0\x08\x9d\x7e\x64\xd3\xf6\x40\x51\xb7\x47\x13\x9c\x60\x2a\x8d\x1e\xbf\x6d\xd7\xe3\x05\x14\xab\xa7\x67\x91\x6b\x9d\x9d\xc7\xe8\x84\x4c\x53\xae\x63\xa0\xae\x98\xd7\xe8\xd6\x6e\x6b\x14\xbe\xb6\x0c\x43\x19\x94\x89\x16\xbf\xb4\x7b\x60\xa8\x3b\xb9\xd7\xcd\x27\x1b\x5d\x32\xb5\xca\x93\xb3\xc4\x2a\x57\xba\x39\x0b\xe5\x9b\xcb\x3c\xd8\x39\xab\x9c\x84\x53\x9b\x36\xe3\x2c\x63\x13\xe2\x37\x36\x71\x69\x74\x0f\x1f\x45\x25\x8a\x7e\x26\x86\xde\x5b\x1c\x5d\x19\x3b\xbd\xd7\x6e\x14\x61\x6c\xc0\xb4\x2d\xab\xd9\x90\xe5\xae\xb0\x84\xcf\x93\xb7\x84\xcb\x72\x75\xf1\xd2\x4f\x74\x51\x22\x9f\xb0\xb9\xb6\x0d\xfe\xb4\xf5\x16\x0b\x91\x30\x2e\x47\x79\x8d\xea\x80\x7f\x4a\xe3\xa7\x8d\x1e\xef\xfb\x6e\x6f\x63\x3c\x73\x98\x27\x07\x1e\xf7\xd7\xdd\xba\x25\xb6\x1b\x10\x43\x1d\xef\x0f\xf8\xfb\x4b\x55\xd7\x78\xa9\xd2\x0e\xca\x34\x1d\x9a\xcf\x5e\x88\x44\x77\x83\x3f\xb9\xdd\xb0\xc0\x8c\x37\xcd\xa1\x32\x0f\x6e\x53\xd5\x6d\xfd\xc3\x2d\xaf\x0c\xb6\xaf\xdc\x81\xd2\x75\x97\xcd\x2c\xd3\xeb\xc2\x6d\x7d\x03\x1b\x83\xf6\xde\xd6\x2c\x77\x6e\xeb\x13\xdc\x8c\x94\x6d\xbd\x12\x7b\x35\x11\xcd\x57\x32\xde\xfa\xcd\xbc\x01\x31\x7e\x19\xab\xf5\x5a\xfa\x07\x9a\x68\x9a\x54\xd5\xcd\x1c\x98\x34\xd5\xf4\xce\xbe\xae\x29\xae\x65\x34\x5f\x63\x3d\xee\x06\x8d\xd9\x53\x31\xbc\xc5\x82\x04\xb5\x85\x6b\x2b\xdd\x25\x69\x10\x34\x5e\x70\x87\x09\xee\xd5\xf5\x4c\x1e\x21\x63\xb5\xe6\x68\x6f\x21\x60\xde\x2a\xf0\x22\x9c\xfa\xa9\xdf\x70\x44\x42\x7d\xab\xaa\x41\xa7\xe5\x47\xe7\xd9\xfc\xb3\xba\x92\xaf\x95\xa9\x3e\x5d\xa2\x78\xde\x10\x57\xc8\x34\x67\xb2\xf6\x81\xdf\x22\x42\xa5\x94\x83\x42\x3e\x2c\x99\x20\x61\xf8\x14\x11\x81\xf4\xb3\xb0\xee\xfe\x87\xce\xa2\xf8\xe2\xa2\x31\x05\x79\x99\x2d\x74\x0c\xd1\xe8\x25\x14\xd5\x4d\x1d\x5e\x53\x24\xba\x51\xb5\xdc\x7a\x67\xf7\x28\xfb\x1e\x93\x08\xc2\x3b\xc0\x55\x2e\xce\x12\x1a\x5d\x4e\x69\x53\xa3\x8e\x4e\x58\x77\xaf\x5a\x81\xc4\x61\x0e\x94\x9f\x7c\x8d\x51\xb0\x09\x4b\x68\x56\x8f\xb5\xc7\xb5\x92\xf5\xb3\x15\x00\xaf\xdf\x7b\x7c\x6c\x0a\x34\x0c\x6c\xe0\x94\xa8\x3c\xb4\x9d\x33\x7c\x79\xb6\x5c\x8d\xd5\x57\xf3\x75\xb5\x57\x7f\xad\x84\xe8\xe9\xf8\xd2\x7b\x72\x05\x01\xe3\x58\x42\xd8\x47\x15\x84\x66\x32\xe3\x67\xeb\x60\x3a\xfc\xbe\x8f\x10\x8f\x4b\xe4\x5c\x2d\xd9\xc2\x92\x06\x7d\xc5\xd6\xd6\x35\x7c\x60\x89\x18\x5f\xbc\xeb\x36\x7f\xf7\x8e\xa8\x6c\xfd\x3e\x4f\xbf\xc7\x65\xc6\x0a\xa2\x76\x7e\x8c\xdd\x7f\xef\xc6\x88\x1b\x34\xbf\xad\xea\xfc\xb8\xed\xc5\x2f\x9e\xfd\xa8\x39\xd6\xbb\xc6\xec\x07\xae\x9b\x1f\x37\xbe\x38\x97\x66\xec\xb3\xc1\x89\x54\x4b\xb4\x96\xe5\xb4\xd4\x2c\xf0\xda\x42\xc7\x0b\x61\x85\xdb\x67\xeb\xfc\x53\x2d\x95\xe9\x06\xd1\xee\x15\xc2\xd1\x2b\xc3\xc1\x02\xba\xc4\x12\xa6\x8c\x2f\xc7\x89\x5e\x74\x7f\x16\xa0\xf3\x64\x4a\xa5\x1a\xdc\x2a\x87\x4c\x80\x69\xce\x2e\x2c\xb6\x2a\xc2\x71\x96\xfe\x2f\x12\xc0\xba\xf7\x74\xfe\x29\x5f\x30\xc2\x0a\x6f\x71\x53\xbc\xbe\xd4\x65\x18\x90\x9a\x80\x8e\x39\x63\x12\x49\x86\x22\xc0\x93\x37\xab\x87\xbf\x8e\x86\xe8\x34\x4b\x83\xd5\x39\xb3\x5f\x8e\xce\x37\xa8\x78\xe8\xcf\xb2\xa3\xf8\x98\x35\xd0\x11\xca\x4a\x2b\xb0\x2f\xe2\x6d\xce\xf5\x06\xbd\xd0\xf9\x4a\x63\xbc\x4f\xb8\x32\x95\x91\x3a\x25\x00\xe5\xe6\xd8\x12\x51\x21\x96\xf8\x04\x51\xc6\x63\x1c\x45\x4b\xf4\xd7\x87\x9b\x8f\x36\xe5\xd8\x56\x5e\x5a\xd5\x25\x4b\xc5\xb0\x4d\x93\xde\x33\x1e\xdf\xba\x6e\x87\x2f\xff\xda\xa0\xb9\xad\x2b\xe3\xf1\x7b\x02\xd1\x46\x45\xbd\x1e\xef\x3e\x7a\xef\xcc\xe3\xdd\xc7\x7c\x85\x98\x04\x0b\x81\xd4\x67\xba\x02\x45\x6e\x03\x8a\xe9\x5a\x42\x62\x09\x36\x55\x5c\xb0\x61\xbb\x42\xfd\x1d\x47\x11\xc8\x9e\xc9\x54\x96\x90\xeb\x30\x36\x95\xa6\xfb\x9a\x1e\xcd\x1b\x66\x41\x74\xab\xec\x63\x86\xeb\x5d\x02\x3e\x61\x3c\xd6\x7f\x9a\xa6\xe6\xab\xe7\x60\xa6\xcb\xf2\x24\x62\x8b\x33\x74\x3d\x71\xed\x96\x89\x40\x5f\x8e\xec\x97\x5f\x17\x98\x48\x42\xa7\x5f\x27\x8c\x7f\x75\xf9\x72\xba\x00\x10\x11\x68\xa2\x98\x6f\x3d\xc7\xce\x3d\xbb\x37\x99\x76\x8b\x19\xd6\x07\x42\xc8\xd0\xa9\xab\x8d\xcc\x21\x24\x1c\x02\x69\xde\x96\x7c\x39\x12\x33\xb6\xf8\x4a\x26\x4a\xc4\x86\xad\x7f\xdb\xa0\xd5\xb2\x31\xad\x6d\x93\x73\x40\xfc\x2c\xd6\x4e\x9a\x4e\x97\x34\xc8\xea\x83\xad\x17\x24\x72\x94\xb4\x4f\x71\x9c\x7c\x61\xb3\xb1\xe7\x7a\xd7\x86\xec\xf7\xd5\x2d\xac\xa1\x47\xb7\xae\x5d\x17\x61\x16\xda\x54\xc1\x14\x81\x9e\x73\x6c\xca\x4e\x3b\xde\xd3\xce\xe0\x8e\xf5\xe1\xd6\xce\x75\x2f\xe4\x6a\x64\xb2\x0e\xbb\xfb\xac\xef\x78\x59\x4e\xde\x19\x36\x76\xf2\x11\xb0\x38\x89\x40\xbf\x95\x3a\x69\x17\x1b\xcd\xf1\x6e\x8c\xc1\xfd\x45\x4c\xa0\xd5\x51\x31\xb0\x5a\x6a\x69\x6c\xf7\xca\xd9\x41\xfe\x04\xfa\xc8\x96\x38\x92\xcb\x77\x98\x53\x42\xa7\x63\x14\x40\xb1\x25\x0a\x5f\x20\x4b\x66\xf4\x94\x96\x75\x9a\xe9\xa5\x8d\x41\xb1\xba\x10\x57\x07\x8a\x39\x10\x9e\xde\x86\x17\xda\x21\x97\xa5\xfa\xd0\x88\x5d\xaf\x92\x20\x16\x72\x53\x25\xf0\xa1\xb0\xb8\x16\x3e\xbf\xd6\xab\x68\xe9\x0d\xfe\x7e\xcb\x08\x95\xe2\x81\xdd\x27\xe0\x93\x6e\xd7\xad\x75\x0d\x1d\x11\x7a\xf7\xc7\xfd\x8c\xa3\x4f\xb9\x0b\xbd\x15\x2b\x65\xad\x4d\x39\x8e\x5f\xe0\x74\xb8\x75\xfd\xf6\x75\x1d\x9c\x8b\xdb\xeb\xe1\x25\xd9\x1c\x92\x8d\x97\x8d\x7e\x25\x70\x5a\xec\xfe\x7e\xc9\x5e\x3c\x98\x61\x9a\x0b\xff\x0f\xbe\x6e\x53\xf9\x65\xe0\x75\x7f\xd6\x6c\xb5\x09\xd0\x0e\x2c\x53\x99\xf1\xd9\xc5\xff\xb7\xa6\x9f\x87\xe6\xb7\x19\xa6\x14\xa2\xb1\x2a\x39\x02\xff\xc0\x59\x9a\x0c\xec\xc2\x65\x01\x1e\x3b\x45\x3f\x4f\xfa\x51\x8a\xbc\x05\x92\xcc\xe1\x2d\x16\x03\x93\x7d\x05\xb7\xed\x31\xc0\x66\xd0\x1f\x29\x19\x18\xb6\x6b\x3a\x74\x97\x46\xbb\x95\x45\xbf\xbf\xc6\x17\xd0\xe5\x0d\xe3\xd0\xe1\xdd\x52\x27\xdb\xe5\x60\xdc\xb5\x80\x7e\xc0\xdf\x2f\x23\xec\x93\x8b\x38\x80\xaa\xbb\xd7\xb5\x38\x7c\xe3\x55\xbd\xd4\xdd\xc6\x8f\x1f\xfc\xcb\x93\xfa\x94\x10\xf6\xeb\x3d\xee\x99\xd6\x76\xe1\xfa\xc1\xe8\x85\xbe\x5e\x78\xb9\x95\x5a\xc8\x5b\xb1\xe9\x88\xee\x6b\x63\x62\xc2\xca\x9b\xa8\x67\x13\x53\x7b\xcd\xc4\x94\xca\x30\xee\xe6\x28\x2d\x1f\x98\xb2\xdb\x6e\xb0\x7e\xb8\xba\x53\x47\x26\x07\x2c\x21\xbc\x18\x5a\xed\xaf\x5d\x19\x07\x04\x7c\x9d\x45\xbb\x07\x86\x2b\x3e\x41\x43\xe9\xc7\x5e\x87\xc6\xb5\xb8\xc7\x11\xe8\xce\x54\xc3\x02\xfe\x1b\x2c\x17\xac\xb1\xf7\xcf\xf6\x31\xda\xba\xb3\xd2\xdf\xad\x5d\x98\x87\xfe\xa5\xa3\xab\xab\x4a\x88\xb9\x1a\x09\xab\x8e\x3c\x91\x75\x47\x80\xfb\xb5\x16\x7f\xc4\xa8\xda\xa6\xc2\x4d\xbb\x39\x1d\xdb\xce\xb6\x6a\x2a\x9a\x59\x36\x42\x5d\xa0\xe1\x84\xf1\xac\xee\xc8\xaa\x40\xeb\x13\xa0\x04\x13\x1d\x7e\xc3\x19\x59\x3d\xbd\x3c\x2f\x41\xd5\x41\x8e\xd7\x1b\x4c\x68\x96\x34\xd3\xcf\x28\x6a\x3b\x52\xea\xab\x82\x79\x54\x93\xe9\xe7\xad\x09\x89\xc7\x23\xa5\x71\xf6\xc8\x5a\x6e\x66\x0d\x1b\x6c\xcc\x9d\x76\x95\xc1\x08\x39\x2b\x0e\xf2\xf0\x55\xd3\x1c\xe4\xfb\xe7\x74\x58\xc0\xee\x30\x79\xcf\x59\x43\x2e\xea\x26\x90\x1f\x3c\x5e\x9d\x75\x82\x3b\x63\x5c\x8e\x66\x0a\xdc\x4b\x16\x3c\x7f\x84\xf9\x46\x49\x84\x55\x77\xbd\x5e\xef\x17\xad\x1e\x30\x93\xfd\x63\xef\x23\x3c\x0f\x7f\x4c\xc2\x31\x4c\xba\xdf\x88\xae\xb1\x3f\x3c\xf7\x59\xc0\x1b\x31\x9f\xff\xe5\x3a\xb7\x41\x03\xdf\xac\xdb\x2e\x69\xc5\x6e\x75\x7a\xf8\xea\x14\x16\x11\x09\x74\x3c\xe5\xc9\x7c\x21\x19\x92\x7a\xad\xa6\x75\x40\x53\xe9\xb6\xdd\xb8\xfe\xf5\x2d\x9e\xf6\x30\x03\x24\x9e\xd3\x62\xdf\x07\x97\xd1\x15\x11\xfa\x6c\x2a\x8e\x9a\xad\x1d\xb4\x12\x74\xcb\x29\x5a\xaa\x8d\xaf\x46\xbf\xca\x26\x0d\x75\xe2\xde\x72\x30\xc5\xe0\x20\xb4\x45\xeb\x1a\xcf\xb1\x62\xbb\xb2\xaa\xd9\x28\x26\xd3\x99\x54\x5b\x26\x40\xda\xa6\x4f\xab\x5a\x77\x9a\xd1\xa7\x64\x0e\x08\xa3\x19\xa1\x32\xdb\x52\xc9\x10\x46\x73\x03\x45\x27\xe3\xac\x4d\x43\xc7\x0f\xba\x2f\x8d\x03\x6d\xb2\x8a\x05\x20\xa2\x46\xea\x84\xbe\x09\x89\x24\x70\x63\xd8\x62\xba\xea\x7b\xab\x3b\x10\xd8\x74\x32\x2b\x61\xea\x4f\xf7\x53\xba\xe1\x80\x69\x21\x9b\xcd\x20\xba\x10\xe1\x82\xb6\x56\xe0\xde\xa2\xd3\x51\x41\x1f\xa3\xf8\x88\xa6\xd8\x1e\xfa\xd8\x0c\x62\x1e\x39\x35\xd9\x8c\xa2\x9e\xaa\x00\x90\x15\xc1\x37\x7c\x96\xb5\xeb\x5b\xbf\x9f\x1a\x5d\x46\x28\x9a\x72\x12\xa2\x73\xd3\xb4\x6b\x4e\xa0\x36\x57\x6e\xe3\xfb\x66\x2b\x01\xea\xc3\x6d\x5e\xe8\x67\xd3\xb3\x3e\x64\xd9\xd5\x51\x9d\x72\xf9\xeb\x65\x56\x01\xd3\xd2\xc8\xe4\xda\x31\xaa\xa9\xa0\x88\xa3\x08\xd1\xb5\x1b\xab\xf7\x25\xb1\x9e\x10\x2d\x2e\xf2\x4a\xec\xf5\x1c\x87\x72\x0e\xd5\xd5\xa9\xa5\xd5\x88\xd6\x3e\xe6\xb0\x42\xc7\x0a\x4d\x85\x62\x3f\x0c\xfb\xa3\x76\x2d\x94\x29\xd7\x1b\xcb\xdc\x74\x57\x84\x55\x59\x2c\x3c\x05\x97\x41\x29\x6c\x31\xd7\xcc\x7c\xb1\xda\x7a\x86\xe7\x90\xeb\xe8\x92\x18\x9f\x84\xd9\xf6\xbc\x8a\x1f\xb6\xa7\xf0\xe3\xdd\xc7\xfb\x28\xf5\xf0\xcd\x0e\x60\x63\x36\xe7\x77\x6f\x68\x66\xb6\x27\xbe\x64\x43\x9b\x39\xa7\x04\xc7\x8f\x8e\x02\x42\x3b\xb5\xad\x73\xd0\x10\x14\x4d\xdc\x2a\x0f\xc5\xa8\x0a\x84\x59\x3d\xf8\xbe\xe4\x10\x12\xdd\x29\x7d\x94\xfc\x08\xca\xe8\x32\x26\xff\xd6\xaf\x23\xc3\x8d\xab\x80\x56\x06\x51\x78\xf8\x57\x16\x85\x43\xc3\x7d\xf7\x3d\x21\x7c\x68\xf3\x67\xf4\x44\xd5\x86\x97\xfc\x83\x67\xf7\xae\xaa\x25\x0e\x9c\xd5\xa5\x96\xfc\x12\x05\x63\xcb\x65\x43\x7a\xa5\x77\x55\x14\x0b\x3b\x68\x19\x05\x23\x57\x0d\xe8\x5a\x42\xbc\xca\x2e\x1c\xef\x7d\xa2\xc5\xeb\x6f\x30\x70\x1d\x63\xb5\xfe\xb1\xab\x49\x94\x8b\x27\x0d\x9f\x94\x1a\x5b\xe2\x8f\x45\xa7\xbf\x62\x91\xfb\x95\xa1\x43\xa2\x12\x62\x43\x99\x57\xbb\x33\xb6\xb0\xb4\xef\x0d\xb2\x8e\x1b\xfe\x90\x43\xfd\x48\x62\x1d\xe9\xfc\x47\xf6\x75\x81\x19\x8a\x0f\xe0\xa2\x08\xe9\xee\x9e\xee\xfd\xd4\xc5\xed\xb5\x40\x40\xc3\x44\xa7\x7e\x9f\xa0\x98\x09\x89\x44\x4a\xa4\xed\x94\xae\x6e\x14\x81\x32\x36\x43\xe3\x01\xc3\xe8\x89\xb3\x85\x00\x7e\x82\x9e\x20\xc0\xa9\x00\xd3\x27\x89\x43\xb4\x74\x5d\x65\x05\x08\xa1\x5f\xbb\xd1\x10\x05\x8c\x3d\x13\x40\x33\xc0\x21\x70\x71\x56\xe4\x46\x6a\x82\x23\x47\xeb\x45\x48\x72\xfb\xda\x8e\x8e\x35\x15\xb7\x0d\x23\xbb\xac\xbe\x48\xd9\xfb\x4c\x35\x52\xf5\x3f\x5a\x2c\xcf\xd1\xe9\x47\x2f\x6d\xf5\xd1\x6d\x23\xa5\x5b\xd7\x51\x41\x06\xfe\xf9\x87\xdf\xff\x2f\x00\x00\xff\xff\x59\x11\x4a\xa4\x4a\x42\x01\x00")
+var _docsOpenapiSwaggerJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\xe1\x6f\xdb\xb8\x92\xff\xee\xbf\x82\xd0\x3b\xe0\xb5\x80\x13\xb7\xdd\x3d\xe0\xae\x9f\x2e\x2f\xdd\x2e\x82\x6b\xda\x20\x69\xf7\x3e\x5c\x1f\x0e\xb4\x34\xb6\xf8\x2a\x91\x2a\x49\xc5\xeb\x2d\xf2\xbf\x1f\x86\xa2\x14\x59\x96\x6c\xca\x76\x62\xc9\xd5\x3a\x78\xaf\x89\x28\x9a\x33\x9c\xf9\xcd\x70\x38\xe4\xfc\x18\x11\x42\x88\xa7\x16\x74\x3e\x07\xe9\xbd\x25\xde\x9b\xf3\x57\xde\x38\xfb\x2b\xe3\x33\xe1\xbd\x25\x59\x1b\xfc\x78\x01\x28\x5f\xb2\x44\x33\xc1\xb1\xed\x5d\xf6\x1a\x79\xf1\x29\x01\x7e\x71\x73\xf5\x92\xdc\x25\xe0\x13\x31\x23\x34\x8a\xc8\xfb\x88\xc6\x8c\xcf\x05\xb9\x14\x71\x0c\xd2\x07\x12\x8b\x20\x8d\x40\xd9\xfe\xf1\xc7\xd3\x4c\x47\x80\x7d\xad\xb7\xbe\xb8\xb9\x32\xfd\x95\x9b\xfb\x82\x6b\xea\xeb\x95\x51\xe1\x8f\xc7\x69\xbc\xd2\x4d\xe9\x25\xfc\xf1\x52\x19\xe1\xe3\x50\xeb\x44\xbd\x9d\x4c\xe6\x4c\x6b\x90\xe7\x2c\x9e\xb0\xb3\x48\xdc\xc3\xd9\xcc\xbe\x37\xf1\x45\x1c\xa7\x9c\xe9\xe5\xdf\xaa\x5d\x40\x4c\x99\xe9\x24\x6f\xfb\x5f\x54\xc0\xb9\x2f\x62\xaf\x68\xf7\xf0\xf8\x8a\x17\x31\x1f\xb8\x82\xe6\xa1\x5e\x5f\x7d\xae\x7f\xf3\x1e\xa4\xb2\x1c\x7e\x7d\xfe\xca\x1b\x95\x9e\x7b\x09\xd5\xa1\x5a\xe9\xd3\x9b\xd0\x84\x4d\xee\x5f\x4f\x7c\x2a\x6b\x18\x33\x87\xf5\x3f\xe2\xc7\x4b\xa4\x08\x52\x1f\xb0\xb3\xff\x5d\x7b\x8a\x3f\x1e\x4d\x92\x88\xf9\x14\x67\x7b\xf2\x2f\x25\xb8\x37\xaa\xb4\x20\xff\x1c\x8f\x2a\x7f\x21\x9e\xa6\xf3\x0d\x7d\x5e\xe2\x20\x9d\xfa\x51\x69\x1c\x53\xb9\x44\x86\xff\x0e\x9a\xe8\x10\x88\x9f\x4a\x09\x5c\x13\x43\x69\xcd\x2b\x12\x54\x22\xb8\x82\x55\x06\x95\x3f\xde\x9b\x57\xaf\x1a\x1f\xd6\x89\xf8\xa7\xff\xae\x88\x41\xf9\xe3\x29\x3f\x84\x98\x6e\xec\x10\x7f\xbc\x7f\x93\x30\xc3\xde\xfe\x36\x09\x60\xc6\x38\xc3\xde\xd5\x04\x85\x59\x8a\x28\x02\x79\x3e\x07\x8d\x9c\xb9\x05\x95\x46\x35\xfc\xc9\x3f\x0f\xb5\x4f\x4a\xb2\x53\xfe\x78\xff\xde\x92\xd6\x2b\xae\x41\x72\x1a\x91\x3b\x90\xf7\x20\xc9\x6f\x52\x0a\xf9\x2c\xe4\x23\xed\x17\x37\x57\x3b\x92\x3f\xda\xdc\xae\xc2\x1e\x2f\x80\x08\x34\xf4\x5e\x29\x6e\x21\x16\xf7\x60\x90\x56\x69\x21\x21\x30\x6a\x41\x10\xb4\x65\x6c\x94\x96\xc0\xf9\xfc\x9c\x30\x0d\xb1\x1a\x93\x00\x22\x76\x0f\x92\x81\x1a\x93\x29\x8b\x22\xc6\xe7\x84\x06\x81\x04\xa5\x08\xe5\x01\x91\xa0\x53\xc9\x95\xd1\x33\x88\x13\xbd\x34\xdd\x9d\xd7\x4c\x7f\x8f\xd5\x6c\x3f\x39\x1b\xd4\x6c\xa3\x9a\x8d\x6a\x18\xb5\x62\x9c\x26\x56\xee\xd6\x28\xf1\x92\xb4\xc1\x48\xf9\x82\xab\x34\x76\xd5\xc7\x3f\xcf\x16\x8b\xc5\x19\xca\xff\x59\x2a\x23\xe0\xbe\x08\x20\x70\x53\xac\x1e\x29\xfe\x45\x10\xa8\x42\x85\x51\xdd\x15\xd1\xc2\xc9\x3e\x26\x54\xd2\x18\x34\xc8\xe6\x21\xad\xcf\x41\xfe\x9f\xa7\x97\x09\xa2\xa6\xa7\xb4\xc4\x49\x1c\x8f\x1a\x1a\xae\x09\xfc\x3d\xd5\x9b\x5a\xe7\xfe\xd0\x96\x66\xcc\xf4\x85\x93\xfb\x8e\x6a\xea\xb5\x51\xd1\xa7\x20\x6a\xc6\xa4\xd2\x66\xe8\x0e\xa4\x39\x35\xae\x10\xb8\xa1\xa5\x84\xef\x29\x93\x10\x78\x6f\x89\x96\x29\x1c\x9b\x15\x11\x75\xe7\x44\x44\x4f\x98\x11\x31\x0b\x82\x08\x5c\x59\xe1\xd6\xba\xc2\x8c\x63\x93\x98\xad\xd7\x1c\xa8\xdb\xda\xb0\x63\x84\x29\x1a\xa5\xda\xa0\xbb\x0b\x75\x6e\xad\xbb\x46\xa2\x96\x00\x4e\x50\xbc\xbd\x65\x27\x49\xfb\x28\xdd\x89\xfb\x28\x7b\x44\x9e\x75\xd4\x3f\x30\x0e\xaf\x5d\x48\x74\x6d\xdf\x5d\x32\xdf\xb4\x24\xf3\x4d\x8f\xc8\xf4\x45\x9c\x50\xbe\x74\xa1\xd0\xa1\x69\xc7\x88\x4b\x84\xd2\x97\x22\x70\xb2\x11\x2e\x6d\xbb\x36\x77\x4c\xbb\x4d\x1c\xd3\x7d\x9a\x35\xa5\xa9\x76\x9a\xb2\xad\x0d\x3b\x46\x98\x84\x39\x13\xdc\x55\x20\xdd\x5a\x77\x8c\x44\x5f\xa4\x5c\x4b\x37\xa9\xdc\xde\xb4\x9b\xc4\xb9\x4e\xa0\x63\xf3\x8e\x11\x99\x84\x82\xc3\x85\x04\xea\x4a\xa6\xf3\x0b\x5d\x24\xf4\xb2\xdd\x94\xb6\x79\xa7\x8b\xe4\x7e\x4c\xe3\x29\x38\x39\xa6\x8e\xcd\x3b\x46\x64\xb6\x2d\xe6\x40\xde\xd6\x86\x15\xc2\xf6\x5d\xf7\x3b\xc5\xd5\x86\x58\xf6\x10\xcb\xde\x29\x96\x8d\xff\xb3\x2e\x34\xcd\xdb\xad\x15\xc6\x5d\xa6\x4a\x8b\x18\xa4\x22\x3e\xe5\xe4\x1b\x40\x42\xe2\x34\xd2\x2c\x89\x80\x20\xf2\x65\xbb\x39\x8a\xbc\x30\x7b\x38\x5f\xbd\x98\x32\xfe\xd5\x33\x3b\x35\x5f\x3d\x45\xef\x21\x20\x33\x21\x49\x44\x35\xc8\xaf\xde\xcb\x31\x41\x95\x21\xc8\x1f\xca\xb8\x22\x94\x44\x4c\x69\xdc\x89\xc7\x6e\xf2\x2f\x43\x91\xb9\xc2\x4d\xfd\x7e\x07\xc0\x71\x3b\x18\xb7\xbd\x90\x34\x85\x34\x62\xec\x3b\x12\xf3\x39\x04\x84\x71\xe2\x5b\x6a\xbd\x41\xdd\x07\x75\x77\x53\xf7\x55\x0a\xcc\xba\xb4\x76\xcc\x55\x7e\x7c\x0e\x81\x50\x5f\xb3\x7b\xb0\x9b\xaf\x8a\x70\xa1\x89\x1f\x52\x3e\x87\xa0\xaa\x94\x28\xa6\xbe\x04\xaa\x21\x38\x45\xb5\xbc\x34\xa4\x11\x4a\x38\x2c\x4a\x18\x66\x70\xca\x5d\x43\x8f\xb4\x47\x85\x03\xc4\x31\xe7\x70\x82\xd3\x39\x26\x71\xaa\x34\x99\x02\x49\x39\xfb\x9e\x42\x41\xc9\x86\xf1\xaf\x79\x3d\x6e\xf1\xfd\xef\x29\xc8\xe5\xe0\xf1\x0c\x1e\x4f\xd7\x3d\x9e\xc9\x0f\xfc\xf7\xd5\xbb\x87\x35\x82\x36\xe6\xd5\x54\x99\x98\x29\x59\x05\x3a\xb3\x0e\x02\xf3\x08\xfd\x9d\xec\xc1\x14\x7c\x11\x83\xb2\x8d\x7b\x8e\x91\xef\x0c\x89\x84\x66\xa4\x89\x59\x3f\x80\x91\x05\x65\x58\x74\x41\x3d\x6c\x77\xf5\x6e\x53\xcb\x0c\xf7\x30\x9d\x71\x80\xbd\x01\xf6\x7a\x03\x7b\x13\x03\x43\x54\x43\x8b\x24\xa6\x0a\x2b\x2f\xa2\x88\xcc\x44\x14\x89\x05\xe6\xe0\x61\xbf\x44\x24\x20\x0d\x3a\x29\x92\x2a\x30\xa0\xa0\x20\x02\x3f\xf7\x14\x7b\x8e\x7a\x77\x0b\xa6\xfd\x70\x0d\xf2\x07\xf8\x1b\xe0\x6f\x80\xbf\x8e\xc3\xdf\x63\xde\xf0\xc4\x64\x12\xb7\xf3\xfb\xfa\x99\x4f\x8d\x84\x67\x79\xd3\x64\x26\x45\x6c\x72\xac\x1f\xf9\x50\x4a\x97\x2e\x40\x61\x6c\x42\x78\xd9\x12\xdf\xb6\x5c\x9a\x54\x6c\x92\x72\x2d\x52\x3f\x84\x60\x48\xa5\x1e\x52\xa9\x0f\x97\x4a\x9d\x0b\xd9\xe4\x47\xfe\x2f\xdc\x1b\x7b\x68\xe1\x95\x0c\xa9\xd5\x0d\xa9\xd5\x39\x43\x8d\xfe\xaa\x31\x51\xa9\x1f\x12\xaa\x88\x0a\x59\x92\x94\x8f\x4d\xd8\xac\xeb\xa2\x7d\x11\x1e\x6a\xf2\xd8\x8e\xe9\xc2\x00\xd7\x6c\xc6\x40\x16\x41\xac\x12\x99\x9b\x46\xbd\xe6\xdb\xe4\xef\xb9\xed\xc5\x1e\xc4\xc3\x19\x3f\x1b\xaf\xee\x69\x2b\x1e\x5c\x64\x09\x6b\xe7\x27\x9c\xba\x5e\x25\x75\x48\x65\xcf\x53\xd9\xab\x9c\x19\x52\xdb\x8b\xd4\xf6\x2a\x6b\x4e\x3b\xd5\xbd\x4a\xed\x69\xa7\xbe\x57\xa9\x3d\xf1\x54\xf8\x35\x72\x4f\x39\x35\xbe\x9e\xd8\x93\x4e\x95\xaf\x92\xec\xfa\x7e\xcf\x53\xe7\x37\x90\x7d\xa2\xa9\xf4\x55\x8a\x4f\x3b\xb5\xbe\x4a\xed\x09\xa7\xda\xaf\x4d\xec\x89\xa6\xde\x57\xe9\x3c\xed\x54\xfc\x2a\xb5\xa7\x9e\x9a\x5f\xa5\xd7\xe1\xd5\x6e\x12\xbb\xeb\x04\x3b\xbe\xde\xf7\xd4\xfd\x2a\xd9\x3f\x53\x2a\x7f\x2d\xed\x3f\x45\x6a\x7f\x2d\xe5\xa7\x9b\xea\x5f\x25\xf7\xb9\x53\xff\x77\x66\xc9\x54\x88\x08\x28\x6f\xc1\x93\x54\xc1\x3f\xb2\xbb\x38\x2c\xb1\x2e\xfc\x69\xf5\x52\xc7\x84\x20\x0f\x83\x5f\x83\x0e\x45\xe0\x42\xad\xfb\x1b\x1d\x25\xf5\x92\x4a\xc9\x40\xb6\xa1\xd5\xe1\x95\x8e\x11\x1b\x89\x6c\x07\xc6\x15\xcc\x5d\xdb\x1f\x9b\x4c\xfc\x66\x8a\x87\x32\xbc\x80\x6a\x38\xd3\x2c\x86\x16\x5c\x09\x40\x61\x14\x96\xe0\xbb\x13\x7c\x17\xb7\x4b\x6e\xdf\x5f\xfe\xf2\xcb\x2f\xff\xe9\xc2\x25\xfb\xfe\x67\x16\xef\xcf\x24\xa7\xdd\xac\x61\x47\x79\xd8\x51\xae\xd9\x51\x1e\x9f\x64\xce\xc6\x25\x1a\xeb\x2c\xeb\x62\xce\xee\x81\x3f\xe6\x5e\x98\xbc\x8d\x61\x33\xf6\x09\x36\x63\x9d\xe6\x68\x40\xa1\x01\x85\x6a\x50\x68\x54\xc3\x28\xa7\xbc\x96\x09\x66\x63\xb5\x48\x6e\xe9\x11\x86\x7d\x49\xd0\xb7\x30\xe9\x66\x5b\xb4\x7f\xc0\xac\x5d\x31\x6b\xfc\xbc\xbc\xc2\xa9\xd4\x21\xd5\x44\x85\x22\x8d\x02\x73\x82\xcc\xcc\xb2\xd3\x72\x09\x25\xe1\xea\xdd\x73\x9d\x19\xdb\x99\x35\x8c\x6b\x98\x83\x6c\xc9\x1b\x3c\x1b\xf8\xdd\x2d\xae\xbf\xa5\xd9\x70\x70\x6e\x38\x38\xd7\x85\x83\x73\xce\x67\x87\x7b\x64\x93\x2e\x82\x80\x5c\x19\x14\x13\x83\x31\xea\xb7\x31\xca\xa4\x4e\x97\x79\x56\x31\x4d\x34\xc0\x54\x5e\x07\x5e\xc5\x54\x7e\x03\x9d\x44\xd4\x07\x37\x76\x1d\xd9\x42\xb5\xe6\x97\x30\xff\xa2\x11\x69\x60\x9c\x3d\x05\x74\x4f\x25\xa3\x5c\x93\x17\xb8\x7a\xf3\x05\x9f\xb1\x79\x2a\xe9\x34\x2a\xde\x51\x2f\x77\xe6\xb1\xed\xfb\x7a\x37\x56\x1f\x98\x81\xed\x4d\xfc\x0a\x07\xbf\xeb\xe5\xce\x7c\x68\x61\xfc\x1d\x61\x7b\x30\xe9\x83\x49\xdf\xcd\xa4\x9f\x48\xb0\xcc\x9e\x3d\xc7\xe5\x45\x76\xac\x69\xb0\xec\xbd\xb6\xec\x75\xcb\x4c\x7b\x85\x82\x0b\x7f\x9e\x77\x99\xe9\x24\xac\x03\x1c\x0f\x70\x5c\x03\xc7\xa3\x1a\x46\xb9\x47\x0d\x27\x58\x26\xa7\x45\xe8\xb0\xc2\xd4\xdf\xf0\xfe\x35\x2d\x08\xde\xb9\x46\x28\xcf\xe2\x74\xc5\xd5\x6b\x63\x52\x68\x22\x53\x44\x9a\x23\xa4\x41\x69\xf3\x61\x5b\x41\x8e\xfe\x58\x8f\x6b\x3c\x1c\xcb\xec\x9a\x90\x72\xa1\x43\x90\x7d\x3b\xc6\x3f\xd8\x96\x7d\x6c\x8b\x91\x6d\x17\xee\xf4\x24\x80\xb9\xa3\x10\xe5\xf2\x6e\x64\xbf\xe0\x55\x85\x4d\x44\x8b\x4d\x1d\xe7\x9c\xd2\x54\xda\xca\x6f\x83\x25\x1e\x2c\xf1\x29\x58\xe2\x39\x9b\xe9\x33\x9f\xca\x60\x8d\x96\x13\x89\x8c\x26\x49\xb4\x24\xbf\xb3\x99\x26\x97\x48\x65\xb7\x4c\x1c\x72\x1f\x8d\x58\x40\xfc\x2d\x96\x29\x47\x20\x5f\xa4\x89\x6b\x0e\xd7\x80\x3f\x03\xfe\x1c\x17\x7f\x4e\x33\x30\x63\x6f\x9e\xe9\x2a\xa8\x3c\x42\x44\x43\xb4\x01\x2f\xc7\x28\x90\x67\x00\x9d\x01\x74\x4e\x0b\x74\x46\x35\x8c\x5a\x75\x7a\x12\xba\x8c\x81\xeb\xb3\xec\xce\xbe\x6c\xb5\xe0\x1a\x6e\xe8\x11\x4e\x65\x99\x4a\x13\x65\xab\x71\xdf\x64\x54\xdf\xe5\x44\x17\xab\xea\x8e\x96\x21\x2d\x5f\xef\x6c\x67\x8c\xcc\xa9\x86\x05\x5d\x92\xb3\xac\x56\xf2\xdf\xc5\x6c\x16\x31\x0e\x7f\x77\x41\x31\xfb\xee\x73\x41\xd8\xf8\xa8\x8c\x8a\xcd\x69\x8f\x2a\x9f\xec\xd3\xff\xf3\xa9\x0a\x05\xcf\xa3\x22\x4e\xdc\x8b\x1d\x0f\x83\x0c\xf8\x3f\xe0\x7f\xd7\xf1\x5f\x82\x90\x01\xc8\xc9\x0f\xf3\x7f\xb5\x97\x63\x37\x2f\x7e\x2b\x5c\x5c\x2f\x19\x60\xf6\xcc\xed\x75\x84\x78\xf5\x60\x1e\x73\xc2\xa7\x54\x13\xdf\x78\x63\x58\x76\x20\xdf\x5f\x1f\x67\x4a\x3a\x05\x9f\xda\xdb\x64\x97\x84\x4a\x30\xa5\x09\x14\x8d\xc0\xa4\x2a\x50\xbe\x8c\x85\xac\x73\xc0\xfa\x64\x92\x2e\x82\x32\x3f\xc4\x8c\x50\x92\x50\x2c\x7c\x82\x13\x51\x94\xc5\xee\x96\x1d\x5a\x0d\x23\x1a\x91\x71\x01\x4c\x2b\x5b\xcf\x14\x6e\x76\x9a\x88\x01\x30\x57\x00\xf3\xd7\x57\xaf\x5b\xd1\xfa\x85\xd3\x54\x87\x42\xb2\xbf\x20\xe8\x33\xd5\xbf\xb6\xa2\xfa\xa3\xd0\xe4\xbd\x48\x79\x30\x58\xc6\xd3\xb7\x8c\xf7\xe6\xee\x5b\x79\xd2\xc1\xe0\x3f\x32\x1a\x49\x43\x3c\xa3\x9b\xa1\x1b\xc3\x43\x18\xa2\x35\x43\xb4\xa6\xf7\xd1\x9a\xf1\x68\x2b\xad\x76\xc7\x56\x04\x90\xa5\x69\xfc\x2b\x2b\xad\x30\x5d\xda\xbf\xa3\x80\x93\x44\x44\xcc\x5f\x8e\x33\x74\x3e\x47\x79\x5f\xf5\xc3\x25\x50\x25\xb8\xb9\xfd\xdb\x38\xe9\xf8\x37\xcc\xfe\x8d\x98\xaf\xf1\x5a\x60\xdb\x0d\xee\xbc\x28\x6f\xb4\x61\x88\x27\x16\xb7\x1e\xf0\x6f\xc0\xbf\x01\xff\x8e\x87\x7f\xa3\xfa\xdf\xb6\xf9\x64\x67\x3b\x6d\xd5\x57\xb8\xf8\xc5\xc4\x17\x98\x22\x6c\x46\x96\x22\x25\x21\x66\xc9\x09\x0e\x58\xc6\x46\x12\xc6\x93\x54\x1b\xc0\x34\xda\x97\xfd\x8a\xf5\x4e\xf1\x40\xa4\x82\x00\x17\xe8\xc0\x4c\x26\x19\xa0\x8b\x4c\x28\xb1\x83\x23\x42\x12\xfa\xb8\xa9\xf4\x95\xdb\x8a\x61\xcf\x09\xe1\xe3\x5e\x83\x73\x25\x51\x01\x19\x9a\x03\xf5\x0b\x9a\x6a\x41\x02\xd0\xc6\x0a\xbe\xec\x1b\x66\xaf\x6c\x37\x22\x5d\x56\x66\x06\x2c\x1f\xb0\xfc\x67\xc2\xf2\x10\xfc\x6f\x22\xd5\x13\x73\x20\xcf\x44\x07\x5b\x94\xa3\x3e\x12\x92\xd9\x31\xb7\x46\xb3\x5b\x53\x6e\x27\xf3\xc3\xf1\x9e\x7d\x93\x8e\x8d\x59\x4e\x5c\xc3\x9f\xda\x3b\x29\x95\x32\xf3\xf9\x09\xe7\xf3\xd2\x92\xd7\xd8\xe1\xc3\xa8\xe6\x8f\xbd\x55\x2b\x2b\x1c\x99\xf6\xb6\xa5\x79\xb4\xf9\x2f\x0f\xe3\x6e\xef\xc7\xef\xaa\x18\x77\x1a\xcb\x13\x1a\xbd\x30\x72\x63\x77\x3e\x12\x29\x7c\x50\x6a\x4c\x16\x21\xf3\x43\xf4\x98\x28\x99\x52\xff\xdb\x5c\x62\xe4\x35\x7f\x4c\x42\xca\x03\xbc\x85\xb0\xd8\xe6\x45\xcf\x08\xc5\x10\xdb\x1a\xa7\x2e\xb7\x71\xe7\x1d\xf3\x12\xa4\x01\x84\x2f\xb7\x1f\xaa\x4e\x82\xf1\x2b\xe9\xcc\x78\x93\x9c\xc0\x9f\x56\x84\x73\x02\x67\x91\x58\xb8\x38\x0a\x45\xff\xfd\xf3\x13\xda\x6d\x42\xbc\x79\xf5\x1a\xe7\x19\xef\xe5\xc8\xa5\x62\x81\xc5\x8e\x50\xae\x20\x78\x42\x6d\x7f\x04\x3c\xf3\x5d\x37\x05\xea\x1d\xd6\x97\xf8\xb5\x25\xe8\xfd\x83\x06\xe4\x16\xbe\xa7\xa0\xea\xcc\xca\xa1\x88\xdf\x0b\xea\x06\x78\xdf\x0a\xef\x1d\x8c\xb0\x59\xf2\x5b\x23\x3c\xde\x71\x27\x4b\x9e\x8f\x81\xf9\xc0\xe2\x3c\x9b\xe1\xb1\xd8\x19\xc3\x69\x6c\xba\x0f\xbe\xbb\xae\x50\xf5\xd6\xdd\xc6\xd6\x83\x22\x38\x2b\xc2\xa8\x86\x3b\x9b\xd6\x0c\x13\x9f\x72\x1f\xa2\x36\x31\xa0\xbe\x29\x90\x21\x10\x3d\x20\x99\x72\x6e\xbc\x9d\x75\x4f\x69\xd0\x9b\x41\x6f\xda\xe9\x0d\x17\x9a\xcd\x96\x93\x1f\xbe\x90\x12\x22\x23\xeb\xfb\x65\x7e\xdd\xac\xe6\xa4\x2a\xe2\x63\xdd\x59\x1d\x32\x45\x80\x07\x89\x60\x5c\x93\x05\xd3\x59\x1d\xed\x34\x7d\x4c\x21\xaa\x71\xfc\xc9\x0b\x7c\x50\x1a\x1a\x1e\x5c\x4c\xa8\xb2\x41\x57\x7c\x68\xbf\xe6\xa5\xcd\x16\x2b\x5c\x66\xaa\x96\xdc\x0f\xa5\xe0\x22\x55\xc5\xba\x00\x5d\x42\x2c\x98\x83\x56\x35\x38\xff\xca\x3f\x87\x50\x7c\x95\xf9\x4a\x08\x14\x96\xba\x65\x11\xe1\x82\xcc\x52\x69\x82\xba\xc6\x22\x11\x3f\xa4\x7c\x6e\x36\xde\x12\xa1\x14\x9b\x46\x30\x5e\x49\x2d\xc5\x56\xa9\xc2\xe7\x34\x32\x74\xcf\x40\xfb\x61\xf9\x18\xb5\x1d\x6a\xfd\x1a\xa4\x5f\x60\x94\x4f\xb2\x91\x1e\x3b\x2a\xb2\x80\x69\x28\xc4\xb7\xf1\x23\x2b\x9b\xa6\x95\xf1\x00\x12\xe0\x78\x4b\x46\x3e\xfb\xf9\x69\x6b\xa2\x40\xa9\xfa\x2a\x6c\xc7\x5c\xa8\x6d\x11\x54\x97\xb5\xd8\x8a\x86\x0d\xf9\x6f\x87\xc8\x7f\x33\xe2\xb7\x7c\xba\x35\x57\xf7\x52\xc2\xf6\x32\x1e\x83\xc1\x3c\x80\xc1\x94\x30\x93\xa0\xc2\x36\x16\xb2\xc7\x51\xea\xfc\x10\x4c\x19\xf3\x6c\xb8\xda\xec\x88\xae\x42\x7d\x01\xef\x84\x12\x2e\x38\x99\x46\xc2\xff\x86\xae\x6a\xfd\x01\x93\x21\xba\x3d\x44\xb7\x57\xa2\xdb\xa3\x1a\xee\xb8\x28\xe3\x59\x2e\x68\x6d\xb4\xb2\xc2\xc8\xcf\xe8\xa1\x32\x85\x3b\xfa\xb3\x34\xc2\x3d\xfd\xb9\x3d\x21\x16\x0b\xa5\x89\x04\xbf\x41\x0f\xc6\xe6\xc4\x18\xfc\x49\xd1\xaf\xb4\x31\xdb\x2c\xee\x8a\x92\x6f\x2e\xd1\xa9\x0b\xe1\x26\x52\xdc\xb3\xfa\xa4\xf9\xde\x79\x83\x75\x20\x80\xe8\x20\x4b\x48\x52\x8b\x20\xc8\xac\x05\x95\x81\x22\x2f\xf2\x29\x7c\x39\x00\xc5\x00\x14\xfb\x01\x85\x5d\x4a\x4c\x8c\xb0\xa9\xce\x6f\x27\x1b\xe9\x68\xad\x74\xbf\x5b\x74\xca\x88\x3c\xc6\xf5\x55\x7b\xde\xab\x9a\xd0\x39\x8c\xb3\x1d\x1a\x95\x45\x04\x9c\x2a\x1d\xe3\x6b\xae\xbb\x59\x6d\x14\xea\xe9\x09\xe6\xa6\x50\x1e\xce\x94\x9d\xb3\x04\x03\x77\x5b\xc8\x29\x93\x7d\xc7\xfe\x3a\x16\xe9\xad\x17\xcd\x05\xe5\xbe\x88\x63\x4a\x14\xa0\xcc\x61\xb6\x72\x16\x27\x81\x42\x62\x33\x5e\xd8\xf0\x4d\x42\x59\x30\x36\xc5\xc7\xdc\x92\x5a\xb3\xce\xf6\xe3\x89\x93\xe2\xf5\xcc\x00\x19\xa6\x7e\x60\xea\x67\x39\x1d\xf6\x74\xf4\x76\xdc\xcc\x1e\x80\xf0\xd1\xe6\x76\x0f\xa3\x1a\x16\x35\xd9\xda\x4d\x67\x87\x4f\xc6\xea\x52\x6e\xdd\xd8\x23\xd8\xdc\x9d\x82\x97\xc3\x31\xdd\x23\x1c\xd3\x35\xac\xfe\x99\xe0\xf7\x67\x38\x92\xfb\x34\x84\xf6\xc1\xc6\xec\x4e\xf4\x68\xf3\x5f\x1e\x46\x35\xec\x29\xec\x8b\x8d\x96\x4c\xac\xa3\xd7\x75\xa3\x62\xf7\xc5\x76\x32\x2b\x35\x9b\x89\x62\xb6\x72\xf7\x32\x79\x21\xe4\x4a\xf6\x0c\x1e\x84\xe9\x57\xbc\x84\x46\xd1\xa7\x59\x23\xff\xca\xff\x6d\xee\x68\x8b\xc8\x06\x22\xa6\x8c\x9f\xbf\x8f\xc4\xe2\x2e\x93\x9c\xad\xbd\x35\x28\xe7\x0e\x43\xca\x6d\xb5\x98\xe2\xe9\x9e\x0d\x5c\x2b\x7f\xd0\x0b\x4a\x40\x6a\xb6\x61\xce\xea\x3e\x58\x0e\x78\x3b\xdf\x1d\xd9\x86\xf2\x74\x5e\x7f\x4e\xa7\xe9\xf3\x30\x6a\x7c\xd4\xaa\xd5\xe6\x16\xff\x1c\xb5\x7b\xaf\xa7\x48\x6b\xd5\x3f\xb3\x2e\x47\x88\x9c\xe5\x85\x7f\x26\x3f\x4a\x45\x92\xf0\x18\x59\xf7\xdd\xf9\x9b\x6c\xe8\xbb\x20\x6f\x7e\xa8\xce\x64\xcb\x42\x90\x97\x3f\xea\x98\x13\x5f\x9a\x11\x73\x2d\x2e\x79\x81\x37\xc1\x07\xa6\xca\xc8\xcb\xe2\xd2\xba\xe6\xb1\xaf\xb9\xf8\x95\x29\x1e\x5c\x7d\x07\x57\xff\x99\xcc\x57\x69\xff\xc0\xc1\xef\xaa\xd1\xe8\x3d\x47\xf5\xbc\x16\x2c\x97\xd9\x36\x2f\x6d\xb7\xfd\x77\x0c\xf7\xe3\x1a\x61\xa1\xe9\xf3\x30\xda\xf8\xd8\xb9\xd5\xf3\xd8\xb3\x2e\xa6\xa8\xb4\x92\xdd\x93\x32\xdf\x07\x21\x7d\xb4\xb9\xdd\xc3\xa8\x86\x49\x85\x01\x5f\x30\x15\x46\x4c\xe9\x16\xe6\xba\xc2\xb2\x4b\x93\xe6\xa8\x56\x0f\x9c\x67\xf7\xd2\x2d\x42\xa1\x70\x7f\x97\xf9\x40\x02\x29\x92\xc4\xe4\xef\x67\x27\x97\xf0\x62\x3e\x3c\x6d\x85\x3b\x4f\x4a\x0b\xff\x1b\x51\x8c\xfb\xf6\xd6\x3e\x73\x9c\x7e\x0a\xc0\x1b\x0b\xe7\x1d\xc9\x69\xf8\x9f\x9c\x5d\x4e\x7d\xd5\xac\xd7\xf2\xd5\x59\xc1\xf7\x3e\x19\xb3\x7a\x21\xce\x49\xf9\xd9\x94\x77\x7f\xba\x47\x9b\xff\xf2\x30\xaa\xe1\xd0\x9a\xe6\x36\x95\x88\xef\x58\x72\xd9\xce\x9a\x83\x57\x5d\xd2\xdc\xc5\xce\x93\xb8\x73\xe2\x3b\xe6\x72\xe7\xa3\x2c\x95\x5c\xda\xb5\x10\x68\xc9\xd9\x7e\xce\x6b\x1b\xc6\xcf\xc6\xaf\x95\x9d\xee\x1a\xc6\x0d\x45\x67\x87\xa2\xb3\xc7\x2c\x3a\xbb\x3f\xbc\x0f\x66\xad\xde\xac\x75\xfe\x56\xb8\x9d\x6d\x95\xbd\x19\xae\x28\x97\x98\x1f\xe4\xc9\x79\xda\x31\x73\x55\x57\xed\xce\xd6\x72\x74\x81\x8d\xa1\x92\xea\xbe\x95\x54\xf7\xd7\xb5\x01\x63\xea\x31\x66\x54\xc3\xa1\x7a\xd7\xd9\xd4\x49\x3d\xd3\x02\xaf\x84\xd3\x9d\x2f\x60\xb2\x33\x34\x5d\x6f\x04\xa6\xdc\xaf\xee\x58\xe9\x92\xa1\x24\x67\x43\x49\xce\x15\xd7\xaf\xe4\x34\xe7\x51\xfd\xe7\x29\xf0\x3a\xb8\x84\x83\x4b\x78\x18\x97\xb0\xf8\xed\x61\x54\xe2\x93\x57\x1a\xc4\xca\xd8\xf3\xcd\xbb\x0b\xad\x25\x9b\xa6\x35\xde\xe3\xe6\x1d\x89\x6d\x3b\x0f\x9e\x91\xff\xba\x27\x75\xb3\x71\x69\x2f\xe7\x44\x45\x63\xf9\xcc\xd0\x7c\x68\xa5\x35\x6d\xc3\x0c\x55\x61\x60\xe4\x20\x36\x66\x84\x1f\xe8\xb4\xe6\x06\x8b\x4d\xc3\x34\x6f\xe4\x63\x0d\xd3\x98\x72\xbc\x1b\x34\x30\xeb\xe9\x17\x09\xc8\x90\x26\x8a\x44\xc2\xa7\x11\xa6\xdf\xbe\x2c\x51\x61\x10\xe2\x80\xe3\x6f\x37\xf6\xdd\xc7\x7d\x4f\xa3\xf4\xa0\x03\xbf\xa5\x8b\x3f\x4c\x9f\xae\x63\xcf\x5f\xc8\x87\x9f\x72\x6d\x2e\xee\xc4\x58\x38\x9b\x9b\x2b\x6c\xcc\x20\xf3\x94\xcb\x62\xec\xdb\x46\x6d\x45\xdb\x69\xd4\x5f\x38\xd3\xad\x84\x3a\x7f\x61\x4d\xb0\x7d\x11\xac\x0f\x35\xa3\x40\x91\x18\xa8\x4a\xd1\x96\x91\x94\xb3\xaa\xd6\xad\x11\xd0\xc8\xf6\x26\x70\x18\x37\x23\xc0\xba\x22\x57\xf9\xb4\x3a\x18\x8f\x06\x81\xd9\x85\xa1\xd1\xcd\x16\x30\xa8\x07\xc5\x35\x08\x72\x1f\xf4\x35\x04\x8c\xb6\x1d\xef\x36\xc8\xba\x66\x31\x7c\xce\x7a\x58\x7f\xea\xc6\xf6\xd5\x6f\xc4\x8f\x77\x0b\x33\x90\xc0\xfd\x03\x77\xfb\x99\xe9\xe8\xd0\x5d\x1e\x9c\xf6\x2f\x8a\xce\xf7\xe9\x72\x54\xff\x5b\xe9\xab\xca\x4b\x84\xf3\xc7\x5b\x09\x6c\x52\xe0\x15\x9f\x89\xb5\xaf\xdf\x53\x4a\x2e\x62\x91\xf2\xfa\x15\xcd\xf6\x1d\xf2\x1b\xc9\x7c\x70\xe3\xdd\xa5\x84\x80\x61\x85\xf7\xa0\x96\x8a\x2d\xdf\xf7\x78\x60\xf7\xbc\xd2\x91\xd3\x97\xff\x6e\x2b\x14\xee\x3e\x75\x35\x9d\x5e\x67\x85\xfb\x0e\xda\xa7\x9d\xe8\x9b\xfc\x64\x6f\xa7\x74\xcc\x41\x7c\x71\x59\x71\x7e\x51\x00\xe9\xbb\xba\xcc\xc2\x3d\x05\xf6\xd2\x9c\x90\xd8\x00\xf3\x4d\x06\xac\xfa\x22\x41\x0f\x16\x6d\xec\x37\x58\x5a\x73\xe5\x8d\xeb\x7b\xda\x38\xe2\xf6\x06\x64\xad\xdf\x26\x96\xaf\xb3\xbd\x69\x6e\x6f\x41\x61\x82\x42\xf0\xc9\x9e\xba\x70\x65\x4a\xe5\x3d\x34\xee\x94\x93\xab\x77\x84\x46\xe8\x07\x2e\xc9\x37\x2e\x16\x3c\xbf\x5d\x1e\x13\x3b\x73\x53\x3f\x4b\x75\x2a\xed\x79\x10\x72\xf5\x6e\x1b\xeb\x0e\x21\x55\x12\xd4\x3a\x43\x37\x4f\xce\x76\xfc\x2b\x26\xcd\x7e\xc1\x07\xc6\x37\x4c\x5b\xf1\x6d\x54\xca\xc6\x92\xa7\x26\xca\xd0\xdc\x87\x13\x5f\xdc\xa7\xfe\x92\xe9\x03\x23\xdb\xa5\x88\x13\xca\x0f\xde\x69\xca\xb5\x7c\x9a\x4e\x37\x3b\xb2\xbb\x74\xfc\x5b\x4c\x59\x74\xd8\x2e\xdf\x33\xa9\xb4\x8d\xab\x1c\xb0\xdb\x0f\xf4\x29\x7a\xbd\x66\x41\x10\xc1\xc7\x83\xf7\x7b\x23\xd4\x96\x65\xc7\x2e\xbd\xde\xc2\x9c\x09\x7e\xf8\x7e\xef\x68\x94\x6a\x6a\xb1\xf2\x90\xfd\xe2\x7d\x70\x87\xee\x52\x02\xe8\xa7\xe8\xf3\xe3\xa1\xdd\x10\x88\x20\x09\x05\x87\x6e\x79\x37\x35\x5d\xfe\x41\xf7\x61\xe8\xa8\xfe\xb7\x75\xc3\x86\x2e\x3f\x04\xef\x98\xf2\x6b\x5d\xf1\x7d\x0d\x9c\x2d\xf0\xe4\xea\x11\x84\x62\x41\xe2\xd4\x0f\x73\x3b\x1f\xd8\x71\x91\x90\xaa\x2c\x05\x4f\xa5\x53\x2d\x29\x96\x4c\xc9\xf6\x0d\x90\x8a\x2c\xa9\x6f\x4c\xae\xae\x6f\x3e\xdd\x7e\xbe\xf8\xf8\xf9\x6d\x7e\x89\x21\x87\x39\xd5\xec\xbe\x31\xe4\x72\xb0\x05\x06\x8d\x13\xca\xe6\x5b\x50\xa0\x42\x6c\xca\xd9\xf7\xd4\x56\xd3\xb1\xf4\xa6\x3c\x00\x19\x2d\x31\x64\xe1\xdb\x2e\x31\x47\x51\xa6\x11\x64\x37\x0c\x7c\x35\x17\xd7\x80\x3c\xcb\x1f\x9f\xbd\x79\xf5\xfa\x3f\xbe\x7a\xde\x78\x47\x41\xa9\x23\xe6\xb1\x3c\x8b\x2b\x29\x39\x0d\xc5\x7c\x99\xc1\xda\x3b\x82\x4c\x89\x37\x53\x8a\x28\x1f\xfd\xe1\xc7\x7c\xa5\xae\x34\xc4\xb7\x78\x41\x61\x0b\x71\x9b\x45\x74\x4e\x18\x0f\xcc\xa2\x97\xcf\x09\xab\x48\x1d\x53\x45\xb9\x9b\x20\x05\xdc\x96\x42\xe7\x0a\xb7\x31\x36\x6c\x61\x6c\xbf\x18\x76\xff\x08\x64\x11\x6e\xcc\xab\xc4\x17\x43\xfe\xea\xdd\xa5\x78\x31\xc7\x9d\x11\x13\x72\x47\x23\x20\x87\x67\xf7\x9d\x90\xfa\x53\x6d\xb1\x93\xa6\x11\x5b\x2e\x03\xde\x9e\x69\xeb\x21\x64\xde\x7b\x31\xf2\x52\x9a\x6d\xc6\xf3\x31\x89\xc4\x22\x5b\x1c\x3d\xea\x7f\x3e\x1f\x53\x98\x09\x09\x24\x64\xf3\xd0\x2d\xaa\x9a\x67\x34\x39\xd1\xb7\x39\x7c\x53\x21\x0d\xcb\x38\x31\x85\x02\x94\x32\x15\x92\x29\xe8\x05\x8e\x34\x27\xac\xe9\x7e\x0f\x4f\x2f\x13\xf0\xde\x12\xef\xff\xd9\xbb\x82\xe6\xb6\x71\x25\x7d\xd7\xaf\x40\xe9\x94\x54\x29\x99\xfb\xde\x1c\xd9\xc9\xb8\x9e\x93\xe7\xb5\xe5\x99\xc3\x7a\x2b\x05\x93\x90\x84\x15\x45\xe8\x11\x94\x65\xbd\x2d\xff\xf7\xad\x06\x01\x92\x02\x41\x02\x20\x48\xd9\xd9\xca\x65\x6a\x62\x11\x0d\xf4\xd7\x8d\x06\xd0\xe8\x6e\xb4\x02\xef\x69\xc4\xbf\xd1\xa5\xf0\x71\x34\x06\xfe\xc6\x46\xbc\x7a\x26\xca\xc1\x8a\x8f\x6e\xa9\x3b\xcd\x9a\x55\x24\xb3\x01\x7d\x02\xd5\x91\x5d\x56\x5e\xa8\x3c\xd8\x56\x9d\x31\x4a\xd2\xe6\x0c\x30\xde\x15\x9b\x40\xba\x23\x00\xab\xa9\x20\x5d\x1b\x2f\xed\x32\xa7\xf0\x8c\x05\x4d\x12\x84\x9f\x31\x4d\xc0\x68\x0d\x2a\x62\xd7\x39\x32\x37\xc6\x53\x04\x4e\x8c\x6e\x5f\x53\x1b\x56\xa7\x2e\xaa\x93\xa7\xf8\xe0\xc6\x7c\xee\xaa\x0b\x66\xbc\x4c\x5e\x30\x27\xa1\xcb\x69\x5e\xad\xfe\xee\x9a\xdc\x6c\x8a\xd6\x2c\x89\x6b\x8f\x02\x72\xc4\x6a\xa6\x1e\xb6\x0b\xbc\xca\xbc\x50\x36\xbd\x3b\xec\x63\x50\x0f\x48\x07\x76\x15\x17\x4d\xdc\x9a\x2a\x67\x41\x53\xd9\x64\x6f\x2c\xcb\x86\xc2\x2b\x56\xba\x0b\x15\x54\xe5\xfc\xe2\x6f\x88\x94\x36\xd4\x20\xb8\xf6\xf9\x1a\xe2\x46\x60\x8b\x10\x3f\x70\x2f\x37\xa2\xa1\xad\x50\x3f\x59\xb8\x91\xe5\x40\xb8\x34\xb2\x61\x0e\x43\xc3\xd0\xbf\x90\x84\xa5\x2b\xbe\x60\x8d\x71\x38\x73\xd0\x4e\x02\x76\x16\xbb\x04\x8e\x32\x72\x6f\x0a\x2a\x0a\x2a\xb1\x82\x6c\x5f\x59\x56\x61\x89\x13\x4e\x3e\xc2\x0c\x53\xe5\x3a\x71\x9d\x0e\xd4\x04\xcd\xd0\x07\xb0\xfe\x1f\x6d\x8c\x7b\xed\x59\xbf\xd0\x04\xde\xad\x6a\x73\x8e\xb6\x72\x7b\xd2\x4c\x5d\xe9\x82\x95\x47\x4f\xc5\x4f\x90\x98\x00\xfe\x56\xf4\x21\x23\x09\x79\xc6\x69\x2e\x32\x84\xa1\x12\xbf\xcc\x31\xe7\x7f\xd0\xf4\x99\xd1\x88\xf0\x56\x96\xba\x14\x57\x8e\xd8\x89\xcb\x4b\xb2\xc4\xfb\x24\x9f\x8b\x5c\xa9\x68\x60\x1f\xe2\x65\x11\xe2\x44\x89\x3b\x7c\x55\x93\x2a\xcb\xad\x32\x10\x31\xe1\x10\x19\x86\x6a\x5f\x41\xd9\x8d\xfb\x35\xdd\x41\xdd\x56\xfe\x11\x01\x70\xc9\x73\x51\x65\x51\xbc\x6b\xf0\xc6\xf6\x56\x8e\xd4\x39\x74\xcb\x04\xe3\x55\x9a\xd3\xfc\xe8\x61\x33\x54\x03\xd0\x3e\x28\xef\x17\xb1\x34\xae\xe7\x7b\x88\xb5\x69\x8b\x8f\xe5\xca\xfc\x74\x44\x9c\x6d\x8b\x2c\x41\x92\xc6\x7c\x48\x0b\xe2\x31\xee\xeb\xcb\x93\xf9\x52\x1b\x31\x5b\x3a\x2f\x9e\x3e\x63\xeb\xf6\xc2\x6a\xa3\x83\x8f\xeb\x67\x21\x22\xab\x70\x8b\xd7\xf1\x60\xf5\xaa\x6c\xb0\x00\x78\x43\xc8\x8e\xa3\xed\x3e\xc9\x29\x14\x1f\x06\x75\xe0\xe8\x83\x74\x74\x00\x83\x8f\x53\xb0\x6a\x8f\xd3\xe2\xa1\x49\xb0\x01\x70\xc0\xcf\x1e\xa7\x1f\x87\xe4\x51\x5e\x7c\xde\x93\x84\x44\x92\x15\x37\x7e\xf5\x86\xb2\x00\xb3\xd8\xc9\xf0\x1c\xce\xa7\x34\x5d\xb2\x6c\x2b\xbc\xb8\x88\xa5\xe8\x11\x0e\x47\x8f\x53\x99\x7c\x29\xa1\x38\xe0\x14\x1e\x0f\x64\x68\x87\x8f\x3d\x8c\x59\x63\xf4\x6e\x3c\xef\xb3\x68\x8d\x7d\x16\xa9\xb2\x85\x58\x5c\x51\xed\xf4\x02\x4c\xf2\xb2\x86\x43\x42\x56\xb0\xd8\x82\x69\x8a\x72\xb4\x23\x19\x3c\xf6\xac\x6c\x4d\x57\x05\xb7\x4e\x26\x05\x19\x37\x71\x2e\x58\x8e\x93\x6e\x9b\xd4\xbe\x25\x17\x85\xdf\x73\xfc\x02\x07\x15\x94\x03\xa5\xb7\xdc\x61\x95\xac\x38\x1b\xc7\x89\xf9\xf7\xe6\x79\xa8\xda\xe5\xea\x83\x0b\x3c\x15\x75\x3b\x0e\xfb\xcc\xcf\xde\x27\x6c\xbd\xa1\x38\x6b\xe9\xda\x0b\x15\x88\x84\xf2\xd6\xde\x1d\x47\x9f\xd0\x86\x1c\xe1\x3a\x3a\x56\xc1\x64\x90\x22\xbe\xcb\x18\x9c\x13\x11\xdf\x91\x88\x2e\x69\x34\x9d\x75\x33\x69\x04\xb0\xdf\x29\x7d\x62\xfe\x57\x43\xae\xe5\x6a\xaa\x63\x14\x2a\x55\x9c\xe5\x7e\x73\xaa\x6c\xa1\x56\x2c\xb5\x45\x29\xfe\xf8\x24\xf6\xbc\xe0\xe9\x16\xc7\x3e\xca\xcb\x30\x71\x1b\xaa\x23\xce\xb7\x6b\x9f\xa9\xd6\x1c\x81\xda\x9b\x1d\xbb\xc3\x8c\x34\xa0\xea\x8d\xaa\x2d\x5d\x4c\x72\x4c\x13\x65\x56\x6b\xf0\x14\x17\x01\xe0\x70\x01\xed\x3c\xac\x49\x26\xea\x14\x54\xbf\x57\x99\x09\xf2\x4f\xe2\xb9\xa9\x36\xc0\x3a\xd0\x38\xe1\xc6\x89\x7f\xd8\x66\xee\x68\xba\xba\x36\x65\x81\xb7\xf1\x5f\x6f\xf4\x98\x67\x64\x97\x11\x0e\x55\x09\x80\x29\x2e\x7f\x43\x11\xbc\x62\x50\xdf\x95\x35\xb6\xb1\x8a\xff\x1e\x8c\x9e\x0c\x7b\xd2\x2d\xfa\xd7\x89\x01\x80\xd3\xb9\x67\x94\x7e\xe0\xfc\xd3\x3c\x3a\xae\xd8\x76\xf8\x9a\xca\x3d\x8a\x6e\xd5\xf4\x4d\x4b\x5d\xb9\x6c\x73\xd3\xc8\x9c\xcd\xe2\x99\x59\x71\x5a\x2e\x9a\xf2\x69\x53\xcd\x39\xce\x32\xea\xb1\xdd\x91\xdf\xa3\x32\xb9\x45\x5d\xb5\xc8\x58\xa4\xcc\x90\x4f\x2f\x33\x48\xe0\x75\x35\xf9\xaa\x06\x89\xf6\xb0\x19\xf6\xc2\xd0\x6b\x69\xec\x5c\x6c\x5b\x52\x03\x70\x63\x21\x33\x65\xed\x28\x6d\x46\x9f\xd0\x91\xed\x51\x4a\x60\xae\xe5\xe5\xcf\x17\x71\xbc\x60\x60\xe3\xd5\x43\xbd\xf5\x77\x44\x1e\x53\xf8\x04\xdc\x7c\x42\xe5\x96\x2c\x81\xab\x9c\xe2\x6f\xe9\x33\x9c\x59\xd4\x9e\x90\xa0\x3b\x82\xe3\x2d\xf9\x0f\x04\x77\x2e\x3f\x8b\xb8\xc6\x9f\x37\x0c\x6e\xe4\x58\x2a\xfe\xa6\xfe\xd1\x91\x06\xd4\x0b\x3b\xc5\xa0\xa2\xef\x8c\xa3\xde\x50\x2d\x70\x39\xce\xe0\xd1\x95\x44\xfd\x59\x41\x15\x60\x99\x1a\x63\x74\xe4\x4c\x38\x01\x16\xd4\xe3\xec\x56\x6b\x23\x03\xf1\x4a\xcd\x57\x3e\x85\x1c\x7e\x73\x65\xaa\x8f\x44\x6c\x51\xad\xda\x90\x8b\xcf\x15\xfa\xe5\x52\xb1\x2d\xfe\x0c\xc7\xf5\x7c\x5d\x4c\x3e\x9c\xc3\x57\x0d\xad\x87\x05\x54\xfc\x08\x4a\xba\x25\x18\x2e\xed\x96\x22\xc4\x3c\x2f\xf9\x43\xff\xda\xc3\x69\x82\x12\xf9\xca\x83\xe1\x0b\x58\x9b\x06\x75\x08\xfc\xcd\xb2\x8d\x78\x23\xdd\x15\x09\xd5\x40\xd9\x27\xa5\x36\xc5\x5e\xa1\x1c\x28\xcb\xd0\x8e\x46\x9b\xfd\x6e\x86\x38\xa9\xbe\x52\xad\x6f\xe5\x6f\xfa\xdf\xd5\xbf\xe1\x2c\xae\xff\xf6\x90\x4a\x34\x49\xdc\x1b\x81\x89\xd9\x9a\xb7\xae\xaf\xe5\x64\xd0\xe1\xe9\x5e\x86\x5c\xd6\x58\x2f\x47\xa6\xfc\xbe\xda\xb3\x01\xf4\xca\x75\x29\x25\x51\x62\xaf\xac\xc2\x0c\x36\x30\x4f\x04\xa5\x2c\x47\xa5\x77\x53\x3a\x77\x61\xf8\xa0\xa8\xf0\x9b\xa4\xd3\xc3\x66\x28\x36\x9c\x54\xad\xfb\xb4\xd6\xb6\x80\xd4\x8c\x83\xee\x82\xa2\xbc\x34\x80\x7f\xc4\x04\xae\xc5\x85\x25\xef\xad\x1c\xa1\xb7\xf3\x0b\x89\x69\x89\xaf\x2e\x98\x4f\xe0\x1f\x2f\x9c\x7b\xf2\xa7\x88\xa5\x3c\x07\xa7\xb3\x80\x56\x6d\x31\xa1\xbc\x71\xb4\xc1\x2b\x38\xc8\x6c\xaa\xc9\xa3\x98\x85\x7e\x14\xf2\x03\xf2\xfa\xc0\x49\x4f\x37\x7b\xa3\xa5\xba\x42\x50\x1a\x5a\xed\x5b\x72\xbc\x21\x69\x71\x79\xa0\x3c\xf0\x1f\x58\x9a\x1c\x2b\x05\x15\x56\xff\xb8\x2b\x1b\x07\x5c\x24\xb8\xce\xf6\xab\x97\xc2\x91\x38\x97\x2e\x43\xe3\xbe\x37\x70\xc6\xfb\x79\x5e\x95\x7a\xc8\x01\xf5\x16\xb3\x2b\x02\xc6\x53\xd4\x99\xce\x11\x9d\xbd\xbc\x8b\x0d\xbd\x16\xba\xc8\x9d\x25\xa9\x37\x3c\xb5\xdf\xea\xdc\x8d\x9f\xd8\x3e\x3f\x09\x07\xab\x82\xc1\x94\xc7\x02\x3c\x19\xe8\x13\x7c\x74\x54\x47\xab\x47\xe1\x81\x10\x6f\x51\x93\xb8\x70\x5f\xa7\xac\x15\xbf\x33\xb8\x34\x34\x6e\x43\x10\xbf\x92\x8f\x00\x3a\xa4\xe1\x69\x90\x37\x5a\x9e\x9c\x44\x9f\x00\xbd\x2c\x47\x90\x0d\x02\xd5\x19\x45\xe9\x53\xb8\xad\x12\x2b\x87\x70\x8d\xab\x07\x98\x64\xad\x63\xe9\x22\x90\xbb\xef\xb4\xfe\x42\xe1\x63\x7a\x42\xab\x2c\x2e\x21\xa3\x2d\x41\x3a\x03\x5f\x00\xf7\x32\x22\x52\x75\xe0\x54\x25\x18\x7c\x2a\x47\xc8\xa0\xd4\x27\xb0\x79\x58\x33\x79\x29\x32\xe4\x68\xf5\xfa\x62\xad\x43\xef\x43\x5c\xa6\x94\xc2\x3d\xd0\xb0\x84\xff\xd3\x25\xcd\xc4\x2b\xc4\xef\x8e\x1d\x44\x06\xe1\xb7\x8c\x79\x2c\xaa\xa7\xad\x66\x13\x8f\xd9\xe8\x1f\xab\xa6\x3a\xfb\x41\x72\xef\x01\x42\x9b\xf1\x87\xb7\xc0\x2f\x84\x7b\x8f\xad\x13\xbc\x33\x98\xc4\x05\x7e\x09\x31\x83\xf7\x34\x5d\x25\xa4\xc6\x89\x2b\xff\x7a\x43\xb4\x12\xff\xdd\x81\x8c\xd1\x07\x9a\x46\xc9\x67\x94\x03\xa2\x45\x41\x74\x0c\x45\x68\x57\x55\x6d\xc1\xb1\xe5\x59\x1b\x9e\x8f\xc6\x9d\x36\x43\x29\x91\x91\x9c\xe8\x03\x79\x79\x6b\x8e\xd8\x3e\x8b\x88\x87\x7d\x2e\x1a\xa0\x6b\xf1\xe2\x54\xe5\x5a\x07\x63\x5d\xdf\x2f\x8b\x21\xc1\x63\xb8\x34\xda\x90\x18\x7d\x42\xea\xf5\x5d\x4e\x72\x95\xae\x28\x48\xd1\x74\x75\xc3\x56\xf6\x8b\x22\x1f\x53\xf8\x57\xbd\x4e\xe4\x6d\x59\x27\xd2\x95\x45\x73\xf3\xf2\xe6\x1a\x14\x6f\x6e\x2a\x6c\xd9\x9b\x85\x89\x79\x86\xd5\x58\x9b\x9a\xef\xb1\x75\x8e\xf4\x3d\x69\x07\xa9\xe2\xb6\xd8\x46\xc0\x7f\xeb\xdc\x7d\x00\x1b\xea\x4c\xee\x74\xf2\x69\x93\xaf\xa9\x71\x19\x3c\x26\xeb\x3d\xef\xca\xab\xfc\xe2\x30\x4f\x64\x9b\x2a\x26\xe1\x93\x0c\x0d\x52\x3b\x63\xb8\x12\x50\x7b\xe2\xc6\xd7\xd3\x99\x37\x1e\x46\x16\x9d\xc0\x29\x64\x8b\x93\xcb\x62\x9f\xde\x4b\x1a\x3a\x8d\x49\xf7\x42\xd0\xa5\xb4\x96\xd1\x04\xaa\xdc\x25\xce\xc9\x3f\x97\x5f\x68\x96\x37\x5f\xec\x6f\x74\xe1\x63\x44\x7e\x88\xad\x33\x38\x36\x8f\xc3\x12\xbe\xc5\x9c\xef\x58\x96\x8f\x92\x71\xab\x88\xff\x10\x4f\xd1\x06\xd0\x76\x15\xef\xc9\xc5\xa0\xde\x5d\xa0\x6c\xf5\x63\xa8\x9d\x9d\x5f\xe3\x94\x68\xdf\x40\x0c\xb5\xbc\x2f\xf0\xcb\xb9\x2a\x88\x9c\xab\x7c\x05\x6c\x4d\xf5\x5e\x42\xf5\xec\x4c\x10\xdd\x0d\x9e\x56\xdc\xed\xe7\xb5\x52\x74\xc6\x9c\x18\xe3\xe0\x02\x61\x57\xfe\x61\x4b\x26\xc5\x50\x73\xdb\x86\x46\x13\x91\x36\xd4\xc1\xd6\xcd\xbb\x55\xa6\xd7\x81\x5b\xfa\x06\x82\x49\x4f\xcc\x2c\x35\xc5\x5a\xc6\xce\xe9\x9d\x05\x4a\x76\x0c\xb7\x09\x8d\xc8\xf8\x33\x74\x84\x2c\xf5\xf3\x4c\x51\xb5\x4b\x84\x70\x83\x51\x82\x6b\x22\x91\x24\x6e\xe5\xc3\x2b\x3b\x02\x06\xdb\x62\x5a\x2c\x92\xad\x5b\x25\xa7\xae\xae\x2f\xed\x63\xf7\x91\x6a\xa0\xff\xce\x51\xaa\xf5\x77\x02\xf5\xce\x02\x05\x3a\xfc\x14\xfd\x4e\xf8\xf8\x05\xd8\xa2\x35\x89\x36\x6c\xff\x1b\x93\x3a\x26\xa6\x57\xa9\xf4\x1e\x03\xa1\x31\xe3\x6d\x99\xa5\xd2\x31\xd2\xf9\xda\x65\xc7\x3a\xd8\xd3\xdc\x7f\xc1\x9c\x46\x8a\x84\x53\x7f\xf7\xfb\x28\xea\x74\x5b\xd8\xad\xdb\xc4\xfc\xaf\x16\x31\x81\xe1\x1b\x4d\x54\x67\xb4\xaa\xd0\xd5\x5f\x38\xa1\xb1\x38\x2b\xb7\xb0\x63\xe9\xf4\xb9\x6c\xff\x59\x12\x70\xea\xda\xeb\x6e\xd6\x99\x62\xc5\x8c\x71\x11\x0d\xa2\x6f\x99\x42\x9a\x93\x6a\xae\x47\x39\xd3\x25\xe2\x85\x9e\x82\x27\x52\x24\x34\x4e\x67\x66\x52\xed\xd2\xf5\x9e\x8a\x67\x9f\x1a\x2b\x92\xcf\xcb\x81\x36\xba\xd5\x81\xef\x31\x35\x7c\xf5\xb3\xca\x8d\x77\x02\xec\x5c\x53\x62\x62\xfe\x57\x0b\xaa\x29\xcb\xe9\xf2\x58\x55\x2c\x1d\x07\xde\x11\xea\x66\x3d\x3c\x04\xed\xdc\xfc\x50\xda\x95\xf8\xc0\xec\x23\x2f\xbf\xa2\xfe\x7d\xc5\x34\x21\xf1\x1d\xc1\x26\xf7\x7e\x83\x0d\x1f\x51\x88\x6a\x36\x60\x16\xb9\x37\x0b\x1a\xc4\xb2\x9c\xa5\x20\xe5\xd4\xf5\x28\x05\xd9\x70\x4e\xba\x17\x11\x33\x33\xbb\x8c\x81\x11\xfe\x5c\x11\x70\xea\xef\xfc\x8a\x5c\x47\x59\xef\x38\x50\x8f\x47\x28\xbf\x28\xef\xb6\x2c\xfa\x35\x94\x2f\xc8\x2c\x5a\x7b\x75\x67\x23\xc5\x57\x37\x06\x85\x4c\x2e\x49\xc4\x20\x4a\x27\xee\x63\x0a\xe2\xa2\x31\xcb\x3e\x9f\x92\xf1\xe8\xbf\x43\x27\x1a\xe2\x1b\x07\xe4\x5a\xad\x68\x6d\x48\xce\xe8\x4e\xcc\xbf\xb7\x4c\x06\x9e\xe3\x2c\x1f\x7b\xe9\x1b\x7f\x7a\xb7\x09\x7f\x60\x46\xce\xb0\x44\x95\xe3\x97\x0e\xe0\xb6\xb1\x9c\x41\x19\x0d\xa0\x2a\xaf\x74\x93\x97\xa6\xa4\x02\x65\x37\xc6\x9d\x99\x91\xa6\x05\x85\x8e\xc4\xce\xb3\x1f\xcf\xfb\xa1\x59\x0a\x6d\x60\x34\xcb\x0e\xae\x3b\x35\xec\xfc\x5a\x5a\xaa\x8f\x91\xd6\xeb\x44\xfb\x43\x1d\xbf\x46\x2a\xae\xaf\x20\x4f\x32\x4d\x7a\x8a\x50\xe9\x42\xbc\x22\x43\xcb\x6c\x78\x2f\x9e\xa5\xf2\xa4\x95\xa4\x0f\x20\xb5\xc9\x61\xc3\xa5\x8b\xd0\x1c\xe7\x64\xc5\xb2\x63\x8b\x23\x48\x27\x35\x28\xc4\x0d\x4f\x46\x2c\x63\xc4\xb4\x3c\x18\x48\x12\x2d\x46\x39\x9d\x99\x29\x59\x91\xf5\xf6\xcc\x6b\x63\xfb\x81\xb7\x65\xea\x0b\xdf\x11\xbc\x81\x10\x9f\x7a\x1a\x6b\x34\xc2\x08\x6f\x71\xbe\x76\x1e\xe1\x62\x4d\x10\x34\x40\x1f\x32\xc6\x72\x88\x83\x4e\x08\x5e\x7e\x94\x49\x86\x94\x97\x18\xa2\x4f\x65\x08\xb8\x28\x27\xfb\x38\xfd\x23\xa0\xa2\xa9\xbb\xca\xb6\x5a\x91\x40\x1d\x53\x74\xbb\x1d\x71\x0e\x16\xca\xbc\xb5\x34\x09\xe6\x6d\x8d\x7c\x01\xa7\xd8\xa3\x86\x5a\x76\x11\x94\x43\xe2\x8b\xdc\x59\xcd\xca\x16\x2a\x46\xf3\xb0\x26\xa7\x79\xed\xe2\x87\xe2\xab\xe9\xac\x1b\x20\x9f\xc9\xd0\x19\x3f\x64\x81\x6c\xa0\xca\x04\x12\x79\xb3\x7f\x36\x50\x8b\x05\xd1\xe1\x57\x24\x41\xf6\x2c\x97\x4b\x12\x9c\xaf\x09\x3b\x5c\x88\xd2\x4f\x46\x9f\x49\x20\x4a\x97\x45\x09\xbf\x6e\x77\x8c\xa6\xb1\xb5\x36\xb2\x96\x21\x94\xa9\x99\xa1\x14\x0a\x33\x24\xc9\x11\xfd\xb9\xf8\x7e\x23\xf3\x46\x64\x89\xc0\xaa\x80\x26\xd4\xfb\x9b\xce\xcc\xfd\x58\x51\x6b\xb6\x9b\x7e\x65\xd9\xf6\x56\xbd\xb9\xda\xce\x42\x27\x4a\x01\x29\x63\x9d\x16\x05\xc6\xf6\x95\x92\x24\x6e\xb2\xd2\x54\x82\x36\x06\x1f\xee\x6e\x9c\x25\xf3\x70\x77\x53\x2f\xf3\xb5\xc3\x9c\x23\xf8\x1b\xc8\xa7\x2e\x00\x3d\xe6\x96\x83\x47\x4d\x54\x51\xe0\x22\xd9\x74\x48\x01\xfd\x8d\x93\x84\xe4\x6d\x31\xa8\x16\x3b\x23\x81\x3c\xa5\x31\xe9\x06\xf2\x75\x62\x18\x8e\x5a\x33\x61\x36\x81\xff\x70\xdf\x1c\x4a\xe0\x4c\xba\xf0\x2b\xcf\x56\x7c\x0e\x42\xd9\x91\x0c\xea\xb0\x89\xff\x05\x0f\x67\x3d\xa7\x57\xf8\xbf\x10\xe4\xb2\x7f\x46\xd7\x4b\x21\xa7\x3d\x07\x11\x3f\x4e\xe5\x8f\x3f\x0f\x98\x42\xb0\xf2\xcf\x25\xcb\x7e\xaa\xa0\x67\x51\xc5\x0d\xee\xaa\x40\xf9\x4e\x03\xa5\x55\xed\x94\x22\x5c\xfa\x00\x05\x06\xa0\x46\x38\x43\x9f\x54\x11\xff\x8c\xc4\x34\x23\x51\x5e\xd6\xb7\x5b\xb3\xc3\x4f\xba\x84\x29\x36\x6c\xa1\xf6\x0e\xab\xe6\xa6\x16\x9a\x69\x74\xea\xd4\xcf\xd2\xc1\xbc\x29\xe1\xab\xac\xc3\x09\x92\x32\x9f\x52\xcd\x2f\x2c\xb8\x42\x7f\x08\xa9\x4d\x67\x4e\xe6\xc8\x69\xe4\x7e\xb7\x98\xe2\x6b\xeb\xd8\xc5\x6b\x01\x5c\x24\x97\x43\x9a\xa2\x68\x53\xd4\x38\x2c\x75\x4f\xdc\x6a\xb4\xa6\x54\x77\xca\xc7\xe7\xb6\xd3\x3c\x27\xdb\xb8\x2b\xa6\xb0\x3a\x2a\x94\x63\x15\xf3\xe4\x0a\x86\x5f\xcd\x8f\x88\x41\xc6\xa6\x48\x78\x9d\xd9\xa7\x0d\xcb\x6a\xdf\x14\xbc\x9f\x65\x2f\x5f\x2d\x15\x3a\x04\xba\xae\x78\x9a\x25\xcb\x0b\xac\x43\xed\xac\x6d\x50\x34\xe1\xf0\x04\xc8\xb8\x4b\x0d\xc4\xe6\x22\x8e\xbb\xb7\xea\x36\xae\x5e\x67\x8d\x3f\x4d\x5b\xdd\x8b\x6d\xba\xac\x1a\xd4\xeb\x42\x17\x7e\x08\x94\x95\x29\xc9\x50\x0d\xe6\x5f\x7b\x2c\x2a\xc3\xa2\x3d\x57\x65\x9b\x60\x1f\x8e\xe0\xe0\x83\xb6\x2c\x26\xc9\xec\x31\x2d\x9e\xaf\xc0\x50\xd3\x88\xa3\x2d\x7b\x56\x5f\x8a\x8f\xa4\x89\x12\xad\xa0\x60\x0c\x2c\x01\x5b\x2c\x36\xf1\x7e\x13\xdc\xdb\x6f\x49\x23\xd2\x7d\x9c\xd4\x40\x29\x5b\x28\x1f\x01\x98\xd5\x67\x22\xb3\xf7\xd8\xf2\x04\x26\x71\x62\xa2\x39\x3a\x60\x61\xe8\xc4\x7b\xc1\x28\xc1\x50\x83\x1a\x82\xd6\x48\xec\xc7\x9c\xd4\xb8\x6a\xd0\x4e\x3c\xde\xe7\x2c\xda\xdc\x90\x67\x8f\x97\x52\xaa\x26\x83\x71\x64\xd5\x58\xf7\x19\x77\xc3\x8e\x38\xc9\x8f\x57\x38\x4b\xe1\x90\x67\x92\x5e\xe0\xfc\x93\xd5\xab\x8d\x3f\x3a\xca\xe7\xbd\x44\x02\x9f\x62\x56\xa9\xce\xc0\x88\xb5\x45\x47\x78\x20\xa6\x48\x38\x01\x77\x36\x09\xa9\xe4\x9e\x45\x27\x77\x56\x49\x75\x50\x26\xf1\xf8\x5c\x5c\x73\x97\xde\xec\x81\x5b\x06\xd2\xdf\xf1\xcb\x2d\xa3\x69\xce\x17\xec\x7e\x47\xd2\x81\x41\xfa\x4e\xd3\x11\xa9\x77\x4f\x3f\xcd\x28\xc2\xc7\x60\xbf\x7f\xd4\x7c\xc1\x72\x5a\x81\xc1\x5f\x65\x78\x3b\x9d\x99\x29\x59\xc7\xe6\x3e\x91\xcd\x0f\x48\x05\xce\x5e\x97\x2a\xc1\x9d\x3d\xd8\x1c\x15\xe6\xf2\xbc\x4d\x1a\xda\x33\x07\xce\xb2\xd1\x6a\x36\x49\xe9\xa8\xed\xf3\x74\xe6\x33\xbb\x7a\x94\x03\xcb\x88\x38\xbb\x76\x97\x0e\xb4\x2a\xc1\xac\xd5\xe3\x4d\x89\x3b\x14\x55\x13\x75\xc8\x12\x58\x88\x1a\xe2\x70\x82\x87\x2d\x33\xd4\x50\xd8\xef\x8a\x22\x32\xca\x99\x3b\x2b\x2a\x74\xd1\xd4\xb9\x22\xfe\xa8\x4e\x6f\xef\x3b\xf7\xe6\x38\xa6\xdf\x32\x9c\xc6\xa2\x1a\xb8\x33\x7a\x55\x13\xb5\xbf\x13\xe5\xcc\x11\x16\xf9\x80\x27\x5a\x25\x9e\x57\x03\xdf\x08\x3e\x96\xf5\x1d\x7b\x54\x6a\xef\x61\xcc\x43\xc2\x4a\xda\xe3\xac\xb8\x9d\xea\xa8\x12\x97\xc3\x08\x11\x78\xbf\xc3\x3a\xe5\x0d\x9f\xe3\x9e\xcf\x44\x81\xbb\x45\x86\x53\x2e\x04\xb5\x60\xe0\x47\x89\xd6\x38\x5d\xc1\x99\x65\x3a\xeb\x46\xca\x07\xff\x62\xd4\x7f\x52\x78\xfc\xa0\x79\x67\xd6\x20\x3d\xaa\x10\x8a\xb1\x54\x6c\x87\x48\xe3\x61\x17\xe3\x9c\x04\x1a\xc5\x89\xb9\xdf\xb6\x95\x71\x94\xd3\xf8\xbb\x59\x1d\x83\x02\xca\xce\x5d\xd6\xea\x07\xde\x0e\x4c\xf1\xcd\xea\x59\x0d\x65\xb9\x55\x77\x67\x49\xf4\xb7\x57\x9a\x1a\xd7\xa2\x9c\xb7\x6a\xd4\x50\xa8\x9d\x16\x66\x3a\x43\x7f\x9b\xbd\xf3\x7a\x75\x79\x75\x7b\x77\x35\xbf\x58\x5c\x0d\x5a\x89\x2f\xa0\xde\xd3\x69\xb1\xa7\x5a\xad\xa7\x1d\x0d\xf2\x05\x19\x46\x29\xb6\x71\xea\x10\x7b\xae\xea\x0c\x27\x65\x9f\x86\x30\x93\x13\xf3\xbf\x0c\xab\x99\xda\x0d\xe9\x7d\x75\xaf\x34\xef\xa6\xae\xc5\x37\x9c\x93\x03\x3e\x06\x40\x65\x20\x6a\xab\x74\xde\x87\xa6\xc4\x39\x68\x65\xf5\x96\xaa\x2c\xfe\x7f\x71\x7b\xdd\xe8\x35\x50\xbe\x73\x79\xa9\xd4\x79\x39\x6f\x65\xc8\x78\x12\xb5\xdc\x93\xf7\x21\xfa\x9d\x40\x49\xad\xb4\x96\x1d\x37\xf8\xb8\x0b\x9d\x19\x78\xdc\xff\x14\xb6\x30\x84\xe8\xc4\xfc\x2f\x93\xca\x18\xcb\x5c\xe8\x6a\x62\xa5\xd0\xe6\xa0\xd5\x09\xf9\xea\xdb\x1a\xa7\x29\x49\x86\xdf\x3d\xaa\xea\x15\xdf\xc0\x53\x31\x2c\x69\xa8\x84\x9f\x90\xf3\x09\x6f\x0c\x7f\x3b\x44\x17\x3c\x93\x2f\x98\x0f\x0c\x7b\x45\xd7\xb6\x52\x84\x51\x7f\x48\xe9\xc0\xb4\xe7\x78\xbb\xc3\x74\x95\xde\xed\x13\xc2\xed\xa4\x43\xf7\xbc\xb6\x21\x36\x15\xa5\x75\xe0\x2d\x33\xd3\x63\x4d\x7e\xa7\x57\x27\x24\x3d\x7e\x67\x19\x51\xbb\x36\x3e\xf0\xcd\xc3\xef\xab\x19\xcb\xd5\xcc\x02\xbf\xcc\x13\xcc\xb9\x9d\x6c\x2b\x38\x13\xb3\x3e\x1b\x4c\xdd\xbd\x28\x40\xee\x9a\xa8\xd0\xcb\xdc\x05\x57\x7c\x72\xbf\x34\x77\xb9\x11\x31\xf7\x27\x11\xa8\x11\x70\xeb\x50\x3d\xf4\x2f\x06\xea\x20\xb2\x71\x0e\xed\x76\xb4\x9a\x6a\xd1\xc6\xd2\x17\x1c\xaf\xde\x9c\x13\x31\x88\x10\x2e\x64\x32\x05\x7d\x73\x4e\xb4\xfc\x9d\x70\x96\x8e\x0b\x06\xfb\xb6\xef\x32\xce\x66\x74\xee\x6c\xc6\xc6\x83\x01\xb8\x70\x1b\x3e\x42\xea\xf2\xc4\xcf\x31\x20\xe1\xeb\x32\xcd\x69\x60\xba\xfc\x07\x39\xd8\x49\x7a\x2d\x1a\xd7\xfc\x1e\x27\x04\x2c\xd1\xc0\x84\xff\x41\x8e\x07\x96\xc5\xfc\x57\x52\xb4\xd3\x50\x23\xee\xec\x22\xd3\xda\xa1\xff\x11\x11\x74\xd5\xf3\x4f\xb5\xc2\xd0\x3b\x96\x83\x72\xe0\x04\x25\x32\x98\x80\xa8\xde\x66\x93\x4e\xf6\x46\xb5\x36\xa7\x1c\x84\x2e\x05\x92\x9a\x6d\x6d\x33\xa3\x58\xb4\x42\x6b\x13\x86\x70\xf1\x2a\x63\xd3\x78\xf5\x2a\x1d\x78\x20\x31\x15\xe1\xea\x18\x25\x7e\x31\x1a\xe7\x40\x75\x90\xe5\xf5\x3b\xa6\xa9\xb2\xe4\xed\x90\x76\x0e\xc7\xb6\xa4\x98\xbb\x75\x2c\xa1\x6f\x9b\x85\x46\xe2\x24\xa6\xd8\x4e\x72\x1c\x19\xc9\x9d\x5b\x31\x86\x00\xc1\xdc\x89\x94\x12\x92\x0d\x0f\x8f\xa2\x3c\xfc\xd5\x9a\xa2\x7c\xbf\xd9\x0f\x4b\x58\x2d\x26\x5f\x33\xb6\x1d\x87\xf2\xa2\xe9\x5c\x09\xa3\xbb\x66\x59\x3e\xda\x56\xc0\x25\x1a\xb7\x0f\x5d\x39\x85\xfb\xd9\x81\xa2\xb1\x7b\xae\xca\x08\x35\x71\x8b\x60\x81\xc1\xb7\x74\x7f\x51\x4e\x47\xd1\x3e\x49\x38\x48\xf9\x26\x66\xdb\x62\x3a\x5c\xeb\x61\x19\x7a\xa7\x81\xe7\xeb\x39\xdb\x1a\x6f\x9b\x1a\xe4\x7d\x20\x1a\x1e\xf4\xe1\x03\xec\xce\x24\xbf\xda\x04\xd3\xbb\x0b\x94\x9c\xed\x90\xad\x6d\xa9\x8a\xcf\xab\x5d\x14\x4f\x64\xda\xc2\x53\xf1\x03\x24\x62\x88\xb1\x22\xac\xf6\x55\xd3\x99\x99\xf2\xb8\x8b\xf1\x50\xc7\x77\xe7\xed\x8a\x06\x14\x14\x59\xe0\x9b\xbd\xfe\x58\xb9\xca\x60\x4d\x68\xba\x29\x9e\xc9\x2b\x44\x6b\xdd\xac\xfb\x28\xa5\x65\x17\xa4\x8d\xb4\xf8\xfa\x4d\x84\x34\xd4\x8e\xe9\x36\x23\xf7\x24\x21\x51\x4e\x62\x79\xe5\xde\xb9\x0f\xd1\x00\x30\xb6\x46\x5b\xba\x5a\xe7\x20\x32\x28\x5d\x00\xc7\x84\xa8\xf6\x40\x93\x50\xf4\x15\x7d\x26\x08\xa3\x35\x4d\xf3\x52\xa4\x39\x43\x18\x3d\x17\x54\x20\xc8\x1b\x9f\x34\x43\x1f\x16\xa0\x0e\x25\x69\x88\x7c\x88\x30\x27\x90\xb6\x8c\x21\x60\x30\x43\x4b\x9a\xe4\x24\x2b\x0e\x26\x38\x45\x58\xb9\x01\x45\xa2\x94\x0c\xae\x95\x33\x0c\x5a\xab\xae\x20\xe9\xa9\xd0\xb5\xaa\x85\xa8\xae\xc0\x0e\xe9\xc7\x21\x75\x6b\xe4\xdd\x0d\x50\x1f\xa3\x62\xbe\x40\xec\xff\xa1\x8f\xb4\xb0\x1e\x86\xc3\xb6\xab\xf2\x9b\x08\x94\xa1\xcb\xe2\xb7\x32\x38\x59\xf3\x2f\x80\xbe\x0a\x95\x5c\x65\x34\x46\x7f\xa0\x84\xf2\x1c\x3d\x53\x72\x68\x03\xac\x13\x0d\x03\x0b\xfe\x00\x54\x90\xf6\x62\xbf\x6c\xae\x00\xa8\x8e\xfe\xb0\xca\xd5\xdd\x03\xe5\xb3\x6d\x72\x2e\x16\xb9\xc5\x2c\x15\x28\x00\x38\x00\x04\xef\x97\x5d\xdc\x60\xc6\x03\x08\xcb\x15\x87\x91\x7b\xd1\x46\xb1\x5c\x63\xb5\x5a\xb5\xf8\x9a\x1d\xc4\x93\xaf\x05\xb7\x1c\x7d\x00\x36\x81\xc5\x7e\x1c\xf6\x67\xed\x9a\xc3\xae\xb0\x37\x97\xb5\xe6\xaa\x2a\x0d\xec\x58\x32\x78\xa3\xb6\xc8\x18\xe7\x32\x28\xad\xdc\xbe\x48\x6b\xbd\xc6\xcf\xf0\x28\xf1\x52\xe4\xad\xca\xc7\x16\xb9\x14\x7b\xdd\xc4\xb7\x02\xd2\xcb\xe7\xf9\x70\x77\x73\x9f\xec\x57\x01\xc6\x70\x62\xfe\x97\x61\x8f\xd9\x5d\xcf\x22\x70\x9b\x69\x0f\x5c\x72\xd4\x9c\x06\x1d\x37\x1c\x39\x89\xe5\x10\x82\x83\xc0\x3c\x10\xa5\x7c\x0d\x13\x65\x68\x30\x3d\x62\x2d\xaf\x2f\xd5\xc4\x06\x19\x1b\xea\xa5\x1d\xe4\x18\x67\x45\x95\x8c\x93\x04\x15\x3a\x68\x04\xe6\x7b\x28\x85\x65\xce\xec\x6e\x8a\xd5\x55\xd0\x22\x5f\x67\xb4\x67\x17\xde\xba\xbc\x3f\xab\x8a\xdb\x7a\x54\xbc\x30\x67\x18\x5a\xba\x92\xf2\x29\xda\x3a\x75\x73\x9e\x67\x04\x04\x04\x37\x94\xe7\xbf\x85\xdc\x14\xf2\x5b\xcf\xe6\x16\x6d\x69\xca\xb6\x8d\x8b\x5b\xa7\x5a\x67\x5e\x19\x21\x40\xf2\x9e\xfe\x7b\x68\xb2\x03\x68\xbb\x81\xaa\x08\x8a\x1f\xe3\xd9\x39\x41\x18\xa0\xe0\x21\x84\x27\x66\x89\xd6\x3a\x9c\x36\xb4\x57\xef\x2e\x70\x76\xfe\xa2\xef\x37\xc9\x42\x3f\xbf\x81\x69\x00\x53\x95\x8a\x9f\x67\x24\xa6\xf0\x32\x4c\x6c\x3c\x31\x06\xe2\x73\x91\xb2\xf4\xb8\xa5\xff\x16\x75\x95\xe3\xe0\xb7\x73\x8d\x51\x38\x59\xfc\x27\x4b\xe2\xa1\xe9\x5e\xbd\xec\x68\x46\xce\x59\x28\xc1\x4a\xd1\x4f\xb0\xfa\x1b\x00\x7a\xbf\x81\x92\x55\x99\xe5\xc3\xcf\x00\x31\xe4\x73\x3c\xb3\xdc\x7c\x70\x44\xef\x4e\xc7\xa8\x8d\x8c\xfe\x18\x9b\x8d\x4e\xd5\xda\x09\xeb\x5f\xd4\xca\xd4\x5e\x5b\x82\x13\x47\x95\x9e\x32\x0a\x48\xf5\xca\xad\xff\x20\x03\xe7\x4c\xc1\xf8\xaf\x2f\xcf\x06\xd7\x38\x7b\x6c\xb8\x9e\x64\xe9\xa8\x38\xfd\x89\x79\xad\x97\x81\xf7\x69\x20\x84\x02\x19\x6e\xa7\x3c\xce\x96\xdb\xa2\xd2\x46\x72\xaf\x93\xee\xbf\xbc\x4e\x0c\x4c\x4f\x95\x47\x62\xb4\x13\xd7\x5c\x14\x61\xe0\xee\x67\xae\xe2\xfb\xaa\xe6\xa4\x4a\x58\xe5\xc5\xb5\x0b\x46\x71\xc6\xa0\x88\xb5\x74\x9e\x82\x57\x18\x5c\xc5\x38\x23\xe8\x09\x47\x1b\x70\x2d\x72\x08\xdb\x98\xce\xde\x42\x72\x0a\x4f\xf1\xc4\xb4\x60\xa5\xa9\x74\x4d\xd9\xb4\xa9\xe2\x5b\x1f\x57\x15\x37\xf5\x45\xc7\x69\xe0\xe3\x9c\xa0\xfe\x96\xc3\x69\x27\xdb\x79\x82\x2d\x9b\x4f\xba\xa5\xf1\x3a\x31\x0c\x62\x6a\x90\xac\x3e\x8c\xc0\xa9\xf2\x05\x47\x9b\xeb\x54\xc4\x1c\x0d\x0c\xdc\xbc\xa8\x96\x62\xf1\xe4\x0f\x96\x24\x13\xba\x8a\x19\x68\xde\x66\xe4\x99\xb2\x3d\x3f\x13\x0b\xe2\xd3\xcb\xc2\xd0\x84\xc8\x62\x62\xfe\x97\x49\xaf\x7e\x6f\xec\xc4\xc6\x6e\x52\xeb\x72\x9a\xe3\x15\xb0\xf4\x5f\xe5\xcf\xff\x3b\xe9\x32\x80\x17\x49\x82\xa0\x0e\x6a\x59\x4c\xf9\xe2\xf6\x9a\x23\x92\xc6\x3b\x51\x95\x6e\x86\xb6\x8c\xe7\x88\xef\x69\x0e\x97\x47\xb2\xb6\x7c\x04\x37\x31\x71\x11\x1e\x82\xd1\x53\xc6\x0e\x9c\x64\x33\xf4\x44\x22\x0c\x45\x85\xf2\x35\x39\x02\xbd\x23\x82\x3a\xdb\x10\x67\x42\x38\x87\xd2\xcc\x10\x22\x10\x31\xb6\xa1\x04\xad\x09\x8e\x49\xc6\x3f\xeb\x72\x81\x87\x48\x54\x6d\xd6\xe9\xc4\x80\xa7\x9d\x1d\x79\x8f\xf2\xde\x38\x92\xc3\xea\xcb\x94\xbc\xec\x33\x33\xd5\xde\xa9\x0c\x71\xea\xd7\xe9\x5c\xbe\x3c\xfe\xee\x94\x43\x8e\xab\x27\x96\x6a\x51\x7b\x6f\x6c\x35\x17\x5b\x2f\xb6\xc4\xe9\xfc\xbd\xf1\xa4\xb9\xc0\x5f\x27\x08\x21\xf4\xdf\x93\xd7\xff\x1b\x00\x9f\xe4\xb7\x2d\xde\xba\x01\x00")
 
 func docsOpenapiSwaggerJsonBytes() ([]byte, error) {
 	return bindataRead(
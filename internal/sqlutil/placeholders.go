@@ -0,0 +1,33 @@
+// Package sqlutil contains the helpers shared by the database/sql adapters of the modules
+package sqlutil
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IsPostgres returns true if the database driver uses the postgres dialect
+func IsPostgres(driver string) bool {
+	return driver == "postgres" || driver == "pgx"
+}
+
+// Rebind replaces the "?" placeholders of the query with the numbered ones used by postgres,
+// for other dialects the query is returned unchanged
+func Rebind(query string, postgres bool) string {
+	if !postgres {
+		return query
+	}
+
+	builder := strings.Builder{}
+	position := 0
+	for _, char := range query {
+		if char == '?' {
+			position++
+			builder.WriteString("$" + strconv.Itoa(position))
+			continue
+		}
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,23 @@
+package sqlutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lunarforge/flamingo_commerce/internal/sqlutil"
+)
+
+func TestRebind(t *testing.T) {
+	query := "SELECT id FROM orders WHERE customer_id = ? AND status IN (?, ?) LIMIT ?"
+
+	assert.Equal(t, query, sqlutil.Rebind(query, false))
+	assert.Equal(t, "SELECT id FROM orders WHERE customer_id = $1 AND status IN ($2, $3) LIMIT $4", sqlutil.Rebind(query, true))
+}
+
+func TestIsPostgres(t *testing.T) {
+	assert.True(t, sqlutil.IsPostgres("postgres"))
+	assert.True(t, sqlutil.IsPostgres("pgx"))
+	assert.False(t, sqlutil.IsPostgres("sqlite3"))
+	assert.False(t, sqlutil.IsPostgres("mysql"))
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+)
+
+type (
+	// CustomerOrderService provides the orders of the customer authenticated in the request
+	CustomerOrderService struct {
+		webIdentityService           *auth.WebIdentityService
+		customerIdentityOrderService domain.CustomerIdentityOrderService
+		orderDecorator               domain.OrderDecoratorInterface
+	}
+)
+
+// ErrNoIdentity is returned if the request has no authenticated customer
+var ErrNoIdentity = errors.New("no identity")
+
+// Inject dependencies
+func (s *CustomerOrderService) Inject(
+	webIdentityService *auth.WebIdentityService,
+	customerIdentityOrderService domain.CustomerIdentityOrderService,
+	orderDecorator domain.OrderDecoratorInterface,
+) *CustomerOrderService {
+	s.webIdentityService = webIdentityService
+	s.customerIdentityOrderService = customerIdentityOrderService
+	s.orderDecorator = orderDecorator
+
+	return s
+}
+
+// Find returns the requested page of the orders of the customer
+func (s *CustomerOrderService) Find(ctx context.Context, r *web.Request, filter domain.Filter) (*domain.OrderPage, error) {
+	identity, err := s.identify(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.customerIdentityOrderService.Find(ctx, identity, filter.Normalized())
+}
+
+// GetByID returns a single order of the customer
+func (s *CustomerOrderService) GetByID(ctx context.Context, r *web.Request, orderID string) (*domain.Order, error) {
+	identity, err := s.identify(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.customerIdentityOrderService.GetByID(ctx, identity, orderID)
+}
+
+// Decorate adds the products to the items of the order
+func (s *CustomerOrderService) Decorate(ctx context.Context, order *domain.Order) *domain.DecoratedOrder {
+	return s.orderDecorator.Create(ctx, order)
+}
+
+func (s *CustomerOrderService) identify(ctx context.Context, r *web.Request) (auth.Identity, error) {
+	if r == nil {
+		return nil, ErrNoIdentity
+	}
+
+	identity := s.webIdentityService.Identify(ctx, r)
+	if identity == nil {
+		return nil, ErrNoIdentity
+	}
+
+	return identity, nil
+}
@@ -2,6 +2,10 @@ package domain
 
 import (
 	"time"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
 )
 
 type (
@@ -10,43 +14,192 @@ type (
 		ID           string
 		CreationTime time.Time
 		UpdateTime   time.Time
-		OrderItems   []*OrderItem
-		Status       string
-		Total        float64
-		CurrencyCode string
-		Attributes   Attributes
+		// Status is the current status, use TransitionTo to change it
+		Status        Status
+		StatusHistory []StatusTransition
+		// BillingAddress of the customer
+		BillingAddress *cart.Address
+		// Deliveries contain the ordered items grouped by delivery, like in the cart
+		Deliveries []*Delivery
+		Payments   []Payment
+		// GrandTotal is the total amount the customer has to pay for the order
+		GrandTotal priceDomain.Price
+		// CartID is the id of the placed cart, it is the reference of the gift card bookings
+		CartID string
+		// AppliedGiftCards are the gift cards of the placed cart
+		AppliedGiftCards []cart.AppliedGiftCard
+		Attributes       Attributes
+	}
+
+	// Delivery of an order with its items and shipping costs
+	Delivery struct {
+		DeliveryInfo cart.DeliveryInfo
+		ShippingItem cart.ShippingItem
+		Items        []*OrderItem
+		// ShippedAt is set when the delivery is shipped
+		ShippedAt *time.Time
 	}
 
 	// OrderItem struct
 	OrderItem struct {
+		ID string
+
 		// DEPRECATED
 		Sku string
 
 		MarketplaceCode        string
 		VariantMarketplaceCode string
 
-		Qty float64
-
-		CurrencyCode       string
-		SinglePrice        float64
-		SinglePriceInclTax float64
-		RowTotal           float64
-		TaxAmount          float64
-		RowTotalInclTax    float64
+		Name string
+		Qty  int
 
-		Name         string
-		Price        float64
-		PriceInclTax float64
+		SinglePriceNet      priceDomain.Price
+		SinglePriceGross    priceDomain.Price
+		RowPriceNet         priceDomain.Price
+		RowPriceGross       priceDomain.Price
+		RowTaxes            cart.Taxes
+		TotalDiscountAmount priceDomain.Price
 
 		// Source Id where the item should be picked
 		SourceID string
 
+		// BundleConfiguration contains the chosen components if the item is a bundle product
+		BundleConfiguration productDomain.BundleConfiguration
+
 		Attributes Attributes
 	}
 
+	// Payment of an order
+	Payment struct {
+		Gateway   string
+		Method    string
+		PaymentID string
+		Amount    priceDomain.Price
+	}
+
 	// Attributes map
 	Attributes map[string]Attribute
 
 	// Attribute interface
 	Attribute interface{}
 )
+
+// GetAllItems returns the items of all deliveries
+func (o *Order) GetAllItems() []*OrderItem {
+	var items []*OrderItem
+	for _, delivery := range o.Deliveries {
+		items = append(items, delivery.Items...)
+	}
+
+	return items
+}
+
+// GetDeliveryByCode returns the delivery with the given code
+func (o *Order) GetDeliveryByCode(deliveryCode string) (*Delivery, bool) {
+	for _, delivery := range o.Deliveries {
+		if delivery.DeliveryInfo.Code == deliveryCode {
+			return delivery, true
+		}
+	}
+
+	return nil, false
+}
+
+// SubTotalNet returns the sum of the net row prices of all items
+func (o *Order) SubTotalNet() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, item := range o.GetAllItems() {
+		prices = append(prices, item.RowPriceNet)
+	}
+
+	return o.sum(prices)
+}
+
+// SubTotalGross returns the sum of the gross row prices of all items
+func (o *Order) SubTotalGross() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, item := range o.GetAllItems() {
+		prices = append(prices, item.RowPriceGross)
+	}
+
+	return o.sum(prices)
+}
+
+// ShippingNet returns the net shipping costs of all deliveries
+func (o *Order) ShippingNet() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, delivery := range o.Deliveries {
+		prices = append(prices, delivery.ShippingItem.PriceNet)
+	}
+
+	return o.sum(prices)
+}
+
+// ShippingGross returns the gross shipping costs of all deliveries
+func (o *Order) ShippingGross() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, delivery := range o.Deliveries {
+		prices = append(prices, delivery.ShippingItem.PriceNet, delivery.ShippingItem.TaxAmount)
+	}
+
+	return o.sum(prices)
+}
+
+// TotalDiscountAmount returns the discounts of all items
+func (o *Order) TotalDiscountAmount() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, item := range o.GetAllItems() {
+		prices = append(prices, item.TotalDiscountAmount)
+	}
+
+	return o.sum(prices)
+}
+
+// SumTaxes returns the taxes of all items and shipping costs merged by type and rate
+func (o *Order) SumTaxes() cart.Taxes {
+	taxes := cart.Taxes{}
+	for _, delivery := range o.Deliveries {
+		for _, item := range delivery.Items {
+			taxes = taxes.AddTaxesWithMerge(item.RowTaxes)
+		}
+		taxes = taxes.AddTaxesWithMerge(delivery.ShippingItem.Taxes)
+	}
+
+	return taxes
+}
+
+// TotalTaxAmount returns the sum of all taxes
+func (o *Order) TotalTaxAmount() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0)
+	for _, item := range o.GetAllItems() {
+		prices = append(prices, item.RowTaxes.TotalAmount())
+	}
+	for _, delivery := range o.Deliveries {
+		prices = append(prices, delivery.ShippingItem.TaxAmount)
+	}
+
+	return o.sum(prices)
+}
+
+// TotalPaid returns the sum of all payments
+func (o *Order) TotalPaid() priceDomain.Price {
+	prices := make([]priceDomain.Price, 0, len(o.Payments))
+	for _, payment := range o.Payments {
+		prices = append(prices, payment.Amount)
+	}
+
+	return o.sum(prices)
+}
+
+// sum adds the prices that have a currency, the result is a zero price in the currency of the grand total if there is none
+func (o *Order) sum(prices []priceDomain.Price) priceDomain.Price {
+	result := priceDomain.NewZero(o.GrandTotal.Currency())
+	for _, price := range prices {
+		if price.Currency() == "" {
+			continue
+		}
+		result = result.ForceAdd(price)
+	}
+
+	return result
+}
@@ -16,8 +16,10 @@ type (
 
 	// OrderDecorator struct defines the order decorator
 	OrderDecorator struct {
-		ProductService domain.ProductService `inject:""`
-		Logger         flamingo.Logger       `inject:""`
+		ProductService      domain.ProductService      `inject:""`
+		BatchProductService domain.BatchProductService `inject:",optional"`
+		MaxParallelLookups  float64                    `inject:"config:commerce.product.maxParallelLookups,optional"`
+		Logger              flamingo.Logger            `inject:""`
 	}
 
 	// DecoratedOrder struct
@@ -51,26 +53,38 @@ var _ OrderDecoratorInterface = (*OrderDecorator)(nil)
 // Create creates a new decorated order
 func (rd *OrderDecorator) Create(ctx context.Context, order *Order) *DecoratedOrder {
 	result := &DecoratedOrder{Order: order}
-	result.DecoratedItems = rd.createDecoratedItems(ctx, order.OrderItems)
+	result.DecoratedItems = rd.createDecoratedItems(ctx, order.GetAllItems())
 
 	return result
 }
 
 func (rd *OrderDecorator) createDecoratedItems(ctx context.Context, items []*OrderItem) []*DecoratedOrderItem {
+	marketplaceCodes := make([]string, 0, len(items))
+	for _, item := range items {
+		marketplaceCodes = append(marketplaceCodes, item.MarketplaceCode)
+	}
+
+	loader := domain.ProductLoader{
+		ProductService:      rd.ProductService,
+		BatchProductService: rd.BatchProductService,
+		MaxParallel:         int(rd.MaxParallelLookups),
+	}
+	products := loader.Load(ctx, marketplaceCodes)
+
 	result := make([]*DecoratedOrderItem, len(items))
 	for i, item := range items {
-		result[i] = rd.createDecoratedItem(ctx, item)
+		result[i] = rd.createDecoratedItem(ctx, item, products)
 	}
 
 	return result
 }
 
-func (rd *OrderDecorator) createDecoratedItem(ctx context.Context, item *OrderItem) *DecoratedOrderItem {
+func (rd *OrderDecorator) createDecoratedItem(ctx context.Context, item *OrderItem, products domain.ProductLoadResults) *DecoratedOrderItem {
 	result := &DecoratedOrderItem{
 		Item: item,
 	}
 
-	product, err := rd.ProductService.Get(ctx, item.MarketplaceCode)
+	product, err := products.Get(item.MarketplaceCode)
 	switch {
 	case err != nil:
 		rd.Logger.WithContext(ctx).Error("order.decorator - no product for item", err)
@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	countingProductService struct {
+		mutex sync.Mutex
+		calls map[string]int
+	}
+
+	batchProductService struct {
+		calls [][]string
+	}
+)
+
+func (s *countingProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	s.mutex.Lock()
+	s.calls[marketplaceCode]++
+	s.mutex.Unlock()
+
+	if marketplaceCode == "missing" {
+		return nil, productDomain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return productDomain.SimpleProduct{Identifier: marketplaceCode, BasicProductData: productDomain.BasicProductData{Title: marketplaceCode}}, nil
+}
+
+func (s *batchProductService) GetMany(_ context.Context, marketplaceCodes []string) (map[string]productDomain.BasicProduct, error) {
+	s.calls = append(s.calls, marketplaceCodes)
+
+	products := make(map[string]productDomain.BasicProduct)
+	for _, code := range marketplaceCodes {
+		if code != "missing" {
+			products[code] = productDomain.SimpleProduct{Identifier: code, BasicProductData: productDomain.BasicProductData{Title: code}}
+		}
+	}
+
+	return products, nil
+}
+
+func decoratorTestOrder() *domain.Order {
+	return &domain.Order{
+		Deliveries: []*domain.Delivery{
+			{Items: []*domain.OrderItem{
+				{ID: "1", MarketplaceCode: "product-a"},
+				{ID: "2", MarketplaceCode: "missing", Name: "Gone"},
+			}},
+			{Items: []*domain.OrderItem{
+				{ID: "3", MarketplaceCode: "product-a"},
+				{ID: "4", MarketplaceCode: "product-b"},
+			}},
+		},
+	}
+}
+
+func decoratedTitles(decoratedOrder *domain.DecoratedOrder) []string {
+	titles := make([]string, 0, len(decoratedOrder.DecoratedItems))
+	for _, item := range decoratedOrder.DecoratedItems {
+		titles = append(titles, item.Product.BaseData().Title)
+	}
+
+	return titles
+}
+
+func TestOrderDecorator_Create(t *testing.T) {
+	t.Run("products are loaded once with parallel calls", func(t *testing.T) {
+		productService := &countingProductService{calls: make(map[string]int)}
+		decorator := &domain.OrderDecorator{ProductService: productService, Logger: flamingo.NullLogger{}}
+
+		decoratedOrder := decorator.Create(context.Background(), decoratorTestOrder())
+
+		assert.Equal(t, []string{"product-a", "Gone", "product-a", "product-b"}, decoratedTitles(decoratedOrder))
+		assert.Equal(t, map[string]int{"product-a": 1, "missing": 1, "product-b": 1}, productService.calls)
+	})
+
+	t.Run("products are loaded with one batch call", func(t *testing.T) {
+		productService := &countingProductService{calls: make(map[string]int)}
+		batchService := new(batchProductService)
+		decorator := &domain.OrderDecorator{ProductService: productService, BatchProductService: batchService, Logger: flamingo.NullLogger{}}
+
+		decoratedOrder := decorator.Create(context.Background(), decoratorTestOrder())
+
+		assert.Equal(t, []string{"product-a", "Gone", "product-a", "product-b"}, decoratedTitles(decoratedOrder))
+		require.Len(t, batchService.calls, 1)
+		assert.Equal(t, []string{"product-a", "missing", "product-b"}, batchService.calls[0])
+		assert.Empty(t, productService.calls)
+	})
+}
@@ -0,0 +1,144 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+func testOrder() *domain.Order {
+	vat := func(amount float64) cart.Taxes {
+		return cart.Taxes{{Amount: priceDomain.NewFromFloat(amount, "EUR"), Type: "vat", Rate: big.NewFloat(19)}}
+	}
+
+	return &domain.Order{
+		ID:     "1",
+		Status: domain.StatusPlaced,
+		Deliveries: []*domain.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "home"},
+				ShippingItem: cart.ShippingItem{
+					PriceNet:  priceDomain.NewFromFloat(10, "EUR"),
+					TaxAmount: priceDomain.NewFromFloat(1.9, "EUR"),
+					Taxes:     vat(1.9),
+				},
+				Items: []*domain.OrderItem{
+					{ID: "a", RowPriceNet: priceDomain.NewFromFloat(100, "EUR"), RowPriceGross: priceDomain.NewFromFloat(119, "EUR"), RowTaxes: vat(19)},
+					{ID: "b", RowPriceNet: priceDomain.NewFromFloat(50, "EUR"), RowPriceGross: priceDomain.NewFromFloat(59.5, "EUR"), RowTaxes: vat(9.5)},
+				},
+			},
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "store"},
+				Items: []*domain.OrderItem{
+					{ID: "c", RowPriceNet: priceDomain.NewFromFloat(10, "EUR"), RowPriceGross: priceDomain.NewFromFloat(11.9, "EUR"), RowTaxes: vat(1.9)},
+				},
+			},
+		},
+		GrandTotal: priceDomain.NewFromFloat(202.3, "EUR"),
+	}
+}
+
+func TestOrder_Totals(t *testing.T) {
+	t.Parallel()
+
+	order := testOrder()
+
+	assert.Len(t, order.GetAllItems(), 3)
+	assert.Equal(t, 160.0, order.SubTotalNet().GetPayable().FloatAmount())
+	assert.Equal(t, 190.4, order.SubTotalGross().GetPayable().FloatAmount())
+	assert.Equal(t, 11.9, order.ShippingGross().GetPayable().FloatAmount())
+	assert.Equal(t, 32.3, order.TotalTaxAmount().GetPayable().FloatAmount())
+	assert.Equal(t, 32.3, order.SumTaxes().TotalAmount().GetPayable().FloatAmount())
+	assert.True(t, order.TotalPaid().IsZero())
+	assert.Equal(t, "EUR", order.TotalPaid().Currency())
+}
+
+func TestOrder_TransitionTo(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	order := testOrder()
+
+	err := order.TransitionTo(domain.StatusShipped, now, "")
+	assert.True(t, errors.Is(err, domain.ErrInvalidStatusTransition))
+	assert.Equal(t, domain.StatusPlaced, order.Status)
+
+	require.NoError(t, order.TransitionTo(domain.StatusPaid, now, "payment received"))
+	require.NoError(t, order.ShipDelivery("home", now))
+	assert.Equal(t, domain.StatusPartiallyShipped, order.Status)
+
+	err = order.ShipDelivery("unknown", now)
+	assert.True(t, errors.Is(err, domain.ErrDeliveryNotFound))
+
+	require.NoError(t, order.ShipDelivery("store", now))
+	assert.Equal(t, domain.StatusShipped, order.Status)
+	assert.NotNil(t, order.Deliveries[1].ShippedAt)
+
+	require.NoError(t, order.TransitionTo(domain.StatusReturned, now, ""))
+	assert.True(t, order.Status.IsFinal())
+	assert.Equal(t, []domain.StatusTransition{
+		{From: domain.StatusPlaced, To: domain.StatusPaid, Time: now, Comment: "payment received"},
+		{From: domain.StatusPaid, To: domain.StatusPartiallyShipped, Time: now, Comment: "delivery home shipped"},
+		{From: domain.StatusPartiallyShipped, To: domain.StatusShipped, Time: now, Comment: "delivery store shipped"},
+		{From: domain.StatusShipped, To: domain.StatusReturned, Time: now},
+	}, order.StatusHistory)
+
+	assert.False(t, domain.StatusCancelled.CanTransitionTo(domain.StatusPaid))
+}
+
+func TestFilter_Apply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	var orders []*domain.Order
+	for i := 0; i < 5; i++ {
+		status := domain.StatusPlaced
+		if i%2 == 1 {
+			status = domain.StatusShipped
+		}
+		orders = append(orders, &domain.Order{ID: fmt.Sprint(i), Status: status, CreationTime: now.AddDate(0, 0, -i)})
+	}
+
+	page := domain.Filter{PageSize: 2, Page: 2}.Apply(orders)
+	assert.Equal(t, 5, page.TotalCount)
+	assert.Equal(t, 3, page.TotalPages())
+	assert.True(t, page.HasNextPage())
+	require.Len(t, page.Orders, 2)
+	assert.Equal(t, "2", page.Orders[0].ID)
+	assert.Equal(t, "3", page.Orders[1].ID)
+
+	page = domain.Filter{Statuses: []domain.Status{domain.StatusShipped}}.Apply(orders)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, domain.DefaultPageSize, page.PageSize)
+	assert.False(t, page.HasNextPage())
+
+	page = domain.Filter{CreatedFrom: now.AddDate(0, 0, -2), CreatedTo: now}.Apply(orders)
+	assert.Equal(t, 2, page.TotalCount)
+
+	page = domain.Filter{Page: 10}.Apply(orders)
+	assert.Empty(t, page.Orders)
+}
+
+func TestFilter_Normalized(t *testing.T) {
+	t.Parallel()
+
+	filter := domain.Filter{Page: -1, PageSize: -1}.Normalized()
+	assert.Equal(t, 1, filter.Page)
+	assert.Equal(t, domain.DefaultPageSize, filter.PageSize)
+
+	filter = domain.Filter{Page: 3, PageSize: 20}.Normalized()
+	assert.Equal(t, 3, filter.Page)
+	assert.Equal(t, 20, filter.PageSize)
+
+	filter = domain.Filter{PageSize: 100000}.Normalized()
+	assert.Equal(t, domain.MaxPageSize, filter.PageSize)
+}
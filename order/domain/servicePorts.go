@@ -2,6 +2,9 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"time"
 
 	"flamingo.me/flamingo/v3/core/auth"
 )
@@ -13,5 +16,126 @@ type (
 		Get(ctx context.Context, identity auth.Identity) ([]*Order, error)
 		// GetByID returns a single order for a customer
 		GetByID(ctx context.Context, identity auth.Identity, orderID string) (*Order, error)
+		// Find returns a page of the orders of a customer that match the filter, newest orders first
+		Find(ctx context.Context, identity auth.Identity, filter Filter) (*OrderPage, error)
+	}
+
+	// Filter restricts and paginates the orders returned by CustomerIdentityOrderService.Find
+	Filter struct {
+		// Statuses of the orders, empty for all statuses
+		Statuses []Status
+		// CreatedFrom only returns orders created at or after the time if set
+		CreatedFrom time.Time
+		// CreatedTo only returns orders created before the time if set
+		CreatedTo time.Time
+		// Page starts with 1
+		Page int
+		// PageSize is the number of orders per page, DefaultPageSize is used if it is not set, at most MaxPageSize
+		PageSize int
+	}
+
+	// OrderPage is a page of orders
+	OrderPage struct {
+		Orders     []*Order
+		Page       int
+		PageSize   int
+		TotalCount int
 	}
 )
+
+const (
+	// DefaultPageSize is used if the filter has no page size
+	DefaultPageSize = 10
+	// MaxPageSize is the upper bound of the page size, larger page sizes are reduced to it
+	MaxPageSize = 100
+)
+
+// ErrOrderNotFound is returned if the order does not exist or does not belong to the customer
+var ErrOrderNotFound = errors.New("order not found")
+
+// Matches checks if the order matches the statuses and creation time of the filter
+func (f Filter) Matches(order *Order) bool {
+	if len(f.Statuses) > 0 {
+		found := false
+		for _, status := range f.Statuses {
+			if order.Status == status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !f.CreatedFrom.IsZero() && order.CreationTime.Before(f.CreatedFrom) {
+		return false
+	}
+
+	if !f.CreatedTo.IsZero() && !order.CreationTime.Before(f.CreatedTo) {
+		return false
+	}
+
+	return true
+}
+
+// Normalized returns the filter with a valid page and a page size between 1 and MaxPageSize
+func (f Filter) Normalized() Filter {
+	if f.Page < 1 {
+		f.Page = 1
+	}
+	if f.PageSize < 1 {
+		f.PageSize = DefaultPageSize
+	}
+	if f.PageSize > MaxPageSize {
+		f.PageSize = MaxPageSize
+	}
+
+	return f
+}
+
+// Apply filters, sorts (newest first) and paginates the orders, it can be used by adapters that load all orders of a customer
+func (f Filter) Apply(orders []*Order) *OrderPage {
+	f = f.Normalized()
+
+	matching := make([]*Order, 0, len(orders))
+	for _, order := range orders {
+		if f.Matches(order) {
+			matching = append(matching, order)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		if !matching[i].CreationTime.Equal(matching[j].CreationTime) {
+			return matching[i].CreationTime.After(matching[j].CreationTime)
+		}
+		return matching[i].ID > matching[j].ID
+	})
+
+	page := &OrderPage{Page: f.Page, PageSize: f.PageSize, TotalCount: len(matching), Orders: []*Order{}}
+	start := (f.Page - 1) * f.PageSize
+	if start >= len(matching) {
+		return page
+	}
+	end := start + f.PageSize
+	if end > len(matching) {
+		end = len(matching)
+	}
+	page.Orders = matching[start:end]
+
+	return page
+}
+
+// TotalPages returns the number of pages
+func (p OrderPage) TotalPages() int {
+	if p.PageSize < 1 {
+		return 0
+	}
+
+	return (p.TotalCount + p.PageSize - 1) / p.PageSize
+}
+
+// HasNextPage returns true if there is a page after the current one
+func (p OrderPage) HasNextPage() bool {
+	return p.Page < p.TotalPages()
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// Status of an order
+	Status string
+
+	// StatusTransition records a status change of an order
+	StatusTransition struct {
+		From    Status
+		To      Status
+		Time    time.Time
+		Comment string
+	}
+)
+
+const (
+	// StatusHeld the order is placed but held for a manual review, e.g. because of its fraud risk
+	StatusHeld Status = "held"
+	// StatusPlaced the order is placed but not paid yet
+	StatusPlaced Status = "placed"
+	// StatusPaid the order is paid
+	StatusPaid Status = "paid"
+	// StatusPartiallyShipped some of the deliveries of the order are shipped
+	StatusPartiallyShipped Status = "partially_shipped"
+	// StatusShipped all deliveries of the order are shipped
+	StatusShipped Status = "shipped"
+	// StatusCancelled the order is cancelled
+	StatusCancelled Status = "cancelled"
+	// StatusReturned the shipped order is returned
+	StatusReturned Status = "returned"
+)
+
+var (
+	// ErrInvalidStatusTransition is returned if the status of an order cannot be changed to the requested status
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+
+	// ErrDeliveryNotFound is returned if the order has no delivery with the requested code
+	ErrDeliveryNotFound = errors.New("order delivery not found")
+
+	// statusTransitions are the allowed next states per status
+	statusTransitions = map[Status][]Status{
+		StatusHeld:             {StatusPlaced, StatusCancelled},
+		StatusPlaced:           {StatusPaid, StatusCancelled},
+		StatusPaid:             {StatusPartiallyShipped, StatusShipped, StatusCancelled},
+		StatusPartiallyShipped: {StatusPartiallyShipped, StatusShipped},
+		StatusShipped:          {StatusReturned},
+	}
+)
+
+// AllStatuses returns all known order statuses in lifecycle order
+func AllStatuses() []Status {
+	return []Status{StatusHeld, StatusPlaced, StatusPaid, StatusPartiallyShipped, StatusShipped, StatusCancelled, StatusReturned}
+}
+
+// CanTransitionTo checks if the status may change to the next status
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinal returns true if the status cannot be changed anymore
+func (s Status) IsFinal() bool {
+	return len(statusTransitions[s]) == 0
+}
+
+// TransitionTo changes the status of the order and records the change in the status history
+func (o *Order) TransitionTo(next Status, at time.Time, comment string) error {
+	if !o.Status.CanTransitionTo(next) {
+		return fmt.Errorf("%w: from %q to %q", ErrInvalidStatusTransition, o.Status, next)
+	}
+
+	o.StatusHistory = append(o.StatusHistory, StatusTransition{From: o.Status, To: next, Time: at, Comment: comment})
+	o.Status = next
+	o.UpdateTime = at
+
+	return nil
+}
+
+// ShipDelivery marks the delivery as shipped and changes the status to partially shipped or shipped
+func (o *Order) ShipDelivery(deliveryCode string, at time.Time) error {
+	delivery, found := o.GetDeliveryByCode(deliveryCode)
+	if !found {
+		return fmt.Errorf("%w: %q", ErrDeliveryNotFound, deliveryCode)
+	}
+
+	next := StatusShipped
+	for _, d := range o.Deliveries {
+		if d != delivery && d.ShippedAt == nil {
+			next = StatusPartiallyShipped
+			break
+		}
+	}
+
+	if err := o.TransitionTo(next, at, "delivery "+deliveryCode+" shipped"); err != nil {
+		return err
+	}
+
+	delivery.ShippedAt = &at
+
+	return nil
+}
@@ -2,10 +2,15 @@ package fake
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"time"
 
-	"github.com/lunarforge/flamingo_commerce/order/domain"
 	"flamingo.me/flamingo/v3/core/auth"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
 )
 
 type (
@@ -19,52 +24,97 @@ var (
 
 // Get all orders for a customer
 func (co *CustomerOrders) Get(_ context.Context, _ auth.Identity) ([]*domain.Order, error) {
-	return []*domain.Order{
-		{
-			ID:           "100",
-			CreationTime: time.Now(),
-			UpdateTime:   time.Now(),
-			Status:       "Teststatus",
-			OrderItems:   make([]*domain.OrderItem, 0),
-			Total:        123.45,
-			CurrencyCode: "EUR",
-		}, {
-			ID:           "101",
-			CreationTime: time.Now(),
-			UpdateTime:   time.Now(),
-			Status:       "Teststatus",
-			OrderItems:   make([]*domain.OrderItem, 0),
-			Total:        123.45,
-			CurrencyCode: "EUR",
-		}, {
-			ID:           "102",
-			CreationTime: time.Now(),
-			UpdateTime:   time.Now(),
-			Status:       "Teststatus",
-			OrderItems:   make([]*domain.OrderItem, 0),
-			Total:        123.45,
-			CurrencyCode: "EUR",
-		}, {
-			ID:           "103",
-			CreationTime: time.Now(),
-			UpdateTime:   time.Now(),
-			Status:       "Teststatus",
-			OrderItems:   make([]*domain.OrderItem, 0),
-			Total:        123.45,
-			CurrencyCode: "EUR",
-		},
-	}, nil
+	return fakeOrders(), nil
 }
 
 // GetByID returns a single customer order
 func (co *CustomerOrders) GetByID(_ context.Context, _ auth.Identity, orderID string) (*domain.Order, error) {
-	return &domain.Order{
-		ID:           orderID,
-		CreationTime: time.Now(),
-		UpdateTime:   time.Now(),
-		Status:       "Teststatus",
-		OrderItems:   make([]*domain.OrderItem, 0),
-		Total:        123.45,
-		CurrencyCode: "EUR",
-	}, nil
+	return fakeOrder(orderID, domain.StatusPlaced, time.Now()), nil
+}
+
+// Find returns a page of the fake orders
+func (co *CustomerOrders) Find(_ context.Context, _ auth.Identity, filter domain.Filter) (*domain.OrderPage, error) {
+	return filter.Apply(fakeOrders()), nil
+}
+
+func fakeOrders() []*domain.Order {
+	now := time.Now()
+	statuses := []domain.Status{domain.StatusPlaced, domain.StatusPaid, domain.StatusShipped, domain.StatusCancelled}
+
+	orders := make([]*domain.Order, 0, len(statuses))
+	for i, status := range statuses {
+		orders = append(orders, fakeOrder(fmt.Sprint(100+i), status, now.AddDate(0, 0, -i)))
+	}
+
+	return orders
+}
+
+func fakeOrder(orderID string, status domain.Status, creationTime time.Time) *domain.Order {
+	address := &cart.Address{
+		Firstname:   "Max",
+		Lastname:    "Mustermann",
+		Street:      "Musterstraße",
+		StreetNr:    "1",
+		City:        "München",
+		PostCode:    "80331",
+		CountryCode: "DE",
+		Email:       "max@example.com",
+	}
+
+	vat := func(amount float64) cart.Taxes {
+		return cart.Taxes{{Amount: priceDomain.NewFromFloat(amount, "EUR"), Type: "vat", Rate: big.NewFloat(19)}}
+	}
+
+	order := &domain.Order{
+		ID:             orderID,
+		CreationTime:   creationTime,
+		UpdateTime:     creationTime,
+		Status:         domain.StatusPlaced,
+		BillingAddress: address,
+		Deliveries: []*domain.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{
+					Code:             "delivery",
+					Workflow:         cart.DeliveryWorkflowDelivery,
+					Method:           "standard",
+					DeliveryLocation: cart.DeliveryLocation{Type: cart.DeliverylocationTypeAddress, Address: address},
+				},
+				ShippingItem: cart.ShippingItem{
+					Title:     "Standard shipping",
+					PriceNet:  priceDomain.NewFromFloat(4.2, "EUR"),
+					TaxAmount: priceDomain.NewFromFloat(0.8, "EUR"),
+					Taxes:     vat(0.8),
+				},
+				Items: []*domain.OrderItem{
+					{
+						ID:               orderID + "-1",
+						MarketplaceCode:  "fake_simple",
+						Name:             "Fake simple product",
+						Qty:              2,
+						SinglePriceNet:   priceDomain.NewFromFloat(50, "EUR"),
+						SinglePriceGross: priceDomain.NewFromFloat(59.5, "EUR"),
+						RowPriceNet:      priceDomain.NewFromFloat(100, "EUR"),
+						RowPriceGross:    priceDomain.NewFromFloat(119, "EUR"),
+						RowTaxes:         vat(19),
+					},
+				},
+			},
+		},
+		Payments: []domain.Payment{
+			{Gateway: "offline", Method: "offlinepayment_cashondelivery", PaymentID: "payment-" + orderID, Amount: priceDomain.NewFromFloat(124, "EUR")},
+		},
+		GrandTotal: priceDomain.NewFromFloat(124, "EUR"),
+	}
+
+	switch status {
+	case domain.StatusPaid:
+		_ = order.TransitionTo(domain.StatusPaid, creationTime, "")
+	case domain.StatusShipped:
+		_ = order.TransitionTo(domain.StatusPaid, creationTime, "")
+		_ = order.ShipDelivery("delivery", creationTime)
+	case domain.StatusCancelled:
+		_ = order.TransitionTo(domain.StatusCancelled, creationTime, "")
+	}
+
+	return order
 }
@@ -0,0 +1,395 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/gob"
+	"fmt"
+	"strings"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/lunarforge/flamingo_commerce/internal/sqlutil"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+)
+
+type (
+	// SQLOrderStorage persists orders in a SQL database using database/sql and reads the orders of customers from it.
+	// The database driver (e.g. sqlite3 or postgres) must be registered by the project.
+	SQLOrderStorage struct {
+		db        *sql.DB
+		logger    flamingo.Logger
+		tableName string
+		postgres  bool
+	}
+)
+
+var (
+	_ domain.CustomerIdentityOrderService = &SQLOrderStorage{}
+	_ healthcheck.Status                  = &SQLOrderStorage{}
+
+	// ErrOrderAlreadyExists is returned if an order with the same id has already been stored
+	ErrOrderAlreadyExists = errors.New("order already exists")
+)
+
+// Inject dependencies and open the database
+func (s *SQLOrderStorage) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Driver    string `inject:"config:commerce.order.sqlStorage.driver"`
+		DSN       string `inject:"config:commerce.order.sqlStorage.dsn"`
+		TableName string `inject:"config:commerce.order.sqlStorage.tableName"`
+		Migrate   bool   `inject:"config:commerce.order.sqlStorage.migrate"`
+	},
+) *SQLOrderStorage {
+	s.logger = logger.WithField(flamingo.LogKeyModule, "order").WithField(flamingo.LogKeyCategory, "SQLOrderStorage")
+	if cfg == nil {
+		return s
+	}
+
+	db, err := sql.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("order.infrastructure.SQLOrderStorage: cannot open database with driver %q: %s", cfg.Driver, err))
+		return s
+	}
+
+	s.SetDB(db, cfg.Driver, cfg.TableName)
+	if cfg.Migrate {
+		if err := s.Migrate(context.Background()); err != nil {
+			s.logger.Error("order.infrastructure.SQLOrderStorage: migration failed: ", err)
+		}
+	}
+
+	return s
+}
+
+// SetDB sets the database handle used by the storage, the driver name decides on the SQL dialect
+func (s *SQLOrderStorage) SetDB(db *sql.DB, driver string, tableName string) {
+	s.db = db
+	s.postgres = sqlutil.IsPostgres(driver)
+	s.tableName = tableName
+	if s.tableName == "" {
+		s.tableName = "orders"
+	}
+}
+
+// Migrate creates the order table and its customer index if they do not exist yet
+func (s *SQLOrderStorage) Migrate(ctx context.Context) error {
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	for _, statement := range s.schema() {
+		if _, err := s.db.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SQLOrderStorage) schema() []string {
+	blobType := "BLOB"
+	if s.postgres {
+		blobType = "BYTEA"
+	}
+
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id VARCHAR(255) NOT NULL PRIMARY KEY,
+	customer_id VARCHAR(255) NOT NULL DEFAULT '',
+	status VARCHAR(64) NOT NULL,
+	data %s NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+)`, s.tableName, blobType),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_customer_id ON %s (customer_id, created_at)", s.tableName, s.tableName),
+	}
+}
+
+// rebind replaces the "?" placeholders of the query with numbered ones if the dialect requires it
+func (s *SQLOrderStorage) rebind(query string) string {
+	return sqlutil.Rebind(query, s.postgres)
+}
+
+// StoreOrder inserts a new order of the customer, guest orders are stored with an empty customer id.
+// ErrOrderAlreadyExists is returned if an order with the same id is already stored
+func (s *SQLOrderStorage) StoreOrder(ctx context.Context, customerID string, order *domain.Order) error {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/StoreOrder")
+	defer span.End()
+
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	data, err := encodeOrder(order)
+	if err != nil {
+		return errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot encode order")
+	}
+
+	// the conflict clause makes concurrent inserts of the same order safe, only one of them affects a row
+	result, err := s.db.ExecContext(
+		ctx,
+		s.rebind(fmt.Sprintf("INSERT INTO %s (id, customer_id, status, data, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO NOTHING", s.tableName)),
+		order.ID, customerID, string(order.Status), data, order.CreationTime.UTC(), order.UpdateTime.UTC(),
+	)
+	if err != nil {
+		return errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot insert order")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrOrderAlreadyExists
+	}
+
+	return nil
+}
+
+// UpdateOrder stores the changed status and data of an existing order
+func (s *SQLOrderStorage) UpdateOrder(ctx context.Context, order *domain.Order) error {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/UpdateOrder")
+	defer span.End()
+
+	if s.db == nil {
+		return errors.New("no database configured")
+	}
+
+	data, err := encodeOrder(order)
+	if err != nil {
+		return errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot encode order")
+	}
+
+	result, err := s.db.ExecContext(
+		ctx,
+		s.rebind(fmt.Sprintf("UPDATE %s SET status = ?, data = ?, updated_at = ? WHERE id = ?", s.tableName)),
+		string(order.Status), data, order.UpdateTime.UTC(), order.ID,
+	)
+	if err != nil {
+		return errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot update order")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return domain.ErrOrderNotFound
+	}
+
+	return nil
+}
+
+// LoadOrder returns the order with the given id and the id of the customer it belongs to
+func (s *SQLOrderStorage) LoadOrder(ctx context.Context, orderID string) (*domain.Order, string, error) {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/LoadOrder")
+	defer span.End()
+
+	if s.db == nil {
+		return nil, "", errors.New("no database configured")
+	}
+
+	var (
+		customerID string
+		data       []byte
+	)
+	err := s.db.QueryRowContext(ctx, s.rebind(fmt.Sprintf("SELECT customer_id, data FROM %s WHERE id = ?", s.tableName)), orderID).Scan(&customerID, &data)
+	if err == sql.ErrNoRows {
+		return nil, "", domain.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot load order")
+	}
+
+	order, err := decodeOrder(data)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "order.infrastructure.SQLOrderStorage: order %q is not decodable", orderID)
+	}
+
+	return order, customerID, nil
+}
+
+// Get all orders for a customer, newest orders first
+func (s *SQLOrderStorage) Get(ctx context.Context, identity auth.Identity) ([]*domain.Order, error) {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/Get")
+	defer span.End()
+
+	customerID, err := s.customerID(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.queryOrders(ctx, fmt.Sprintf("SELECT id, data FROM %s WHERE customer_id = ? ORDER BY created_at DESC, id DESC", s.tableName), customerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot load customer orders")
+	}
+
+	return orders, nil
+}
+
+// GetByID returns a single order of the customer, ErrOrderNotFound if it belongs to someone else
+func (s *SQLOrderStorage) GetByID(ctx context.Context, identity auth.Identity, orderID string) (*domain.Order, error) {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/GetByID")
+	defer span.End()
+
+	customerID, err := s.customerID(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	order, owner, err := s.LoadOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if owner != customerID {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	return order, nil
+}
+
+// Find returns a page of the orders of the customer that match the filter, newest orders first
+func (s *SQLOrderStorage) Find(ctx context.Context, identity auth.Identity, filter domain.Filter) (*domain.OrderPage, error) {
+	ctx, span := trace.StartSpan(ctx, "order/infrastructure/SQLOrderStorage/Find")
+	defer span.End()
+
+	customerID, err := s.customerID(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	filter = filter.Normalized()
+	where, args := filterCondition(customerID, filter)
+
+	page := &domain.OrderPage{Page: filter.Page, PageSize: filter.PageSize, Orders: []*domain.Order{}}
+	err = s.db.QueryRowContext(ctx, s.rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", s.tableName, where)), args...).Scan(&page.TotalCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot count customer orders")
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	if offset >= page.TotalCount {
+		return page, nil
+	}
+
+	orders, err := s.queryOrders(
+		ctx,
+		fmt.Sprintf("SELECT id, data FROM %s WHERE %s ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?", s.tableName, where),
+		append(args, filter.PageSize, offset)...,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "order.infrastructure.SQLOrderStorage: cannot load customer orders")
+	}
+	page.Orders = orders
+
+	return page, nil
+}
+
+// filterCondition returns the where clause and its arguments for the orders of the customer that match the filter
+func filterCondition(customerID string, filter domain.Filter) (string, []interface{}) {
+	conditions := []string{"customer_id = ?"}
+	args := []interface{}{customerID}
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			placeholders = append(placeholders, "?")
+			args = append(args, string(status))
+		}
+		conditions = append(conditions, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ", ")))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.CreatedFrom.UTC())
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.CreatedTo.UTC())
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// customerID returns the subject of the identity, guest orders can't be loaded by a customer
+func (s *SQLOrderStorage) customerID(identity auth.Identity) (string, error) {
+	if s.db == nil {
+		return "", errors.New("no database configured")
+	}
+	if identity == nil || identity.Subject() == "" {
+		return "", errors.New("no customer identity given")
+	}
+
+	return identity.Subject(), nil
+}
+
+// queryOrders decodes the orders of a query selecting id and data
+func (s *SQLOrderStorage) queryOrders(ctx context.Context, query string, args ...interface{}) ([]*domain.Order, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]*domain.Order, 0)
+	for rows.Next() {
+		var (
+			id   string
+			data []byte
+		)
+		err = rows.Scan(&id, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		order, err := decodeOrder(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "order %q is not decodable", id)
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, rows.Err()
+}
+
+// Status handles the health check of the database
+func (s *SQLOrderStorage) Status() (alive bool, details string) {
+	if s.db == nil {
+		return false, "no database configured for order storage"
+	}
+
+	err := s.db.Ping()
+	if err == nil {
+		return true, "database for order storage replies to ping"
+	}
+
+	return false, err.Error()
+}
+
+func encodeOrder(order *domain.Order) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	err := gob.NewEncoder(buffer).Encode(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func decodeOrder(data []byte) (*domain.Order, error) {
+	order := new(domain.Order)
+	err := gob.NewDecoder(bytes.NewBuffer(data)).Decode(order)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
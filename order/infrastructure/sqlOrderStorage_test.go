@@ -0,0 +1,199 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+// newSQLiteOrderStorage returns a migrated order storage using an in memory sqlite database
+func newSQLiteOrderStorage(t *testing.T) *SQLOrderStorage {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+
+	storage := new(SQLOrderStorage).Inject(flamingo.NullLogger{}, nil)
+	storage.SetDB(db, "sqlite3", "")
+	require.NoError(t, storage.Migrate(context.Background()))
+
+	return storage
+}
+
+func storedOrder(id string, status domain.Status, created time.Time) *domain.Order {
+	return &domain.Order{
+		ID:           id,
+		CreationTime: created,
+		UpdateTime:   created,
+		Status:       status,
+		Deliveries: []*domain.Delivery{
+			{DeliveryInfo: cart.DeliveryInfo{Code: "delivery"}, Items: []*domain.OrderItem{{ID: "item-1", MarketplaceCode: "product-1", Qty: 1}}},
+		},
+		GrandTotal: priceDomain.NewFromInt(1000, 100, "EUR"),
+	}
+}
+
+func TestSQLOrderStorage_rebind(t *testing.T) {
+	t.Parallel()
+
+	query := "UPDATE orders SET status = ? WHERE id = ?"
+
+	sqlite := &SQLOrderStorage{}
+	sqlite.SetDB(nil, "sqlite3", "")
+	assert.Equal(t, query, sqlite.rebind(query))
+	assert.Equal(t, "orders", sqlite.tableName)
+	assert.Contains(t, sqlite.schema()[0], "data BLOB NOT NULL")
+
+	postgres := &SQLOrderStorage{}
+	postgres.SetDB(nil, "postgres", "shop_orders")
+	assert.Equal(t, "UPDATE orders SET status = $1 WHERE id = $2", postgres.rebind(query))
+	assert.Contains(t, postgres.schema()[0], "CREATE TABLE IF NOT EXISTS shop_orders")
+	assert.Contains(t, postgres.schema()[0], "data BYTEA NOT NULL")
+	assert.Contains(t, postgres.schema()[1], "ON shop_orders (customer_id, created_at)")
+}
+
+func TestSQLOrderStorage_filterCondition(t *testing.T) {
+	t.Parallel()
+
+	where, args := filterCondition("customer-1", domain.Filter{})
+	assert.Equal(t, "customer_id = ?", where)
+	assert.Equal(t, []interface{}{"customer-1"}, args)
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	where, args = filterCondition("customer-1", domain.Filter{
+		Statuses:    []domain.Status{domain.StatusPlaced, domain.StatusPaid},
+		CreatedFrom: from,
+		CreatedTo:   to,
+	})
+	assert.Equal(t, "customer_id = ? AND status IN (?, ?) AND created_at >= ? AND created_at < ?", where)
+	assert.Equal(t, []interface{}{"customer-1", "placed", "paid", from, to}, args)
+}
+
+func TestSQLOrderStorage_encodeDecodeOrder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	order := &domain.Order{
+		ID:           "order-1",
+		CreationTime: now,
+		Status:       domain.StatusPlaced,
+		Deliveries: []*domain.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "delivery"},
+				Items: []*domain.OrderItem{
+					{ID: "item-1", MarketplaceCode: "product-1", Qty: 2, RowPriceGross: priceDomain.NewFromInt(2000, 100, "EUR"), Attributes: domain.Attributes{"gift": "true"}},
+				},
+			},
+		},
+		GrandTotal: priceDomain.NewFromInt(2000, 100, "EUR"),
+	}
+	require.NoError(t, order.TransitionTo(domain.StatusPaid, now, "payment received"))
+
+	data, err := encodeOrder(order)
+	require.NoError(t, err)
+
+	decoded, err := decodeOrder(data)
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", decoded.ID)
+	assert.Equal(t, domain.StatusPaid, decoded.Status)
+	assert.Len(t, decoded.StatusHistory, 1)
+	assert.Equal(t, "product-1", decoded.Deliveries[0].Items[0].MarketplaceCode)
+	assert.Equal(t, "true", decoded.Deliveries[0].Items[0].Attributes["gift"])
+	assert.True(t, decoded.GrandTotal.Equal(order.GrandTotal))
+}
+
+func TestSQLOrderStorage_StoreAndLoadOrder(t *testing.T) {
+	storage := newSQLiteOrderStorage(t)
+	defer storage.db.Close()
+	ctx := context.Background()
+
+	created := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, storage.StoreOrder(ctx, "customer-1", storedOrder("order-1", domain.StatusPlaced, created)))
+	assert.Equal(t, ErrOrderAlreadyExists, storage.StoreOrder(ctx, "customer-2", storedOrder("order-1", domain.StatusPaid, created)))
+
+	order, owner, err := storage.LoadOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, "customer-1", owner)
+	assert.Equal(t, domain.StatusPlaced, order.Status, "the first stored order must not be overwritten")
+	assert.Equal(t, "product-1", order.GetAllItems()[0].MarketplaceCode)
+
+	_, _, err = storage.LoadOrder(ctx, "unknown")
+	assert.Equal(t, domain.ErrOrderNotFound, err)
+
+	order.Status = domain.StatusPaid
+	require.NoError(t, storage.UpdateOrder(ctx, order))
+	order, _, err = storage.LoadOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusPaid, order.Status)
+
+	assert.Equal(t, domain.ErrOrderNotFound, storage.UpdateOrder(ctx, storedOrder("unknown", domain.StatusPaid, created)))
+
+	_, err = storage.GetByID(ctx, &authMock.Identity{Sub: "customer-1"}, "order-1")
+	assert.NoError(t, err)
+	_, err = storage.GetByID(ctx, &authMock.Identity{Sub: "customer-2"}, "order-1")
+	assert.Equal(t, domain.ErrOrderNotFound, err, "orders of other customers must not be returned")
+}
+
+func TestSQLOrderStorage_Find(t *testing.T) {
+	storage := newSQLiteOrderStorage(t)
+	defer storage.db.Close()
+	ctx := context.Background()
+	customer := &authMock.Identity{Sub: "customer-1"}
+
+	created := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, storage.StoreOrder(ctx, "customer-1", storedOrder("order-1", domain.StatusPlaced, created)))
+	require.NoError(t, storage.StoreOrder(ctx, "customer-1", storedOrder("order-2", domain.StatusShipped, created.AddDate(0, 0, 1))))
+	require.NoError(t, storage.StoreOrder(ctx, "customer-1", storedOrder("order-3", domain.StatusPlaced, created.AddDate(0, 0, 2))))
+	require.NoError(t, storage.StoreOrder(ctx, "customer-2", storedOrder("order-4", domain.StatusPlaced, created)))
+
+	orderIDs := func(page *domain.OrderPage) []string {
+		ids := make([]string, 0, len(page.Orders))
+		for _, order := range page.Orders {
+			ids = append(ids, order.ID)
+		}
+		return ids
+	}
+
+	t.Run("newest first with pagination", func(t *testing.T) {
+		page, err := storage.Find(ctx, customer, domain.Filter{Page: 1, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.Equal(t, []string{"order-3", "order-2"}, orderIDs(page))
+
+		page, err = storage.Find(ctx, customer, domain.Filter{Page: 2, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"order-1"}, orderIDs(page))
+
+		page, err = storage.Find(ctx, customer, domain.Filter{Page: 3, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.Empty(t, page.Orders)
+	})
+
+	t.Run("status and creation time", func(t *testing.T) {
+		page, err := storage.Find(ctx, customer, domain.Filter{Statuses: []domain.Status{domain.StatusPlaced}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"order-3", "order-1"}, orderIDs(page))
+
+		page, err = storage.Find(ctx, customer, domain.Filter{CreatedFrom: created.AddDate(0, 0, 1), CreatedTo: created.AddDate(0, 0, 2)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"order-2"}, orderIDs(page))
+	})
+
+	t.Run("customer is required", func(t *testing.T) {
+		_, err := storage.Find(ctx, nil, domain.Filter{})
+		assert.Error(t, err)
+	})
+}
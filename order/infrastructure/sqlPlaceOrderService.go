@@ -0,0 +1,278 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+)
+
+type (
+	// SQLPlaceOrderService places carts as orders in the SQLOrderStorage, so that they show up in the order history of the customer
+	SQLPlaceOrderService struct {
+		logger          flamingo.Logger
+		storage         *SQLOrderStorage
+		giftCardService cartDomain.GiftCardService
+		now             func() time.Time
+	}
+)
+
+var (
+	_ placeorder.Service = new(SQLPlaceOrderService)
+)
+
+// Inject dependencies
+func (p *SQLPlaceOrderService) Inject(
+	logger flamingo.Logger,
+	storage *SQLOrderStorage,
+	optionals *struct {
+		GiftCardService cartDomain.GiftCardService `inject:",optional"`
+	},
+) *SQLPlaceOrderService {
+	p.logger = logger.WithField(flamingo.LogKeyModule, "order").WithField(flamingo.LogKeyCategory, "SQLPlaceOrderService")
+	p.storage = storage
+	p.now = time.Now
+	if optionals != nil {
+		p.giftCardService = optionals.GiftCardService
+	}
+
+	return p
+}
+
+// PlaceGuestCart stores the cart as order without a customer
+func (p *SQLPlaceOrderService) PlaceGuestCart(ctx context.Context, cart *cartDomain.Cart, payment *placeorder.Payment) (placeorder.PlacedOrderInfos, error) {
+	return p.placeCart(ctx, "", cart, payment)
+}
+
+// PlaceCustomerCart stores the cart as order of the customer
+func (p *SQLPlaceOrderService) PlaceCustomerCart(ctx context.Context, identity auth.Identity, cart *cartDomain.Cart, payment *placeorder.Payment) (placeorder.PlacedOrderInfos, error) {
+	if identity == nil || identity.Subject() == "" {
+		return nil, errors.New("no customer identity given")
+	}
+
+	return p.placeCart(ctx, identity.Subject(), cart, payment)
+}
+
+// ReserveOrderID returns the order id already reserved on the cart or a new unique one
+func (p *SQLPlaceOrderService) ReserveOrderID(_ context.Context, cart *cartDomain.Cart) (string, error) {
+	if cart.AdditionalData.ReservedOrderID != "" {
+		return cart.AdditionalData.ReservedOrderID, nil
+	}
+
+	return uuid.New().String(), nil
+}
+
+// CancelGuestOrder cancels the stored guest orders
+func (p *SQLPlaceOrderService) CancelGuestOrder(ctx context.Context, orderInfos placeorder.PlacedOrderInfos) error {
+	return p.cancelOrders(ctx, "", orderInfos)
+}
+
+// CancelCustomerOrder cancels the stored orders of the customer
+func (p *SQLPlaceOrderService) CancelCustomerOrder(ctx context.Context, orderInfos placeorder.PlacedOrderInfos, identity auth.Identity) error {
+	if identity == nil || identity.Subject() == "" {
+		return errors.New("no customer identity given")
+	}
+
+	return p.cancelOrders(ctx, identity.Subject(), orderInfos)
+}
+
+func (p *SQLPlaceOrderService) placeCart(ctx context.Context, customerID string, cart *cartDomain.Cart, payment *placeorder.Payment) (placeorder.PlacedOrderInfos, error) {
+	if err := checkPayment(cart, payment); err != nil {
+		return nil, err
+	}
+
+	orderID, err := p.ReserveOrderID(ctx, cart)
+	if err != nil {
+		return nil, err
+	}
+
+	order := orderFromCart(orderID, cart, payment, p.now())
+	err = p.storage.StoreOrder(ctx, customerID, order)
+	if err == ErrOrderAlreadyExists {
+		return p.alreadyPlaced(ctx, customerID, cart, orderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.logger.WithContext(ctx).WithField("placeorder", orderID).Info("Order placed and stored")
+
+	return placedOrderInfos(order), nil
+}
+
+// alreadyPlaced returns the infos of the stored order if the same cart was placed before with the reserved order id,
+// e.g. by a retried place order process. Orders of other carts or customers with the same id are an error
+func (p *SQLPlaceOrderService) alreadyPlaced(ctx context.Context, customerID string, cart *cartDomain.Cart, orderID string) (placeorder.PlacedOrderInfos, error) {
+	existing, owner, err := p.storage.LoadOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if owner != customerID || existing.CartID != cart.ID {
+		return nil, ErrOrderAlreadyExists
+	}
+
+	p.logger.WithContext(ctx).WithField("placeorder", orderID).Info("Order was already placed, returning the stored order")
+
+	return placedOrderInfos(existing), nil
+}
+
+// placedOrderInfos returns an info per delivery of the order
+func placedOrderInfos(order *domain.Order) placeorder.PlacedOrderInfos {
+	placedOrders := make(placeorder.PlacedOrderInfos, 0, len(order.Deliveries))
+	for _, delivery := range order.Deliveries {
+		placedOrders = append(placedOrders, placeorder.PlacedOrderInfo{
+			OrderNumber:  order.ID,
+			DeliveryCode: delivery.DeliveryInfo.Code,
+		})
+	}
+
+	return placedOrders
+}
+
+// cancelOrders transitions the orders to cancelled and releases their gift cards, orders of other customers are not touched
+func (p *SQLPlaceOrderService) cancelOrders(ctx context.Context, customerID string, orderInfos placeorder.PlacedOrderInfos) error {
+	cancelled := make(map[string]bool)
+	for _, orderInfo := range orderInfos {
+		if cancelled[orderInfo.OrderNumber] {
+			continue
+		}
+		cancelled[orderInfo.OrderNumber] = true
+
+		order, owner, err := p.storage.LoadOrder(ctx, orderInfo.OrderNumber)
+		if err != nil {
+			return err
+		}
+		if owner != customerID {
+			return domain.ErrOrderNotFound
+		}
+		if order.Status == domain.StatusCancelled {
+			continue
+		}
+
+		now := p.now()
+		if err := order.TransitionTo(domain.StatusCancelled, now, "order cancelled"); err != nil {
+			return err
+		}
+		order.UpdateTime = now
+		if err := p.storage.UpdateOrder(ctx, order); err != nil {
+			return err
+		}
+		p.releaseGiftCards(ctx, order)
+	}
+
+	return nil
+}
+
+// releaseGiftCards frees the gift card amounts booked for the cart of the cancelled order,
+// a failure is only logged since the order is already cancelled
+func (p *SQLPlaceOrderService) releaseGiftCards(ctx context.Context, order *domain.Order) {
+	if p.giftCardService == nil || order.CartID == "" {
+		return
+	}
+
+	for _, giftCard := range order.AppliedGiftCards {
+		err := p.giftCardService.Release(ctx, giftCard.Code, order.CartID)
+		if err != nil {
+			p.logger.WithContext(ctx).WithField("placeorder", order.ID).Error("gift card ", giftCard.Code, " could not be released: ", err)
+		}
+	}
+}
+
+// checkPayment ensures that carts with a positive grand total are paid completely
+func checkPayment(cart *cartDomain.Cart, payment *placeorder.Payment) error {
+	if !cart.GrandTotal().IsPositive() {
+		return nil
+	}
+	if payment == nil {
+		return errors.New("no valid payment given")
+	}
+
+	totalPrice, err := payment.TotalValue()
+	if err != nil {
+		return err
+	}
+	if !totalPrice.Equal(cart.GrandTotal()) {
+		return errors.New("payment total does not match with grand total")
+	}
+
+	return nil
+}
+
+// orderFromCart creates a placed (or held) order with the deliveries, items and payments of the cart
+func orderFromCart(orderID string, cart *cartDomain.Cart, payment *placeorder.Payment, now time.Time) *domain.Order {
+	order := &domain.Order{
+		ID:             orderID,
+		CreationTime:   now,
+		UpdateTime:     now,
+		Status:         domain.StatusPlaced,
+		BillingAddress: cart.BillingAddress,
+		Deliveries:     make([]*domain.Delivery, 0, len(cart.Deliveries)),
+		Payments:       make([]domain.Payment, 0),
+		GrandTotal:     cart.GrandTotal(),
+		CartID:         cart.ID,
+	}
+	if len(cart.AppliedGiftCards) > 0 {
+		order.AppliedGiftCards = append([]cartDomain.AppliedGiftCard(nil), cart.AppliedGiftCards...)
+	}
+	if placeorder.IsHeldForReview(cart) {
+		order.Status = domain.StatusHeld
+	}
+
+	for _, cartDelivery := range cart.Deliveries {
+		delivery := &domain.Delivery{
+			DeliveryInfo: cartDelivery.DeliveryInfo,
+			ShippingItem: cartDelivery.ShippingItem,
+			Items:        make([]*domain.OrderItem, 0, len(cartDelivery.Cartitems)),
+		}
+		for _, item := range cartDelivery.Cartitems {
+			var attributes domain.Attributes
+			if len(item.AdditionalData) > 0 {
+				attributes = make(domain.Attributes, len(item.AdditionalData))
+				for key, value := range item.AdditionalData {
+					attributes[key] = value
+				}
+			}
+
+			delivery.Items = append(delivery.Items, &domain.OrderItem{
+				ID:                     item.ID,
+				MarketplaceCode:        item.MarketplaceCode,
+				VariantMarketplaceCode: item.VariantMarketPlaceCode,
+				Name:                   item.ProductName,
+				Qty:                    item.Qty,
+				SinglePriceNet:         item.SinglePriceNet,
+				SinglePriceGross:       item.SinglePriceGross,
+				RowPriceNet:            item.RowPriceNet,
+				RowPriceGross:          item.RowPriceGross,
+				RowTaxes:               item.RowTaxes,
+				TotalDiscountAmount:    item.TotalDiscountAmount(),
+				SourceID:               item.SourceID,
+				BundleConfiguration:    item.BundleConfiguration,
+				Attributes:             attributes,
+			})
+		}
+		order.Deliveries = append(order.Deliveries, delivery)
+	}
+
+	if payment != nil {
+		for _, transaction := range payment.Transactions {
+			paymentID := transaction.TransactionID
+			if paymentID == "" {
+				paymentID = payment.PaymentID
+			}
+			order.Payments = append(order.Payments, domain.Payment{
+				Gateway:   payment.Gateway,
+				Method:    transaction.Method,
+				PaymentID: paymentID,
+				Amount:    transaction.ValuedAmountPayed,
+			})
+		}
+	}
+
+	return order
+}
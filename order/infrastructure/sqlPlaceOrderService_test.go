@@ -0,0 +1,232 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+func testCart() *cart.Cart {
+	return &cart.Cart{
+		ID:             "cart-1",
+		BillingAddress: &cart.Address{Firstname: "Max", Lastname: "Mustermann"},
+		Deliveries: []cart.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cart.Item{
+					{
+						ID:              "item-1",
+						MarketplaceCode: "product-1",
+						ProductName:     "Product 1",
+						Qty:             2,
+						SourceID:        "warehouse",
+						AdditionalData:  map[string]string{"gift": "true"},
+						BundleConfiguration: productDomain.BundleConfiguration{
+							{OptionGroupCode: "memory", ChoiceCode: "16gb", Qty: 1},
+						},
+						SinglePriceGross: priceDomain.NewFromInt(1000, 100, "EUR"),
+						RowPriceGross:    priceDomain.NewFromInt(2000, 100, "EUR"),
+					},
+				},
+				ShippingItem: cart.ShippingItem{PriceNet: priceDomain.NewFromInt(500, 100, "EUR"), TaxAmount: priceDomain.NewZero("EUR")},
+			},
+		},
+		AdditionalData: cart.AdditionalData{ReservedOrderID: "order-1"},
+	}
+}
+
+// recordingGiftCardService records the released gift card bookings as "code/reference"
+type recordingGiftCardService struct {
+	cart.GiftCardService
+	released []string
+}
+
+func (s *recordingGiftCardService) Release(_ context.Context, code string, reference string) error {
+	s.released = append(s.released, code+"/"+reference)
+	return nil
+}
+
+// newSQLitePlaceOrderService returns a place order service storing the orders in an in memory sqlite database
+func newSQLitePlaceOrderService(t *testing.T, giftCardService cart.GiftCardService) *SQLPlaceOrderService {
+	t.Helper()
+
+	return new(SQLPlaceOrderService).Inject(flamingo.NullLogger{}, newSQLiteOrderStorage(t), &struct {
+		GiftCardService cart.GiftCardService `inject:",optional"`
+	}{GiftCardService: giftCardService})
+}
+
+func TestSQLPlaceOrderService_checkPayment(t *testing.T) {
+	t.Parallel()
+
+	paidCart := testCart()
+	payment := &placeorder.Payment{
+		Gateway:      "offline",
+		Transactions: []placeorder.Transaction{{Method: "cash", ValuedAmountPayed: priceDomain.NewFromInt(2500, 100, "EUR")}},
+	}
+	assert.NoError(t, checkPayment(paidCart, payment))
+	assert.Error(t, checkPayment(paidCart, nil))
+
+	payment.Transactions[0].ValuedAmountPayed = priceDomain.NewFromInt(2000, 100, "EUR")
+	assert.Error(t, checkPayment(paidCart, payment))
+
+	assert.NoError(t, checkPayment(&cart.Cart{}, nil))
+}
+
+func TestSQLPlaceOrderService_orderFromCart(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	payment := &placeorder.Payment{
+		Gateway:   "offline",
+		PaymentID: "payment-1",
+		Transactions: []placeorder.Transaction{
+			{Method: "cash", ValuedAmountPayed: priceDomain.NewFromInt(2500, 100, "EUR")},
+		},
+	}
+
+	order := orderFromCart("order-1", testCart(), payment, now)
+	assert.Equal(t, "order-1", order.ID)
+	assert.Equal(t, domain.StatusPlaced, order.Status)
+	assert.Equal(t, now, order.CreationTime)
+	assert.Equal(t, "Max", order.BillingAddress.Firstname)
+	assert.Equal(t, 25.0, order.GrandTotal.FloatAmount())
+
+	require.Len(t, order.Deliveries, 1)
+	assert.Equal(t, "delivery", order.Deliveries[0].DeliveryInfo.Code)
+	require.Len(t, order.GetAllItems(), 1)
+	item := order.GetAllItems()[0]
+	assert.Equal(t, "product-1", item.MarketplaceCode)
+	assert.Equal(t, "Product 1", item.Name)
+	assert.Equal(t, 2, item.Qty)
+	assert.Equal(t, "warehouse", item.SourceID)
+	assert.Equal(t, "true", item.Attributes["gift"])
+	assert.Equal(t, productDomain.BundleConfiguration{{OptionGroupCode: "memory", ChoiceCode: "16gb", Qty: 1}}, item.BundleConfiguration)
+
+	assert.Equal(t, []domain.Payment{
+		{Gateway: "offline", Method: "cash", PaymentID: "payment-1", Amount: priceDomain.NewFromInt(2500, 100, "EUR")},
+	}, order.Payments)
+
+	for value, expected := range map[string]domain.Status{"true": domain.StatusHeld, "false": domain.StatusPlaced, "": domain.StatusPlaced} {
+		heldCart := testCart()
+		heldCart.AdditionalData.CustomAttributes = map[string]string{placeorder.HoldForReviewAttribute: value}
+		assert.Equal(t, expected, orderFromCart("order-1", heldCart, payment, now).Status, "hold for review attribute %q", value)
+	}
+}
+
+func TestSQLPlaceOrderService_PlaceGuestCartHeldForReview(t *testing.T) {
+	service := newSQLitePlaceOrderService(t, nil)
+	defer service.storage.db.Close()
+	ctx := context.Background()
+
+	heldCart := testCart()
+	heldCart.AdditionalData.CustomAttributes = map[string]string{placeorder.HoldForReviewAttribute: "true"}
+	payment := &placeorder.Payment{
+		Gateway:      "offline",
+		Transactions: []placeorder.Transaction{{Method: "cash", ValuedAmountPayed: heldCart.GrandTotal()}},
+	}
+
+	_, err := service.PlaceGuestCart(ctx, heldCart, payment)
+	require.NoError(t, err)
+
+	order, _, err := service.storage.LoadOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusHeld, order.Status)
+	assert.True(t, order.Status.CanTransitionTo(domain.StatusPlaced), "held orders are released after the review")
+}
+
+func TestSQLPlaceOrderService_CancelGuestOrderReleasesGiftCards(t *testing.T) {
+	giftCardService := new(recordingGiftCardService)
+	service := newSQLitePlaceOrderService(t, giftCardService)
+	defer service.storage.db.Close()
+	ctx := context.Background()
+
+	giftCardCart := testCart()
+	giftCardCart.AppliedGiftCards = []cart.AppliedGiftCard{{Code: "gift-1", Applied: priceDomain.NewFromInt(500, 100, "EUR")}}
+	payment := &placeorder.Payment{
+		Gateway:      "offline",
+		Transactions: []placeorder.Transaction{{Method: "cash", ValuedAmountPayed: giftCardCart.GrandTotal()}},
+	}
+
+	placed, err := service.PlaceGuestCart(ctx, giftCardCart, payment)
+	require.NoError(t, err)
+
+	order, _, err := service.storage.LoadOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, "cart-1", order.CartID)
+	assert.Equal(t, []string{"gift-1"}, []string{order.AppliedGiftCards[0].Code})
+
+	require.NoError(t, service.CancelGuestOrder(ctx, placed))
+	assert.Equal(t, []string{"gift-1/cart-1"}, giftCardService.released)
+
+	order, _, err = service.storage.LoadOrder(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusCancelled, order.Status)
+
+	// cancelling again doesn't release twice
+	require.NoError(t, service.CancelGuestOrder(ctx, placed))
+	assert.Len(t, giftCardService.released, 1)
+}
+
+func TestSQLPlaceOrderService_PlaceCustomerCart(t *testing.T) {
+	service := newSQLitePlaceOrderService(t, nil)
+	defer service.storage.db.Close()
+	ctx := context.Background()
+	customer := &authMock.Identity{Sub: "customer-1"}
+
+	placedCart := testCart()
+	payment := &placeorder.Payment{
+		Gateway:      "offline",
+		Transactions: []placeorder.Transaction{{Method: "cash", ValuedAmountPayed: placedCart.GrandTotal()}},
+	}
+
+	_, err := service.PlaceCustomerCart(ctx, nil, placedCart, payment)
+	assert.Error(t, err)
+	_, err = service.PlaceCustomerCart(ctx, customer, placedCart, nil)
+	assert.Error(t, err, "unpaid carts must not be placed")
+
+	placed, err := service.PlaceCustomerCart(ctx, customer, placedCart, payment)
+	require.NoError(t, err)
+	assert.Equal(t, placeorder.PlacedOrderInfos{{OrderNumber: "order-1", DeliveryCode: "delivery"}}, placed)
+
+	page, err := service.storage.Find(ctx, customer, domain.Filter{})
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, "order-1", page.Orders[0].ID)
+	assert.Equal(t, domain.StatusPlaced, page.Orders[0].Status)
+	require.Len(t, page.Orders[0].GetAllItems(), 1)
+	assert.Equal(t, placedCart.Deliveries[0].Cartitems[0].BundleConfiguration, page.Orders[0].GetAllItems()[0].BundleConfiguration)
+	assert.Equal(t, placedCart.Deliveries[0].DeliveryInfo, page.Orders[0].Deliveries[0].DeliveryInfo)
+
+	t.Run("retry returns the stored order", func(t *testing.T) {
+		retried, err := service.PlaceCustomerCart(ctx, customer, placedCart, payment)
+		require.NoError(t, err)
+		assert.Equal(t, placed, retried)
+
+		page, err := service.storage.Find(ctx, customer, domain.Filter{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, page.TotalCount)
+	})
+
+	t.Run("order id of another customer", func(t *testing.T) {
+		_, err := service.PlaceCustomerCart(ctx, &authMock.Identity{Sub: "customer-2"}, placedCart, payment)
+		assert.Equal(t, ErrOrderAlreadyExists, err)
+	})
+
+	t.Run("order id of another cart", func(t *testing.T) {
+		otherCart := testCart()
+		otherCart.ID = "cart-2"
+		_, err := service.PlaceCustomerCart(ctx, customer, otherCart, payment)
+		assert.Equal(t, ErrOrderAlreadyExists, err)
+	})
+}
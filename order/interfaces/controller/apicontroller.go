@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/order/application"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+)
+
+type (
+	// APIController for the customer order rest api
+	APIController struct {
+		responder            *web.Responder
+		customerOrderService *application.CustomerOrderService
+		logger               flamingo.Logger
+	}
+
+	// OrdersAPIResult view data of a page of orders
+	OrdersAPIResult struct {
+		// Contains details if success is false
+		Error      *resultError
+		Success    bool
+		Orders     []*domain.Order
+		Page       int
+		PageSize   int
+		TotalCount int
+		TotalPages int
+	} // @name orderListAPIResult
+
+	// OrderAPIResult view data of a single order
+	OrderAPIResult struct {
+		// Contains details if success is false
+		Error   *resultError
+		Success bool
+		Order   *domain.Order
+	} // @name orderAPIResult
+
+	resultError struct {
+		Message string
+		Code    string
+	} // @name orderResultError
+)
+
+// Inject dependencies
+func (c *APIController) Inject(
+	responder *web.Responder,
+	customerOrderService *application.CustomerOrderService,
+	logger flamingo.Logger,
+) *APIController {
+	c.responder = responder
+	c.customerOrderService = customerOrderService
+	c.logger = logger.WithField(flamingo.LogKeyModule, "order").WithField(flamingo.LogKeyCategory, "apicontroller")
+
+	return c
+}
+
+// OrdersAction returns the orders of the logged in customer, newest orders first
+// @Summary Get the orders of the logged in customer
+// @Tags Order
+// @Produce json
+// @Success 200 {object} OrdersAPIResult
+// @Failure 401 {object} OrdersAPIResult
+// @Failure 500 {object} OrdersAPIResult
+// @Param page query integer false "optional the page, starts with 1"
+// @Param pageSize query integer false "optional the number of orders per page"
+// @Param status query string false "optional comma separated statuses of the orders, e.g. paid,shipped"
+// @Router /api/v1/customer/orders [get]
+func (c *APIController) OrdersAction(ctx context.Context, r *web.Request) web.Result {
+	page, err := c.customerOrderService.Find(ctx, r, filterFromParams(r.Params))
+	if err != nil {
+		resultErr, status := c.errorResult(ctx, err, "get_orders_error")
+		return c.responder.Data(OrdersAPIResult{Error: resultErr}).Status(status)
+	}
+
+	return c.responder.Data(OrdersAPIResult{
+		Success:    true,
+		Orders:     page.Orders,
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		TotalCount: page.TotalCount,
+		TotalPages: page.TotalPages(),
+	})
+}
+
+// OrderAction returns a single order of the logged in customer
+// @Summary Get an order of the logged in customer
+// @Tags Order
+// @Produce json
+// @Success 200 {object} OrderAPIResult
+// @Failure 401 {object} OrderAPIResult
+// @Failure 404 {object} OrderAPIResult
+// @Failure 500 {object} OrderAPIResult
+// @Param orderID path string true "the id of the order"
+// @Router /api/v1/customer/orders/{orderID} [get]
+func (c *APIController) OrderAction(ctx context.Context, r *web.Request) web.Result {
+	orderID, _ := r.Params["orderID"]
+
+	order, err := c.customerOrderService.GetByID(ctx, r, orderID)
+	if err != nil {
+		resultErr, status := c.errorResult(ctx, err, "get_order_error")
+		return c.responder.Data(OrderAPIResult{Error: resultErr}).Status(status)
+	}
+
+	return c.responder.Data(OrderAPIResult{Success: true, Order: order})
+}
+
+// errorResult logs unexpected errors and returns the error details with the matching http status
+func (c *APIController) errorResult(ctx context.Context, err error, code string) (*resultError, uint) {
+	switch {
+	case errors.Is(err, application.ErrNoIdentity):
+		return &resultError{Message: err.Error(), Code: "unauthorized"}, http.StatusUnauthorized
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return &resultError{Message: err.Error(), Code: "order_not_found"}, http.StatusNotFound
+	}
+
+	c.logger.WithContext(ctx).Error("order.apicontroller: ", err)
+
+	return &resultError{Message: err.Error(), Code: code}, http.StatusInternalServerError
+}
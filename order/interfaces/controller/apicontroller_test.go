@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/order/application"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+)
+
+type (
+	// fakeOrderService returns the orders of the customer "customer" and records the requested filter
+	fakeOrderService struct {
+		orders []*domain.Order
+		filter domain.Filter
+		err    error
+	}
+
+	nullOrderDecorator struct{}
+)
+
+func (s *fakeOrderService) Get(context.Context, auth.Identity) ([]*domain.Order, error) {
+	return s.orders, s.err
+}
+
+func (s *fakeOrderService) GetByID(_ context.Context, _ auth.Identity, orderID string) (*domain.Order, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, order := range s.orders {
+		if order.ID == orderID {
+			return order, nil
+		}
+	}
+
+	return nil, domain.ErrOrderNotFound
+}
+
+func (s *fakeOrderService) Find(_ context.Context, _ auth.Identity, filter domain.Filter) (*domain.OrderPage, error) {
+	s.filter = filter
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return filter.Apply(s.orders), nil
+}
+
+func (nullOrderDecorator) Create(_ context.Context, order *domain.Order) *domain.DecoratedOrder {
+	return &domain.DecoratedOrder{Order: order}
+}
+
+func newAPIController(identity auth.Identity, orderService *fakeOrderService) *APIController {
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			if identity == nil {
+				return nil, errors.New("not logged in")
+			}
+			return identity, nil
+		},
+	)
+
+	customerOrderService := new(application.CustomerOrderService).Inject(
+		new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil),
+		orderService,
+		nullOrderDecorator{},
+	)
+
+	return new(APIController).Inject(new(web.Responder), customerOrderService, flamingo.NullLogger{})
+}
+
+func TestFilterFromParams(t *testing.T) {
+	t.Parallel()
+
+	filter := filterFromParams(web.RequestParams{"page": "2", "pageSize": "5", "status": "paid, shipped"})
+	assert.Equal(t, domain.Filter{Page: 2, PageSize: 5, Statuses: []domain.Status{domain.StatusPaid, domain.StatusShipped}}, filter)
+
+	filter = filterFromParams(web.RequestParams{"page": "x", "pageSize": "1000000"})
+	assert.Equal(t, domain.Filter{Page: 1, PageSize: domain.MaxPageSize}, filter)
+}
+
+func TestAPIController_OrdersAction(t *testing.T) {
+	t.Parallel()
+
+	orderService := &fakeOrderService{orders: []*domain.Order{{ID: "order-1"}, {ID: "order-2"}, {ID: "order-3"}}}
+
+	t.Run("page of orders", func(t *testing.T) {
+		controller := newAPIController(&authMock.Identity{Sub: "customer"}, orderService)
+		r := web.CreateRequest(&http.Request{}, web.EmptySession())
+		r.Params = web.RequestParams{"page": "2", "pageSize": "2"}
+
+		result := controller.OrdersAction(context.Background(), r)
+		require.IsType(t, &web.DataResponse{}, result)
+		response := result.(*web.DataResponse)
+		assert.Equal(t, uint(http.StatusOK), response.Response.Status)
+
+		data := response.Data.(OrdersAPIResult)
+		assert.True(t, data.Success)
+		assert.Equal(t, 2, data.Page)
+		assert.Equal(t, 2, data.PageSize)
+		assert.Equal(t, 3, data.TotalCount)
+		assert.Equal(t, 2, data.TotalPages)
+		require.Len(t, data.Orders, 1)
+		assert.Equal(t, "order-1", data.Orders[0].ID)
+	})
+
+	t.Run("page size is limited", func(t *testing.T) {
+		orderService := &fakeOrderService{}
+		controller := newAPIController(&authMock.Identity{Sub: "customer"}, orderService)
+		r := web.CreateRequest(&http.Request{}, web.EmptySession())
+		r.Params = web.RequestParams{"pageSize": "1000000"}
+
+		response := controller.OrdersAction(context.Background(), r).(*web.DataResponse)
+		assert.Equal(t, domain.MaxPageSize, orderService.filter.PageSize)
+		assert.Equal(t, domain.MaxPageSize, response.Data.(OrdersAPIResult).PageSize)
+	})
+
+	t.Run("not logged in", func(t *testing.T) {
+		controller := newAPIController(nil, orderService)
+
+		response := controller.OrdersAction(context.Background(), web.CreateRequest(&http.Request{}, web.EmptySession())).(*web.DataResponse)
+		assert.Equal(t, uint(http.StatusUnauthorized), response.Response.Status)
+		data := response.Data.(OrdersAPIResult)
+		assert.False(t, data.Success)
+		assert.Equal(t, "unauthorized", data.Error.Code)
+	})
+
+	t.Run("error of the order service", func(t *testing.T) {
+		controller := newAPIController(&authMock.Identity{Sub: "customer"}, &fakeOrderService{err: errors.New("backend down")})
+
+		response := controller.OrdersAction(context.Background(), web.CreateRequest(&http.Request{}, web.EmptySession())).(*web.DataResponse)
+		assert.Equal(t, uint(http.StatusInternalServerError), response.Response.Status)
+		assert.Equal(t, "get_orders_error", response.Data.(OrdersAPIResult).Error.Code)
+	})
+}
+
+func TestAPIController_OrderAction(t *testing.T) {
+	t.Parallel()
+
+	orderService := &fakeOrderService{orders: []*domain.Order{{ID: "order-1"}}}
+	controller := newAPIController(&authMock.Identity{Sub: "customer"}, orderService)
+
+	r := web.CreateRequest(&http.Request{}, web.EmptySession())
+	r.Params = web.RequestParams{"orderID": "order-1"}
+	response := controller.OrderAction(context.Background(), r).(*web.DataResponse)
+	assert.Equal(t, uint(http.StatusOK), response.Response.Status)
+	data := response.Data.(OrderAPIResult)
+	assert.True(t, data.Success)
+	assert.Equal(t, "order-1", data.Order.ID)
+
+	r.Params = web.RequestParams{"orderID": "unknown"}
+	response = controller.OrderAction(context.Background(), r).(*web.DataResponse)
+	assert.Equal(t, uint(http.StatusNotFound), response.Response.Status)
+	assert.Equal(t, "order_not_found", response.Data.(OrderAPIResult).Error.Code)
+
+	controller = newAPIController(nil, orderService)
+	response = controller.OrderAction(context.Background(), r).(*web.DataResponse)
+	assert.Equal(t, uint(http.StatusUnauthorized), response.Response.Status)
+}
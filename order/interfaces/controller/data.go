@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"flamingo.me/flamingo/v3/core/auth"
 	"flamingo.me/flamingo/v3/framework/web"
@@ -26,14 +28,51 @@ func (dc *DataControllerCustomerOrders) Inject(
 	dc.webIdentityService = webIdentityService
 }
 
-// Data controller for blocks
-func (dc *DataControllerCustomerOrders) Data(ctx context.Context, r *web.Request, _ web.RequestParams) interface{} {
+// Data controller for blocks, returns the orders of the requested page
+func (dc *DataControllerCustomerOrders) Data(ctx context.Context, r *web.Request, params web.RequestParams) interface{} {
+	page := dc.find(ctx, r, params)
+	if page == nil {
+		return nil
+	}
+
+	return page.Orders
+}
+
+// PageData controller for blocks, returns the requested page with the pagination info
+func (dc *DataControllerCustomerOrders) PageData(ctx context.Context, r *web.Request, params web.RequestParams) interface{} {
+	page := dc.find(ctx, r, params)
+	if page == nil {
+		return nil
+	}
+
+	return page
+}
+
+func (dc *DataControllerCustomerOrders) find(ctx context.Context, r *web.Request, params web.RequestParams) *domain.OrderPage {
 	identity := dc.webIdentityService.Identify(ctx, r)
 	if identity == nil {
 		return nil
 	}
 
-	customerOrders, _ := dc.customerIdentityOrderService.Get(ctx, identity)
+	page, _ := dc.customerIdentityOrderService.Find(ctx, identity, filterFromParams(params))
+
+	return page
+}
+
+// filterFromParams reads the params "page", "pageSize" and the comma separated "status"
+func filterFromParams(params web.RequestParams) domain.Filter {
+	filter := domain.Filter{}
+	if page, err := strconv.Atoi(params["page"]); err == nil {
+		filter.Page = page
+	}
+	if pageSize, err := strconv.Atoi(params["pageSize"]); err == nil {
+		filter.PageSize = pageSize
+	}
+	if params["status"] != "" {
+		for _, status := range strings.Split(params["status"], ",") {
+			filter.Statuses = append(filter.Statuses, domain.Status(strings.TrimSpace(status)))
+		}
+	}
 
-	return customerOrders
+	return filter.Normalized()
 }
@@ -0,0 +1,161 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDto "github.com/lunarforge/flamingo_commerce/product/interfaces/graphql/product/dto"
+)
+
+type (
+	// OrderPage is the graphql representation of a page of customer orders
+	OrderPage struct {
+		Orders      []*Order
+		Page        int
+		PageSize    int
+		TotalCount  int
+		TotalPages  int
+		HasNextPage bool
+	}
+
+	// Order is the graphql representation of a decorated order
+	Order struct {
+		ID                  string
+		CreationTime        time.Time
+		UpdateTime          time.Time
+		Status              string
+		StatusHistory       []StatusTransition
+		BillingAddress      *cart.Address
+		Deliveries          []*Delivery
+		Payments            []domain.Payment
+		SubTotalGross       priceDomain.Price
+		SubTotalNet         priceDomain.Price
+		ShippingGross       priceDomain.Price
+		TotalDiscountAmount priceDomain.Price
+		TotalTaxAmount      priceDomain.Price
+		TotalPaid           priceDomain.Price
+		GrandTotal          priceDomain.Price
+	}
+
+	// StatusTransition is the graphql representation of a status change of an order
+	StatusTransition struct {
+		From    string
+		To      string
+		Time    time.Time
+		Comment string
+	}
+
+	// Delivery is the graphql representation of an order delivery
+	Delivery struct {
+		DeliveryInfo cart.DeliveryInfo
+		ShippingItem cart.ShippingItem
+		Items        []*OrderItem
+		ShippedAt    *time.Time
+	}
+
+	// OrderItem is the graphql representation of an order item with its product
+	OrderItem struct {
+		ID                     string
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Name                   string
+		Qty                    int
+		SinglePriceNet         priceDomain.Price
+		SinglePriceGross       priceDomain.Price
+		RowPriceNet            priceDomain.Price
+		RowPriceGross          priceDomain.Price
+		TotalDiscountAmount    priceDomain.Price
+		SourceID               string
+		Product                productDto.Product
+	}
+)
+
+// NewOrderPage maps the order page to the graphql dto, the orders are decorated by the given function
+func NewOrderPage(page *domain.OrderPage, decorate func(*domain.Order) *domain.DecoratedOrder) *OrderPage {
+	result := &OrderPage{
+		Orders:      make([]*Order, 0, len(page.Orders)),
+		Page:        page.Page,
+		PageSize:    page.PageSize,
+		TotalCount:  page.TotalCount,
+		TotalPages:  page.TotalPages(),
+		HasNextPage: page.HasNextPage(),
+	}
+
+	for _, order := range page.Orders {
+		result.Orders = append(result.Orders, NewOrder(decorate(order)))
+	}
+
+	return result
+}
+
+// NewOrder maps the decorated order to the graphql dto
+func NewOrder(decoratedOrder *domain.DecoratedOrder) *Order {
+	order := decoratedOrder.Order
+
+	result := &Order{
+		ID:                  order.ID,
+		CreationTime:        order.CreationTime,
+		UpdateTime:          order.UpdateTime,
+		Status:              string(order.Status),
+		StatusHistory:       make([]StatusTransition, 0, len(order.StatusHistory)),
+		BillingAddress:      order.BillingAddress,
+		Deliveries:          make([]*Delivery, 0, len(order.Deliveries)),
+		Payments:            order.Payments,
+		SubTotalGross:       order.SubTotalGross(),
+		SubTotalNet:         order.SubTotalNet(),
+		ShippingGross:       order.ShippingGross(),
+		TotalDiscountAmount: order.TotalDiscountAmount(),
+		TotalTaxAmount:      order.TotalTaxAmount(),
+		TotalPaid:           order.TotalPaid(),
+		GrandTotal:          order.GrandTotal,
+	}
+
+	if result.Payments == nil {
+		result.Payments = []domain.Payment{}
+	}
+
+	for _, transition := range order.StatusHistory {
+		result.StatusHistory = append(result.StatusHistory, StatusTransition{
+			From:    string(transition.From),
+			To:      string(transition.To),
+			Time:    transition.Time,
+			Comment: transition.Comment,
+		})
+	}
+
+	products := make(map[*domain.OrderItem]productDto.Product, len(decoratedOrder.DecoratedItems))
+	for _, decoratedItem := range decoratedOrder.DecoratedItems {
+		variantMarketplaceCode := decoratedItem.Item.VariantMarketplaceCode
+		products[decoratedItem.Item] = productDto.NewGraphqlProductDto(decoratedItem.Product, &variantMarketplaceCode)
+	}
+
+	for _, delivery := range order.Deliveries {
+		resultDelivery := &Delivery{
+			DeliveryInfo: delivery.DeliveryInfo,
+			ShippingItem: delivery.ShippingItem,
+			Items:        make([]*OrderItem, 0, len(delivery.Items)),
+			ShippedAt:    delivery.ShippedAt,
+		}
+		for _, item := range delivery.Items {
+			resultDelivery.Items = append(resultDelivery.Items, &OrderItem{
+				ID:                     item.ID,
+				MarketplaceCode:        item.MarketplaceCode,
+				VariantMarketplaceCode: item.VariantMarketplaceCode,
+				Name:                   item.Name,
+				Qty:                    item.Qty,
+				SinglePriceNet:         item.SinglePriceNet,
+				SinglePriceGross:       item.SinglePriceGross,
+				RowPriceNet:            item.RowPriceNet,
+				RowPriceGross:          item.RowPriceGross,
+				TotalDiscountAmount:    item.TotalDiscountAmount,
+				SourceID:               item.SourceID,
+				Product:                products[item],
+			})
+		}
+		result.Deliveries = append(result.Deliveries, resultDelivery)
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	"github.com/lunarforge/flamingo_commerce/order/interfaces/graphql/dto"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+func TestNewOrderPage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	first := &domain.OrderItem{ID: "1", MarketplaceCode: "p1", Qty: 1, RowPriceGross: priceDomain.NewFromInt(1000, 100, "EUR")}
+	second := &domain.OrderItem{ID: "2", MarketplaceCode: "p2", Qty: 2, RowPriceGross: priceDomain.NewFromInt(2000, 100, "EUR")}
+	order := &domain.Order{
+		ID:           "order-1",
+		CreationTime: now,
+		Status:       domain.StatusPlaced,
+		Deliveries: []*domain.Delivery{
+			{DeliveryInfo: cart.DeliveryInfo{Code: "home"}, Items: []*domain.OrderItem{first}},
+			{DeliveryInfo: cart.DeliveryInfo{Code: "store"}, Items: []*domain.OrderItem{second}},
+		},
+		GrandTotal: priceDomain.NewFromInt(3000, 100, "EUR"),
+	}
+	require.NoError(t, order.TransitionTo(domain.StatusPaid, now, "paid"))
+
+	decorate := func(order *domain.Order) *domain.DecoratedOrder {
+		decorated := &domain.DecoratedOrder{Order: order}
+		for _, item := range order.GetAllItems() {
+			decorated.DecoratedItems = append(decorated.DecoratedItems, &domain.DecoratedOrderItem{
+				Item:    item,
+				Product: productDomain.SimpleProduct{BasicProductData: productDomain.BasicProductData{MarketPlaceCode: item.MarketplaceCode}},
+			})
+		}
+		return decorated
+	}
+
+	page := dto.NewOrderPage(&domain.OrderPage{Orders: []*domain.Order{order}, Page: 1, PageSize: 1, TotalCount: 2}, decorate)
+	assert.Equal(t, 2, page.TotalPages)
+	assert.True(t, page.HasNextPage)
+	require.Len(t, page.Orders, 1)
+
+	result := page.Orders[0]
+	assert.Equal(t, "paid", result.Status)
+	assert.Equal(t, []dto.StatusTransition{{From: "placed", To: "paid", Time: now, Comment: "paid"}}, result.StatusHistory)
+	assert.Equal(t, 30.0, result.SubTotalGross.FloatAmount())
+	assert.Empty(t, result.Payments)
+	require.Len(t, result.Deliveries, 2)
+	assert.Equal(t, "p1", result.Deliveries[0].Items[0].Product.MarketPlaceCode())
+	assert.Equal(t, "p2", result.Deliveries[1].Items[0].Product.MarketPlaceCode())
+	assert.Equal(t, 2, result.Deliveries[1].Items[0].Qty)
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/order/application"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	"github.com/lunarforge/flamingo_commerce/order/interfaces/graphql/dto"
+)
+
+type (
+	// OrderResolver graphql resolver for the orders of the customer
+	OrderResolver struct {
+		customerOrderService *application.CustomerOrderService
+	}
+)
+
+// Inject dependencies
+func (r *OrderResolver) Inject(
+	customerOrderService *application.CustomerOrderService,
+) *OrderResolver {
+	r.customerOrderService = customerOrderService
+
+	return r
+}
+
+// CommerceCustomerOrders resolves the requested page of the orders of the logged in customer
+func (r *OrderResolver) CommerceCustomerOrders(ctx context.Context, page *int, pageSize *int, statuses []string) (*dto.OrderPage, error) {
+	filter := domain.Filter{}
+	if page != nil {
+		filter.Page = *page
+	}
+	if pageSize != nil {
+		filter.PageSize = *pageSize
+	}
+	for _, status := range statuses {
+		filter.Statuses = append(filter.Statuses, domain.Status(status))
+	}
+
+	orderPage, err := r.customerOrderService.Find(ctx, web.RequestFromContext(ctx), filter)
+	if errors.Is(err, application.ErrNoIdentity) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewOrderPage(orderPage, func(order *domain.Order) *domain.DecoratedOrder {
+		return r.customerOrderService.Decorate(ctx, order)
+	}), nil
+}
+
+// CommerceCustomerOrder resolves a single order of the logged in customer
+func (r *OrderResolver) CommerceCustomerOrder(ctx context.Context, id string) (*dto.Order, error) {
+	order, err := r.customerOrderService.GetByID(ctx, web.RequestFromContext(ctx), id)
+	if errors.Is(err, application.ErrNoIdentity) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewOrder(r.customerOrderService.Decorate(ctx, order)), nil
+}
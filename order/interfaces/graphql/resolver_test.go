@@ -0,0 +1,124 @@
+package graphql_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/order/application"
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	"github.com/lunarforge/flamingo_commerce/order/interfaces/graphql"
+)
+
+type (
+	// fakeOrderService returns the given orders and records the requested filter
+	fakeOrderService struct {
+		orders []*domain.Order
+		filter domain.Filter
+	}
+
+	nullOrderDecorator struct{}
+)
+
+func (s *fakeOrderService) Get(context.Context, auth.Identity) ([]*domain.Order, error) {
+	return s.orders, nil
+}
+
+func (s *fakeOrderService) GetByID(_ context.Context, _ auth.Identity, orderID string) (*domain.Order, error) {
+	for _, order := range s.orders {
+		if order.ID == orderID {
+			return order, nil
+		}
+	}
+
+	return nil, domain.ErrOrderNotFound
+}
+
+func (s *fakeOrderService) Find(_ context.Context, _ auth.Identity, filter domain.Filter) (*domain.OrderPage, error) {
+	s.filter = filter
+
+	return filter.Apply(s.orders), nil
+}
+
+func (nullOrderDecorator) Create(_ context.Context, order *domain.Order) *domain.DecoratedOrder {
+	return &domain.DecoratedOrder{Order: order}
+}
+
+func newOrderResolver(identity auth.Identity, orderService *fakeOrderService) *graphql.OrderResolver {
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			if identity == nil {
+				return nil, errors.New("not logged in")
+			}
+			return identity, nil
+		},
+	)
+
+	return new(graphql.OrderResolver).Inject(new(application.CustomerOrderService).Inject(
+		new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil),
+		orderService,
+		nullOrderDecorator{},
+	))
+}
+
+func requestContext() context.Context {
+	return web.ContextWithRequest(context.Background(), web.CreateRequest(&http.Request{}, web.EmptySession()))
+}
+
+func TestOrderResolver_CommerceCustomerOrders(t *testing.T) {
+	t.Parallel()
+
+	orderService := &fakeOrderService{orders: []*domain.Order{
+		{ID: "order-1", Status: domain.StatusPlaced},
+		{ID: "order-2", Status: domain.StatusShipped},
+		{ID: "order-3", Status: domain.StatusShipped},
+	}}
+	resolver := newOrderResolver(&authMock.Identity{Sub: "customer"}, orderService)
+
+	page, pageSize := 1, 1
+	result, err := resolver.CommerceCustomerOrders(requestContext(), &page, &pageSize, []string{"shipped"})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Status{domain.StatusShipped}, orderService.filter.Statuses)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 2, result.TotalPages)
+	assert.True(t, result.HasNextPage)
+	require.Len(t, result.Orders, 1)
+	assert.Equal(t, "order-3", result.Orders[0].ID)
+
+	pageSize = 1000000
+	result, err = resolver.CommerceCustomerOrders(requestContext(), nil, &pageSize, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.MaxPageSize, orderService.filter.PageSize)
+	assert.Equal(t, domain.MaxPageSize, result.PageSize)
+	assert.Len(t, result.Orders, 3)
+
+	result, err = newOrderResolver(nil, orderService).CommerceCustomerOrders(requestContext(), nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result, "guests have no orders")
+}
+
+func TestOrderResolver_CommerceCustomerOrder(t *testing.T) {
+	t.Parallel()
+
+	orderService := &fakeOrderService{orders: []*domain.Order{{ID: "order-1", Status: domain.StatusPlaced}}}
+	resolver := newOrderResolver(&authMock.Identity{Sub: "customer"}, orderService)
+
+	order, err := resolver.CommerceCustomerOrder(requestContext(), "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, "order-1", order.ID)
+	assert.Equal(t, "placed", order.Status)
+
+	_, err = resolver.CommerceCustomerOrder(requestContext(), "unknown")
+	assert.True(t, errors.Is(err, domain.ErrOrderNotFound))
+
+	order, err = newOrderResolver(nil, orderService).CommerceCustomerOrder(requestContext(), "order-1")
+	assert.NoError(t, err)
+	assert.Nil(t, order)
+}
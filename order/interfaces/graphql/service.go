@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"flamingo.me/graphql"
+
+	"github.com/lunarforge/flamingo_commerce/order/domain"
+	"github.com/lunarforge/flamingo_commerce/order/interfaces/graphql/dto"
+)
+
+//go:generate go run github.com/go-bindata/go-bindata/v3/go-bindata -nometadata -o fs.go -pkg graphql schema.graphql
+
+// Service is the Graphql-Service of this module
+type Service struct{}
+
+var _ graphql.Service = new(Service)
+
+// Schema returns graphql schema of this module
+func (*Service) Schema() []byte {
+	return MustAsset("schema.graphql")
+}
+
+// Types configures the GraphQL to Go resolvers
+func (*Service) Types(types *graphql.Types) {
+	types.Map("Commerce_Customer_OrderPage", dto.OrderPage{})
+	types.Map("Commerce_Customer_Order", dto.Order{})
+	types.Map("Commerce_Customer_OrderStatusTransition", dto.StatusTransition{})
+	types.Map("Commerce_Customer_OrderDelivery", dto.Delivery{})
+	types.Map("Commerce_Customer_OrderItem", dto.OrderItem{})
+	types.Map("Commerce_Customer_OrderPayment", domain.Payment{})
+	types.Resolve("Query", "Commerce_Customer_Orders", OrderResolver{}, "CommerceCustomerOrders")
+	types.Resolve("Query", "Commerce_Customer_Order", OrderResolver{}, "CommerceCustomerOrder")
+}
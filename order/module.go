@@ -2,9 +2,14 @@ package order
 
 import (
 	"flamingo.me/dingo"
+	"flamingo.me/flamingo/v3/core/healthcheck/domain/healthcheck"
+	flamingographql "flamingo.me/graphql"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/placeorder"
 	"github.com/lunarforge/flamingo_commerce/order/domain"
+	"github.com/lunarforge/flamingo_commerce/order/infrastructure"
 	"github.com/lunarforge/flamingo_commerce/order/infrastructure/fake"
 	"github.com/lunarforge/flamingo_commerce/order/interfaces/controller"
+	"github.com/lunarforge/flamingo_commerce/order/interfaces/graphql"
 	"flamingo.me/flamingo/v3/framework/web"
 )
 
@@ -13,6 +18,7 @@ type (
 	Module struct {
 		useFakeAdapter     bool
 		useInMemoryService bool
+		useSQLStorage      bool
 	}
 )
 
@@ -25,34 +31,70 @@ const (
 func (m *Module) Inject(
 	config *struct {
 		UseFakeAdapter bool `inject:"config:commerce.order.useFakeAdapter,optional"`
+		UseSQLStorage  bool `inject:"config:commerce.order.sqlStorage.enabled,optional"`
 	},
 ) {
 	if config != nil {
 		m.useFakeAdapter = config.UseFakeAdapter
+		m.useSQLStorage = config.UseSQLStorage
 	}
 }
 
 // Configure DI
 func (m *Module) Configure(injector *dingo.Injector) {
 
-	if m.useFakeAdapter {
+	if m.useSQLStorage {
+		injector.Bind(new(infrastructure.SQLOrderStorage)).In(dingo.Singleton)
+		injector.Bind((*domain.CustomerIdentityOrderService)(nil)).To(new(infrastructure.SQLOrderStorage))
+		injector.BindMap(new(healthcheck.Status), "order.storage.sql").To(new(infrastructure.SQLOrderStorage))
+		// placed orders are stored instead of handled by the place order adapter of the cart module
+		injector.Override((*placeorder.Service)(nil), "").To(infrastructure.SQLPlaceOrderService{})
+	} else if m.useFakeAdapter {
 		injector.Bind((*domain.CustomerIdentityOrderService)(nil)).To(fake.CustomerOrders{})
 	}
 
 	injector.Bind((*domain.OrderDecoratorInterface)(nil)).To(domain.OrderDecorator{})
+	injector.BindMulti(new(flamingographql.Service)).To(graphql.Service{})
 	web.BindRoutes(injector, new(routes))
 }
 
 type routes struct {
-	controller *controller.DataControllerCustomerOrders
+	controller    *controller.DataControllerCustomerOrders
+	apiController *controller.APIController
 }
 
-func (r *routes) Inject(controller *controller.DataControllerCustomerOrders) {
+func (r *routes) Inject(controller *controller.DataControllerCustomerOrders, apiController *controller.APIController) {
 	r.controller = controller
+	r.apiController = apiController
 }
 
 func (r *routes) Routes(registry *web.RouterRegistry) {
 	registry.HandleData("customerorders", r.controller.Data)
+	registry.HandleData("customerorderpage", r.controller.PageData)
+
+	registry.MustRoute("/api/v1/customer/orders", `order.api.orders(page?="1",pageSize?="10",status?="")`)
+	registry.HandleGet("order.api.orders", r.apiController.OrdersAction)
+
+	registry.MustRoute("/api/v1/customer/orders/:orderID", "order.api.order")
+	registry.HandleGet("order.api.order", r.apiController.OrderAction)
+}
+
+// CueConfig defines the order module configuration
+func (m *Module) CueConfig() string {
+	return `
+commerce: {
+	order: {
+		useFakeAdapter: bool | *false
+		sqlStorage: {
+			enabled:   bool | *false
+			driver:    string | *"sqlite3"
+			dsn:       string | *"file:orders.db?cache=shared"
+			tableName: string | *"orders"
+			migrate:   bool | *true
+		}
+	}
+}
+`
 }
 
 // FlamingoLegacyConfigAlias maps legacy config entries to new ones
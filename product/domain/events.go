@@ -0,0 +1,9 @@
+package domain
+
+type (
+	// ProductChangedEvent should be dispatched if products have been changed, e.g. by the import of the PIM.
+	// Product caches evict the given marketplace codes, or all products if no marketplace code is given.
+	ProductChangedEvent struct {
+		MarketplaceCodes []string
+	}
+)
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// DefaultMaxParallelLookups is the number of concurrent ProductService.Get calls of a ProductLoader if nothing else is configured
+	DefaultMaxParallelLookups = 10
+)
+
+type (
+	// BatchProductService interface - optional secondary port to get many products with one call.
+	// Bind it if the product backend supports batch requests, the decorators of cart and order use it then.
+	BatchProductService interface {
+		// GetMany returns the found products by marketplace code, codes without a product are missing in the result
+		GetMany(ctx context.Context, marketplaceCodes []string) (map[string]BasicProduct, error)
+	}
+
+	// ProductLoader loads the products of many marketplace codes at once:
+	// with one GetMany call if a BatchProductService is set, otherwise with bounded parallel ProductService.Get calls.
+	// Every marketplace code is only requested once per Load.
+	ProductLoader struct {
+		ProductService      ProductService
+		BatchProductService BatchProductService
+		// MaxParallel limits the concurrent Get calls, DefaultMaxParallelLookups is used if not positive
+		MaxParallel int
+	}
+
+	// ProductLoadResult is the result of a single marketplace code of ProductLoader.Load
+	ProductLoadResult struct {
+		Product BasicProduct
+		Error   error
+	}
+
+	// ProductLoadResults are the results of ProductLoader.Load by marketplace code
+	ProductLoadResults map[string]ProductLoadResult
+)
+
+// Get returns the loaded product of the marketplace code, codes that were not loaded return a ProductNotFound error
+func (r ProductLoadResults) Get(marketplaceCode string) (BasicProduct, error) {
+	result, found := r[marketplaceCode]
+	if !found {
+		return nil, ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return result.Product, result.Error
+}
+
+// Load returns the products of the marketplace codes, errors are returned per marketplace code
+func (l ProductLoader) Load(ctx context.Context, marketplaceCodes []string) ProductLoadResults {
+	codes := uniqueMarketplaceCodes(marketplaceCodes)
+	results := make(ProductLoadResults, len(codes))
+	if len(codes) == 0 {
+		return results
+	}
+
+	if l.BatchProductService != nil {
+		l.loadBatch(ctx, codes, results)
+		return results
+	}
+
+	if l.ProductService == nil {
+		for _, code := range codes {
+			results[code] = ProductLoadResult{Error: ProductNotFound{MarketplaceCode: code}}
+		}
+		return results
+	}
+
+	l.loadParallel(ctx, codes, results)
+	return results
+}
+
+func (l ProductLoader) loadBatch(ctx context.Context, codes []string, results ProductLoadResults) {
+	products, err := l.BatchProductService.GetMany(ctx, codes)
+	for _, code := range codes {
+		if err != nil {
+			results[code] = ProductLoadResult{Error: err}
+			continue
+		}
+
+		product, found := products[code]
+		if !found || product == nil {
+			results[code] = ProductLoadResult{Error: ProductNotFound{MarketplaceCode: code}}
+			continue
+		}
+
+		results[code] = ProductLoadResult{Product: product}
+	}
+}
+
+func (l ProductLoader) loadParallel(ctx context.Context, codes []string, results ProductLoadResults) {
+	maxParallel := l.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = DefaultMaxParallelLookups
+	}
+
+	if len(codes) == 1 || maxParallel == 1 {
+		for _, code := range codes {
+			product, err := l.ProductService.Get(ctx, code)
+			results[code] = ProductLoadResult{Product: product, Error: err}
+		}
+		return
+	}
+
+	var (
+		mutex     sync.Mutex
+		waitGroup sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, maxParallel)
+	for _, code := range codes {
+		waitGroup.Add(1)
+		semaphore <- struct{}{}
+		go func(code string) {
+			defer func() {
+				<-semaphore
+				waitGroup.Done()
+			}()
+
+			product, err := l.ProductService.Get(ctx, code)
+
+			mutex.Lock()
+			results[code] = ProductLoadResult{Product: product, Error: err}
+			mutex.Unlock()
+		}(code)
+	}
+
+	waitGroup.Wait()
+}
+
+// uniqueMarketplaceCodes removes duplicated codes and keeps the order
+func uniqueMarketplaceCodes(marketplaceCodes []string) []string {
+	seen := make(map[string]struct{}, len(marketplaceCodes))
+	codes := make([]string, 0, len(marketplaceCodes))
+	for _, code := range marketplaceCodes {
+		if _, found := seen[code]; found {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+
+	return codes
+}
@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	countingProductService struct {
+		mutex      sync.Mutex
+		calls      map[string]int
+		running    int32
+		maxRunning int32
+	}
+
+	batchProductService struct {
+		calls [][]string
+		err   error
+	}
+)
+
+func (s *countingProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	running := atomic.AddInt32(&s.running, 1)
+	defer atomic.AddInt32(&s.running, -1)
+
+	s.mutex.Lock()
+	s.calls[marketplaceCode]++
+	if running > s.maxRunning {
+		s.maxRunning = running
+	}
+	s.mutex.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	if marketplaceCode == "missing" {
+		return nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return domain.SimpleProduct{Identifier: marketplaceCode}, nil
+}
+
+func (s *batchProductService) GetMany(_ context.Context, marketplaceCodes []string) (map[string]domain.BasicProduct, error) {
+	s.calls = append(s.calls, marketplaceCodes)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	products := make(map[string]domain.BasicProduct)
+	for _, code := range marketplaceCodes {
+		if code != "missing" {
+			products[code] = domain.SimpleProduct{Identifier: code}
+		}
+	}
+
+	return products, nil
+}
+
+func TestProductLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	codes := []string{"a", "b", "a", "c", "missing", "d", "b", "e"}
+
+	t.Run("parallel get calls", func(t *testing.T) {
+		t.Parallel()
+
+		service := &countingProductService{calls: make(map[string]int)}
+		loader := domain.ProductLoader{ProductService: service, MaxParallel: 2}
+
+		results := loader.Load(context.Background(), codes)
+		assert.Len(t, results, 6)
+		for code, calls := range service.calls {
+			assert.Equal(t, 1, calls, code)
+		}
+		assert.LessOrEqual(t, service.maxRunning, int32(2))
+
+		product, err := results.Get("c")
+		require.NoError(t, err)
+		assert.Equal(t, "c", product.GetIdentifier())
+
+		_, err = results.Get("missing")
+		assert.Equal(t, domain.ProductNotFound{MarketplaceCode: "missing"}, err)
+
+		_, err = results.Get("not-loaded")
+		assert.Equal(t, domain.ProductNotFound{MarketplaceCode: "not-loaded"}, err)
+	})
+
+	t.Run("batch call", func(t *testing.T) {
+		t.Parallel()
+
+		service := &countingProductService{calls: make(map[string]int)}
+		batchService := &batchProductService{}
+		loader := domain.ProductLoader{ProductService: service, BatchProductService: batchService}
+
+		results := loader.Load(context.Background(), codes)
+		assert.Empty(t, service.calls)
+		require.Len(t, batchService.calls, 1)
+		assert.Equal(t, []string{"a", "b", "c", "missing", "d", "e"}, batchService.calls[0])
+
+		product, err := results.Get("e")
+		require.NoError(t, err)
+		assert.Equal(t, "e", product.GetIdentifier())
+
+		_, err = results.Get("missing")
+		assert.Equal(t, domain.ProductNotFound{MarketplaceCode: "missing"}, err)
+	})
+
+	t.Run("failing batch call", func(t *testing.T) {
+		t.Parallel()
+
+		batchErr := errors.New("pim not available")
+		loader := domain.ProductLoader{BatchProductService: &batchProductService{err: batchErr}}
+
+		results := loader.Load(context.Background(), codes)
+		_, err := results.Get("a")
+		assert.Equal(t, batchErr, err)
+	})
+}
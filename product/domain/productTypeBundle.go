@@ -0,0 +1,382 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+const (
+	// TypeBundle denotes bundle products
+	TypeBundle = "bundle"
+
+	// TypeBundleWithActiveChoices denotes bundle products with chosen components
+	TypeBundleWithActiveChoices = "bundle_with_activechoices"
+
+	// BundlePricingFixed - the bundle is sold for the price of the bundle plus the surcharges of the chosen components
+	BundlePricingFixed = "fixed"
+	// BundlePricingDynamic - the bundle is sold for the price of the bundle plus the prices of the chosen components
+	BundlePricingDynamic = "dynamic"
+)
+
+type (
+	// BundleProduct - A product that consists of the bundle itself and components which are chosen from option groups.
+	// The bundle can't be sold until the components are chosen, see GetBundleWithActiveChoices
+	BundleProduct struct {
+		Identifier string
+		BasicProductData
+		Teaser TeaserData
+		// Saleable of the bundle itself, the ActivePrice is the base price of the bundle
+		Saleable
+		PricingRule  BundlePricingRule
+		OptionGroups []BundleOptionGroup
+	}
+
+	// BundleProductWithActiveChoices - A bundle product with chosen components that can be sold
+	BundleProductWithActiveChoices struct {
+		BundleProduct
+		ActiveComponents []ActiveBundleComponent
+		// ActivePrice is the price of the bundle with the chosen components
+		ActivePrice PriceInfo
+	}
+
+	// BundlePricingRule defines how the price of the bundle is calculated
+	BundlePricingRule struct {
+		// Type is BundlePricingFixed or BundlePricingDynamic
+		Type string
+		// DiscountPercent reduces the price of the bundle with the chosen components
+		DiscountPercent float64
+	}
+
+	// BundleOptionGroup is a group of choices, e.g. "Memory", with the number of choices that have to be made
+	BundleOptionGroup struct {
+		Code  string
+		Label string
+		// MinChoices is the number of choices that must be made at least, a group with MinChoices > 0 is required
+		MinChoices int
+		// MaxChoices is the number of choices that can be made at most, 0 means no limit
+		MaxChoices int
+		Choices    []BundleChoice
+	}
+
+	// BundleChoice is a component that can be chosen in an option group
+	BundleChoice struct {
+		Code    string
+		Product BasicProduct
+		// MinQty and MaxQty limit the quantity of the component per bundle, 0 means no limit
+		MinQty int
+		MaxQty int
+		// DefaultQty is used if the choice is made without quantity, 1 if not set
+		DefaultQty int
+		// Surcharge per quantity that is added to the price of fixed priced bundles
+		Surcharge priceDomain.Price
+	}
+
+	// BundleComponentSelection is the choice of a component in an option group
+	BundleComponentSelection struct {
+		OptionGroupCode string
+		ChoiceCode      string
+		Qty             int
+	}
+
+	// BundleConfiguration contains the chosen components of a bundle
+	BundleConfiguration []BundleComponentSelection
+
+	// ActiveBundleComponent is a chosen component of a BundleProductWithActiveChoices
+	ActiveBundleComponent struct {
+		OptionGroupCode string
+		Choice          BundleChoice
+		Qty             int
+	}
+)
+
+var (
+	// ErrBundleNotConfigured is returned if a bundle is used without chosen components
+	ErrBundleNotConfigured = errors.New("no components chosen for bundle product")
+
+	_ BasicProduct = BundleProduct{}
+	_ BasicProduct = BundleProductWithActiveChoices{}
+)
+
+// Type interface implementation for BundleProduct
+func (p BundleProduct) Type() string {
+	return TypeBundle
+}
+
+// IsSaleable is false, the components need to be chosen first
+func (p BundleProduct) IsSaleable() bool {
+	return false
+}
+
+// SaleableData getter for BundleProduct - contains the base price of the bundle
+func (p BundleProduct) SaleableData() Saleable {
+	return p.Saleable
+}
+
+// BaseData interface implementation for BundleProduct
+func (p BundleProduct) BaseData() BasicProductData {
+	return p.BasicProductData
+}
+
+// TeaserData interface implementation for BundleProduct
+func (p BundleProduct) TeaserData() TeaserData {
+	return p.Teaser
+}
+
+// GetIdentifier interface implementation for BundleProduct
+func (p BundleProduct) GetIdentifier() string {
+	return p.Identifier
+}
+
+// HasMedia for BundleProduct
+func (p BundleProduct) HasMedia(group string, usage string) bool {
+	return findMediaInProduct(BasicProduct(p), group, usage) != nil
+}
+
+// GetMedia for BundleProduct
+func (p BundleProduct) GetMedia(group string, usage string) Media {
+	return *findMediaInProduct(BasicProduct(p), group, usage)
+}
+
+// OptionGroup returns the option group with the given code
+func (p BundleProduct) OptionGroup(code string) (*BundleOptionGroup, error) {
+	for i := range p.OptionGroups {
+		if p.OptionGroups[i].Code == code {
+			return &p.OptionGroups[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no option group with code %q found", code)
+}
+
+// DefaultConfiguration returns the default choices: the first choices of the required option groups with their default quantity
+func (p BundleProduct) DefaultConfiguration() BundleConfiguration {
+	var configuration BundleConfiguration
+	for _, group := range p.OptionGroups {
+		for i := 0; i < group.MinChoices && i < len(group.Choices); i++ {
+			configuration = append(configuration, BundleComponentSelection{
+				OptionGroupCode: group.Code,
+				ChoiceCode:      group.Choices[i].Code,
+				Qty:             group.Choices[i].defaultQty(),
+			})
+		}
+	}
+
+	return configuration
+}
+
+// GetBundleWithActiveChoices validates the configuration against the option groups and returns the saleable bundle
+func (p BundleProduct) GetBundleWithActiveChoices(configuration BundleConfiguration) (BundleProductWithActiveChoices, error) {
+	choicesPerGroup := make(map[string]int, len(p.OptionGroups))
+	seen := make(map[BundleComponentSelection]bool, len(configuration))
+	components := make([]ActiveBundleComponent, 0, len(configuration))
+	for _, selection := range configuration.Normalized() {
+		group, err := p.OptionGroup(selection.OptionGroupCode)
+		if err != nil {
+			return BundleProductWithActiveChoices{}, err
+		}
+
+		choice, err := group.Choice(selection.ChoiceCode)
+		if err != nil {
+			return BundleProductWithActiveChoices{}, err
+		}
+
+		key := BundleComponentSelection{OptionGroupCode: selection.OptionGroupCode, ChoiceCode: selection.ChoiceCode}
+		if seen[key] {
+			return BundleProductWithActiveChoices{}, fmt.Errorf("choice %q of option group %q is chosen more than once", choice.Code, group.Code)
+		}
+		seen[key] = true
+
+		qty := selection.Qty
+		if qty == 0 {
+			qty = choice.defaultQty()
+		}
+		if qty < 0 || (choice.MinQty > 0 && qty < choice.MinQty) || (choice.MaxQty > 0 && qty > choice.MaxQty) {
+			return BundleProductWithActiveChoices{}, fmt.Errorf("quantity %d of choice %q of option group %q is not allowed", qty, choice.Code, group.Code)
+		}
+
+		choicesPerGroup[group.Code]++
+		components = append(components, ActiveBundleComponent{
+			OptionGroupCode: group.Code,
+			Choice:          *choice,
+			Qty:             qty,
+		})
+	}
+
+	for _, group := range p.OptionGroups {
+		chosen := choicesPerGroup[group.Code]
+		if chosen < group.MinChoices && len(components) == 0 {
+			return BundleProductWithActiveChoices{}, ErrBundleNotConfigured
+		}
+		if chosen < group.MinChoices {
+			return BundleProductWithActiveChoices{}, fmt.Errorf("option group %q needs at least %d choices", group.Code, group.MinChoices)
+		}
+		if group.MaxChoices > 0 && chosen > group.MaxChoices {
+			return BundleProductWithActiveChoices{}, fmt.Errorf("option group %q allows at most %d choices", group.Code, group.MaxChoices)
+		}
+	}
+
+	activePrice, err := p.priceWithComponents(components)
+	if err != nil {
+		return BundleProductWithActiveChoices{}, err
+	}
+
+	return BundleProductWithActiveChoices{
+		BundleProduct:    p,
+		ActiveComponents: components,
+		ActivePrice:      activePrice,
+	}, nil
+}
+
+// priceWithComponents calculates the price of the bundle following the pricing rule
+func (p BundleProduct) priceWithComponents(components []ActiveBundleComponent) (PriceInfo, error) {
+	price := p.Saleable.ActivePrice
+	defaultPrice := price.Default
+	finalPrice := price.GetFinalPrice()
+
+	for _, component := range components {
+		var defaultAdd, finalAdd priceDomain.Price
+		switch p.PricingRule.Type {
+		case BundlePricingDynamic:
+			if component.Choice.Product == nil {
+				return PriceInfo{}, fmt.Errorf("choice %q has no product", component.Choice.Code)
+			}
+			componentPrice := component.Choice.Product.SaleableData().ActivePrice
+			defaultAdd = componentPrice.Default.Multiply(component.Qty)
+			finalAdd = componentPrice.GetFinalPrice().Multiply(component.Qty)
+		case BundlePricingFixed, "":
+			defaultAdd = component.Choice.Surcharge.Multiply(component.Qty)
+			finalAdd = defaultAdd
+		default:
+			return PriceInfo{}, fmt.Errorf("unknown bundle pricing type %q", p.PricingRule.Type)
+		}
+
+		var err error
+		defaultPrice, err = defaultPrice.Add(defaultAdd)
+		if err != nil {
+			return PriceInfo{}, err
+		}
+		finalPrice, err = finalPrice.Add(finalAdd)
+		if err != nil {
+			return PriceInfo{}, err
+		}
+	}
+
+	if p.PricingRule.DiscountPercent > 0 {
+		finalPrice = finalPrice.Discounted(p.PricingRule.DiscountPercent).GetPayable()
+	}
+
+	price.Default = defaultPrice
+	price.IsDiscounted = finalPrice.IsLessThen(defaultPrice)
+	price.Discounted = priceDomain.Price{}
+	if price.IsDiscounted {
+		price.Discounted = finalPrice
+	}
+
+	return price, nil
+}
+
+// Choice returns the choice with the given code
+func (g BundleOptionGroup) Choice(code string) (*BundleChoice, error) {
+	for i := range g.Choices {
+		if g.Choices[i].Code == code {
+			return &g.Choices[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no choice with code %q found in option group %q", code, g.Code)
+}
+
+// IsRequired returns true if at least one choice has to be made in the group
+func (g BundleOptionGroup) IsRequired() bool {
+	return g.MinChoices > 0
+}
+
+func (c BundleChoice) defaultQty() int {
+	if c.DefaultQty > 0 {
+		return c.DefaultQty
+	}
+
+	return 1
+}
+
+// Normalized returns the selections sorted by option group and choice, equal configurations are normalized equally
+func (c BundleConfiguration) Normalized() BundleConfiguration {
+	if len(c) == 0 {
+		return nil
+	}
+
+	normalized := make(BundleConfiguration, len(c))
+	copy(normalized, c)
+	sort.SliceStable(normalized, func(i, j int) bool {
+		if normalized[i].OptionGroupCode != normalized[j].OptionGroupCode {
+			return normalized[i].OptionGroupCode < normalized[j].OptionGroupCode
+		}
+		return normalized[i].ChoiceCode < normalized[j].ChoiceCode
+	})
+
+	return normalized
+}
+
+// Equals checks if both configurations contain the same selections regardless of their order
+func (c BundleConfiguration) Equals(other BundleConfiguration) bool {
+	if len(c) != len(other) {
+		return false
+	}
+
+	a, b := c.Normalized(), other.Normalized()
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+//********BUNDLE WITH ACTIVE CHOICES
+
+// Type getter
+func (p BundleProductWithActiveChoices) Type() string {
+	return TypeBundleWithActiveChoices
+}
+
+// IsSaleable is true
+func (p BundleProductWithActiveChoices) IsSaleable() bool {
+	return true
+}
+
+// SaleableData contains the price of the bundle with the chosen components
+func (p BundleProductWithActiveChoices) SaleableData() Saleable {
+	saleable := p.Saleable
+	saleable.ActivePrice = p.ActivePrice
+	saleable.AvailablePrices = nil
+
+	return saleable
+}
+
+// HasMedia for BundleProductWithActiveChoices
+func (p BundleProductWithActiveChoices) HasMedia(group string, usage string) bool {
+	return findMediaInProduct(BasicProduct(p), group, usage) != nil
+}
+
+// GetMedia for BundleProductWithActiveChoices
+func (p BundleProductWithActiveChoices) GetMedia(group string, usage string) Media {
+	return *findMediaInProduct(BasicProduct(p), group, usage)
+}
+
+// Configuration returns the chosen components
+func (p BundleProductWithActiveChoices) Configuration() BundleConfiguration {
+	configuration := make(BundleConfiguration, 0, len(p.ActiveComponents))
+	for _, component := range p.ActiveComponents {
+		configuration = append(configuration, BundleComponentSelection{
+			OptionGroupCode: component.OptionGroupCode,
+			ChoiceCode:      component.Choice.Code,
+			Qty:             component.Qty,
+		})
+	}
+
+	return configuration
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+)
+
+func bundleComponent(code string, price float64) SimpleProduct {
+	return SimpleProduct{
+		BasicProductData: BasicProductData{MarketPlaceCode: code},
+		Saleable: Saleable{
+			IsSaleable:  true,
+			ActivePrice: PriceInfo{Default: priceDomain.NewFromFloat(price, "EUR")},
+		},
+	}
+}
+
+func testBundle(pricingRule BundlePricingRule) BundleProduct {
+	return BundleProduct{
+		BasicProductData: BasicProductData{MarketPlaceCode: "pc"},
+		Saleable: Saleable{
+			IsSaleable:  true,
+			ActivePrice: PriceInfo{Default: priceDomain.NewFromFloat(500, "EUR")},
+		},
+		PricingRule: pricingRule,
+		OptionGroups: []BundleOptionGroup{
+			{
+				Code:       "memory",
+				MinChoices: 1,
+				MaxChoices: 1,
+				Choices: []BundleChoice{
+					{Code: "8gb", Product: bundleComponent("ram-8", 40), MaxQty: 2},
+					{Code: "16gb", Product: bundleComponent("ram-16", 70), Surcharge: priceDomain.NewFromFloat(25, "EUR"), MaxQty: 2},
+				},
+			},
+			{
+				Code:       "extras",
+				MaxChoices: 2,
+				Choices: []BundleChoice{
+					{Code: "mouse", Product: bundleComponent("mouse", 20), Surcharge: priceDomain.NewFromFloat(10, "EUR")},
+					{Code: "keyboard", Product: bundleComponent("keyboard", 30), Surcharge: priceDomain.NewFromFloat(15, "EUR")},
+					{Code: "headset", Product: bundleComponent("headset", 50), Surcharge: priceDomain.NewFromFloat(30, "EUR")},
+				},
+			},
+		},
+	}
+}
+
+func TestBundleProduct_GetBundleWithActiveChoices(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fixed pricing", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := testBundle(BundlePricingRule{})
+		assert.False(t, bundle.IsSaleable())
+
+		active, err := bundle.GetBundleWithActiveChoices(BundleConfiguration{
+			{OptionGroupCode: "extras", ChoiceCode: "mouse"},
+			{OptionGroupCode: "memory", ChoiceCode: "16gb", Qty: 2},
+		})
+		require.NoError(t, err)
+		assert.True(t, active.IsSaleable())
+		assert.Equal(t, TypeBundleWithActiveChoices, active.Type())
+		assert.Equal(t, 560.0, active.SaleableData().ActivePrice.GetFinalPrice().FloatAmount())
+
+		// the configuration is sorted and contains the quantities
+		assert.Equal(t, BundleConfiguration{
+			{OptionGroupCode: "extras", ChoiceCode: "mouse", Qty: 1},
+			{OptionGroupCode: "memory", ChoiceCode: "16gb", Qty: 2},
+		}, active.Configuration())
+	})
+
+	t.Run("dynamic pricing with discount", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := testBundle(BundlePricingRule{Type: BundlePricingDynamic, DiscountPercent: 10})
+		active, err := bundle.GetBundleWithActiveChoices(BundleConfiguration{
+			{OptionGroupCode: "memory", ChoiceCode: "8gb"},
+			{OptionGroupCode: "extras", ChoiceCode: "keyboard"},
+		})
+		require.NoError(t, err)
+
+		price := active.SaleableData().ActivePrice
+		assert.Equal(t, 570.0, price.Default.FloatAmount())
+		assert.True(t, price.IsDiscounted)
+		assert.Equal(t, 513.0, price.GetFinalPrice().FloatAmount())
+	})
+
+	t.Run("invalid configurations", func(t *testing.T) {
+		t.Parallel()
+
+		bundle := testBundle(BundlePricingRule{})
+
+		_, err := bundle.GetBundleWithActiveChoices(nil)
+		assert.Equal(t, ErrBundleNotConfigured, err)
+
+		tests := map[string]BundleConfiguration{
+			"required group missing": {{OptionGroupCode: "extras", ChoiceCode: "mouse"}},
+			"too many choices": {
+				{OptionGroupCode: "memory", ChoiceCode: "8gb"},
+				{OptionGroupCode: "memory", ChoiceCode: "16gb"},
+			},
+			"choice chosen twice": {
+				{OptionGroupCode: "memory", ChoiceCode: "8gb"},
+				{OptionGroupCode: "memory", ChoiceCode: "8gb"},
+			},
+			"unknown option group": {
+				{OptionGroupCode: "memory", ChoiceCode: "8gb"},
+				{OptionGroupCode: "cables", ChoiceCode: "hdmi"},
+			},
+			"unknown choice":       {{OptionGroupCode: "memory", ChoiceCode: "4gb"}},
+			"quantity exceeds max": {{OptionGroupCode: "memory", ChoiceCode: "8gb", Qty: 3}},
+		}
+
+		for name, configuration := range tests {
+			_, err := bundle.GetBundleWithActiveChoices(configuration)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestBundleConfiguration_Equals(t *testing.T) {
+	t.Parallel()
+
+	a := BundleConfiguration{
+		{OptionGroupCode: "memory", ChoiceCode: "8gb", Qty: 1},
+		{OptionGroupCode: "extras", ChoiceCode: "mouse", Qty: 1},
+	}
+	b := BundleConfiguration{
+		{OptionGroupCode: "extras", ChoiceCode: "mouse", Qty: 1},
+		{OptionGroupCode: "memory", ChoiceCode: "8gb", Qty: 1},
+	}
+
+	assert.True(t, a.Equals(b))
+	assert.True(t, BundleConfiguration(nil).Equals(BundleConfiguration{}))
+	assert.False(t, a.Equals(b[:1]))
+	assert.False(t, a.Equals(BundleConfiguration{
+		{OptionGroupCode: "extras", ChoiceCode: "mouse", Qty: 2},
+		{OptionGroupCode: "memory", ChoiceCode: "8gb", Qty: 1},
+	}))
+}
@@ -0,0 +1,318 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// CachingProductService intercepts the bound ProductService and caches the products
+	// in a memo of the current request and in the SharedProductCache
+	CachingProductService struct {
+		domain.ProductService
+		productCaches
+	}
+
+	// CachingBatchProductService intercepts the bound BatchProductService and serves the products from the same caches
+	// as the CachingProductService, only the products that are not cached are requested with GetMany
+	CachingBatchProductService struct {
+		domain.BatchProductService
+		productCaches
+	}
+
+	// ProductCacheScope separates the cached products of contexts that get different products from the ProductService,
+	// e.g. because of another locale or channel. The shared cache only serves products that are cached with the same scope.
+	ProductCacheScope interface {
+		Scope(ctx context.Context) string
+	}
+
+	// ConfiguredProductCacheScope scopes the cached products by the locale and the channel configured for the area of the request
+	ConfiguredProductCacheScope struct {
+		scope string
+	}
+
+	// productCaches are the request memo and the shared cache used by the caching services
+	productCaches struct {
+		sharedCache *SharedProductCache
+		scope       ProductCacheScope
+		requestMemo bool
+		shared      bool
+	}
+
+	// ProductCacheInvalidator evicts the changed products of a ProductChangedEvent from the product caches
+	ProductCacheInvalidator struct {
+		sharedCache *SharedProductCache
+	}
+
+	// requestProductMemo holds the products loaded during the current request
+	requestProductMemo struct {
+		products sync.Map
+	}
+
+	requestProductMemoEntry struct {
+		product domain.BasicProduct
+		err     error
+	}
+
+	productCacheContextKey string
+)
+
+const (
+	requestProductMemoKey productCacheContextKey = "product.requestMemo"
+
+	layerRequest = "request"
+	layerShared  = "shared"
+)
+
+var (
+	_ domain.ProductService      = new(CachingProductService)
+	_ domain.BatchProductService = new(CachingBatchProductService)
+	_ ProductCacheScope          = new(ConfiguredProductCacheScope)
+	_ flamingo.Subscriber        = new(ProductCacheInvalidator)
+
+	// productCacheHits counts the products that are served by a cache
+	productCacheHits = stats.Int64("flamingo-commerce/product/cache/hit_count", "Counts how often a product is served by the cache", stats.UnitDimensionless)
+	// productCacheMisses counts the products that have to be loaded from the product service
+	productCacheMisses = stats.Int64("flamingo-commerce/product/cache/miss_count", "Counts how often a product is not cached", stats.UnitDimensionless)
+	keyLayer, _        = tag.NewKey("layer")
+)
+
+func init() {
+	if err := opencensus.View("flamingo-commerce/product/cache/hit_count", productCacheHits, view.Count(), keyLayer); err != nil {
+		panic(err)
+	}
+	if err := opencensus.View("flamingo-commerce/product/cache/miss_count", productCacheMisses, view.Count(), keyLayer); err != nil {
+		panic(err)
+	}
+}
+
+// Inject dependencies
+func (s *CachingProductService) Inject(
+	sharedCache *SharedProductCache,
+	scope ProductCacheScope,
+	cfg *struct {
+		RequestMemo bool `inject:"config:commerce.product.cache.requestMemo,optional"`
+		Shared      bool `inject:"config:commerce.product.cache.shared.enabled,optional"`
+	},
+) *CachingProductService {
+	s.productCaches.inject(sharedCache, scope, cfg)
+
+	return s
+}
+
+// Get returns the product from the request memo, the shared cache or the intercepted ProductService
+func (s *CachingProductService) Get(ctx context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	if cached, found := s.lookup(ctx, marketplaceCode); found {
+		return cached.product, cached.err
+	}
+
+	product, err := s.ProductService.Get(ctx, marketplaceCode)
+	s.store(ctx, marketplaceCode, product, err)
+
+	return product, err
+}
+
+// Inject dependencies
+func (s *CachingBatchProductService) Inject(
+	sharedCache *SharedProductCache,
+	scope ProductCacheScope,
+	cfg *struct {
+		RequestMemo bool `inject:"config:commerce.product.cache.requestMemo,optional"`
+		Shared      bool `inject:"config:commerce.product.cache.shared.enabled,optional"`
+	},
+) *CachingBatchProductService {
+	s.productCaches.inject(sharedCache, scope, cfg)
+
+	return s
+}
+
+// GetMany returns the cached products and loads the others with the intercepted BatchProductService
+func (s *CachingBatchProductService) GetMany(ctx context.Context, marketplaceCodes []string) (map[string]domain.BasicProduct, error) {
+	products := make(map[string]domain.BasicProduct, len(marketplaceCodes))
+	var uncached []string
+	for _, marketplaceCode := range marketplaceCodes {
+		cached, found := s.lookup(ctx, marketplaceCode)
+		if !found {
+			uncached = append(uncached, marketplaceCode)
+			continue
+		}
+		if cached.err == nil {
+			products[marketplaceCode] = cached.product
+		}
+	}
+
+	if len(uncached) == 0 {
+		return products, nil
+	}
+
+	loaded, err := s.BatchProductService.GetMany(ctx, uncached)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, marketplaceCode := range uncached {
+		product, found := loaded[marketplaceCode]
+		if !found || product == nil {
+			s.store(ctx, marketplaceCode, nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode})
+			continue
+		}
+		s.store(ctx, marketplaceCode, product, nil)
+		products[marketplaceCode] = product
+	}
+
+	return products, nil
+}
+
+// Inject configuration
+func (s *ConfiguredProductCacheScope) Inject(
+	cfg *struct {
+		Locale  string `inject:"config:locale.locale,optional"`
+		Channel string `inject:"config:commerce.product.cache.channel,optional"`
+	},
+) *ConfiguredProductCacheScope {
+	if cfg != nil {
+		s.scope = cfg.Locale + "/" + cfg.Channel
+	}
+
+	return s
+}
+
+// Scope returns the configured locale and channel
+func (s *ConfiguredProductCacheScope) Scope(context.Context) string {
+	return s.scope
+}
+
+func (c *productCaches) inject(
+	sharedCache *SharedProductCache,
+	scope ProductCacheScope,
+	cfg *struct {
+		RequestMemo bool `inject:"config:commerce.product.cache.requestMemo,optional"`
+		Shared      bool `inject:"config:commerce.product.cache.shared.enabled,optional"`
+	},
+) {
+	c.sharedCache = sharedCache
+	c.scope = scope
+	if cfg != nil {
+		c.requestMemo = cfg.RequestMemo
+		c.shared = cfg.Shared
+	}
+}
+
+// lookup returns the product (or the not found error) from the request memo or the shared cache, found is false if it is not cached
+func (c *productCaches) lookup(ctx context.Context, marketplaceCode string) (requestProductMemoEntry, bool) {
+	memo := c.memo(ctx)
+	if memo != nil {
+		if cached, found := memo.products.Load(marketplaceCode); found {
+			record(ctx, productCacheHits, layerRequest)
+			return cached.(requestProductMemoEntry), true
+		}
+		record(ctx, productCacheMisses, layerRequest)
+	}
+
+	if c.shared && c.sharedCache != nil {
+		if product, found := c.sharedCache.Get(c.scopeOf(ctx), marketplaceCode); found {
+			record(ctx, productCacheHits, layerShared)
+			if memo != nil {
+				memo.products.Store(marketplaceCode, requestProductMemoEntry{product: product})
+			}
+			return requestProductMemoEntry{product: product}, true
+		}
+		record(ctx, productCacheMisses, layerShared)
+	}
+
+	return requestProductMemoEntry{}, false
+}
+
+// store caches the loaded product, a missing product is remembered for the current request only, other errors are not cached at all
+func (c *productCaches) store(ctx context.Context, marketplaceCode string, product domain.BasicProduct, err error) {
+	memo := c.memo(ctx)
+	if err != nil {
+		if _, notFound := err.(domain.ProductNotFound); notFound && memo != nil {
+			memo.products.Store(marketplaceCode, requestProductMemoEntry{err: err})
+		}
+		return
+	}
+
+	if memo != nil {
+		memo.products.Store(marketplaceCode, requestProductMemoEntry{product: product})
+	}
+	if c.shared && c.sharedCache != nil {
+		c.sharedCache.Set(c.scopeOf(ctx), marketplaceCode, product)
+	}
+}
+
+// scopeOf returns the cache scope of the context, all contexts share one scope if no ProductCacheScope is set
+func (c *productCaches) scopeOf(ctx context.Context) string {
+	if c.scope == nil {
+		return ""
+	}
+
+	return c.scope.Scope(ctx)
+}
+
+// memo returns the product memo of the current request, nil if there is no request or the memo is disabled
+func (c *productCaches) memo(ctx context.Context) *requestProductMemo {
+	if !c.requestMemo {
+		return nil
+	}
+
+	request := web.RequestFromContext(ctx)
+	if request == nil {
+		return nil
+	}
+
+	memo, _ := request.Values.LoadOrStore(requestProductMemoKey, new(requestProductMemo))
+	return memo.(*requestProductMemo)
+}
+
+func record(ctx context.Context, measure *stats.Int64Measure, layer string) {
+	censusCtx, _ := tag.New(ctx, tag.Upsert(keyLayer, layer))
+	stats.Record(censusCtx, measure.M(1))
+}
+
+// Inject dependencies
+func (i *ProductCacheInvalidator) Inject(sharedCache *SharedProductCache) *ProductCacheInvalidator {
+	i.sharedCache = sharedCache
+
+	return i
+}
+
+// Notify evicts the changed products from the shared cache and the memo of the current request
+func (i *ProductCacheInvalidator) Notify(ctx context.Context, event flamingo.Event) {
+	var marketplaceCodes []string
+	switch event := event.(type) {
+	case *domain.ProductChangedEvent:
+		marketplaceCodes = event.MarketplaceCodes
+	case domain.ProductChangedEvent:
+		marketplaceCodes = event.MarketplaceCodes
+	default:
+		return
+	}
+
+	i.sharedCache.Evict(marketplaceCodes...)
+
+	request := web.RequestFromContext(ctx)
+	if request == nil {
+		return
+	}
+	memo, found := request.Values.Load(requestProductMemoKey)
+	if !found {
+		return
+	}
+	if len(marketplaceCodes) == 0 {
+		request.Values.Delete(requestProductMemoKey)
+		return
+	}
+	for _, marketplaceCode := range marketplaceCodes {
+		memo.(*requestProductMemo).products.Delete(marketplaceCode)
+	}
+}
@@ -0,0 +1,233 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	countingProductService struct {
+		calls map[string]int
+	}
+
+	batchProductService struct {
+		calls [][]string
+	}
+
+	// contextScope scopes the products by the locale stored in the context
+	contextScope struct{}
+
+	localeKey struct{}
+)
+
+func (s *countingProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	s.calls[marketplaceCode]++
+	switch marketplaceCode {
+	case "missing":
+		return nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	case "broken":
+		return nil, errors.New("pim not available")
+	}
+
+	return domain.SimpleProduct{Identifier: marketplaceCode}, nil
+}
+
+func (s *batchProductService) GetMany(_ context.Context, marketplaceCodes []string) (map[string]domain.BasicProduct, error) {
+	s.calls = append(s.calls, marketplaceCodes)
+
+	products := make(map[string]domain.BasicProduct)
+	for _, code := range marketplaceCodes {
+		if code != "missing" {
+			products[code] = domain.SimpleProduct{Identifier: code}
+		}
+	}
+
+	return products, nil
+}
+
+func (contextScope) Scope(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+func newSharedProductCache(maxEntries float64, lifetimeSeconds float64) *SharedProductCache {
+	return new(SharedProductCache).Inject(&struct {
+		MaxEntries      float64 `inject:"config:commerce.product.cache.shared.maxEntries,optional"`
+		LifetimeSeconds float64 `inject:"config:commerce.product.cache.shared.lifetimeSeconds,optional"`
+	}{MaxEntries: maxEntries, LifetimeSeconds: lifetimeSeconds})
+}
+
+func newCachingProductService(sharedCache *SharedProductCache, requestMemo bool, shared bool) (*CachingProductService, *countingProductService) {
+	service := &countingProductService{calls: make(map[string]int)}
+	caching := new(CachingProductService).Inject(sharedCache, contextScope{}, &struct {
+		RequestMemo bool `inject:"config:commerce.product.cache.requestMemo,optional"`
+		Shared      bool `inject:"config:commerce.product.cache.shared.enabled,optional"`
+	}{RequestMemo: requestMemo, Shared: shared})
+	caching.ProductService = service
+
+	return caching, service
+}
+
+func TestSharedProductCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := newSharedProductCache(2, 60)
+	cache.now = func() time.Time { return now }
+
+	cache.Set("", "a", domain.SimpleProduct{Identifier: "a"})
+	cache.Set("", "b", domain.SimpleProduct{Identifier: "b"})
+	_, found := cache.Get("", "a")
+	assert.True(t, found)
+
+	// b is the least recently used product
+	cache.Set("", "c", domain.SimpleProduct{Identifier: "c"})
+	assert.Equal(t, 2, cache.Len())
+	_, found = cache.Get("", "b")
+	assert.False(t, found)
+
+	now = now.Add(61 * time.Second)
+	_, found = cache.Get("", "a")
+	assert.False(t, found)
+	assert.Equal(t, 1, cache.Len())
+
+	// products are cached per scope and evicted in all scopes
+	cache.Set("de", "a", domain.SimpleProduct{Identifier: "a-de"})
+	_, found = cache.Get("en", "a")
+	assert.False(t, found)
+	cache.Set("en", "a", domain.SimpleProduct{Identifier: "a-en"})
+	product, found := cache.Get("de", "a")
+	require.True(t, found)
+	assert.Equal(t, "a-de", product.GetIdentifier())
+	cache.Evict("a")
+	_, found = cache.Get("de", "a")
+	assert.False(t, found)
+	_, found = cache.Get("en", "a")
+	assert.False(t, found)
+
+	cache.Evict()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCachingProductService_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request memo", func(t *testing.T) {
+		t.Parallel()
+
+		caching, service := newCachingProductService(newSharedProductCache(10, 60), true, false)
+		ctx := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil))
+
+		for i := 0; i < 3; i++ {
+			product, err := caching.Get(ctx, "a")
+			require.NoError(t, err)
+			assert.Equal(t, "a", product.GetIdentifier())
+
+			_, err = caching.Get(ctx, "missing")
+			assert.Equal(t, domain.ProductNotFound{MarketplaceCode: "missing"}, err)
+
+			_, err = caching.Get(ctx, "broken")
+			assert.Error(t, err)
+		}
+		assert.Equal(t, map[string]int{"a": 1, "missing": 1, "broken": 3}, service.calls)
+
+		// a new request starts with an empty memo
+		_, err := caching.Get(web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil)), "a")
+		require.NoError(t, err)
+		assert.Equal(t, 2, service.calls["a"])
+
+		// without request nothing is memorized
+		_, err = caching.Get(context.Background(), "a")
+		require.NoError(t, err)
+		assert.Equal(t, 3, service.calls["a"])
+	})
+
+	t.Run("shared cache and invalidation", func(t *testing.T) {
+		t.Parallel()
+
+		sharedCache := newSharedProductCache(10, 60)
+		caching, service := newCachingProductService(sharedCache, true, true)
+		invalidator := new(ProductCacheInvalidator).Inject(sharedCache)
+
+		firstRequest := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil))
+		_, err := caching.Get(firstRequest, "a")
+		require.NoError(t, err)
+		_, err = caching.Get(firstRequest, "missing")
+		assert.Error(t, err)
+
+		secondRequest := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil))
+		_, err = caching.Get(secondRequest, "a")
+		require.NoError(t, err)
+		_, err = caching.Get(secondRequest, "missing")
+		assert.Error(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "missing": 2}, service.calls)
+
+		invalidator.Notify(secondRequest, &domain.ProductChangedEvent{MarketplaceCodes: []string{"a"}})
+		_, err = caching.Get(secondRequest, "a")
+		require.NoError(t, err)
+		assert.Equal(t, 2, service.calls["a"])
+
+		invalidator.Notify(context.Background(), domain.ProductChangedEvent{})
+		assert.Equal(t, 0, sharedCache.Len())
+	})
+
+	t.Run("shared cache is scoped", func(t *testing.T) {
+		t.Parallel()
+
+		caching, service := newCachingProductService(newSharedProductCache(10, 60), false, true)
+		german := context.WithValue(context.Background(), localeKey{}, "de")
+		english := context.WithValue(context.Background(), localeKey{}, "en")
+
+		for _, ctx := range []context.Context{german, english, german, english} {
+			_, err := caching.Get(ctx, "a")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, service.calls["a"])
+	})
+}
+
+func TestCachingBatchProductService_GetMany(t *testing.T) {
+	t.Parallel()
+
+	sharedCache := newSharedProductCache(10, 60)
+	caching, service := newCachingProductService(sharedCache, true, true)
+	batchService := new(batchProductService)
+	batchCaching := new(CachingBatchProductService).Inject(sharedCache, contextScope{}, &struct {
+		RequestMemo bool `inject:"config:commerce.product.cache.requestMemo,optional"`
+		Shared      bool `inject:"config:commerce.product.cache.shared.enabled,optional"`
+	}{RequestMemo: true, Shared: true})
+	batchCaching.BatchProductService = batchService
+
+	firstRequest := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil))
+	_, err := caching.Get(firstRequest, "a")
+	require.NoError(t, err)
+
+	products, err := batchCaching.GetMany(firstRequest, []string{"a", "b", "missing"})
+	require.NoError(t, err)
+	assert.Len(t, products, 2)
+	assert.Equal(t, [][]string{{"b", "missing"}}, batchService.calls)
+
+	// the memo remembers the missing product, the products are shared with the CachingProductService
+	products, err = batchCaching.GetMany(firstRequest, []string{"a", "b", "missing"})
+	require.NoError(t, err)
+	assert.Len(t, products, 2)
+	assert.Len(t, batchService.calls, 1)
+	_, err = caching.Get(firstRequest, "b")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, service.calls)
+
+	// the shared cache serves the found products of other requests
+	secondRequest := web.ContextWithRequest(context.Background(), web.CreateRequest(nil, nil))
+	products, err = batchCaching.GetMany(secondRequest, []string{"a", "b", "missing"})
+	require.NoError(t, err)
+	assert.Len(t, products, 2)
+	assert.Equal(t, [][]string{{"b", "missing"}, {"missing"}}, batchService.calls)
+}
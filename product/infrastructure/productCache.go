@@ -0,0 +1,131 @@
+package infrastructure
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// SharedProductCache is a LRU cache of products shared by all requests, entries expire after the configured lifetime.
+	// The products are cached per scope (see ProductCacheScope), a product is evicted in all scopes.
+	SharedProductCache struct {
+		mutex      sync.Mutex
+		maxEntries int
+		ttl        time.Duration
+		entries    map[string]map[string]*list.Element
+		order      *list.List
+		now        func() time.Time
+	}
+
+	sharedProductCacheEntry struct {
+		scope           string
+		marketplaceCode string
+		product         domain.BasicProduct
+		expires         time.Time
+	}
+)
+
+// Inject configuration
+func (c *SharedProductCache) Inject(
+	cfg *struct {
+		MaxEntries      float64 `inject:"config:commerce.product.cache.shared.maxEntries,optional"`
+		LifetimeSeconds float64 `inject:"config:commerce.product.cache.shared.lifetimeSeconds,optional"`
+	},
+) *SharedProductCache {
+	c.entries = make(map[string]map[string]*list.Element)
+	c.order = list.New()
+	c.now = time.Now
+	if cfg != nil {
+		c.maxEntries = int(cfg.MaxEntries)
+		c.ttl = time.Duration(cfg.LifetimeSeconds * float64(time.Second))
+	}
+
+	return c
+}
+
+// Get returns the cached product of the scope if it is not expired yet
+func (c *SharedProductCache) Get(scope string, marketplaceCode string) (domain.BasicProduct, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, found := c.entries[marketplaceCode][scope]
+	if !found {
+		return nil, false
+	}
+
+	entry := element.Value.(*sharedProductCacheEntry)
+	if c.ttl > 0 && !c.now().Before(entry.expires) {
+		c.removeElement(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.product, true
+}
+
+// Set caches the product for the scope, the least recently used product is removed if the cache is full
+func (c *SharedProductCache) Set(scope string, marketplaceCode string, product domain.BasicProduct) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expires := c.now().Add(c.ttl)
+	if element, found := c.entries[marketplaceCode][scope]; found {
+		entry := element.Value.(*sharedProductCacheEntry)
+		entry.product = product
+		entry.expires = expires
+		c.order.MoveToFront(element)
+		return
+	}
+
+	if c.entries[marketplaceCode] == nil {
+		c.entries[marketplaceCode] = make(map[string]*list.Element)
+	}
+	c.entries[marketplaceCode][scope] = c.order.PushFront(&sharedProductCacheEntry{
+		scope:           scope,
+		marketplaceCode: marketplaceCode,
+		product:         product,
+		expires:         expires,
+	})
+
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// Evict removes the products of all scopes from the cache, all products are removed if no marketplace code is given
+func (c *SharedProductCache) Evict(marketplaceCodes ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(marketplaceCodes) == 0 {
+		c.entries = make(map[string]map[string]*list.Element)
+		c.order.Init()
+		return
+	}
+
+	for _, marketplaceCode := range marketplaceCodes {
+		for _, element := range c.entries[marketplaceCode] {
+			c.removeElement(element)
+		}
+	}
+}
+
+// Len returns the number of cached products
+func (c *SharedProductCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *SharedProductCache) removeElement(element *list.Element) {
+	c.order.Remove(element)
+	entry := element.Value.(*sharedProductCacheEntry)
+	delete(c.entries[entry.marketplaceCode], entry.scope)
+	if len(c.entries[entry.marketplaceCode]) == 0 {
+		delete(c.entries, entry.marketplaceCode)
+	}
+}
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xdd\x6e\xdb\xb8\x12\xbe\xf7\x53\x30\x3e\x17\x4e\x70\x5c\x3f\x40\xee\x1c\x37\x3d\x27\x68\xd2\xe6\xd4\x39\xdd\x05\x8a\x22\x18\x4b\x23\x89\x28\x45\x6a\x49\x2a\x8d\xb6\xe8\x5b\xed\x13\xec\x93\x2d\x86\xa4\x64\xfd\xda\xc9\xb6\x58\xa0\x40\x03\xb4\x40\x38\xbf\x9c\xf9\xe6\x87\x0a\x97\x16\x75\x02\x11\xb2\x8d\xca\x73\xd4\x11\xde\xdf\x6a\x15\x97\x91\x65\x5f\x66\x8c\x31\x66\xab\x02\xcf\xd9\xd6\x6a\x2e\xd3\x13\x77\x92\x83\xfe\x84\xf6\x56\x40\x84\x1b\x15\xf7\x88\x3c\x46\x69\x79\xc2\x51\xf7\x84\x30\xe6\x70\x3e\x30\x72\x7f\x43\xe7\x27\x4b\xc7\x53\x68\x1e\xe1\x08\xcf\x2d\x9d\x5f\xc9\x44\x05\x3e\xcb\xad\xe8\x99\x8d\xc0\x62\xaa\x34\x47\x33\x22\xbf\x69\x88\x9e\x39\x46\x13\x69\x5e\x58\xae\x64\x57\x8b\xc9\x94\xb6\x2f\xa7\xa8\x39\xda\xf1\x1b\x58\xf0\x0c\x42\x55\x20\x6c\x35\xc2\x73\xed\x29\x9e\x0d\xac\xd5\x7c\x57\xda\x51\x5f\xd7\x0d\xd1\x33\xef\x20\x4e\x47\x19\x2f\x1c\xe1\x64\xf6\x75\x36\x9b\xcf\xe7\xb3\x35\x33\x3c\x2f\x04\xb2\xc2\xd3\x97\xcc\x66\x60\x59\x06\x86\x49\xc5\x1e\x40\x73\xd8\x09\x6c\xd9\x66\x20\x63\x66\x33\xd4\x98\x28\x8d\xc4\xa4\x51\x00\xdd\x9a\x59\xc5\x14\x51\x6a\x5d\xc6\x59\x20\x20\x0c\xdd\xd8\x3a\xab\x35\x64\xdc\x2f\x39\x4a\x6b\x7e\xc2\xe9\x07\x87\x53\xa4\x64\xc2\xd3\x52\x3b\xd8\x04\x20\xb0\x18\x13\x2e\xd1\x10\x6e\x58\xa1\x8c\xe1\x44\x74\xe0\xa2\xfb\x19\xa6\x12\x06\x35\xf3\x8a\x5d\x59\xa6\xa4\xa8\x48\x95\x05\x2e\xcd\x8c\xcb\x44\xe9\xdc\x83\x0c\x76\xaa\xb4\x8d\x62\xa7\x83\x60\xb3\x2b\x1b\xd0\x42\x64\xf9\x03\xd6\x24\xc6\xad\x41\x91\xac\x0e\x60\x71\xd3\x72\xf9\x27\x22\x7f\x10\x44\x36\xe8\xd9\xa2\xc0\xc8\xc1\xe8\x9c\x7d\x18\x88\xbd\x1f\xb0\x9d\x7c\x7c\x1e\xa2\xe5\x00\x50\x86\x29\x89\x84\xd9\x1c\xa4\x43\x69\xa4\xd1\xe2\x1e\x8b\xae\x83\xc2\xa0\x12\x1e\x78\x8c\x66\xc5\xd6\x42\xb0\x18\x2c\xf8\xbe\x49\x45\xa1\x9c\xbe\xae\x95\x19\xb5\x59\xa9\xac\x2b\x19\xfa\xd7\xd6\xe6\x4a\x04\x84\x51\x4d\x89\xb0\x61\x89\x70\xfb\xe7\x1f\x86\x51\xa9\x71\x99\x1a\x76\xea\x5b\x73\xe3\x24\xf5\xeb\x0c\x99\x81\xbc\xab\xfb\xec\x40\xa1\xac\x9d\x8b\xef\xbd\x8a\x6f\xa9\x94\xf9\x5d\x86\xfd\x72\xa1\x80\x92\x47\x8b\xb6\x37\x8b\x7d\x57\xd8\x22\xfa\x3b\x2f\xc2\x1d\x6e\xba\xf2\x0b\x96\x28\xed\x34\xd4\x89\xea\x19\x98\xff\x2c\xd2\x7f\xba\x48\x0f\xe5\x19\x22\x5b\x82\xa8\x93\x35\xdf\x17\xf5\x20\xb3\x3d\xec\x6c\xa6\x31\xef\x31\x0e\x0f\xc0\x45\x67\xfe\xec\x47\xcd\xfc\xbb\x34\x0f\xf2\xe1\x01\x25\x47\x19\x39\x23\x05\x6a\x5b\x51\x21\x43\x14\xa1\x31\xf5\xfd\xda\x5d\x43\xc0\x0e\x85\x61\x08\x86\x8b\xca\x7b\x01\xfb\x72\x3a\xee\xcb\x7a\x82\xf9\x39\xed\x6c\xcd\x52\xad\xca\xc2\x8d\xdb\x26\x53\x2b\x76\xb9\x4a\x57\x6c\x61\xf8\xef\xb8\x98\xaa\xfc\xa1\xdd\x50\xdb\xd1\x20\x3f\xee\xa2\xbd\x94\x51\xcf\x0b\x73\x9f\x77\x06\xbf\x2f\x59\x6a\x97\xb5\x3f\xb5\x3b\x37\x8b\x25\x5b\x5c\xd3\x7f\xbf\x5e\x2f\x7c\xbc\x54\xf1\x8c\x4c\xdd\xbf\x75\xdc\x1f\xdd\xc5\x2f\xc1\x54\x2f\xac\x7a\x11\xd2\xd3\xce\x58\xcc\x4d\x21\xa0\xda\x3b\x30\x02\x2b\xe8\xcf\x80\x27\x34\xc8\xbf\x1d\xac\x07\x10\x65\x8b\xcb\xe5\x4d\x86\xbb\xbb\x78\xc1\x58\x16\x9f\x9e\xb8\x10\x98\xe0\xd2\x88\x03\xc6\x82\x1d\x6b\x64\x93\xaa\xb6\x24\x10\x32\x3d\xf7\xa9\x3a\x50\xa3\xcd\xa2\xe7\x13\x9f\x83\x8d\x32\xb7\x1a\xd2\x5c\x75\xfa\x56\x4e\xc5\x4b\x2c\x50\xc6\x5c\xa6\x34\xaa\x78\xe2\xdf\x1c\x34\x7c\x07\xd9\x60\x4a\xd3\xa8\x5c\x32\x6e\x99\xa5\x46\x49\x79\xe5\x32\x12\x65\x8c\xed\x81\x10\xfa\x2f\x59\xdd\xa1\x69\x9b\x46\x16\x95\x5a\x23\xa9\x6a\x79\x50\x5f\x26\x88\x3f\x27\x24\x61\x46\x9e\x2c\x5d\xfe\xae\x06\x41\x20\x93\xa5\x8c\x51\x8b\x8a\x6e\x78\x04\x56\xc7\xcc\x84\x5c\x4e\x8e\x36\xf2\x81\x3a\xb1\xcb\xac\xc3\x4d\x03\x28\xbf\x84\xc4\x14\xb1\x56\x18\x44\x35\x00\x3c\xca\x32\x7f\x2e\x26\x82\x5f\xce\xf6\x94\x66\xb7\xb5\xbb\xe4\xe3\x23\x44\xad\x46\xc0\xfe\xcd\x40\x88\xba\xa5\x77\x65\xda\xb8\x27\x0b\xeb\xcd\xdd\xd5\xfb\xcb\xd0\x6b\x1a\xae\xb0\xf2\xd8\x0c\xe4\x48\x47\x3e\x63\xf8\xc8\x8d\x35\xcb\x11\x1c\x7e\x83\x2b\x37\xeb\xbb\xcd\x7f\xbd\x27\x6f\x54\xc3\xf5\x9d\x4d\xad\xbc\xad\x37\x6f\xef\xbd\x39\x4a\xf0\x2f\x1a\x8a\x02\x43\x3b\x0d\xe8\x37\x2c\x07\x2e\xeb\xfd\xa2\x72\x6f\x77\x52\xbf\x5f\x38\x26\xdf\x44\x0d\x47\x03\x2e\x2e\xa7\xb7\x93\xea\x0e\xc1\xa0\x0e\x2b\x82\x10\xe7\x8c\x8d\x74\xe9\x1e\xb3\xef\xcd\xb4\x81\x8c\xb4\x09\xf7\x54\xf4\x72\x53\x3e\x3a\x49\xef\xdd\x27\xac\x3e\x2b\x1d\x1b\xb2\xeb\x7e\x3e\x04\xec\x7b\x1b\x61\x83\x19\x35\xc3\xcd\x31\x3b\xb5\xf4\x97\xf6\x9e\xc7\x7c\x9b\x1b\x67\xdd\x2f\x7d\x4e\x04\x41\x4b\x2e\xd3\xf3\x83\x22\x97\x9e\xc9\x09\x7d\x9d\x1d\x76\x65\xaf\x7f\x72\xc5\x8e\x31\x81\x52\x74\x9b\x16\x8f\x42\x9b\xe6\xe6\x25\x37\x91\x2a\xa5\xc5\xf8\x9c\x5d\x28\x25\x10\x64\x90\x6b\x11\xc6\x44\x6b\xfa\x1d\x3e\xda\xde\x86\xc9\xe5\xad\xe2\xd2\x9a\x3b\xb5\x2d\x90\xfa\xe5\x2b\xa1\xc0\xd6\x6f\xe3\xc7\x69\x22\xbd\x62\x9c\xba\xf1\xe5\x79\xe3\xc9\xbe\x8f\x6d\x33\xf5\xd9\xb7\x6c\x17\xa1\xf0\x35\x8a\x15\x4e\xb9\x8b\x34\xc6\x47\x12\xd9\x0e\x75\xbb\x45\x39\xa1\xb0\x9d\x06\xd6\x16\xe7\x92\x21\x2d\x26\x37\x5c\xa0\x59\xcb\xf8\x46\xe9\xf0\xa2\x98\x78\xde\xb8\x39\x7e\x48\x9d\xef\x88\x51\xc5\x22\x90\x6c\x87\x5e\x7d\xb8\x87\xd2\x2c\x27\x43\xf3\xc3\xb9\x9c\xc4\x49\x3b\x6e\xf5\xf2\x51\x1a\xab\x72\xd4\xff\xa1\xe5\xa1\xf7\xfe\xc8\x40\x4a\x14\xc3\x5d\x5b\xa8\x08\x44\x77\x90\x4c\x95\x62\xcc\xeb\x5a\x74\xe5\x3f\x2c\x7e\xc7\x72\x65\x31\x0f\x1b\x6b\x8a\xd6\x1d\x9d\x96\x06\xd2\xbd\x8d\xb3\xa9\x67\x96\x13\x3d\xe2\x01\xf1\x74\x2a\xc2\xfd\x74\x41\x9a\xe3\x9d\x27\xb5\x8f\x83\x0f\x7d\xee\xf0\x56\xeb\x1f\x6b\x4c\x90\x52\xf7\x94\xc8\xec\x9f\x44\x75\x78\xea\x83\xd7\x58\xd1\x2e\xdb\x34\xaa\xfe\xeb\x6a\xe4\x0d\x50\x53\x03\x77\x06\xa6\x39\x3a\xfd\x84\x55\x3b\x88\xa1\xa8\xeb\x50\x4f\xf2\x4d\x1b\x19\x88\x9a\x8b\xea\x35\x56\xa4\xa0\xed\xf7\xd9\x31\x4f\x8f\x87\x66\x72\x3d\xa6\x93\xeb\xe1\x86\x3a\xb2\xb4\x96\x92\xdb\x21\x7e\x5d\x0d\x76\x82\x3c\xe9\x4c\x77\x36\x4d\x7a\x54\x80\xcd\xba\x27\x12\xf2\x01\x8f\xc6\xd1\x75\xb1\x6b\xe3\x48\xf5\xb6\xba\xd3\x44\xfd\x1f\xe9\xd7\x47\xda\xb5\x5f\x2e\x2e\xc0\x60\xa7\x17\xef\x8f\xd7\x39\x09\x4e\x10\xff\x2f\x79\xa3\x6f\x7a\xa4\x84\xaf\x1b\x79\x01\x3c\x95\xef\x4a\x81\x03\xc0\xc7\x28\x2b\x6a\xa5\xb5\xb0\xe9\xca\xfe\xeb\xe9\xe3\xc1\x17\x2c\x3c\x6e\x04\x18\xd3\x29\xcc\xa9\x3f\x41\x20\xe8\x28\x7b\x87\xa6\x14\xb6\x99\xed\xfe\x0f\x17\x23\x90\x0e\xfe\x26\x10\x61\x97\xee\xd5\xdc\xbf\x22\xc2\xc9\xc7\xf0\x84\x2a\xd3\x14\xcd\xe0\xb5\x1a\x58\xb7\x0d\x35\x28\x35\xee\xfc\xa6\xfb\x85\x26\x30\xef\x3f\xd0\x64\x60\xfc\x96\x8d\xb1\x33\xd6\x1b\xdc\x85\x56\xb9\xf2\xdf\x7d\xfa\x3a\x6e\x6b\xd2\x34\xe4\xfc\x67\x82\xc3\x1d\xdc\xf1\xd4\x71\xe0\xda\x8c\x65\xc4\xf1\x1c\x31\xd3\x29\xae\x43\x85\xfd\x75\x36\xc3\x47\x8b\x34\xe2\x49\xdb\xff\x4a\xd4\xf5\x16\xd6\xd7\x7c\x3a\xf5\xf8\x59\x1e\xf9\xa8\x34\xd2\x02\x47\x0d\x84\x58\x9e\x9a\x80\x9a\xdf\x4a\xec\x04\x20\x84\x3a\x10\x46\x3b\x6b\x1b\x70\x74\xb9\xbf\x02\x00\x00\xff\xff\xe9\x9c\x6d\x55\x44\x1d\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xdd\x6e\x5b\x37\x12\xbe\xf7\x53\xd0\xda\x0b\xd9\x58\xd5\x9b\x6b\x5f\x14\x90\x15\x77\xd7\xd8\xb8\x75\x62\x6f\x76\x81\xa0\x30\xe8\x73\x46\x12\x51\x1e\x52\x21\x79\x1c\x9f\x06\x7d\xab\x3e\xc1\x3e\xd9\xce\x90\x3c\xff\x87\x92\xdd\x06\x05\xba\x88\x80\x04\x16\x39\x7f\x9c\xf9\x66\x38\x43\x5b\x28\x07\x66\xcd\x33\x60\x2b\x5d\x14\x60\x32\xb8\xbf\x31\x3a\x2f\x33\xc7\x3e\x1f\x31\xfc\xb8\x6a\x07\xe7\xec\xd6\x19\xa1\x36\xc7\x7e\xa5\xe0\xe6\x27\x70\x37\x12\x99\x56\x3a\x1f\x6c\x8a\x1c\x94\x13\x6b\x01\x66\xc0\x04\xb9\xe0\xe7\x23\x25\xf7\xd7\xb4\x7e\xbc\xf0\x34\x3b\x23\x32\x98\xa0\xb9\xa1\xf5\x2b\xb5\xd6\x91\xce\x09\x27\x07\x6a\x33\xee\x60\xa3\x8d\x00\x3b\xc1\xbf\x6a\x36\x03\x71\x0e\x36\x33\x62\xe7\x84\x56\x7d\x29\x76\xab\x8d\x7b\x9d\xda\x2d\xc0\x4d\x9f\xc0\xf1\x40\x20\x75\xc5\xa5\xab\x26\x68\xde\x84\x9d\x40\xc6\x1d\x0a\x7d\x28\xdd\xa4\xad\xcb\x66\x33\x10\x3f\xf0\x7c\x33\x49\x78\xe1\x37\x8e\x8f\x7e\x39\x3a\x9a\xcd\x66\x47\x4b\x66\x45\xb1\x93\x80\x4e\xf4\xfb\x0b\xe6\xb6\xdc\xb1\x2d\xb7\x4c\x69\xf6\xc8\x8d\xe0\x0f\xb8\xdb\xea\x66\x5c\xe5\x48\x03\x06\xd6\xda\x00\x11\x19\x90\x9c\x4e\xcd\x9c\x66\x9a\x76\x6a\x59\xd6\x6b\x20\x20\x8c\xcd\xb8\xf5\x5a\x6b\xc8\xf8\x2f\x05\x22\xc0\x7e\x85\xd3\x9f\x1c\x4e\x99\x56\x6b\xb1\x29\x8d\x87\x4d\x04\x02\x1e\x75\x2d\x14\x62\x07\xd1\xc1\x76\xda\x5a\x41\x9b\x1e\x5c\x74\x3e\xcb\xf4\x9a\xf1\x9a\xf8\x8c\x5d\x39\xa6\x95\xac\x48\x94\xe3\x42\xd9\x23\x81\x4e\x37\x45\x00\x19\x7f\xd0\xa5\x6b\x04\x7b\x19\x04\x1b\xb4\xb5\x06\x2d\xcf\x9c\x78\x84\x7a\x8b\x09\x67\x41\xae\xcf\xf6\x60\x71\xd5\x31\xf9\x2b\x22\xff\x24\x88\x6c\xd0\x73\x0b\x12\x32\x0f\xa3\x73\xf6\x61\xc4\xf6\x7e\x44\x76\xfc\xe3\xcb\x10\xad\x46\x80\x42\xbc\x2a\x20\xcc\x16\x5c\x79\x94\x66\x06\x1c\xb4\x58\xf4\x15\x94\x8f\x32\xe1\x11\x21\x60\xcf\xd8\x52\x4a\x96\x73\xc7\x43\xdd\xa4\xa4\xd0\x5e\x5e\x5f\xcb\x11\x95\x59\xa5\x9d\x4f\x19\xfa\xd7\x95\xe6\x53\x84\x4b\xab\x9b\x14\x61\xe3\x14\x11\xee\xbf\xbf\x5a\x46\xa9\x86\x81\xb2\xec\x24\x94\xe6\xc6\x48\xaa\xd7\x28\xd6\xf2\xa2\x2f\xfb\x74\x4f\xa2\x2c\xbd\x89\xef\x83\x88\xdf\x93\x29\xb3\x3b\x54\x3d\x48\x17\x72\x28\x59\x34\xef\x5a\x33\x6f\xab\xc2\x2d\x40\x38\xf3\x3c\x9e\xe1\xba\xcf\x3f\x67\xe8\x01\x2f\xa1\x0e\xd4\x40\xc1\xec\x6b\x92\xfe\xd1\x49\xba\x2f\xce\x88\xf7\x92\xcb\x3a\x58\xb3\x36\xa9\x47\x91\x1d\x60\x67\x95\xc6\x7c\xc0\x38\x7f\xe4\x42\xf6\xee\x9f\xf6\xaa\x99\x7d\x91\xe2\x41\x36\x3c\x82\x12\xa0\x32\xaf\x64\x07\xc6\x55\x94\xc8\x3c\xcb\xc0\xda\xfa\x7c\xdd\xaa\x81\x06\x81\xb4\x0c\xb8\x15\xb2\x0a\x56\xf0\x36\x9d\x0e\xdb\xb2\x4c\x10\xbf\xb4\x9c\xe1\x4d\xa9\x72\xe9\x73\xde\x0a\xeb\x6c\x1d\x8e\xb8\x5c\x7b\x8c\xca\x4f\xa6\x8b\x1d\x56\xa6\xb6\xa4\x61\xbf\x97\x6d\xb5\x05\xc5\xd6\x46\x17\x4c\x7b\x98\xb1\x8d\xd1\xe5\x0e\x0b\xdb\xd5\x3a\x16\xaa\x86\xab\x65\x38\x3a\x81\xb3\xcd\x19\x46\x2c\x90\x70\xe3\x4e\xbb\x4e\x1a\xf0\x58\x70\x75\x9b\x19\xd2\xaa\xad\x73\xed\x52\xb4\x3b\x1a\xd4\x4a\xd8\x53\xbf\x2e\xfc\x19\xbf\x5e\xf1\xff\x2f\x4d\xa7\x4f\xc5\x4b\xe1\x93\x7e\xbe\x16\x4f\x90\xcf\xd9\x49\x03\x64\x02\xc9\x4e\x96\x78\x07\x96\x26\xdb\x72\x83\x2c\x0d\x68\x1a\xb4\x9c\x32\xbc\x34\xe6\x79\xa5\x78\x21\xb2\x49\x6e\xff\xe3\x14\xe7\xac\x09\x1c\xba\xe9\x6e\x04\x95\x90\x1d\x7f\xf7\xc9\x31\x95\xd0\x01\x8c\x3f\xb4\x54\xc7\x3f\x1e\x77\xca\xc2\xaa\x51\x94\x66\x5e\xf6\x29\xb1\x14\x50\x96\x2f\x43\x46\x92\xc5\x9d\xbc\xf2\x2d\x76\x38\xdd\x82\xf9\x64\x9c\x17\x50\x68\x53\xcd\xb1\x25\x89\x1c\x9f\xd0\x95\xac\x10\x6a\xb5\xd5\xfe\xcc\xdf\xb2\x57\xd4\xed\x18\xf8\x58\x0a\x03\xf9\x02\xe1\xff\x54\xef\xbd\x42\x4c\x70\xe5\x1b\x6e\x29\x0a\xe1\xf6\xe7\x5c\xe7\x98\x31\xb7\xb2\x51\xfa\xf8\x0a\x39\x80\x5d\x63\xcb\x39\xbb\xc2\x03\xc6\x1c\x7c\x1a\x2f\x66\xf5\x4a\xca\x57\x81\x85\x7c\x1c\x7c\xd4\xb8\x26\xd4\xb6\x8c\x2b\xf6\xd0\x54\x13\xac\x53\xf8\xbd\x5b\xdf\x16\x64\xcb\x5b\x2c\xf2\x7f\x23\xfd\xf4\xc3\xcb\x1c\x10\xd4\x27\xcf\x1e\xcb\xee\x18\xeb\xb5\x1b\xde\x52\x6e\x75\x5d\xd0\x5f\xc0\x01\x8b\x97\xd2\xf5\x17\x1b\xdc\xf7\xc4\xa2\x19\xe4\x82\x7d\xc6\x0e\x70\x15\xad\xee\x00\x7a\x5c\xfb\x82\xff\xc7\xeb\x1f\x7b\x16\xa5\x8f\x79\xc0\xa2\xc6\x96\xe6\xde\xfb\x32\x46\x11\x16\xee\xc2\x2d\x12\xef\x14\xde\x22\x63\xe1\x33\x3e\xba\x96\x7d\x2c\xf1\x1a\x17\x18\xf8\xf6\xe2\x21\x16\xcc\x8f\xd2\x42\xce\xc4\x9a\xc4\xd2\xd7\x57\x33\x1c\x58\x77\xd8\x8d\x3c\xfb\x2c\x57\x9e\xfc\x8b\x1d\xa8\x53\x00\xda\x7a\x7b\xc6\x2e\x7d\xd2\x5b\xf1\x33\xcc\x53\x58\x1d\xb7\x17\x2f\xc9\xd6\x19\x8d\x36\x71\xbc\x17\xbd\xf9\x3e\x74\xe6\xd4\x42\xd4\xf6\xd4\xe6\x5c\xcf\x17\x6c\xfe\x86\xfe\xfb\xcf\x9b\xf9\xac\xe3\x81\x67\x36\x64\xf7\xa1\xb2\x84\xca\x77\xc9\x6d\xf5\x8d\xd3\xdf\xc4\x2e\xac\xdb\x98\xe5\xc2\xee\x24\xaf\x5a\x03\x26\xba\x47\x3e\x1c\xf5\x9e\x31\x07\xfd\x66\x67\x3d\x72\x59\x76\xa8\x7c\xdc\x9a\x92\x43\xfe\xe2\x53\x51\x7c\x7e\xe0\xa2\x63\xa2\x49\x13\x06\x58\x87\xad\xc1\xf9\x0b\x44\xdd\x12\x43\x8c\xf4\x2c\x84\x6a\x4f\x2b\xde\xbc\xe7\x84\xc0\xe3\x5e\xb6\xf5\x2f\x40\x34\x3e\x7b\x79\x67\x5e\xc4\x6b\xd8\x81\xca\xd1\x2a\x9a\x48\xc5\x3a\x3c\x2d\x52\x1a\x8d\xa2\x41\x37\x35\x4e\xc4\x0b\x9c\x6c\x99\xa3\x8e\x86\xe2\x2a\x54\x26\xcb\x1c\xba\x73\x5f\x6c\x94\x48\xeb\x03\xd8\xae\x6a\x4c\xda\xd2\x18\xaa\x68\x5d\x0b\xea\xc3\x44\xf6\x97\xb8\x24\x8e\xc2\xd8\x9b\x51\xfc\xae\x46\x4e\x20\x95\x98\xf3\x60\x64\x45\x27\x3c\x00\xab\x43\x6a\x62\x2c\x93\x3d\x68\x5d\xcc\x7c\x64\x3d\x6e\x1a\x40\x85\xb7\x86\x9c\x3c\xd6\x71\x83\xac\x46\x80\x07\x55\x16\x2f\xc5\x44\xb4\x2b\x14\xd2\x84\x64\xff\x38\xe7\x83\x0f\x4f\x3c\xeb\x14\x02\xf6\x57\x9c\xea\x65\x3d\xb9\xf5\x79\xba\xb8\x27\x0d\xcb\xd5\xdd\xd5\xfb\xcb\x58\x6b\x1a\xaa\xf8\xb2\x81\x01\x57\x13\x83\xd7\x29\x2a\xa4\x39\x67\x31\x81\xc3\xdf\x61\xca\xf5\xf2\x6e\xf5\x8f\x60\xc9\xf7\xba\xa1\xfa\xc2\xaa\xce\x82\xae\xef\x7f\xb8\x0f\xea\x28\xc0\xff\x36\x7c\xb7\x83\x58\x4e\x23\xfa\x2d\xea\xc2\x96\x25\x0e\x02\x95\x9f\x9d\x48\x7c\x3b\x19\x24\x9f\x3e\x1b\x8a\x06\x5c\x42\xa5\xc7\x88\xea\x0e\xe7\x57\x30\xb1\x4f\x95\x58\x51\xd8\x44\x95\x1e\x10\x87\xda\x4c\xa3\xc2\x44\x99\x08\xf3\x9c\xe7\x4b\xd9\xe8\x39\x83\x75\x3f\x41\xf5\x49\x9b\xdc\x92\x5e\xff\xf9\x10\xb1\x1f\x74\xc4\x51\x63\x52\x8d\xb0\x87\xf4\xd4\xdc\x9f\xbb\x03\x19\x0b\x65\x6e\x9a\xb4\x9d\xce\x3c\x0b\x70\xa3\xd0\x98\xf3\xbd\x2c\x97\x81\xc8\x33\x25\x9b\x9e\x91\xfc\xe4\x40\x1a\xbb\x93\x61\x87\x17\x07\x52\xfb\x5a\xd8\x4c\x97\x0a\x53\xff\x9c\x5d\x68\x2d\xb1\x63\x8d\x7c\x9d\x8d\x29\xd6\x7a\xff\x0e\x9e\xdc\xa8\x27\xbf\xd1\x02\x47\x8a\x3b\x7d\x8b\xa5\x1b\x77\xbf\x93\x9a\xb7\x8d\x69\x7a\x93\x86\x78\x2f\x6e\x7a\xca\x5d\x85\xed\x50\xc7\x6e\xb7\xfa\x53\x28\xd9\xde\x43\xcd\x6b\x80\x17\xee\x3d\x0d\xf9\x81\x40\x76\x5d\xdd\x2d\x51\x9e\x29\x36\x71\x91\xb4\x43\x19\x87\xa3\x6b\x21\xc1\x2e\x55\x7e\xad\x4d\x7c\x38\x4c\xbc\x62\xfa\x7b\x7c\x9f\xb8\x50\x11\xb3\xaa\x1e\x31\xbc\xf8\x78\x0e\xbc\xd1\x0a\x52\x34\xdb\x1f\xcb\x24\x4e\xba\x7e\xab\x9b\x8f\xd2\x3a\x8d\x54\xbe\x83\x1c\x76\x8f\x5c\x29\x90\xe3\xf6\x51\xea\x8c\xcb\xfe\x45\x92\x4a\xc5\x5c\xd4\xb9\xe8\xd3\xff\xc3\x34\xc9\x95\x83\x22\x3e\x4c\x6d\xc0\xf9\xa5\x93\xd2\xf2\x4d\xab\xe3\x34\xf5\x1e\xe2\x59\x0f\x58\x40\x34\xbd\x8c\xf0\x9f\x3e\x48\x0b\x08\x23\x79\x6f\x39\xda\x30\xa4\x8e\x8f\x2a\xc3\x65\x03\x6b\xa0\xd0\x3d\xc7\x33\xed\xdb\x45\xed\x9e\x7a\xe1\x9f\x50\x51\x2f\xdb\x14\xaa\xe1\x33\xc8\x87\xb4\xac\x48\x8d\x57\x67\xb3\x74\x82\x25\xb0\xeb\xc4\x98\xd4\xb5\xab\x93\x74\x69\x25\x23\x56\x7b\x51\xa1\xcd\x24\xa0\x6b\xf7\xe9\x21\x4b\x0f\xbb\x26\xd9\x1e\xd3\xca\x9b\x71\x87\x3a\xd1\xb4\x96\x4a\xb8\x31\x7e\x7d\x0e\xf6\x9c\x9c\x34\xa6\x7f\x37\xa5\xe7\x71\xee\xb6\xfd\x15\xc5\x8b\x11\x8d\x81\xc9\x76\xb1\xaf\xe3\x40\xf6\x76\xaa\x53\x22\xff\x0f\xd4\xeb\x03\xe5\x3a\x34\x17\x17\x68\x49\xaf\x16\xb7\xcb\xcb\x82\x18\x13\x9b\xff\x42\x7f\xd7\xf2\xd2\x57\x4a\x7c\x86\x2c\x76\x5c\x6c\xd4\xbb\x52\xc2\x08\xf0\x39\xa8\x8a\x4a\x69\xcd\x6c\xfb\xbc\x7f\x79\xfe\xf5\x10\x12\x96\x3f\xad\x24\xb7\xb6\x97\x98\xa9\xbf\x34\xc0\xcb\x22\xdb\xbe\x03\x4b\x43\xfc\xe7\xee\x33\xc4\x54\xf2\x45\x7b\xe9\x2f\x5b\xfa\xfb\x41\xcc\xfd\x77\xb4\x51\xbf\xd4\xd9\x72\xb3\xc1\x09\x63\x38\xad\x46\xd2\xdb\x66\x37\x0a\xb5\x7e\xfd\xba\xff\x94\x1a\x89\xdb\x97\x54\xcc\xf6\xd0\x65\x43\xee\x95\x0d\x2e\x6e\xb4\xbd\xd0\xe1\x81\x76\x28\xe3\xa6\xde\xda\xf3\x9a\xe2\x5f\x4e\xf7\x57\x70\x4f\x53\xfb\x41\x18\xeb\x52\x2f\xb0\x07\xd4\xf4\x92\x6b\x5f\x62\xa3\x18\x0c\x2c\xd0\x15\x4f\xd2\xde\x96\x60\xea\x2e\x6c\x28\xf9\x24\x35\xfc\x2c\x0e\xfc\xee\xe8\x34\xf1\xb4\x96\x80\xcb\x89\x8d\xa8\xf9\x88\x85\xc5\x8d\x5d\x1d\x37\x26\x2b\x6b\x17\x70\x74\xb8\xff\x01\x55\x6b\x74\x2c\x2b\x25\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
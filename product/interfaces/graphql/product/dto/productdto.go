@@ -95,6 +95,7 @@ var (
 	_ Product = SimpleProduct{}
 	_ Product = ConfigurableProduct{}
 	_ Product = ActiveVariantProduct{}
+	_ Product = BundleProduct{}
 )
 
 // GetMedia returns the FIRST found Product media by usage
@@ -144,6 +145,10 @@ func NewGraphqlProductDto(product productDomain.BasicProduct, preSelectedVariant
 		}
 	}
 
+	if product.Type() == productDomain.TypeBundle || product.Type() == productDomain.TypeBundleWithActiveChoices {
+		return NewBundleProduct(product)
+	}
+
 	simpleProduct := product.(productDomain.SimpleProduct)
 	return SimpleProduct{
 		product: simpleProduct,
@@ -0,0 +1,200 @@
+package graphqlproductdto
+
+import (
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// BundleProduct is a bundle with its option groups, the active components are set if components are chosen
+	BundleProduct struct {
+		product       productDomain.BundleProduct
+		activeChoices *productDomain.BundleProductWithActiveChoices
+	}
+
+	// BundleOptionGroup a group of choices of a bundle
+	BundleOptionGroup struct {
+		Code       string
+		Label      string
+		MinChoices int
+		MaxChoices int
+		Choices    []BundleChoice
+	}
+
+	// BundleChoice a component that can be chosen
+	BundleChoice struct {
+		Code       string
+		Product    Product
+		MinQty     int
+		MaxQty     int
+		DefaultQty int
+		Surcharge  *priceDomain.Price
+	}
+
+	// BundleActiveComponent a chosen component of the bundle
+	BundleActiveComponent struct {
+		OptionGroupCode string
+		ChoiceCode      string
+		Qty             int
+		Product         Product
+	}
+)
+
+// NewBundleProduct returns the dto of a bundle product with or without active choices
+func NewBundleProduct(product productDomain.BasicProduct) BundleProduct {
+	switch bundle := product.(type) {
+	case productDomain.BundleProductWithActiveChoices:
+		return BundleProduct{product: bundle.BundleProduct, activeChoices: &bundle}
+	case productDomain.BundleProduct:
+		return BundleProduct{product: bundle}
+	}
+
+	return BundleProduct{}
+}
+
+// Type of the bundle
+func (bp BundleProduct) Type() string {
+	return bp.Product().Type()
+}
+
+// Product the base product
+func (bp BundleProduct) Product() productDomain.BasicProduct {
+	if bp.activeChoices != nil {
+		return *bp.activeChoices
+	}
+	return bp.product
+}
+
+// MarketPlaceCode of the bundle
+func (bp BundleProduct) MarketPlaceCode() string {
+	return bp.product.BaseData().MarketPlaceCode
+}
+
+// Identifier of the bundle
+func (bp BundleProduct) Identifier() string {
+	return bp.product.GetIdentifier()
+}
+
+// Media of the bundle
+func (bp BundleProduct) Media() ProductMedia {
+	return ProductMedia{All: bp.product.TeaserData().Media}
+}
+
+// Price of the bundle, the base price or the price with the chosen components
+func (bp BundleProduct) Price() productDomain.PriceInfo {
+	return bp.Product().SaleableData().ActivePrice
+}
+
+// Title of the bundle
+func (bp BundleProduct) Title() string {
+	return bp.product.BaseData().Title
+}
+
+// Categories of the bundle
+func (bp BundleProduct) Categories() ProductCategories {
+	return ProductCategories{
+		Main: bp.product.BaseData().MainCategory,
+		All:  bp.product.BaseData().Categories,
+	}
+}
+
+// Description of the bundle
+func (bp BundleProduct) Description() string {
+	return bp.product.BaseData().Description
+}
+
+// ShortDescription of the bundle
+func (bp BundleProduct) ShortDescription() string {
+	return bp.product.BaseData().ShortDescription
+}
+
+// Meta of the bundle
+func (bp BundleProduct) Meta() ProductMeta {
+	return ProductMeta{
+		Keywords: bp.product.BaseData().Keywords,
+	}
+}
+
+// Loyalty of the bundle
+func (bp BundleProduct) Loyalty() ProductLoyalty {
+	return ProductLoyalty{
+		Price:   bp.product.TeaserData().TeaserLoyaltyPriceInfo,
+		Earning: bp.product.TeaserData().TeaserLoyaltyEarningInfo,
+	}
+}
+
+// Attributes of the bundle
+func (bp BundleProduct) Attributes() productDomain.Attributes {
+	return bp.product.BaseData().Attributes
+}
+
+// Badges of the bundle
+func (bp BundleProduct) Badges() ProductBadges {
+	return ProductBadges{
+		All: bp.product.BaseData().Badges,
+	}
+}
+
+// PricingType of the bundle, fixed or dynamic
+func (bp BundleProduct) PricingType() string {
+	if bp.product.PricingRule.Type == "" {
+		return productDomain.BundlePricingFixed
+	}
+	return bp.product.PricingRule.Type
+}
+
+// OptionGroups of the bundle
+func (bp BundleProduct) OptionGroups() []BundleOptionGroup {
+	groups := make([]BundleOptionGroup, 0, len(bp.product.OptionGroups))
+	for _, group := range bp.product.OptionGroups {
+		choices := make([]BundleChoice, 0, len(group.Choices))
+		for _, choice := range group.Choices {
+			dtoChoice := BundleChoice{
+				Code:       choice.Code,
+				MinQty:     choice.MinQty,
+				MaxQty:     choice.MaxQty,
+				DefaultQty: choice.DefaultQty,
+			}
+			if choice.Product != nil {
+				dtoChoice.Product = NewGraphqlProductDto(choice.Product, nil)
+			}
+			if !choice.Surcharge.IsZero() {
+				surcharge := choice.Surcharge
+				dtoChoice.Surcharge = &surcharge
+			}
+			choices = append(choices, dtoChoice)
+		}
+
+		groups = append(groups, BundleOptionGroup{
+			Code:       group.Code,
+			Label:      group.Label,
+			MinChoices: group.MinChoices,
+			MaxChoices: group.MaxChoices,
+			Choices:    choices,
+		})
+	}
+
+	return groups
+}
+
+// ActiveComponents the chosen components, empty if no components are chosen
+func (bp BundleProduct) ActiveComponents() []BundleActiveComponent {
+	if bp.activeChoices == nil {
+		return nil
+	}
+
+	components := make([]BundleActiveComponent, 0, len(bp.activeChoices.ActiveComponents))
+	for _, component := range bp.activeChoices.ActiveComponents {
+		dtoComponent := BundleActiveComponent{
+			OptionGroupCode: component.OptionGroupCode,
+			ChoiceCode:      component.Choice.Code,
+			Qty:             component.Qty,
+		}
+		if component.Choice.Product != nil {
+			dtoComponent.Product = NewGraphqlProductDto(component.Choice.Product, nil)
+		}
+		components = append(components, dtoComponent)
+	}
+
+	return components
+}
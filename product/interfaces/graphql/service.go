@@ -24,6 +24,12 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Product_SimpleProduct", graphqlProductDto.SimpleProduct{})
 	types.Map("Commerce_Product_ConfigurableProduct", graphqlProductDto.ConfigurableProduct{})
 	types.Map("Commerce_Product_ActiveVariantProduct", graphqlProductDto.ActiveVariantProduct{})
+	types.Map("Commerce_Product_BundleProduct", graphqlProductDto.BundleProduct{})
+	types.Map("Commerce_Product_BundleOptionGroup", graphqlProductDto.BundleOptionGroup{})
+	types.Map("Commerce_Product_BundleChoice", graphqlProductDto.BundleChoice{})
+	types.Map("Commerce_Product_BundleActiveComponent", graphqlProductDto.BundleActiveComponent{})
+	types.Map("Commerce_Product_BundleComponentSelection", domain.BundleComponentSelection{})
+	types.Map("Commerce_Product_BundleComponentSelectionInput", domain.BundleComponentSelection{})
 	types.Map("Commerce_Product_VariationSelection", graphqlProductDto.VariationSelection{})
 	types.Map("Commerce_Product_ActiveVariationSelection", graphqlProductDto.ActiveVariationSelection{})
 	types.Map("Commerce_Product_VariationSelection_Option", graphqlProductDto.VariationSelectionOption{})
@@ -4,6 +4,7 @@ import (
 	"flamingo.me/dingo"
 	"github.com/lunarforge/flamingo_commerce/price"
 	"github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/product/infrastructure"
 	"github.com/lunarforge/flamingo_commerce/product/infrastructure/fake"
 	"github.com/lunarforge/flamingo_commerce/product/interfaces/controller"
 	productgraphql "github.com/lunarforge/flamingo_commerce/product/interfaces/graphql"
@@ -18,6 +19,7 @@ import (
 type Module struct {
 	fakeService bool
 	api         bool
+	cache       bool
 }
 
 // Inject module configuration
@@ -25,11 +27,13 @@ func (m *Module) Inject(
 	cfg *struct {
 		FakeService bool `inject:"config:commerce.product.fakeservice.enabled,optional"`
 		API         bool `inject:"config:commerce.product.api.enabled,optional"`
+		Cache       bool `inject:"config:commerce.product.cache.enabled,optional"`
 	},
 ) *Module {
 	if cfg != nil {
 		m.api = cfg.API
 		m.fakeService = cfg.FakeService
+		m.cache = cfg.Cache
 	}
 
 	return m
@@ -52,6 +56,14 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.Override((*domain.SearchService)(nil), "").To(fake.SearchService{}).In(dingo.ChildSingleton)
 	}
 
+	if m.cache {
+		injector.Bind(new(infrastructure.SharedProductCache)).In(dingo.Singleton)
+		injector.Bind(new(infrastructure.ProductCacheScope)).To(infrastructure.ConfiguredProductCacheScope{})
+		injector.BindInterceptor(new(domain.ProductService), infrastructure.CachingProductService{})
+		injector.BindInterceptor(new(domain.BatchProductService), infrastructure.CachingBatchProductService{})
+		flamingo.BindEventSubscriber(injector).To(new(infrastructure.ProductCacheInvalidator))
+	}
+
 }
 
 // Depends adds our dependencies
@@ -83,6 +95,17 @@ commerce: {
 		api: {
 			enabled: bool | *true
 		}
+		maxParallelLookups: number | *10
+		cache: {
+			enabled: bool | *false
+			requestMemo: bool | *true
+			channel: string | *""
+			shared: {
+				enabled: bool | *true
+				maxEntries: number | *10000
+				lifetimeSeconds: number | *300
+			}
+		}
 		pagination: defaultPageSize: number | *commerce.pagination.defaultPageSize
 	}
 }`
@@ -27,6 +27,7 @@ type (
 		sourcingService     domain.SourcingService
 		cartReceiverService *application.CartReceiverService
 		deliveryInfoBuilder cart.DeliveryInfoBuilder
+		stockReservation    domain.StockReservationService
 	}
 )
 
@@ -40,12 +41,18 @@ func (s *Service) Inject(
 	cartReceiverService *application.CartReceiverService,
 	sourcingService domain.SourcingService,
 	deliveryInfoBuilder cart.DeliveryInfoBuilder,
+	optionals *struct {
+		StockReservationService domain.StockReservationService `inject:",optional"`
+	},
 ) *Service {
 	s.logger = l.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "Application.Service")
 
 	s.cartReceiverService = cartReceiverService
 	s.deliveryInfoBuilder = deliveryInfoBuilder
 	s.sourcingService = sourcingService
+	if optionals != nil {
+		s.stockReservation = optionals.StockReservationService
+	}
 
 	return s
 }
@@ -62,7 +69,12 @@ func (s *Service) GetAvailableSourcesDeductedByCurrentCart(ctx context.Context,
 		return nil, err
 	}
 
-	return s.sourcingService.GetAvailableSources(ctx, product, deliveryInfo, decoratedCart)
+	availableSources, err := s.sourcingService.GetAvailableSources(ctx, product, deliveryInfo, decoratedCart)
+	if err != nil {
+		return availableSources, err
+	}
+
+	return s.deductReservations(ctx, product, availableSources)
 }
 
 // GetAvailableSources without evaluating current cart items
@@ -78,7 +90,28 @@ func (s *Service) GetAvailableSources(ctx context.Context, session *web.Session,
 		return nil, err
 	}
 
-	return s.sourcingService.GetAvailableSources(ctx, product, deliveryInfo, nil)
+	availableSources, err := s.sourcingService.GetAvailableSources(ctx, product, deliveryInfo, nil)
+	if err != nil {
+		return availableSources, err
+	}
+
+	return s.deductReservations(ctx, product, availableSources)
+}
+
+// deductReservations reduces the available sources by the active stock reservations of the product
+func (s *Service) deductReservations(ctx context.Context, product productDomain.BasicProduct, availableSources domain.AvailableSources) (domain.AvailableSources, error) {
+	if s.stockReservation == nil {
+		return availableSources, nil
+	}
+
+	// the reservations of the running place order process belong to the current cart and must not be deducted
+	reserved, err := s.stockReservation.ReservedQtys(ctx, product.GetIdentifier(), domain.StockReservationReferenceFromContext(ctx))
+	if err != nil {
+		s.logger.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	return availableSources.Reduce(reserved), nil
 }
 
 func (s *Service) getDeliveryInfo(ctx context.Context, session *web.Session, deliveryCode string) (*cart.DeliveryInfo, *decorator.DecoratedCart, error) {
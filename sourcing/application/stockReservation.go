@@ -0,0 +1,109 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/pkg/errors"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/decorator"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	// StockReservationApplication reserves the stock of a cart while it is placed
+	StockReservationApplication interface {
+		// ReserveCart allocates the items of the cart and reserves the allocated qtys under the reference
+		ReserveCart(ctx context.Context, reference string, cart cart.Cart) error
+		// CommitReservation keeps the reservations of the reference for the placed order
+		CommitReservation(ctx context.Context, reference string) error
+		// ReleaseReservation frees the reserved stock of the reference
+		ReleaseReservation(ctx context.Context, reference string) error
+	}
+
+	// StockReservation reserves the stock of a cart with the bound SourcingService and StockReservationService
+	StockReservation struct {
+		logger               flamingo.Logger
+		sourcingService      domain.SourcingService
+		reservationService   domain.StockReservationService
+		decoratedCartFactory *decorator.DecoratedCartFactory
+	}
+)
+
+var _ StockReservationApplication = new(StockReservation)
+
+// Inject dependencies
+func (s *StockReservation) Inject(
+	l flamingo.Logger,
+	sourcingService domain.SourcingService,
+	reservationService domain.StockReservationService,
+	decoratedCartFactory *decorator.DecoratedCartFactory,
+) *StockReservation {
+	s.logger = l.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "Application.StockReservation")
+	s.sourcingService = sourcingService
+	s.reservationService = reservationService
+	s.decoratedCartFactory = decoratedCartFactory
+
+	return s
+}
+
+// ReserveCart allocates the cart items and reserves the allocated qtys, existing reservations of the reference are replaced
+func (s *StockReservation) ReserveCart(ctx context.Context, reference string, cart cart.Cart) error {
+	ctx = domain.ContextWithStockReservationReference(ctx, reference)
+	decoratedCart := s.decoratedCartFactory.Create(ctx, cart)
+
+	allocations, err := s.sourcingService.AllocateItems(ctx, decoratedCart)
+	if err != nil {
+		return errors.Wrap(err, "stock cannot be allocated")
+	}
+
+	var reservations []domain.StockReservation
+	for _, delivery := range decoratedCart.DecoratedDeliveries {
+		for _, item := range delivery.DecoratedItems {
+			allocation, found := allocations[domain.ItemID(item.Item.ID)]
+			if !found {
+				continue
+			}
+			if allocation.Error != nil {
+				return errors.Wrapf(allocation.Error, "stock for item %q cannot be allocated", item.Item.ID)
+			}
+
+			deliveryInfo := delivery.Delivery.DeliveryInfo
+			availableSources, err := s.sourcingService.GetAvailableSources(ctx, item.Product, &deliveryInfo, nil)
+			if err != nil {
+				return errors.Wrapf(err, "stock for item %q cannot be checked", item.Item.ID)
+			}
+
+			reservations = append(reservations, domain.StockReservationsFromAllocations(item.Product.GetIdentifier(), allocation.AllocatedQtys, availableSources)...)
+		}
+	}
+
+	err = s.reservationService.Reserve(ctx, reference, reservations)
+	if err != nil {
+		s.logger.WithContext(ctx).Info("stock reservation for ", reference, " failed: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// CommitReservation keeps the reservations of the reference for the placed order
+func (s *StockReservation) CommitReservation(ctx context.Context, reference string) error {
+	err := s.reservationService.Commit(ctx, reference)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("stock reservation commit for ", reference, " failed: ", err)
+	}
+
+	return err
+}
+
+// ReleaseReservation frees the reserved stock of the reference
+func (s *StockReservation) ReleaseReservation(ctx context.Context, reference string) error {
+	err := s.reservationService.Release(ctx, reference)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("stock reservation release for ", reference, " failed: ", err)
+	}
+
+	return err
+}
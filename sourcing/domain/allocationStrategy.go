@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+)
+
+type (
+	// AllocationStrategy decides in which order the sources of an item are used by the DefaultSourcingService.
+	// The item qty is taken from the returned sources one after another until it is allocated completely.
+	AllocationStrategy interface {
+		// Rank returns the candidates in the order they should be used for the qty.
+		// The candidates are passed in the order of the AvailableSourcesProvider and only contain sources with stock
+		Rank(ctx context.Context, qty int, candidates []SourceStock, deliveryInfo cartDomain.DeliveryInfo) []SourceStock
+	}
+
+	// CartAllocationStrategy can be implemented by an AllocationStrategy that ranks the sources for all items of the cart at once.
+	// The DefaultSourcingService then uses the returned order of sources for every item instead of calling Rank per item
+	CartAllocationStrategy interface {
+		// RankCart returns the sources in the order they should be used for the items, sources that are not returned are used last
+		RankCart(ctx context.Context, items []ItemSourceStock) []Source
+	}
+
+	// ItemSourceStock is the qty of a cart item and the stock of its candidate sources before allocation
+	ItemSourceStock struct {
+		ItemID       ItemID
+		ProductID    string
+		Qty          int
+		DeliveryInfo cartDomain.DeliveryInfo
+		Candidates   []SourceStock
+	}
+
+	// SourceStock is the remaining stock of a source during allocation
+	SourceStock struct {
+		Source Source
+		Qty    int
+	}
+
+	// Coordinates of a source or a delivery location
+	Coordinates struct {
+		Latitude  float64
+		Longitude float64
+	}
+
+	// DeliveryLocationGeocoder is a secondary port to detect the coordinates of a delivery, used by the NearestSourceAllocationStrategy.
+	// The module binds a configuration based adapter if the nearestSource strategy is chosen
+	DeliveryLocationGeocoder interface {
+		Coordinates(ctx context.Context, deliveryInfo cartDomain.DeliveryInfo) (Coordinates, bool)
+	}
+
+	// SourceOrderAllocationStrategy uses the sources in the order of the AvailableSourcesProvider
+	SourceOrderAllocationStrategy struct{}
+
+	// MinimizeSourcesAllocationStrategy uses the sources that can serve most of the cart first, so that the cart is split over as few sources as possible
+	MinimizeSourcesAllocationStrategy struct{}
+
+	// PriorityAllocationStrategy uses the sources in the configured priority order, sources without priority are used last
+	PriorityAllocationStrategy struct {
+		logger     flamingo.Logger
+		priorities map[string]int
+	}
+
+	// NearestSourceAllocationStrategy uses the source nearest to the delivery location first.
+	// Sources without configured coordinates are used last, without delivery coordinates the order of the AvailableSourcesProvider is used
+	NearestSourceAllocationStrategy struct {
+		logger      flamingo.Logger
+		coordinates map[string]Coordinates
+		geocoder    DeliveryLocationGeocoder
+	}
+
+	rankedSource struct {
+		SourceStock
+		rank float64
+	}
+)
+
+const (
+	// AllocationStrategySourceOrder config value for the SourceOrderAllocationStrategy
+	AllocationStrategySourceOrder = "sourceOrder"
+	// AllocationStrategyMinimizeSources config value for the MinimizeSourcesAllocationStrategy
+	AllocationStrategyMinimizeSources = "minimizeSources"
+	// AllocationStrategyPriority config value for the PriorityAllocationStrategy
+	AllocationStrategyPriority = "priority"
+	// AllocationStrategyNearestSource config value for the NearestSourceAllocationStrategy
+	AllocationStrategyNearestSource = "nearestSource"
+
+	earthRadiusKm = 6371.0
+)
+
+var (
+	_ AllocationStrategy = new(SourceOrderAllocationStrategy)
+	_ AllocationStrategy = new(MinimizeSourcesAllocationStrategy)
+	_ AllocationStrategy = new(PriorityAllocationStrategy)
+	_ AllocationStrategy = new(NearestSourceAllocationStrategy)
+
+	_ CartAllocationStrategy = new(MinimizeSourcesAllocationStrategy)
+)
+
+// Rank keeps the order of the AvailableSourcesProvider
+func (SourceOrderAllocationStrategy) Rank(_ context.Context, _ int, candidates []SourceStock, _ cartDomain.DeliveryInfo) []SourceStock {
+	return sortSources(candidates, func(position int, _ SourceStock) float64 { return float64(position) })
+}
+
+// Rank orders the sources of a single item by descending stock
+func (MinimizeSourcesAllocationStrategy) Rank(_ context.Context, _ int, candidates []SourceStock, _ cartDomain.DeliveryInfo) []SourceStock {
+	return sortSources(candidates, func(_ int, candidate SourceStock) float64 { return -float64(candidate.Qty) })
+}
+
+// RankCart orders the sources so that the whole cart is split over as few sources as possible:
+// the source that can serve most of the remaining qty of all items is used next
+func (MinimizeSourcesAllocationStrategy) RankCart(_ context.Context, items []ItemSourceStock) []Source {
+	remainingQtys := make([]int, len(items))
+	// stock per product and source, shared by the items of the same product
+	stock := make(map[string]map[Source]int)
+	var sources []Source
+	for i, item := range items {
+		remainingQtys[i] = item.Qty
+		if stock[item.ProductID] == nil {
+			stock[item.ProductID] = make(map[Source]int)
+		}
+		for _, candidate := range item.Candidates {
+			if _, known := stock[item.ProductID][candidate.Source]; !known {
+				stock[item.ProductID][candidate.Source] = candidate.Qty
+			}
+			if !containsSource(sources, candidate.Source) {
+				sources = append(sources, candidate.Source)
+			}
+		}
+	}
+
+	// equal coverage is decided by the location codes, so that the result does not depend on the order of the items
+	sort.SliceStable(sources, func(i, j int) bool {
+		if sources[i].LocationCode != sources[j].LocationCode {
+			return sources[i].LocationCode < sources[j].LocationCode
+		}
+		return sources[i].ExternalLocationCode < sources[j].ExternalLocationCode
+	})
+
+	var result []Source
+	for len(sources) > 0 {
+		best, bestQty := -1, 0
+		for i, source := range sources {
+			if qty := coveredQty(items, remainingQtys, stock, source, false); qty > bestQty {
+				best, bestQty = i, qty
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		coveredQty(items, remainingQtys, stock, sources[best], true)
+		result = append(result, sources[best])
+		sources = append(sources[:best], sources[best+1:]...)
+	}
+
+	return result
+}
+
+// coveredQty returns the remaining qty of the items the source can serve, with apply the remaining qtys and the stock are reduced accordingly
+func coveredQty(items []ItemSourceStock, remainingQtys []int, stock map[string]map[Source]int, source Source, apply bool) int {
+	used := make(map[string]int)
+	covered := 0
+	for i, item := range items {
+		if !containsCandidate(item.Candidates, source) {
+			continue
+		}
+		qty := min(remainingQtys[i], stock[item.ProductID][source]-used[item.ProductID])
+		if qty <= 0 {
+			continue
+		}
+		used[item.ProductID] += qty
+		covered += qty
+		if apply {
+			remainingQtys[i] -= qty
+		}
+	}
+
+	if apply {
+		for productID, qty := range used {
+			stock[productID][source] -= qty
+		}
+	}
+
+	return covered
+}
+
+func containsSource(sources []Source, source Source) bool {
+	for _, s := range sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCandidate(candidates []SourceStock, source Source) bool {
+	for _, candidate := range candidates {
+		if candidate.Source == source {
+			return true
+		}
+	}
+	return false
+}
+
+// Inject dependencies
+func (p *PriorityAllocationStrategy) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Priority config.Slice `inject:"config:commerce.sourcing.allocation.priority,optional"`
+	},
+) *PriorityAllocationStrategy {
+	p.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "PriorityAllocationStrategy")
+	p.priorities = make(map[string]int)
+	if cfg != nil && cfg.Priority != nil {
+		var locationCodes []string
+		if err := cfg.Priority.MapInto(&locationCodes); err != nil {
+			p.logger.Error("invalid commerce.sourcing.allocation.priority, sources are ordered by location code: ", err)
+			return p
+		}
+		for i, locationCode := range locationCodes {
+			if _, exists := p.priorities[locationCode]; !exists {
+				p.priorities[locationCode] = i
+			}
+		}
+	}
+
+	return p
+}
+
+// Rank orders the sources by the configured priority of their location code
+func (p *PriorityAllocationStrategy) Rank(_ context.Context, _ int, candidates []SourceStock, _ cartDomain.DeliveryInfo) []SourceStock {
+	return sortSources(candidates, func(_ int, candidate SourceStock) float64 {
+		if priority, found := p.priorities[candidate.Source.LocationCode]; found {
+			return float64(priority)
+		}
+		return math.MaxInt32
+	})
+}
+
+// Inject dependencies
+func (n *NearestSourceAllocationStrategy) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		SourceCoordinates config.Map `inject:"config:commerce.sourcing.allocation.sourceCoordinates,optional"`
+	},
+	optionals *struct {
+		Geocoder DeliveryLocationGeocoder `inject:",optional"`
+	},
+) *NearestSourceAllocationStrategy {
+	n.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "NearestSourceAllocationStrategy")
+	n.coordinates = make(map[string]Coordinates)
+	if cfg != nil && cfg.SourceCoordinates != nil {
+		if err := cfg.SourceCoordinates.MapInto(&n.coordinates); err != nil {
+			n.logger.Error("invalid commerce.sourcing.allocation.sourceCoordinates, sources are used in the given order: ", err)
+			n.coordinates = make(map[string]Coordinates)
+		}
+	}
+	if optionals != nil {
+		n.geocoder = optionals.Geocoder
+	}
+	if n.geocoder == nil {
+		n.logger.Warn("no DeliveryLocationGeocoder bound, sources are used in the given order")
+	}
+
+	return n
+}
+
+// Rank orders the sources by their distance to the delivery location
+func (n *NearestSourceAllocationStrategy) Rank(ctx context.Context, qty int, candidates []SourceStock, deliveryInfo cartDomain.DeliveryInfo) []SourceStock {
+	if n.geocoder == nil {
+		return SourceOrderAllocationStrategy{}.Rank(ctx, qty, candidates, deliveryInfo)
+	}
+
+	deliveryCoordinates, found := n.geocoder.Coordinates(ctx, deliveryInfo)
+	if !found {
+		return SourceOrderAllocationStrategy{}.Rank(ctx, qty, candidates, deliveryInfo)
+	}
+
+	return sortSources(candidates, func(_ int, candidate SourceStock) float64 {
+		sourceCoordinates, found := n.coordinates[candidate.Source.LocationCode]
+		if !found {
+			return math.Inf(1)
+		}
+		return sourceCoordinates.DistanceKm(deliveryCoordinates)
+	})
+}
+
+// DistanceKm returns the great circle distance to the other coordinates in kilometers
+func (c Coordinates) DistanceKm(other Coordinates) float64 {
+	toRadians := func(degree float64) float64 { return degree * math.Pi / 180 }
+
+	deltaLatitude := toRadians(other.Latitude - c.Latitude)
+	deltaLongitude := toRadians(other.Longitude - c.Longitude)
+	a := math.Sin(deltaLatitude/2)*math.Sin(deltaLatitude/2) +
+		math.Cos(toRadians(c.Latitude))*math.Cos(toRadians(other.Latitude))*math.Sin(deltaLongitude/2)*math.Sin(deltaLongitude/2)
+
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// sortSources orders the candidates ascending by rank, equal ranks are ordered by the location codes
+// so that the result does not depend on the order of the AvailableSourcesProvider
+func sortSources(candidates []SourceStock, rank func(position int, candidate SourceStock) float64) []SourceStock {
+	ranked := make([]rankedSource, 0, len(candidates))
+	for i, candidate := range candidates {
+		ranked = append(ranked, rankedSource{SourceStock: candidate, rank: rank(i, candidate)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].rank != ranked[j].rank {
+			return ranked[i].rank < ranked[j].rank
+		}
+		if ranked[i].Source.LocationCode != ranked[j].Source.LocationCode {
+			return ranked[i].Source.LocationCode < ranked[j].Source.LocationCode
+		}
+		return ranked[i].Source.ExternalLocationCode < ranked[j].Source.ExternalLocationCode
+	})
+
+	result := make([]SourceStock, 0, len(ranked))
+	for _, source := range ranked {
+		result = append(result, source.SourceStock)
+	}
+
+	return result
+}
@@ -0,0 +1,225 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/cart/domain/decorator"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	geocoderMock struct {
+		coordinates domain.Coordinates
+	}
+)
+
+var _ domain.DeliveryLocationGeocoder = new(geocoderMock)
+
+func (g geocoderMock) Coordinates(context.Context, cart.DeliveryInfo) (domain.Coordinates, bool) {
+	return g.coordinates, true
+}
+
+func TestAllocationStrategies(t *testing.T) {
+	t.Parallel()
+
+	berlin := domain.Source{LocationCode: "berlin"}
+	munich := domain.Source{LocationCode: "munich"}
+	hamburg := domain.Source{LocationCode: "hamburg"}
+
+	stock := stockBySourceAndProductProviderMock{
+		Qty: map[string]map[string]int{
+			"berlin":  {"product1": 2},
+			"munich":  {"product1": 5},
+			"hamburg": {"product1": 3},
+		},
+	}
+
+	testCart := decorator.DecoratedCart{
+		DecoratedDeliveries: []decorator.DecoratedDelivery{
+			{
+				DecoratedItems: []decorator.DecoratedCartItem{
+					{
+						Product: productDomain.SimpleProduct{Identifier: "product1"},
+						Item:    cart.Item{Qty: 5, ID: "item1"},
+					},
+				},
+			},
+		},
+	}
+
+	nearest := new(domain.NearestSourceAllocationStrategy).Inject(
+		flamingo.NullLogger{},
+		&struct {
+			SourceCoordinates config.Map `inject:"config:commerce.sourcing.allocation.sourceCoordinates,optional"`
+		}{SourceCoordinates: config.Map{
+			"berlin":  config.Map{"latitude": 52.52, "longitude": 13.405},
+			"hamburg": config.Map{"latitude": 53.551, "longitude": 9.994},
+		}},
+		&struct {
+			Geocoder domain.DeliveryLocationGeocoder `inject:",optional"`
+		}{Geocoder: geocoderMock{coordinates: domain.Coordinates{Latitude: 53.866, Longitude: 10.687}}},
+	)
+
+	priority := new(domain.PriorityAllocationStrategy).Inject(flamingo.NullLogger{}, &struct {
+		Priority config.Slice `inject:"config:commerce.sourcing.allocation.priority,optional"`
+	}{Priority: config.Slice{"hamburg", "berlin"}})
+
+	tests := []struct {
+		name     string
+		strategy domain.AllocationStrategy
+		expected domain.AllocatedQtys
+	}{
+		{
+			name:     "source order",
+			strategy: nil,
+			expected: domain.AllocatedQtys{berlin: 2, munich: 3},
+		},
+		{
+			name:     "minimize sources",
+			strategy: domain.MinimizeSourcesAllocationStrategy{},
+			expected: domain.AllocatedQtys{munich: 5},
+		},
+		{
+			name:     "priority",
+			strategy: priority,
+			expected: domain.AllocatedQtys{hamburg: 3, berlin: 2},
+		},
+		{
+			name:     "nearest source",
+			strategy: nearest,
+			expected: domain.AllocatedQtys{hamburg: 3, berlin: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sourcingService := newDefaultSourcingServiceWithStrategy(stock, []domain.Source{berlin, munich, hamburg}, tt.strategy)
+			allocations, err := sourcingService.AllocateItems(context.Background(), &testCart)
+			require.NoError(t, err)
+			require.NoError(t, allocations["item1"].Error)
+			assert.Equal(t, tt.expected, allocations["item1"].AllocatedQtys)
+		})
+	}
+}
+
+func TestMinimizeSourcesAllocationStrategy_Rank(t *testing.T) {
+	t.Parallel()
+
+	candidates := []domain.SourceStock{
+		{Source: domain.Source{LocationCode: "b"}, Qty: 5},
+		{Source: domain.Source{LocationCode: "c"}, Qty: 1},
+		{Source: domain.Source{LocationCode: "a"}, Qty: 5},
+	}
+
+	// equal stock is ordered by location code, independent of the given order
+	expected := []domain.SourceStock{candidates[2], candidates[0], candidates[1]}
+	assert.Equal(t, expected, domain.MinimizeSourcesAllocationStrategy{}.Rank(context.Background(), 5, candidates, cart.DeliveryInfo{}))
+	reversed := []domain.SourceStock{candidates[2], candidates[1], candidates[0]}
+	assert.Equal(t, expected, domain.MinimizeSourcesAllocationStrategy{}.Rank(context.Background(), 5, reversed, cart.DeliveryInfo{}))
+}
+
+func TestMinimizeSourcesAllocationStrategy_AllocatesCartToFewestSources(t *testing.T) {
+	t.Parallel()
+
+	berlin := domain.Source{LocationCode: "berlin"}
+	munich := domain.Source{LocationCode: "munich"}
+	hamburg := domain.Source{LocationCode: "hamburg"}
+
+	// ranked per item product1 would be taken from berlin and product2 from hamburg, munich serves most of the cart
+	stock := stockBySourceAndProductProviderMock{
+		Qty: map[string]map[string]int{
+			"berlin":  {"product1": 5},
+			"munich":  {"product1": 2, "product2": 2},
+			"hamburg": {"product2": 10},
+		},
+	}
+
+	testCart := decorator.DecoratedCart{
+		DecoratedDeliveries: []decorator.DecoratedDelivery{
+			{
+				DecoratedItems: []decorator.DecoratedCartItem{
+					{
+						Product: productDomain.SimpleProduct{Identifier: "product1"},
+						Item:    cart.Item{Qty: 2, ID: "item1"},
+					},
+					{
+						Product: productDomain.SimpleProduct{Identifier: "product2"},
+						Item:    cart.Item{Qty: 3, ID: "item2"},
+					},
+				},
+			},
+		},
+	}
+
+	sourcingService := newDefaultSourcingServiceWithStrategy(stock, []domain.Source{berlin, munich, hamburg}, domain.MinimizeSourcesAllocationStrategy{})
+	allocations, err := sourcingService.AllocateItems(context.Background(), &testCart)
+	require.NoError(t, err)
+	require.NoError(t, allocations["item1"].Error)
+	require.NoError(t, allocations["item2"].Error)
+	assert.Equal(t, domain.AllocatedQtys{munich: 2}, allocations["item1"].AllocatedQtys)
+	assert.Equal(t, domain.AllocatedQtys{munich: 2, hamburg: 1}, allocations["item2"].AllocatedQtys)
+}
+
+func TestMinimizeSourcesAllocationStrategy_RankCart(t *testing.T) {
+	t.Parallel()
+
+	a := domain.Source{LocationCode: "a"}
+	b := domain.Source{LocationCode: "b"}
+	c := domain.Source{LocationCode: "c"}
+
+	items := []domain.ItemSourceStock{
+		{ItemID: "1", ProductID: "p1", Qty: 4, Candidates: []domain.SourceStock{{Source: a, Qty: 4}, {Source: b, Qty: 2}}},
+		{ItemID: "2", ProductID: "p2", Qty: 3, Candidates: []domain.SourceStock{{Source: b, Qty: 3}, {Source: c, Qty: 2}}},
+		// the second item of p1 shares the stock with the first one
+		{ItemID: "3", ProductID: "p1", Qty: 2, Candidates: []domain.SourceStock{{Source: a, Qty: 4}, {Source: b, Qty: 2}}},
+	}
+
+	// b serves 5 (2 of p1, 3 of p2), then a the remaining 4 of p1, c is not needed
+	assert.Equal(t, []domain.Source{a}, domain.MinimizeSourcesAllocationStrategy{}.RankCart(context.Background(), items[:1]))
+	assert.Equal(t, []domain.Source{b, a}, domain.MinimizeSourcesAllocationStrategy{}.RankCart(context.Background(), items))
+	assert.Empty(t, domain.MinimizeSourcesAllocationStrategy{}.RankCart(context.Background(), nil))
+}
+
+func TestAllocationStrategies_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	candidates := []domain.SourceStock{
+		{Source: domain.Source{LocationCode: "b"}, Qty: 1},
+		{Source: domain.Source{LocationCode: "a"}, Qty: 1},
+	}
+
+	priority := new(domain.PriorityAllocationStrategy).Inject(flamingo.NullLogger{}, &struct {
+		Priority config.Slice `inject:"config:commerce.sourcing.allocation.priority,optional"`
+	}{Priority: config.Slice{config.Map{"invalid": true}}})
+	assert.Equal(t, []domain.SourceStock{candidates[1], candidates[0]}, priority.Rank(context.Background(), 1, candidates, cart.DeliveryInfo{}))
+
+	nearest := new(domain.NearestSourceAllocationStrategy).Inject(
+		flamingo.NullLogger{},
+		&struct {
+			SourceCoordinates config.Map `inject:"config:commerce.sourcing.allocation.sourceCoordinates,optional"`
+		}{SourceCoordinates: config.Map{"a": "invalid"}},
+		nil,
+	)
+	assert.Equal(t, candidates, nearest.Rank(context.Background(), 1, candidates, cart.DeliveryInfo{}))
+}
+
+func TestCoordinates_DistanceKm(t *testing.T) {
+	t.Parallel()
+
+	berlin := domain.Coordinates{Latitude: 52.52, Longitude: 13.405}
+	hamburg := domain.Coordinates{Latitude: 53.551, Longitude: 9.994}
+
+	assert.InDelta(t, 255, berlin.DistanceKm(hamburg), 5)
+	assert.Equal(t, 0.0, berlin.DistanceKm(berlin))
+}
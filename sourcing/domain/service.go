@@ -16,7 +16,8 @@ import (
 type (
 	// SourcingService describes the main port used by the sourcing logic.
 	SourcingService interface {
-		// AllocateItems returns Sources for the given item in the given cart
+		// AllocateItems returns Sources for the given item in the given cart, the stock reserved for other references
+		// than the one of the context (see ContextWithStockReservationReference) is not allocated.
 		// e.g. use this during place order to know
 		// throws ErrInsufficientSourceQty if not enough stock is available for the amount of items in the cart
 		// throws ErrNoSourceAvailable if no source is available at all for one of the items
@@ -62,6 +63,8 @@ type (
 	DefaultSourcingService struct {
 		availableSourcesProvider AvailableSourcesProvider
 		stockProvider            StockProvider
+		allocationStrategy       AllocationStrategy
+		stockReservation         StockReservationService
 		logger                   flamingo.Logger
 	}
 
@@ -74,6 +77,11 @@ type (
 	StockProvider interface {
 		GetStock(ctx context.Context, product domain.BasicProduct, source Source, deliveryInfo *cartDomain.DeliveryInfo) (int, error)
 	}
+
+	// SourceLeadTimeProvider optional interface to get the days until items of a source can be shipped
+	SourceLeadTimeProvider interface {
+		LeadTimeDays(ctx context.Context, source Source) (int, bool)
+	}
 )
 
 var (
@@ -95,6 +103,8 @@ func (d *DefaultSourcingService) Inject(
 	dep *struct {
 		AvailableSourcesProvider AvailableSourcesProvider `inject:",optional"`
 		StockProvider            StockProvider            `inject:",optional"`
+		AllocationStrategy       AllocationStrategy       `inject:",optional"`
+		StockReservationService  StockReservationService  `inject:",optional"`
 	},
 ) *DefaultSourcingService {
 	d.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "DefaultSourcingService")
@@ -102,6 +112,8 @@ func (d *DefaultSourcingService) Inject(
 	if dep != nil {
 		d.availableSourcesProvider = dep.AvailableSourcesProvider
 		d.stockProvider = dep.StockProvider
+		d.allocationStrategy = dep.AllocationStrategy
+		d.stockReservation = dep.StockReservationService
 	}
 
 	return d
@@ -176,12 +188,18 @@ func (d *DefaultSourcingService) AllocateItems(ctx context.Context, decoratedCar
 
 	resultItemAllocations := ItemAllocations{}
 
+	// a strategy that ranks the sources for the whole cart decides the source order of all items up front
+	var sourceOrder map[Source]int
+	if cartStrategy, ok := d.allocationStrategy.(CartAllocationStrategy); ok {
+		sourceOrder = d.cartSourceOrder(ctx, cartStrategy, productSourcestock, decoratedCart)
+	}
+
 	// overallError that will be returned
 	var overallError error
 	for _, delivery := range decoratedCart.DecoratedDeliveries {
 		for _, decoratedItem := range delivery.DecoratedItems {
 			var itemAllocation ItemAllocation
-			itemAllocation, productSourcestock = d.allocateItem(ctx, productSourcestock, decoratedItem, delivery.Delivery.DeliveryInfo)
+			itemAllocation, productSourcestock = d.allocateItem(ctx, productSourcestock, decoratedItem, delivery.Delivery.DeliveryInfo, sourceOrder)
 			resultItemAllocations[ItemID(decoratedItem.Item.ID)] = itemAllocation
 		}
 	}
@@ -202,6 +220,54 @@ func (d *DefaultSourcingService) checkConfiguration() error {
 	return nil
 }
 
+// reservedQtys returns the qtys of the product that are reserved for other references than the one of the context
+func (d *DefaultSourcingService) reservedQtys(ctx context.Context, productID string) (AllocatedQtys, error) {
+	if d.stockReservation == nil {
+		return AllocatedQtys{}, nil
+	}
+
+	reserved, err := d.stockReservation.ReservedQtys(ctx, productID, StockReservationReferenceFromContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "reserved stock cannot be loaded")
+	}
+	if reserved == nil {
+		reserved = AllocatedQtys{}
+	}
+
+	return reserved, nil
+}
+
+// cartSourceOrder returns the position of the sources in the order of the cart strategy,
+// the stock of the candidate sources is fetched into productSourcestock
+func (d *DefaultSourcingService) cartSourceOrder(ctx context.Context, cartStrategy CartAllocationStrategy, productSourcestock map[string]map[Source]int, decoratedCart *decorator.DecoratedCart) map[Source]int {
+	var items []ItemSourceStock
+	for _, delivery := range decoratedCart.DecoratedDeliveries {
+		for _, decoratedItem := range delivery.DecoratedItems {
+			candidates, err := d.sourceCandidates(ctx, productSourcestock, decoratedItem, delivery.Delivery.DeliveryInfo)
+			if err != nil {
+				// the error is returned by the allocation of the item
+				continue
+			}
+			items = append(items, ItemSourceStock{
+				ItemID:       ItemID(decoratedItem.Item.ID),
+				ProductID:    decoratedItem.Product.GetIdentifier(),
+				Qty:          decoratedItem.Item.Qty,
+				DeliveryInfo: delivery.Delivery.DeliveryInfo,
+				Candidates:   candidates,
+			})
+		}
+	}
+
+	sourceOrder := make(map[Source]int)
+	for position, source := range cartStrategy.RankCart(ctx, items) {
+		if _, exists := sourceOrder[source]; !exists {
+			sourceOrder[source] = position
+		}
+	}
+
+	return sourceOrder
+}
+
 func getItemIdsWithProduct(dc *decorator.DecoratedCart, product domain.BasicProduct) []ItemID {
 	var result []ItemID
 	for _, di := range dc.GetAllDecoratedItems() {
@@ -213,74 +279,120 @@ func getItemIdsWithProduct(dc *decorator.DecoratedCart, product domain.BasicProd
 }
 
 // allocateItem returns the itemAllocation and the remaining stock for the given item.
-// The passed productSourcestock is used - and the remaining productSourcestock is returned. In case a source is not yet given in productSourcestock it will be fetched
-func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSourcestock map[string]map[Source]int, decoratedItem decorator.DecoratedCartItem, deliveryInfo cartDomain.DeliveryInfo) (ItemAllocation, map[string]map[Source]int) {
+// The passed productSourcestock is used - and the remaining productSourcestock is returned. In case a source is not yet given in productSourcestock it will be fetched.
+// With a sourceOrder of a CartAllocationStrategy the sources are used in that order, otherwise the AllocationStrategy ranks the sources of the item
+func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSourcestock map[string]map[Source]int, decoratedItem decorator.DecoratedCartItem, deliveryInfo cartDomain.DeliveryInfo, sourceOrder map[Source]int) (ItemAllocation, map[string]map[Source]int) {
 	var resultItemAllocation = ItemAllocation{
 		AllocatedQtys: make(AllocatedQtys),
 	}
 	// copy given known stock
 	remainingSourcestock := productSourcestock
 
-	productID := decoratedItem.Product.GetIdentifier()
-	if productID == "" {
-		return ItemAllocation{
-			Error: errors.New("product id missing"),
-		}, remainingSourcestock
-	}
-	sources, err := d.availableSourcesProvider.GetPossibleSources(ctx, decoratedItem.Product, &deliveryInfo)
+	candidates, err := d.sourceCandidates(ctx, remainingSourcestock, decoratedItem, deliveryInfo)
 	if err != nil {
 		return ItemAllocation{
 			Error: err,
 		}, remainingSourcestock
 	}
-	if len(sources) == 0 {
-		return ItemAllocation{
-			Error: ErrNoSourceAvailable,
-		}, remainingSourcestock
-	}
 
+	productID := decoratedItem.Product.GetIdentifier()
 	qtyToAllocate := decoratedItem.Item.Qty
 	allocatedQty := 0
 
+	for _, candidate := range d.rankCandidates(ctx, qtyToAllocate, candidates, deliveryInfo, sourceOrder) {
+		if allocatedQty >= qtyToAllocate {
+			break
+		}
+		// stock to write to result allocation is the lowest of either :
+		// - the remaining qty that is to be allocated
+		// OR
+		// - the existing sourceStock that is then used completely
+		stockToAllocate := min(qtyToAllocate-allocatedQty, remainingSourcestock[productID][candidate.Source])
+
+		resultItemAllocation.AllocatedQtys[candidate.Source] += stockToAllocate
+
+		// increment allocatedQty by allocated Stock
+		allocatedQty = allocatedQty + stockToAllocate
+
+		// decrement remaining productSourceStock accordingly as its not happening by itself
+		remainingSourcestock[productID][candidate.Source] = remainingSourcestock[productID][candidate.Source] - stockToAllocate
+	}
+
+	if allocatedQty < qtyToAllocate {
+		resultItemAllocation.Error = ErrInsufficientSourceQty
+	}
+	return resultItemAllocation, remainingSourcestock
+}
+
+// sourceCandidates returns the possible sources of the item that have remaining stock.
+// The stock of sources that are not yet given in productSourcestock is fetched, without the stock reserved by other carts
+func (d *DefaultSourcingService) sourceCandidates(ctx context.Context, productSourcestock map[string]map[Source]int, decoratedItem decorator.DecoratedCartItem, deliveryInfo cartDomain.DeliveryInfo) ([]SourceStock, error) {
+	productID := decoratedItem.Product.GetIdentifier()
+	if productID == "" {
+		return nil, errors.New("product id missing")
+	}
+	sources, err := d.availableSourcesProvider.GetPossibleSources(ctx, decoratedItem.Product, &deliveryInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, ErrNoSourceAvailable
+	}
+
 	if _, exists := productSourcestock[productID]; !exists {
 		productSourcestock[productID] = make(map[Source]int)
 	}
 
+	var (
+		candidates []SourceStock
+		reserved   AllocatedQtys
+	)
 	for _, source := range sources {
 		// if we have no stock given for source and productid we fetch it initially
-		if _, exists := remainingSourcestock[productID][source]; !exists {
+		if _, exists := productSourcestock[productID][source]; !exists {
 			sourceStock, err := d.stockProvider.GetStock(ctx, decoratedItem.Product, source, &deliveryInfo)
 			if err != nil {
 				d.logger.Error(err)
 				continue
 			}
-			remainingSourcestock[productID][source] = sourceStock
+
+			// the stock reserved by other carts can't be allocated
+			if reserved == nil {
+				reserved, err = d.reservedQtys(ctx, productID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			productSourcestock[productID][source] = sourceStock - reserved[source]
 		}
 
-		if remainingSourcestock[productID][source] == 0 {
+		if productSourcestock[productID][source] <= 0 {
 			continue
 		}
-		if allocatedQty < qtyToAllocate {
-			// stock to write to result allocation is the lowest of either :
-			// - the remaining qty that is to be allocated
-			// OR
-			// - the existing sourceStock that is then used completely
-			stockToAllocate := min(qtyToAllocate-allocatedQty, productSourcestock[productID][source])
-
-			resultItemAllocation.AllocatedQtys[source] = stockToAllocate
+		candidates = append(candidates, SourceStock{Source: source, Qty: productSourcestock[productID][source]})
+	}
 
-			// increment allocatedQty by allocated Stock
-			allocatedQty = allocatedQty + stockToAllocate
+	return candidates, nil
+}
 
-			// decrement remaining productSourceStock accordingly as its not happening by itself
-			remainingSourcestock[productID][source] = remainingSourcestock[productID][source] - stockToAllocate
-		}
+// rankCandidates orders the candidates by the sourceOrder of a CartAllocationStrategy if given, otherwise by the AllocationStrategy.
+// Without strategy the order of the AvailableSourcesProvider is used
+func (d *DefaultSourcingService) rankCandidates(ctx context.Context, qty int, candidates []SourceStock, deliveryInfo cartDomain.DeliveryInfo, sourceOrder map[Source]int) []SourceStock {
+	if sourceOrder != nil {
+		return sortSources(candidates, func(_ int, candidate SourceStock) float64 {
+			if position, found := sourceOrder[candidate.Source]; found {
+				return float64(position)
+			}
+			return math.MaxInt32
+		})
 	}
 
-	if allocatedQty < qtyToAllocate {
-		resultItemAllocation.Error = ErrInsufficientSourceQty
+	var strategy AllocationStrategy = SourceOrderAllocationStrategy{}
+	if d.allocationStrategy != nil {
+		strategy = d.allocationStrategy
 	}
-	return resultItemAllocation, remainingSourcestock
+
+	return strategy.Rank(ctx, qty, candidates, deliveryInfo)
 }
 
 // QtySum returns the sum of all sourced items
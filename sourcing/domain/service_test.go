@@ -70,6 +70,8 @@ func TestDefaultSourcingService_GetAvailableSources(t *testing.T) {
 		sourcingService.Inject(flamingo.NullLogger{}, &struct {
 			AvailableSourcesProvider domain.AvailableSourcesProvider `inject:",optional"`
 			StockProvider            domain.StockProvider            `inject:",optional"`
+			AllocationStrategy       domain.AllocationStrategy       `inject:",optional"`
+			StockReservationService  domain.StockReservationService  `inject:",optional"`
 		}{
 			AvailableSourcesProvider: availableSourcesProviderMock{
 				Sources: nil,
@@ -254,16 +256,82 @@ func TestDefaultSourcingService_AllocateItems(t *testing.T) {
 	})
 }
 
+// otherReservations reserves qtys for a reference other than the excluded one
+type otherReservations struct {
+	domain.StockReservationService
+	reference string
+	reserved  map[string]domain.AllocatedQtys
+}
+
+func (r otherReservations) ReservedQtys(_ context.Context, productID string, excludeReference string) (domain.AllocatedQtys, error) {
+	if excludeReference == r.reference {
+		return domain.AllocatedQtys{}, nil
+	}
+
+	return r.reserved[productID], nil
+}
+
+func TestDefaultSourcingService_AllocateItemsDeductsReservations(t *testing.T) {
+	source1 := domain.Source{LocationCode: "Source1"}
+	source2 := domain.Source{LocationCode: "Source2"}
+	stockProvider := stockBySourceAndProductProviderMock{
+		Qty: map[string]map[string]int{
+			"Source1": {"product1": 8},
+			"Source2": {"product1": 10},
+		},
+	}
+	reservations := otherReservations{
+		reference: "other-process",
+		reserved:  map[string]domain.AllocatedQtys{"product1": {source1: 6}},
+	}
+	testCart := decorator.DecoratedCart{
+		DecoratedDeliveries: []decorator.DecoratedDelivery{
+			{
+				DecoratedItems: []decorator.DecoratedCartItem{
+					{
+						Product: productDomain.SimpleProduct{Identifier: "product1"},
+						Item:    cart.Item{Qty: 5, ID: "item1"},
+					},
+				},
+			},
+		},
+	}
+
+	sourcingService := newDefaultSourcingServiceWithReservations(stockProvider, []domain.Source{source1, source2}, nil, reservations)
+
+	itemAllocation, err := sourcingService.AllocateItems(domain.ContextWithStockReservationReference(context.Background(), "process"), &testCart)
+	assert.NoError(t, err)
+	assert.NoError(t, itemAllocation["item1"].Error)
+	assert.Equal(t, domain.AllocatedQtys{source1: 2, source2: 3}, itemAllocation["item1"].AllocatedQtys)
+
+	// the reservations of the own reference are not deducted
+	itemAllocation, err = sourcingService.AllocateItems(domain.ContextWithStockReservationReference(context.Background(), "other-process"), &testCart)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AllocatedQtys{source1: 5}, itemAllocation["item1"].AllocatedQtys)
+}
+
 func newDefaultSourcingService(stockProvider domain.StockProvider, expectedSources []domain.Source) domain.DefaultSourcingService {
+	return newDefaultSourcingServiceWithStrategy(stockProvider, expectedSources, nil)
+}
+
+func newDefaultSourcingServiceWithStrategy(stockProvider domain.StockProvider, expectedSources []domain.Source, strategy domain.AllocationStrategy) domain.DefaultSourcingService {
+	return newDefaultSourcingServiceWithReservations(stockProvider, expectedSources, strategy, nil)
+}
+
+func newDefaultSourcingServiceWithReservations(stockProvider domain.StockProvider, expectedSources []domain.Source, strategy domain.AllocationStrategy, stockReservation domain.StockReservationService) domain.DefaultSourcingService {
 	sourcingService := domain.DefaultSourcingService{}
 	availableSourcesProviderMock := availableSourcesProviderMock{Sources: expectedSources}
 
 	sourcingService.Inject(flamingo.NullLogger{}, &struct {
 		AvailableSourcesProvider domain.AvailableSourcesProvider `inject:",optional"`
 		StockProvider            domain.StockProvider            `inject:",optional"`
+		AllocationStrategy       domain.AllocationStrategy       `inject:",optional"`
+		StockReservationService  domain.StockReservationService  `inject:",optional"`
 	}{
 		StockProvider:            stockProvider,
 		AvailableSourcesProvider: availableSourcesProviderMock,
+		AllocationStrategy:       strategy,
+		StockReservationService:  stockReservation,
 	})
 
 	return sourcingService
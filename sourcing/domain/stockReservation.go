@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// StockReservationService describes the port used to hold back stock for carts that are about to be placed.
+	// Reservations are grouped by a reference (e.g. the uuid of the place order process), uncommitted reservations expire automatically.
+	StockReservationService interface {
+		// Reserve replaces all reservations of the reference with the given reservations and restarts the expiry.
+		// throws ErrInsufficientSourceQty if the reservations of other references leave not enough of the AvailableQty
+		Reserve(ctx context.Context, reference string, reservations []StockReservation) error
+		// Commit marks the reservations of the reference as used by a placed order, committed reservations can no longer be released
+		// throws ErrStockReservationNotFound if there are no active reservations for the reference
+		Commit(ctx context.Context, reference string) error
+		// Release drops the uncommitted reservations of the reference
+		Release(ctx context.Context, reference string) error
+		// ReservedQtys returns the active reserved qty per source for the product, reservations of the excluded reference are ignored
+		ReservedQtys(ctx context.Context, productID string, excludeReference string) (AllocatedQtys, error)
+	}
+
+	// StockReservation holds back a qty of a product on a source
+	StockReservation struct {
+		ProductID string
+		Source    Source
+		Qty       int
+		// AvailableQty is the stock of the source without any reservations, it is used to reject over reservations.
+		// If it is zero the reservation is not checked against the stock
+		AvailableQty int
+	}
+
+	stockReservationContextKey string
+)
+
+const stockReservationReferenceKey stockReservationContextKey = "sourcing.stockReservationReference"
+
+var (
+	// ErrStockReservationNotFound - use to indicate that there are no active reservations for a reference
+	ErrStockReservationNotFound = errors.New("Stock reservation not found")
+)
+
+// StockReservationsFromAllocations builds the stock reservations for the allocated qtys of a product,
+// availableSources contains the stock per source that is used as AvailableQty of the reservations
+func StockReservationsFromAllocations(productID string, allocatedQtys AllocatedQtys, availableSources AvailableSources) []StockReservation {
+	reservations := make([]StockReservation, 0, len(allocatedQtys))
+	for source, qty := range allocatedQtys {
+		if qty <= 0 {
+			continue
+		}
+		reservations = append(reservations, StockReservation{
+			ProductID:    productID,
+			Source:       source,
+			Qty:          qty,
+			AvailableQty: availableSources[source],
+		})
+	}
+
+	return reservations
+}
+
+// ContextWithStockReservationReference marks the context as belonging to the reservations of the reference,
+// so that these reservations are not deducted from the available stock
+func ContextWithStockReservationReference(ctx context.Context, reference string) context.Context {
+	return context.WithValue(ctx, stockReservationReferenceKey, reference)
+}
+
+// StockReservationReferenceFromContext returns the reservation reference of the context, empty if there is none
+func StockReservationReferenceFromContext(ctx context.Context) string {
+	reference, _ := ctx.Value(stockReservationReferenceKey).(string)
+	return reference
+}
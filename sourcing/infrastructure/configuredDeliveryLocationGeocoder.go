@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	// ConfiguredDeliveryLocationGeocoder detects the coordinates of a delivery from the configured delivery coordinates.
+	// The coordinates are looked up by the code of the delivery location, then by country code and the longest matching
+	// post code prefix ("DE-80", "DE-8") and at last by the country code ("DE")
+	ConfiguredDeliveryLocationGeocoder struct {
+		coordinates map[string]domain.Coordinates
+	}
+)
+
+var _ domain.DeliveryLocationGeocoder = new(ConfiguredDeliveryLocationGeocoder)
+
+// Inject dependencies
+func (g *ConfiguredDeliveryLocationGeocoder) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		DeliveryCoordinates config.Map `inject:"config:commerce.sourcing.allocation.deliveryCoordinates,optional"`
+	},
+) *ConfiguredDeliveryLocationGeocoder {
+	g.coordinates = make(map[string]domain.Coordinates)
+	if cfg != nil && cfg.DeliveryCoordinates != nil {
+		if err := cfg.DeliveryCoordinates.MapInto(&g.coordinates); err != nil {
+			logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "ConfiguredDeliveryLocationGeocoder").
+				Error("invalid commerce.sourcing.allocation.deliveryCoordinates: ", err)
+			g.coordinates = make(map[string]domain.Coordinates)
+		}
+	}
+
+	return g
+}
+
+// Coordinates of the delivery location, false if none of the configured keys matches
+func (g *ConfiguredDeliveryLocationGeocoder) Coordinates(_ context.Context, deliveryInfo cartDomain.DeliveryInfo) (domain.Coordinates, bool) {
+	location := deliveryInfo.DeliveryLocation
+	if location.Code != "" {
+		if coordinates, found := g.coordinates[location.Code]; found {
+			return coordinates, true
+		}
+	}
+
+	if location.Address == nil || location.Address.CountryCode == "" {
+		return domain.Coordinates{}, false
+	}
+
+	countryCode := strings.ToUpper(location.Address.CountryCode)
+	postCode := strings.ReplaceAll(location.Address.PostCode, " ", "")
+	for length := len(postCode); length > 0; length-- {
+		if coordinates, found := g.coordinates[countryCode+"-"+postCode[:length]]; found {
+			return coordinates, true
+		}
+	}
+
+	coordinates, found := g.coordinates[countryCode]
+	return coordinates, found
+}
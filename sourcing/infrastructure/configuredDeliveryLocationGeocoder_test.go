@@ -0,0 +1,58 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+func TestConfiguredDeliveryLocationGeocoder_Coordinates(t *testing.T) {
+	t.Parallel()
+
+	geocoder := new(ConfiguredDeliveryLocationGeocoder).Inject(flamingo.NullLogger{}, &struct {
+		DeliveryCoordinates config.Map `inject:"config:commerce.sourcing.allocation.deliveryCoordinates,optional"`
+	}{DeliveryCoordinates: config.Map{
+		"store-1": config.Map{"latitude": 1.0, "longitude": 1.0},
+		"DE-80":   config.Map{"latitude": 48.1, "longitude": 11.6},
+		"DE-8":    config.Map{"latitude": 48.0, "longitude": 11.0},
+		"DE":      config.Map{"latitude": 51.0, "longitude": 10.0},
+	}})
+
+	deliveryTo := func(code string, countryCode string, postCode string) cartDomain.DeliveryInfo {
+		return cartDomain.DeliveryInfo{DeliveryLocation: cartDomain.DeliveryLocation{
+			Code:    code,
+			Address: &cartDomain.Address{CountryCode: countryCode, PostCode: postCode},
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		deliveryInfo cartDomain.DeliveryInfo
+		expected     domain.Coordinates
+		found        bool
+	}{
+		{name: "location code", deliveryInfo: deliveryTo("store-1", "DE", "80331"), expected: domain.Coordinates{Latitude: 1, Longitude: 1}, found: true},
+		{name: "longest post code prefix", deliveryInfo: deliveryTo("", "de", "80331"), expected: domain.Coordinates{Latitude: 48.1, Longitude: 11.6}, found: true},
+		{name: "shorter post code prefix", deliveryInfo: deliveryTo("unknown", "DE", "85748"), expected: domain.Coordinates{Latitude: 48, Longitude: 11}, found: true},
+		{name: "country code", deliveryInfo: deliveryTo("", "DE", "10115"), expected: domain.Coordinates{Latitude: 51, Longitude: 10}, found: true},
+		{name: "unknown country", deliveryInfo: deliveryTo("", "FR", "75001"), found: false},
+		{name: "no address", deliveryInfo: cartDomain.DeliveryInfo{}, found: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			coordinates, found := geocoder.Coordinates(context.Background(), tt.deliveryInfo)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, coordinates)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	// InMemoryStockReservationService keeps the stock reservations in memory of the current instance
+	// and drops uncommitted ones after the configured lifetime. Committed reservations are kept as deduction
+	// of the stock of placed orders until the instance is restarted. Only suitable for single instance setups.
+	InMemoryStockReservationService struct {
+		mutex        sync.Mutex
+		reservations map[string]*inMemoryReservation
+		lifetime     time.Duration
+		now          func() time.Time
+	}
+
+	inMemoryReservation struct {
+		reservations []domain.StockReservation
+		committed    bool
+		expiresAt    time.Time
+	}
+
+	reservationKey struct {
+		productID string
+		source    domain.Source
+	}
+)
+
+const defaultReservationLifetime = 15 * time.Minute
+
+var _ domain.StockReservationService = new(InMemoryStockReservationService)
+
+// Inject dependencies
+func (s *InMemoryStockReservationService) Inject(
+	cfg *struct {
+		TTLSeconds float64 `inject:"config:commerce.sourcing.stockReservation.ttlSeconds,optional"`
+	},
+) *InMemoryStockReservationService {
+	s.reservations = make(map[string]*inMemoryReservation)
+	s.lifetime = defaultReservationLifetime
+	s.now = time.Now
+	if cfg != nil && cfg.TTLSeconds > 0 {
+		s.lifetime = time.Duration(cfg.TTLSeconds * float64(time.Second))
+	}
+
+	return s
+}
+
+// Reserve replaces the reservations of the reference if the stock is sufficient
+func (s *InMemoryStockReservationService) Reserve(_ context.Context, reference string, reservations []domain.StockReservation) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+
+	if existing, found := s.reservations[reference]; found && existing.committed {
+		return nil
+	}
+
+	requested := make(map[reservationKey]int)
+	for _, reservation := range reservations {
+		requested[reservationKey{productID: reservation.ProductID, source: reservation.Source}] += reservation.Qty
+	}
+
+	for _, reservation := range reservations {
+		if reservation.AvailableQty <= 0 {
+			continue
+		}
+		key := reservationKey{productID: reservation.ProductID, source: reservation.Source}
+		if requested[key]+s.reservedQty(key, reference) > reservation.AvailableQty {
+			return domain.ErrInsufficientSourceQty
+		}
+	}
+
+	s.reservations[reference] = &inMemoryReservation{
+		reservations: append([]domain.StockReservation(nil), reservations...),
+		expiresAt:    now.Add(s.lifetime),
+	}
+
+	return nil
+}
+
+// Commit marks the reservations of the reference as committed, committed reservations don't expire
+func (s *InMemoryStockReservationService) Commit(_ context.Context, reference string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+
+	reservation, found := s.reservations[reference]
+	if !found {
+		return domain.ErrStockReservationNotFound
+	}
+
+	reservation.committed = true
+
+	return nil
+}
+
+// Release drops the reservations of the reference unless they are committed
+func (s *InMemoryStockReservationService) Release(_ context.Context, reference string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if reservation, found := s.reservations[reference]; found && !reservation.committed {
+		delete(s.reservations, reference)
+	}
+
+	return nil
+}
+
+// ReservedQtys returns the active reserved qty per source for the product
+func (s *InMemoryStockReservationService) ReservedQtys(_ context.Context, productID string, excludeReference string) (domain.AllocatedQtys, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.removeExpired(s.now())
+
+	result := make(domain.AllocatedQtys)
+	for reference, reservation := range s.reservations {
+		if reference == excludeReference {
+			continue
+		}
+		for _, r := range reservation.reservations {
+			if r.ProductID == productID {
+				result[r.Source] += r.Qty
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// reservedQty sums the reservations of all other references, the mutex must be held by the caller
+func (s *InMemoryStockReservationService) reservedQty(key reservationKey, excludeReference string) int {
+	qty := 0
+	for reference, reservation := range s.reservations {
+		if reference == excludeReference {
+			continue
+		}
+		for _, r := range reservation.reservations {
+			if r.ProductID == key.productID && r.Source == key.source {
+				qty += r.Qty
+			}
+		}
+	}
+
+	return qty
+}
+
+// removeExpired drops all expired uncommitted reservations, the mutex must be held by the caller
+func (s *InMemoryStockReservationService) removeExpired(now time.Time) {
+	for reference, reservation := range s.reservations {
+		if !reservation.committed && !now.Before(reservation.expiresAt) {
+			delete(s.reservations, reference)
+		}
+	}
+}
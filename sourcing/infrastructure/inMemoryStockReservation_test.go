@@ -0,0 +1,61 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+func TestInMemoryStockReservationService(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	service := new(InMemoryStockReservationService).Inject(&struct {
+		TTLSeconds float64 `inject:"config:commerce.sourcing.stockReservation.ttlSeconds,optional"`
+	}{TTLSeconds: 60})
+	service.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	warehouse := domain.Source{LocationCode: "warehouse"}
+	reserve := func(reference string, qty int) error {
+		return service.Reserve(ctx, reference, []domain.StockReservation{{ProductID: "p1", Source: warehouse, Qty: qty, AvailableQty: 3}})
+	}
+
+	require.NoError(t, reserve("first", 2))
+	assert.Equal(t, domain.ErrInsufficientSourceQty, reserve("second", 2))
+	require.NoError(t, reserve("second", 1))
+
+	// the own reservation is replaced and not counted twice
+	require.NoError(t, reserve("first", 2))
+
+	reserved, err := service.ReservedQtys(ctx, "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.AllocatedQtys{warehouse: 3}, reserved)
+
+	reserved, err = service.ReservedQtys(ctx, "p1", "second")
+	require.NoError(t, err)
+	assert.Equal(t, domain.AllocatedQtys{warehouse: 2}, reserved)
+
+	// committed reservations survive a release
+	require.NoError(t, service.Commit(ctx, "first"))
+	require.NoError(t, service.Release(ctx, "first"))
+	require.NoError(t, service.Release(ctx, "second"))
+	reserved, err = service.ReservedQtys(ctx, "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.AllocatedQtys{warehouse: 2}, reserved)
+
+	// uncommitted reservations expire, committed ones keep the stock deducted
+	require.NoError(t, reserve("third", 1))
+	now = now.Add(61 * time.Second)
+	reserved, err = service.ReservedQtys(ctx, "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.AllocatedQtys{warehouse: 2}, reserved)
+	assert.Equal(t, domain.ErrStockReservationNotFound, service.Commit(ctx, "third"))
+	assert.Equal(t, domain.ErrInsufficientSourceQty, reserve("fourth", 2))
+	require.NoError(t, service.Commit(ctx, "first"))
+}
@@ -0,0 +1,150 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	// StaticSourcingProvider provides sources and stock from the configuration or a json file,
+	// it can be used for demos and integration tests without a warehouse system
+	StaticSourcingProvider struct {
+		logger  flamingo.Logger
+		sources []StaticSource
+		// stock per location code and marketplace code
+		stock map[string]map[string]int
+	}
+
+	// StaticSource is a configured source and the deliveries it can be used for
+	StaticSource struct {
+		LocationCode         string `json:"locationCode"`
+		ExternalLocationCode string `json:"externalLocationCode"`
+		// DeliveryWorkflows the source is used for, empty for all workflows
+		DeliveryWorkflows []string `json:"deliveryWorkflows"`
+		// LocationTypes of the delivery location the source is used for, empty for all location types
+		LocationTypes []string `json:"locationTypes"`
+		// LeadTimeDays until the items of the source can be shipped, zero if unknown
+		LeadTimeDays int `json:"leadTimeDays"`
+	}
+
+	// staticSourcingData is the structure of the configuration and the json file
+	staticSourcingData struct {
+		Sources []StaticSource            `json:"sources"`
+		Stock   map[string]map[string]int `json:"stock"`
+	}
+)
+
+var (
+	_ domain.AvailableSourcesProvider = new(StaticSourcingProvider)
+	_ domain.StockProvider            = new(StaticSourcingProvider)
+	_ domain.SourceLeadTimeProvider   = new(StaticSourcingProvider)
+)
+
+// Inject dependencies, the json file is used instead of the configured sources and stock if it is set
+func (p *StaticSourcingProvider) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		Sources config.Slice `inject:"config:commerce.sourcing.static.sources,optional"`
+		Stock   config.Map   `inject:"config:commerce.sourcing.static.stock,optional"`
+		File    string       `inject:"config:commerce.sourcing.static.file,optional"`
+	},
+) *StaticSourcingProvider {
+	p.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "StaticSourcingProvider")
+	p.stock = make(map[string]map[string]int)
+	if cfg == nil {
+		return p
+	}
+
+	var data staticSourcingData
+	if cfg.File != "" {
+		content, err := ioutil.ReadFile(cfg.File)
+		if err != nil {
+			p.logger.Error("static sourcing file could not be read: ", err)
+			return p
+		}
+		if err := json.Unmarshal(content, &data); err != nil {
+			p.logger.Error("static sourcing file could not be parsed: ", err)
+			return p
+		}
+	} else {
+		if cfg.Sources != nil {
+			if err := cfg.Sources.MapInto(&data.Sources); err != nil {
+				p.logger.Error("static sources could not be mapped: ", err)
+			}
+		}
+		if cfg.Stock != nil {
+			if err := cfg.Stock.MapInto(&data.Stock); err != nil {
+				p.logger.Error("static stock could not be mapped: ", err)
+			}
+		}
+	}
+
+	p.sources = data.Sources
+	if data.Stock != nil {
+		p.stock = data.Stock
+	}
+
+	return p
+}
+
+// GetPossibleSources returns the configured sources that match the workflow and location type of the delivery
+func (p *StaticSourcingProvider) GetPossibleSources(_ context.Context, _ productDomain.BasicProduct, deliveryInfo *cartDomain.DeliveryInfo) ([]domain.Source, error) {
+	if deliveryInfo == nil {
+		return nil, domain.ErrNeedMoreDetailsSourceCannotBeDetected
+	}
+
+	var sources []domain.Source
+	for _, source := range p.sources {
+		if !matches(source.DeliveryWorkflows, deliveryInfo.Workflow) || !matches(source.LocationTypes, deliveryInfo.DeliveryLocation.Type) {
+			continue
+		}
+		sources = append(sources, domain.Source{
+			LocationCode:         source.LocationCode,
+			ExternalLocationCode: source.ExternalLocationCode,
+		})
+	}
+
+	return sources, nil
+}
+
+// GetStock returns the configured stock of the product on the source, zero if nothing is configured
+func (p *StaticSourcingProvider) GetStock(_ context.Context, product productDomain.BasicProduct, source domain.Source, _ *cartDomain.DeliveryInfo) (int, error) {
+	if product == nil {
+		return 0, nil
+	}
+
+	return p.stock[source.LocationCode][product.BaseData().MarketPlaceCode], nil
+}
+
+// LeadTimeDays returns the configured lead time of the source
+func (p *StaticSourcingProvider) LeadTimeDays(_ context.Context, source domain.Source) (int, bool) {
+	for _, staticSource := range p.sources {
+		if staticSource.LocationCode == source.LocationCode && staticSource.LeadTimeDays > 0 {
+			return staticSource.LeadTimeDays, true
+		}
+	}
+
+	return 0, false
+}
+
+// matches returns true if the allowed values are empty or contain the value
+func matches(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type staticSourcingConfig = struct {
+	Sources config.Slice `inject:"config:commerce.sourcing.static.sources,optional"`
+	Stock   config.Map   `inject:"config:commerce.sourcing.static.stock,optional"`
+	File    string       `inject:"config:commerce.sourcing.static.file,optional"`
+}
+
+func TestStaticSourcingProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	product := productDomain.SimpleProduct{BasicProductData: productDomain.BasicProductData{MarketPlaceCode: "p1"}}
+	warehouse := domain.Source{LocationCode: "warehouse", ExternalLocationCode: "WH-1"}
+	store := domain.Source{LocationCode: "store"}
+
+	assertProvider := func(t *testing.T, provider *StaticSourcingProvider) {
+		t.Helper()
+
+		sources, err := provider.GetPossibleSources(ctx, product, &cartDomain.DeliveryInfo{Workflow: cartDomain.DeliveryWorkflowDelivery})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Source{warehouse}, sources)
+
+		sources, err = provider.GetPossibleSources(ctx, product, &cartDomain.DeliveryInfo{
+			Workflow:         cartDomain.DeliveryWorkflowPickup,
+			DeliveryLocation: cartDomain.DeliveryLocation{Type: cartDomain.DeliverylocationTypeCollectionpoint},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Source{warehouse, store}, sources)
+
+		_, err = provider.GetPossibleSources(ctx, product, nil)
+		assert.Equal(t, domain.ErrNeedMoreDetailsSourceCannotBeDetected, err)
+
+		qty, err := provider.GetStock(ctx, product, warehouse, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 10, qty)
+		qty, err = provider.GetStock(ctx, product, store, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, qty)
+
+		days, found := provider.LeadTimeDays(ctx, warehouse)
+		assert.True(t, found)
+		assert.Equal(t, 2, days)
+		_, found = provider.LeadTimeDays(ctx, store)
+		assert.False(t, found)
+	}
+
+	t.Run("config", func(t *testing.T) {
+		t.Parallel()
+
+		provider := new(StaticSourcingProvider).Inject(flamingo.NullLogger{}, &staticSourcingConfig{
+			Sources: config.Slice{
+				config.Map{"locationCode": "warehouse", "externalLocationCode": "WH-1", "leadTimeDays": 2.0},
+				config.Map{"locationCode": "store", "deliveryWorkflows": config.Slice{"pickup"}, "locationTypes": config.Slice{"collection-point"}},
+			},
+			Stock: config.Map{"warehouse": config.Map{"p1": 10.0}},
+		})
+		assertProvider(t, provider)
+	})
+
+	t.Run("json file", func(t *testing.T) {
+		t.Parallel()
+
+		dir, err := ioutil.TempDir("", "sourcing")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		file := filepath.Join(dir, "sourcing.json")
+		require.NoError(t, ioutil.WriteFile(file, []byte(`{
+			"sources": [
+				{"locationCode": "warehouse", "externalLocationCode": "WH-1", "leadTimeDays": 2},
+				{"locationCode": "store", "deliveryWorkflows": ["pickup"], "locationTypes": ["collection-point"]}
+			],
+			"stock": {"warehouse": {"p1": 10}}
+		}`), 0600))
+
+		provider := new(StaticSourcingProvider).Inject(flamingo.NullLogger{}, &staticSourcingConfig{File: file})
+		assertProvider(t, provider)
+	})
+}
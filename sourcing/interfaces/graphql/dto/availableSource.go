@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+)
+
+type (
+	// AvailableSource is the available qty of a source
+	AvailableSource struct {
+		Source       domain.Source
+		Qty          int
+		LeadTimeDays *int
+	}
+)
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/application"
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/interfaces/graphql/dto"
+)
+
+type (
+	// SourcingResolver graphql resolver
+	SourcingResolver struct {
+		sourcingApplication application.SourcingApplication
+		productService      productDomain.ProductService
+		leadTimeProvider    domain.SourceLeadTimeProvider
+	}
+)
+
+// Inject dependencies
+func (r *SourcingResolver) Inject(
+	sourcingApplication application.SourcingApplication,
+	productService productDomain.ProductService,
+	optionals *struct {
+		LeadTimeProvider domain.SourceLeadTimeProvider `inject:",optional"`
+	},
+) *SourcingResolver {
+	r.sourcingApplication = sourcingApplication
+	r.productService = productService
+	if optionals != nil {
+		r.leadTimeProvider = optionals.LeadTimeProvider
+	}
+
+	return r
+}
+
+// CommerceSourcingAvailableSources resolves the available sources of a product for the delivery, ordered by location code
+func (r *SourcingResolver) CommerceSourcingAvailableSources(ctx context.Context, marketplaceCode string, variantMarketplaceCode *string, deliveryCode string, deductCart *bool) ([]*dto.AvailableSource, error) {
+	product, err := r.productService.Get(ctx, marketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if variantMarketplaceCode != nil && *variantMarketplaceCode != "" {
+		configurable, ok := product.(productDomain.ConfigurableProduct)
+		if !ok {
+			return nil, productDomain.ProductNotFound{MarketplaceCode: *variantMarketplaceCode}
+		}
+		product, err = configurable.GetConfigurableWithActiveVariant(*variantMarketplaceCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var availableSources domain.AvailableSources
+	if deductCart != nil && *deductCart {
+		availableSources, err = r.sourcingApplication.GetAvailableSourcesDeductedByCurrentCart(ctx, web.SessionFromContext(ctx), product, deliveryCode)
+	} else {
+		availableSources, err = r.sourcingApplication.GetAvailableSources(ctx, web.SessionFromContext(ctx), product, deliveryCode)
+	}
+	if errors.Is(err, domain.ErrNoSourceAvailable) {
+		return []*dto.AvailableSource{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.AvailableSource, 0, len(availableSources))
+	for source, qty := range availableSources {
+		availableSource := &dto.AvailableSource{
+			Source: source,
+			// graphql integers are 32 bit, unlimited stock is reported as max int32
+			Qty: int(math.Min(float64(qty), math.MaxInt32)),
+		}
+		if r.leadTimeProvider != nil {
+			if days, found := r.leadTimeProvider.LeadTimeDays(ctx, source); found {
+				availableSource.LeadTimeDays = &days
+			}
+		}
+		result = append(result, availableSource)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source.LocationCode < result[j].Source.LocationCode
+	})
+
+	return result, nil
+}
@@ -0,0 +1,27 @@
+package graphql
+
+import (
+	"flamingo.me/graphql"
+
+	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/interfaces/graphql/dto"
+)
+
+//go:generate go run github.com/go-bindata/go-bindata/v3/go-bindata -nometadata -o fs.go -pkg graphql schema.graphql
+
+// Service is the Graphql-Service of this module
+type Service struct{}
+
+var _ graphql.Service = new(Service)
+
+// Schema returns graphql schema of this module
+func (*Service) Schema() []byte {
+	return MustAsset("schema.graphql")
+}
+
+// Types configures the GraphQL to Go resolvers
+func (*Service) Types(types *graphql.Types) {
+	types.Map("Commerce_Sourcing_Source", domain.Source{})
+	types.Map("Commerce_Sourcing_AvailableSource", dto.AvailableSource{})
+	types.Resolve("Query", "Commerce_Sourcing_AvailableSources", SourcingResolver{}, "CommerceSourcingAvailableSources")
+}
@@ -2,12 +2,15 @@ package sourcing
 
 import (
 	"flamingo.me/dingo"
+	flamingoGraphql "flamingo.me/graphql"
 	"github.com/lunarforge/flamingo_commerce/cart/domain/validation"
 	restrictors "github.com/lunarforge/flamingo_commerce/sourcing/domain/restrictor"
 
 	"github.com/lunarforge/flamingo_commerce/cart"
 	"github.com/lunarforge/flamingo_commerce/sourcing/application"
 	"github.com/lunarforge/flamingo_commerce/sourcing/domain"
+	"github.com/lunarforge/flamingo_commerce/sourcing/infrastructure"
+	sourcingGraphql "github.com/lunarforge/flamingo_commerce/sourcing/interfaces/graphql"
 )
 
 type (
@@ -15,20 +18,29 @@ type (
 	Module struct {
 		useDefaultSourcingService bool
 		enableQtyRestrictor       bool
+		enableStockReservation    bool
+		allocationStrategy        string
+		useStaticProviders        bool
 	}
 )
 
 // Inject dependencies
 func (m *Module) Inject(
 	config *struct {
-		UseDefaultSourcingService bool `inject:"config:commerce.sourcing.useDefaultSourcingService,optional"`
-		EnableQtyRestrictor       bool `inject:"config:commerce.sourcing.enableQtyRestrictor,optional"`
+		UseDefaultSourcingService bool   `inject:"config:commerce.sourcing.useDefaultSourcingService,optional"`
+		EnableQtyRestrictor       bool   `inject:"config:commerce.sourcing.enableQtyRestrictor,optional"`
+		EnableStockReservation    bool   `inject:"config:commerce.sourcing.stockReservation.enabled,optional"`
+		AllocationStrategy        string `inject:"config:commerce.sourcing.allocation.strategy,optional"`
+		UseStaticProviders        bool   `inject:"config:commerce.sourcing.static.enabled,optional"`
 	},
 ) {
 
 	if config != nil {
 		m.useDefaultSourcingService = config.UseDefaultSourcingService
 		m.enableQtyRestrictor = config.EnableQtyRestrictor
+		m.enableStockReservation = config.EnableStockReservation
+		m.allocationStrategy = config.AllocationStrategy
+		m.useStaticProviders = config.UseStaticProviders
 	}
 
 }
@@ -37,13 +49,38 @@ func (m *Module) Inject(
 func (m *Module) Configure(injector *dingo.Injector) {
 	if m.useDefaultSourcingService {
 		injector.Bind(new(domain.SourcingService)).To(domain.DefaultSourcingService{})
+
+		switch m.allocationStrategy {
+		case domain.AllocationStrategyMinimizeSources:
+			injector.Bind(new(domain.AllocationStrategy)).To(domain.MinimizeSourcesAllocationStrategy{})
+		case domain.AllocationStrategyPriority:
+			injector.Bind(new(domain.AllocationStrategy)).To(domain.PriorityAllocationStrategy{})
+		case domain.AllocationStrategyNearestSource:
+			injector.Bind(new(domain.AllocationStrategy)).To(domain.NearestSourceAllocationStrategy{})
+			injector.Bind(new(domain.DeliveryLocationGeocoder)).To(infrastructure.ConfiguredDeliveryLocationGeocoder{})
+		default:
+			injector.Bind(new(domain.AllocationStrategy)).To(domain.SourceOrderAllocationStrategy{})
+		}
+	}
+
+	if m.useStaticProviders {
+		injector.Bind(new(infrastructure.StaticSourcingProvider)).In(dingo.Singleton)
+		injector.Bind(new(domain.AvailableSourcesProvider)).ToProvider(func(p *infrastructure.StaticSourcingProvider) domain.AvailableSourcesProvider { return p })
+		injector.Bind(new(domain.StockProvider)).ToProvider(func(p *infrastructure.StaticSourcingProvider) domain.StockProvider { return p })
+		injector.Bind(new(domain.SourceLeadTimeProvider)).ToProvider(func(p *infrastructure.StaticSourcingProvider) domain.SourceLeadTimeProvider { return p })
 	}
 
 	if m.enableQtyRestrictor {
 		injector.Bind(new(validation.MaxQuantityRestrictor)).To(restrictors.Restrictor{})
 	}
 
+	if m.enableStockReservation {
+		injector.Bind(new(domain.StockReservationService)).To(infrastructure.InMemoryStockReservationService{}).In(dingo.Singleton)
+		injector.Bind(new(application.StockReservationApplication)).To(application.StockReservation{})
+	}
+
 	injector.Bind(new(application.SourcingApplication)).To(application.Service{})
+	injector.BindMulti(new(flamingoGraphql.Service)).To(sourcingGraphql.Service{})
 }
 
 // Depends on other modules
@@ -60,6 +97,48 @@ commerce: {
 	sourcing: {
 		useDefaultSourcingService: bool | *true
 		enableQtyRestrictor: bool | *false
+		allocation: {
+			strategy: "sourceOrder" | "minimizeSources" | "priority" | "nearestSource" | *"sourceOrder"
+			// location codes of the sources in the order they should be used by the priority strategy
+			priority: [...string] | *[]
+			// coordinates of the sources by location code used by the nearestSource strategy
+			sourceCoordinates: {
+				[string]: {
+					latitude:  number
+					longitude: number
+				}
+			}
+			// coordinates of the delivery locations used by the nearestSource strategy,
+			// by location code, country code with post code prefix ("DE-80") or country code ("DE")
+			deliveryCoordinates: {
+				[string]: {
+					latitude:  number
+					longitude: number
+				}
+			}
+		}
+		static: {
+			enabled: bool | *false
+			// json file with sources and stock, it is used instead of the configured sources and stock
+			file: string | *""
+			sources: [...{
+				locationCode: string
+				externalLocationCode: string | *""
+				deliveryWorkflows: [...string] | *[]
+				locationTypes: [...string] | *[]
+				leadTimeDays: int | *0
+			}] | *[]
+			// stock per location code and marketplace code
+			stock: {
+				[string]: {
+					[string]: int
+				}
+			}
+		}
+		stockReservation: {
+			enabled: bool | *false
+			ttlSeconds: number | *900
+		}
 	}
 }
 `
@@ -12,18 +12,24 @@ import (
 	"github.com/lunarforge/flamingo_commerce/cart/interfaces/controller/forms"
 	graphql1 "github.com/lunarforge/flamingo_commerce/cart/interfaces/graphql"
 	"github.com/lunarforge/flamingo_commerce/cart/interfaces/graphql/dto"
-	domain1 "github.com/lunarforge/flamingo_commerce/category/domain"
+	domain2 "github.com/lunarforge/flamingo_commerce/category/domain"
 	graphql7 "github.com/lunarforge/flamingo_commerce/category/interfaces/graphql"
 	"github.com/lunarforge/flamingo_commerce/category/interfaces/graphql/categorydto"
+	"github.com/lunarforge/flamingo_commerce/checkout/domain/placeorder/process"
 	graphql4 "github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql"
 	dto1 "github.com/lunarforge/flamingo_commerce/checkout/interfaces/graphql/dto"
 	graphql6 "github.com/lunarforge/flamingo_commerce/customer/interfaces/graphql"
 	"github.com/lunarforge/flamingo_commerce/customer/interfaces/graphql/dtocustomer"
+	graphql9 "github.com/lunarforge/flamingo_commerce/order/interfaces/graphql"
+	dto3 "github.com/lunarforge/flamingo_commerce/order/interfaces/graphql/dto"
+	"github.com/lunarforge/flamingo_commerce/product/domain"
 	graphql5 "github.com/lunarforge/flamingo_commerce/product/interfaces/graphql"
 	graphqlproductdto "github.com/lunarforge/flamingo_commerce/product/interfaces/graphql/product/dto"
-	"github.com/lunarforge/flamingo_commerce/search/domain"
+	domain1 "github.com/lunarforge/flamingo_commerce/search/domain"
 	graphql2 "github.com/lunarforge/flamingo_commerce/search/interfaces/graphql"
 	"github.com/lunarforge/flamingo_commerce/search/interfaces/graphql/searchdto"
+	graphql8 "github.com/lunarforge/flamingo_commerce/sourcing/interfaces/graphql"
+	dto2 "github.com/lunarforge/flamingo_commerce/sourcing/interfaces/graphql/dto"
 	graphql3 "flamingo.me/graphql"
 )
 
@@ -94,18 +100,24 @@ func (r *rootResolverCommerce_Cart) GetDeliveryByCode(ctx context.Context, obj *
 }
 
 type rootResolverCommerce_CartItem struct {
-	resolveAppliedDiscounts func(ctx context.Context, obj *cart.Item) (*dto.CartAppliedDiscounts, error)
+	resolveAppliedDiscounts    func(ctx context.Context, obj *cart.Item) (*dto.CartAppliedDiscounts, error)
+	resolveBundleConfiguration func(ctx context.Context, obj *cart.Item) ([]*domain.BundleComponentSelection, error)
 }
 
 func (r *rootResolverCommerce_CartItem) Inject(
 	commerce_CartItemAppliedDiscounts *dto.CartAppliedDiscountsResolver,
+	commerce_CartItemBundleConfiguration *graphql1.Resolver,
 ) {
 	r.resolveAppliedDiscounts = commerce_CartItemAppliedDiscounts.ForItem
+	r.resolveBundleConfiguration = commerce_CartItemBundleConfiguration.BundleConfiguration
 }
 
 func (r *rootResolverCommerce_CartItem) AppliedDiscounts(ctx context.Context, obj *cart.Item) (*dto.CartAppliedDiscounts, error) {
 	return r.resolveAppliedDiscounts(ctx, obj)
 }
+func (r *rootResolverCommerce_CartItem) BundleConfiguration(ctx context.Context, obj *cart.Item) ([]*domain.BundleComponentSelection, error) {
+	return r.resolveBundleConfiguration(ctx, obj)
+}
 
 type rootResolverCommerce_CartShippingItem struct {
 	resolveAppliedDiscounts func(ctx context.Context, obj *cart.ShippingItem) (*dto.CartAppliedDiscounts, error)
@@ -136,7 +148,7 @@ func (r *rootResolverCommerce_Cart_DefaultPaymentSelection) CartSplit(ctx contex
 }
 
 type rootResolverCommerce_Search_Meta struct {
-	resolveSortOptions func(ctx context.Context, obj *domain.SearchMeta) ([]*searchdto.CommerceSearchSortOption, error)
+	resolveSortOptions func(ctx context.Context, obj *domain1.SearchMeta) ([]*searchdto.CommerceSearchSortOption, error)
 }
 
 func (r *rootResolverCommerce_Search_Meta) Inject(
@@ -145,13 +157,13 @@ func (r *rootResolverCommerce_Search_Meta) Inject(
 	r.resolveSortOptions = commerce_Search_MetaSortOptions.SortOptions
 }
 
-func (r *rootResolverCommerce_Search_Meta) SortOptions(ctx context.Context, obj *domain.SearchMeta) ([]*searchdto.CommerceSearchSortOption, error) {
+func (r *rootResolverCommerce_Search_Meta) SortOptions(ctx context.Context, obj *domain1.SearchMeta) ([]*searchdto.CommerceSearchSortOption, error) {
 	return r.resolveSortOptions(ctx, obj)
 }
 
 type rootResolverMutation struct {
 	resolveFlamingo                                  func(ctx context.Context) (*string, error)
-	resolveCommerceAddToCart                         func(ctx context.Context, marketplaceCode string, qty int, deliveryCode string) (*dto.DecoratedCart, error)
+	resolveCommerceAddToCart                         func(ctx context.Context, marketplaceCode string, qty int, deliveryCode string, bundleConfiguration []*domain.BundleComponentSelection) (*dto.DecoratedCart, error)
 	resolveCommerceDeleteCartDelivery                func(ctx context.Context, deliveryCode string) (*dto.DecoratedCart, error)
 	resolveCommerceDeleteItem                        func(ctx context.Context, itemID string, deliveryCode string) (*dto.DecoratedCart, error)
 	resolveCommerceUpdateItemQty                     func(ctx context.Context, itemID string, deliveryCode string, qty int) (*dto.DecoratedCart, error)
@@ -163,6 +175,11 @@ type rootResolverMutation struct {
 	resolveCommerceCartUpdateDeliveryAddresses       func(ctx context.Context, deliveryAdresses []*forms.DeliveryForm) ([]*dto.DeliveryAddressForm, error)
 	resolveCommerceCartUpdateDeliveryShippingOptions func(ctx context.Context, shippingOptions []*dto.DeliveryShippingOption) ([]*dto.DeliveryAddressForm, error)
 	resolveCommerceCartClean                         func(ctx context.Context) (bool, error)
+	resolveCommerceCartCreateCustomerCart            func(ctx context.Context, name string) (*cart.Cart, error)
+	resolveCommerceCartDeleteCustomerCart            func(ctx context.Context, cartID string) (bool, error)
+	resolveCommerceCartSwitchCart                    func(ctx context.Context, cartID string) (*dto.DecoratedCart, error)
+	resolveCommerceCartMoveItem                      func(ctx context.Context, itemID string, deliveryCode string, targetCartID string) (*dto.DecoratedCart, error)
+	resolveCommerceCartAddFromOrder                  func(ctx context.Context, orderID string) (*dto.ReorderResult, error)
 	resolveCommerceCheckoutStartPlaceOrder           func(ctx context.Context, returnURL string) (*dto1.StartPlaceOrderResult, error)
 	resolveCommerceCheckoutCancelPlaceOrder          func(ctx context.Context) (bool, error)
 	resolveCommerceCheckoutClearPlaceOrder           func(ctx context.Context) (bool, error)
@@ -184,6 +201,11 @@ func (r *rootResolverMutation) Inject(
 	mutationCommerceCartUpdateDeliveryAddresses *graphql1.CommerceCartMutationResolver,
 	mutationCommerceCartUpdateDeliveryShippingOptions *graphql1.CommerceCartMutationResolver,
 	mutationCommerceCartClean *graphql1.CommerceCartMutationResolver,
+	mutationCommerceCartCreateCustomerCart *graphql1.CommerceCartMutationResolver,
+	mutationCommerceCartDeleteCustomerCart *graphql1.CommerceCartMutationResolver,
+	mutationCommerceCartSwitchCart *graphql1.CommerceCartMutationResolver,
+	mutationCommerceCartMoveItem *graphql1.CommerceCartMutationResolver,
+	mutationCommerceCartAddFromOrder *graphql1.CommerceCartMutationResolver,
 	mutationCommerceCheckoutStartPlaceOrder *graphql4.CommerceCheckoutMutationResolver,
 	mutationCommerceCheckoutCancelPlaceOrder *graphql4.CommerceCheckoutMutationResolver,
 	mutationCommerceCheckoutClearPlaceOrder *graphql4.CommerceCheckoutMutationResolver,
@@ -203,6 +225,11 @@ func (r *rootResolverMutation) Inject(
 	r.resolveCommerceCartUpdateDeliveryAddresses = mutationCommerceCartUpdateDeliveryAddresses.CommerceCartUpdateDeliveryAddresses
 	r.resolveCommerceCartUpdateDeliveryShippingOptions = mutationCommerceCartUpdateDeliveryShippingOptions.CommerceCartUpdateDeliveryShippingOptions
 	r.resolveCommerceCartClean = mutationCommerceCartClean.CartClean
+	r.resolveCommerceCartCreateCustomerCart = mutationCommerceCartCreateCustomerCart.CommerceCartCreateCustomerCart
+	r.resolveCommerceCartDeleteCustomerCart = mutationCommerceCartDeleteCustomerCart.CommerceCartDeleteCustomerCart
+	r.resolveCommerceCartSwitchCart = mutationCommerceCartSwitchCart.CommerceCartSwitchCart
+	r.resolveCommerceCartMoveItem = mutationCommerceCartMoveItem.CommerceCartMoveItem
+	r.resolveCommerceCartAddFromOrder = mutationCommerceCartAddFromOrder.CommerceCartAddFromOrder
 	r.resolveCommerceCheckoutStartPlaceOrder = mutationCommerceCheckoutStartPlaceOrder.CommerceCheckoutStartPlaceOrder
 	r.resolveCommerceCheckoutCancelPlaceOrder = mutationCommerceCheckoutCancelPlaceOrder.CommerceCheckoutCancelPlaceOrder
 	r.resolveCommerceCheckoutClearPlaceOrder = mutationCommerceCheckoutClearPlaceOrder.CommerceCheckoutClearPlaceOrder
@@ -213,8 +240,8 @@ func (r *rootResolverMutation) Inject(
 func (r *rootResolverMutation) Flamingo(ctx context.Context) (*string, error) {
 	return r.resolveFlamingo(ctx)
 }
-func (r *rootResolverMutation) CommerceAddToCart(ctx context.Context, marketplaceCode string, qty int, deliveryCode string) (*dto.DecoratedCart, error) {
-	return r.resolveCommerceAddToCart(ctx, marketplaceCode, qty, deliveryCode)
+func (r *rootResolverMutation) CommerceAddToCart(ctx context.Context, marketplaceCode string, qty int, deliveryCode string, bundleConfiguration []*domain.BundleComponentSelection) (*dto.DecoratedCart, error) {
+	return r.resolveCommerceAddToCart(ctx, marketplaceCode, qty, deliveryCode, bundleConfiguration)
 }
 func (r *rootResolverMutation) CommerceDeleteCartDelivery(ctx context.Context, deliveryCode string) (*dto.DecoratedCart, error) {
 	return r.resolveCommerceDeleteCartDelivery(ctx, deliveryCode)
@@ -249,6 +276,21 @@ func (r *rootResolverMutation) CommerceCartUpdateDeliveryShippingOptions(ctx con
 func (r *rootResolverMutation) CommerceCartClean(ctx context.Context) (bool, error) {
 	return r.resolveCommerceCartClean(ctx)
 }
+func (r *rootResolverMutation) CommerceCartCreateCustomerCart(ctx context.Context, name string) (*cart.Cart, error) {
+	return r.resolveCommerceCartCreateCustomerCart(ctx, name)
+}
+func (r *rootResolverMutation) CommerceCartDeleteCustomerCart(ctx context.Context, cartID string) (bool, error) {
+	return r.resolveCommerceCartDeleteCustomerCart(ctx, cartID)
+}
+func (r *rootResolverMutation) CommerceCartSwitchCart(ctx context.Context, cartID string) (*dto.DecoratedCart, error) {
+	return r.resolveCommerceCartSwitchCart(ctx, cartID)
+}
+func (r *rootResolverMutation) CommerceCartMoveItem(ctx context.Context, itemID string, deliveryCode string, targetCartID string) (*dto.DecoratedCart, error) {
+	return r.resolveCommerceCartMoveItem(ctx, itemID, deliveryCode, targetCartID)
+}
+func (r *rootResolverMutation) CommerceCartAddFromOrder(ctx context.Context, orderID string) (*dto.ReorderResult, error) {
+	return r.resolveCommerceCartAddFromOrder(ctx, orderID)
+}
 func (r *rootResolverMutation) CommerceCheckoutStartPlaceOrder(ctx context.Context, returnURL string) (*dto1.StartPlaceOrderResult, error) {
 	return r.resolveCommerceCheckoutStartPlaceOrder(ctx, returnURL)
 }
@@ -266,18 +308,24 @@ func (r *rootResolverMutation) CommerceCheckoutRefreshPlaceOrderBlocking(ctx con
 }
 
 type rootResolverQuery struct {
-	resolveFlamingo                         func(ctx context.Context) (*string, error)
-	resolveCommerceProduct                  func(ctx context.Context, marketPlaceCode string, variantMarketPlaceCode *string) (graphqlproductdto.Product, error)
-	resolveCommerceProductSearch            func(ctx context.Context, searchRequest searchdto.CommerceSearchRequest) (*graphql5.SearchResultDTO, error)
-	resolveCommerceCustomerStatus           func(ctx context.Context) (*dtocustomer.CustomerStatusResult, error)
-	resolveCommerceCustomer                 func(ctx context.Context) (*dtocustomer.CustomerResult, error)
-	resolveCommerceCart                     func(ctx context.Context) (*dto.DecoratedCart, error)
-	resolveCommerceCartValidator            func(ctx context.Context) (*validation.Result, error)
-	resolveCommerceCartQtyRestriction       func(ctx context.Context, marketplaceCode string, variantCode *string, deliveryCode string) (*validation.RestrictionResult, error)
-	resolveCommerceCheckoutActivePlaceOrder func(ctx context.Context) (bool, error)
-	resolveCommerceCheckoutCurrentContext   func(ctx context.Context) (*dto1.PlaceOrderContext, error)
-	resolveCommerceCategoryTree             func(ctx context.Context, activeCategoryCode string) (domain1.Tree, error)
-	resolveCommerceCategory                 func(ctx context.Context, categoryCode string, categorySearchRequest *searchdto.CommerceSearchRequest) (*categorydto.CategorySearchResult, error)
+	resolveFlamingo                          func(ctx context.Context) (*string, error)
+	resolveCommerceProduct                   func(ctx context.Context, marketPlaceCode string, variantMarketPlaceCode *string) (graphqlproductdto.Product, error)
+	resolveCommerceProductSearch             func(ctx context.Context, searchRequest searchdto.CommerceSearchRequest) (*graphql5.SearchResultDTO, error)
+	resolveCommerceCustomerStatus            func(ctx context.Context) (*dtocustomer.CustomerStatusResult, error)
+	resolveCommerceCustomer                  func(ctx context.Context) (*dtocustomer.CustomerResult, error)
+	resolveCommerceCart                      func(ctx context.Context) (*dto.DecoratedCart, error)
+	resolveCommerceCartValidator             func(ctx context.Context) (*validation.Result, error)
+	resolveCommerceCartQtyRestriction        func(ctx context.Context, marketplaceCode string, variantCode *string, deliveryCode string) (*validation.RestrictionResult, error)
+	resolveCommerceCartCustomerCarts         func(ctx context.Context) ([]*cart.Cart, error)
+	resolveCommerceGiftCardBalance           func(ctx context.Context, code string) (*cart.GiftCardBalance, error)
+	resolveCommerceCheckoutActivePlaceOrder  func(ctx context.Context) (bool, error)
+	resolveCommerceCheckoutCurrentContext    func(ctx context.Context) (*dto1.PlaceOrderContext, error)
+	resolveCommerceCheckoutPlaceOrderHistory func(ctx context.Context, uuid string) ([]*process.HistoryEntry, error)
+	resolveCommerceCategoryTree              func(ctx context.Context, activeCategoryCode string) (domain2.Tree, error)
+	resolveCommerceCategory                  func(ctx context.Context, categoryCode string, categorySearchRequest *searchdto.CommerceSearchRequest) (*categorydto.CategorySearchResult, error)
+	resolveCommerceSourcingAvailableSources  func(ctx context.Context, marketplaceCode string, variantMarketplaceCode *string, deliveryCode string, deductCart *bool) ([]*dto2.AvailableSource, error)
+	resolveCommerceCustomerOrders            func(ctx context.Context, page *int, pageSize *int, statuses []string) (*dto3.OrderPage, error)
+	resolveCommerceCustomerOrder             func(ctx context.Context, id string) (*dto3.Order, error)
 }
 
 func (r *rootResolverQuery) Inject(
@@ -289,10 +337,16 @@ func (r *rootResolverQuery) Inject(
 	queryCommerceCart *graphql1.CommerceCartQueryResolver,
 	queryCommerceCartValidator *graphql1.CommerceCartQueryResolver,
 	queryCommerceCartQtyRestriction *graphql1.CommerceCartQueryResolver,
+	queryCommerceCartCustomerCarts *graphql1.CommerceCartQueryResolver,
+	queryCommerceGiftCardBalance *graphql1.CommerceGiftCardQueryResolver,
 	queryCommerceCheckoutActivePlaceOrder *graphql4.CommerceCheckoutQueryResolver,
 	queryCommerceCheckoutCurrentContext *graphql4.CommerceCheckoutQueryResolver,
+	queryCommerceCheckoutPlaceOrderHistory *graphql4.CommerceCheckoutQueryResolver,
 	queryCommerceCategoryTree *graphql7.CommerceCategoryQueryResolver,
 	queryCommerceCategory *graphql7.CommerceCategoryQueryResolver,
+	queryCommerceSourcingAvailableSources *graphql8.SourcingResolver,
+	queryCommerceCustomerOrders *graphql9.OrderResolver,
+	queryCommerceCustomerOrder *graphql9.OrderResolver,
 ) {
 	r.resolveFlamingo = queryFlamingo.Flamingo
 	r.resolveCommerceProduct = queryCommerceProduct.CommerceProduct
@@ -302,10 +356,16 @@ func (r *rootResolverQuery) Inject(
 	r.resolveCommerceCart = queryCommerceCart.CommerceCart
 	r.resolveCommerceCartValidator = queryCommerceCartValidator.CommerceCartValidator
 	r.resolveCommerceCartQtyRestriction = queryCommerceCartQtyRestriction.CommerceCartQtyRestriction
+	r.resolveCommerceCartCustomerCarts = queryCommerceCartCustomerCarts.CommerceCartCustomerCarts
+	r.resolveCommerceGiftCardBalance = queryCommerceGiftCardBalance.CommerceGiftCardBalance
 	r.resolveCommerceCheckoutActivePlaceOrder = queryCommerceCheckoutActivePlaceOrder.CommerceCheckoutActivePlaceOrder
 	r.resolveCommerceCheckoutCurrentContext = queryCommerceCheckoutCurrentContext.CommerceCheckoutCurrentContext
+	r.resolveCommerceCheckoutPlaceOrderHistory = queryCommerceCheckoutPlaceOrderHistory.CommerceCheckoutPlaceOrderHistory
 	r.resolveCommerceCategoryTree = queryCommerceCategoryTree.CommerceCategoryTree
 	r.resolveCommerceCategory = queryCommerceCategory.CommerceCategory
+	r.resolveCommerceSourcingAvailableSources = queryCommerceSourcingAvailableSources.CommerceSourcingAvailableSources
+	r.resolveCommerceCustomerOrders = queryCommerceCustomerOrders.CommerceCustomerOrders
+	r.resolveCommerceCustomerOrder = queryCommerceCustomerOrder.CommerceCustomerOrder
 }
 
 func (r *rootResolverQuery) Flamingo(ctx context.Context) (*string, error) {
@@ -332,15 +392,33 @@ func (r *rootResolverQuery) CommerceCartValidator(ctx context.Context) (*validat
 func (r *rootResolverQuery) CommerceCartQtyRestriction(ctx context.Context, marketplaceCode string, variantCode *string, deliveryCode string) (*validation.RestrictionResult, error) {
 	return r.resolveCommerceCartQtyRestriction(ctx, marketplaceCode, variantCode, deliveryCode)
 }
+func (r *rootResolverQuery) CommerceCartCustomerCarts(ctx context.Context) ([]*cart.Cart, error) {
+	return r.resolveCommerceCartCustomerCarts(ctx)
+}
+func (r *rootResolverQuery) CommerceGiftCardBalance(ctx context.Context, code string) (*cart.GiftCardBalance, error) {
+	return r.resolveCommerceGiftCardBalance(ctx, code)
+}
 func (r *rootResolverQuery) CommerceCheckoutActivePlaceOrder(ctx context.Context) (bool, error) {
 	return r.resolveCommerceCheckoutActivePlaceOrder(ctx)
 }
 func (r *rootResolverQuery) CommerceCheckoutCurrentContext(ctx context.Context) (*dto1.PlaceOrderContext, error) {
 	return r.resolveCommerceCheckoutCurrentContext(ctx)
 }
-func (r *rootResolverQuery) CommerceCategoryTree(ctx context.Context, activeCategoryCode string) (domain1.Tree, error) {
+func (r *rootResolverQuery) CommerceCheckoutPlaceOrderHistory(ctx context.Context, uuid string) ([]*process.HistoryEntry, error) {
+	return r.resolveCommerceCheckoutPlaceOrderHistory(ctx, uuid)
+}
+func (r *rootResolverQuery) CommerceCategoryTree(ctx context.Context, activeCategoryCode string) (domain2.Tree, error) {
 	return r.resolveCommerceCategoryTree(ctx, activeCategoryCode)
 }
 func (r *rootResolverQuery) CommerceCategory(ctx context.Context, categoryCode string, categorySearchRequest *searchdto.CommerceSearchRequest) (*categorydto.CategorySearchResult, error) {
 	return r.resolveCommerceCategory(ctx, categoryCode, categorySearchRequest)
 }
+func (r *rootResolverQuery) CommerceSourcingAvailableSources(ctx context.Context, marketplaceCode string, variantMarketplaceCode *string, deliveryCode string, deductCart *bool) ([]*dto2.AvailableSource, error) {
+	return r.resolveCommerceSourcingAvailableSources(ctx, marketplaceCode, variantMarketplaceCode, deliveryCode, deductCart)
+}
+func (r *rootResolverQuery) CommerceCustomerOrders(ctx context.Context, page *int, pageSize *int, statuses []string) (*dto3.OrderPage, error) {
+	return r.resolveCommerceCustomerOrders(ctx, page, pageSize, statuses)
+}
+func (r *rootResolverQuery) CommerceCustomerOrder(ctx context.Context, id string) (*dto3.Order, error) {
+	return r.resolveCommerceCustomerOrder(ctx, id)
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/lunarforge/flamingo_commerce/price"
 	"github.com/lunarforge/flamingo_commerce/product"
 	"github.com/lunarforge/flamingo_commerce/search"
+	"github.com/lunarforge/flamingo_commerce/sourcing"
 	"github.com/lunarforge/flamingo_commerce/test/integrationtest"
 	projectTestGraphql "github.com/lunarforge/flamingo_commerce/test/integrationtest/projecttest/graphql"
 	"github.com/lunarforge/flamingo_commerce/w3cdatalayer"
@@ -63,6 +64,7 @@ func modulesDemoProject() []dingo.Module {
 		new(checkout.Module),
 		new(search.Module),
 		new(category.Module),
+		new(sourcing.Module),
 		new(requestlogger.Module),
 		new(filter.DefaultCacheStrategyModule),
 		new(auth.WebModule),
@@ -0,0 +1,44 @@
+// +build integration
+
+package graphql_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/lunarforge/flamingo_commerce/test/integrationtest"
+	"github.com/lunarforge/flamingo_commerce/test/integrationtest/projecttest/helper"
+)
+
+func Test_CommerceSourcingAvailableSources(t *testing.T) {
+	t.Parallel()
+	baseURL := "http://" + FlamingoURL
+
+	t.Run("configured stock", func(t *testing.T) {
+		e := integrationtest.NewHTTPExpect(t, baseURL)
+		response := helper.GraphQlRequest(t, e, loadGraphQL(t, "sourcing_available_sources", map[string]string{"MARKETPLACECODE": "fake_simple"})).Expect()
+		response.Status(http.StatusOK)
+
+		sources := response.JSON().Object().Value("data").Object().Value("Commerce_Sourcing_AvailableSources").Array()
+		sources.Length().Equal(2)
+
+		store := sources.Element(0).Object()
+		store.Value("source").Object().Value("locationCode").String().Equal("store")
+		store.Value("qty").Number().Equal(3)
+		store.Value("leadTimeDays").Null()
+
+		warehouse := sources.Element(1).Object()
+		warehouse.Value("source").Object().Value("locationCode").String().Equal("warehouse")
+		warehouse.Value("source").Object().Value("externalLocationCode").String().Equal("WH-1")
+		warehouse.Value("qty").Number().Equal(10)
+		warehouse.Value("leadTimeDays").Number().Equal(2)
+	})
+
+	t.Run("no stock", func(t *testing.T) {
+		e := integrationtest.NewHTTPExpect(t, baseURL)
+		response := helper.GraphQlRequest(t, e, loadGraphQL(t, "sourcing_available_sources", map[string]string{"MARKETPLACECODE": "fake_configurable"})).Expect()
+		response.Status(http.StatusOK)
+
+		response.JSON().Object().Value("data").Object().Value("Commerce_Sourcing_AvailableSources").Array().Empty()
+	})
+}
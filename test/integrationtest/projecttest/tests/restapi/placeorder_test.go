@@ -73,3 +73,32 @@ func Test_Checkout_SimplePlaceOrderProcess(t *testing.T) {
 	response.Status(200).JSON().Object().Value("FailedReason").String().Equal("")
 	response.Status(200).JSON().Object().Value("State").String().Equal(states.Success{}.Name())
 }
+
+func Test_Checkout_NotifyPlaceOrder(t *testing.T) {
+	e := integrationtest.NewHTTPExpect(t, "http://"+FlamingoURL)
+	response := e.POST("/api/v1/cart/delivery/delivery/item").WithQuery("deliveryCode", "delivery").WithQuery("marketplaceCode", "fake_simple").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+	response = e.PUT("/api/v1/cart/billing").WithFormField("firstname", "Max").WithFormField("lastname", "Mustermann").WithFormField("email", "test@test.de").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+	response = e.PUT("/api/v1/cart/delivery/delivery/").WithFormField("deliveryAddress.firstname", "Max").WithFormField("deliveryAddress.lastname", "Mustermann").WithFormField("deliveryAddress.email", "test@test.de").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+	response = e.PUT("/api/v1/cart/payment-selection").WithQuery("gateway", "fake_payment_gateway").WithQuery("method", "payment_waiting_for_customer").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+
+	response = e.PUT("/api/v1/checkout/placeorder").WithQuery("returnURL", "http://www.example.org").Expect()
+	uuid := response.Status(201).JSON().Object().Value("UUID").String().Raw()
+
+	// the gateway calls the webhook without the session of the customer
+	gateway := integrationtest.NewHTTPExpect(t, "http://"+FlamingoURL)
+	notification := gateway.POST("/api/v1/checkout/placeorder/notify/" + uuid).Expect().Status(200).JSON().Object()
+	notification.Value("UUID").String().Equal(uuid)
+	notification.Value("State").String().Equal(states.WaitForCustomer{}.Name())
+	notification.NotContainsKey("Cart")
+
+	gateway.POST("/api/v1/checkout/placeorder/notify/unknown").Expect().Status(404)
+
+	// the process of the customer is the same one
+	response = e.GET("/api/v1/checkout/placeorder").Expect()
+	response.Status(200).JSON().Object().Value("UUID").String().Equal(uuid)
+	response.Status(200).JSON().Object().Value("State").String().Equal(states.WaitForCustomer{}.Name())
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+)
+
+type (
+	// EventReceiver - handles events from other packages
+	EventReceiver struct {
+		logger          flamingo.Logger
+		wishlistService *WishlistService
+	}
+)
+
+// Inject dependencies
+func (e *EventReceiver) Inject(
+	logger flamingo.Logger,
+	wishlistService *WishlistService,
+) *EventReceiver {
+	e.logger = logger.WithField(flamingo.LogKeyModule, "wishlist").WithField(flamingo.LogKeySubCategory, "wishlist-events")
+	e.wishlistService = wishlistService
+
+	return e
+}
+
+// Notify should get called by flamingo Eventlogic
+func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
+	switch currentEvent := event.(type) {
+	// Handle WebLoginEvent and merge the guest wishlist
+	case *auth.WebLoginEvent:
+		if currentEvent == nil || currentEvent.Request == nil {
+			return
+		}
+		web.RunWithDetachedContext(ctx, func(ctx context.Context) {
+			ctx = web.ContextWithRequest(ctx, currentEvent.Request)
+			err := e.wishlistService.MergeGuestWishlist(ctx, currentEvent.Request.Session())
+			if err != nil {
+				e.logger.WithContext(ctx).Error("WebLoginEvent - guest wishlist cannot be merged: ", err)
+			}
+		})
+	}
+}
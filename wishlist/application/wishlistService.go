@@ -0,0 +1,308 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	cartApplication "github.com/lunarforge/flamingo_commerce/cart/application"
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+)
+
+type (
+	// WishlistService provides methods to get and modify the wishlist of the current customer or guest
+	WishlistService struct {
+		storage            domain.WishlistStorage
+		productService     productDomain.ProductService
+		cartService        *cartApplication.CartService
+		webIdentityService *auth.WebIdentityService
+		eventRouter        flamingo.EventRouter
+		logger             flamingo.Logger
+	}
+)
+
+const (
+	// GuestWishlistSessionKey holds the id of the wishlist of a guest
+	GuestWishlistSessionKey = "wishlist.guestid"
+)
+
+// Inject dependencies
+func (s *WishlistService) Inject(
+	storage domain.WishlistStorage,
+	productService productDomain.ProductService,
+	cartService *cartApplication.CartService,
+	webIdentityService *auth.WebIdentityService,
+	eventRouter flamingo.EventRouter,
+	logger flamingo.Logger,
+) *WishlistService {
+	s.storage = storage
+	s.productService = productService
+	s.cartService = cartService
+	s.webIdentityService = webIdentityService
+	s.eventRouter = eventRouter
+	s.logger = logger.WithField(flamingo.LogKeyModule, "wishlist").WithField(flamingo.LogKeyCategory, "application.wishlistService")
+
+	return s
+}
+
+// GetWishlist returns the wishlist of the current customer or guest, the wishlist is empty if nothing has been added yet
+func (s *WishlistService) GetWishlist(ctx context.Context, session *web.Session) (*domain.Wishlist, error) {
+	id, ok := s.wishlistID(ctx, session)
+	if !ok {
+		return &domain.Wishlist{}, nil
+	}
+
+	return s.getOrCreate(ctx, id)
+}
+
+// AddItem adds a product to the wishlist, the quantity is updated if the product is already on the wishlist
+func (s *WishlistService) AddItem(ctx context.Context, session *web.Session, marketplaceCode string, variantMarketplaceCode string, qty int) (*domain.Wishlist, error) {
+	if qty < 1 {
+		qty = 1
+	}
+
+	product, err := s.getProduct(ctx, marketplaceCode, variantMarketplaceCode)
+	if err != nil {
+		s.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "AddItem").Info(err)
+
+		return nil, err
+	}
+
+	wishlist, err := s.getOrCreate(ctx, s.ensureWishlistID(ctx, session))
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, found := wishlist.FindItem(marketplaceCode, variantMarketplaceCode); found {
+		existing.CartItem.Qty = qty
+	} else {
+		item := domain.Item{
+			CartItem: cartDomain.Item{
+				ID:                     uuid.New().String(),
+				MarketplaceCode:        marketplaceCode,
+				VariantMarketPlaceCode: variantMarketplaceCode,
+				ProductName:            product.TeaserData().ShortTitle,
+				Qty:                    qty,
+			},
+			AddedAt:    time.Now(),
+			StockLevel: product.BaseData().StockLevel,
+		}
+		if product.IsSaleable() {
+			item.PriceInfo = product.SaleableData().ActivePrice
+			item.CartItem.SinglePriceGross = item.PriceInfo.GetFinalPrice()
+		}
+		wishlist.Items = append(wishlist.Items, item)
+	}
+
+	err = s.storage.StoreWishlist(ctx, wishlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+// RemoveItem removes an item from the wishlist
+func (s *WishlistService) RemoveItem(ctx context.Context, session *web.Session, itemID string) (*domain.Wishlist, error) {
+	wishlist, err := s.GetWishlist(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = wishlist.RemoveItem(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.StoreWishlist(ctx, wishlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+// MoveToCart adds the item to the current cart and removes it from the wishlist
+func (s *WishlistService) MoveToCart(ctx context.Context, session *web.Session, itemID string, deliveryCode string) (*domain.Wishlist, error) {
+	wishlist, err := s.GetWishlist(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := wishlist.GetItem(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	addRequest := s.cartService.BuildAddRequest(ctx, item.CartItem.MarketplaceCode, item.CartItem.VariantMarketPlaceCode, item.CartItem.Qty, item.CartItem.AdditionalData)
+	_, err = s.cartService.AddProduct(ctx, session, deliveryCode, addRequest)
+	if err != nil {
+		s.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "MoveToCart").Info(err)
+
+		return nil, err
+	}
+
+	return s.RemoveItem(ctx, session, itemID)
+}
+
+// GetItemStatuses compares all items of the current wishlist with the current product data
+func (s *WishlistService) GetItemStatuses(ctx context.Context, session *web.Session) ([]domain.ItemStatus, error) {
+	wishlist, err := s.GetWishlist(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.itemStatuses(ctx, wishlist), nil
+}
+
+// RefreshWishlist detects price drops and products that are back in stock, dispatches a PriceDroppedEvent / BackInStockEvent
+// for every change and stores the current price and stock level so that every change is only reported once.
+// It can be used by a scheduled job to notify customers.
+func (s *WishlistService) RefreshWishlist(ctx context.Context, wishlistID string) ([]domain.ItemStatus, error) {
+	wishlist, err := s.storage.GetWishlist(ctx, wishlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := s.itemStatuses(ctx, wishlist)
+	for _, status := range statuses {
+		if status.PriceDropped {
+			s.eventRouter.Dispatch(ctx, &domain.PriceDroppedEvent{WishlistID: wishlist.ID, Status: status})
+		}
+		if status.BackInStock {
+			s.eventRouter.Dispatch(ctx, &domain.BackInStockEvent{WishlistID: wishlist.ID, Status: status})
+		}
+
+		item, err := wishlist.GetItem(status.Item.CartItem.ID)
+		if err != nil {
+			continue
+		}
+		item.StockLevel = status.Product.BaseData().StockLevel
+		if status.Product.IsSaleable() {
+			item.PriceInfo = status.Product.SaleableData().ActivePrice
+		}
+	}
+
+	err = s.storage.StoreWishlist(ctx, wishlist)
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// MergeGuestWishlist moves the items of the guest wishlist to the wishlist of the logged in customer
+func (s *WishlistService) MergeGuestWishlist(ctx context.Context, session *web.Session) error {
+	guestID, ok := session.Load(GuestWishlistSessionKey)
+	if !ok {
+		return nil
+	}
+
+	identity := s.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return errors.New("no customer logged in")
+	}
+
+	guestWishlist, err := s.storage.GetWishlist(ctx, guestID.(string))
+	if err == domain.ErrWishlistNotFound {
+		session.Delete(GuestWishlistSessionKey)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	customerWishlist, err := s.getOrCreate(ctx, identity.Subject())
+	if err != nil {
+		return err
+	}
+
+	for _, item := range guestWishlist.Items {
+		if _, found := customerWishlist.FindItem(item.CartItem.MarketplaceCode, item.CartItem.VariantMarketPlaceCode); found {
+			continue
+		}
+		customerWishlist.Items = append(customerWishlist.Items, item)
+	}
+
+	err = s.storage.StoreWishlist(ctx, customerWishlist)
+	if err != nil {
+		return err
+	}
+
+	session.Delete(GuestWishlistSessionKey)
+
+	return s.storage.RemoveWishlist(ctx, guestWishlist.ID)
+}
+
+func (s *WishlistService) itemStatuses(ctx context.Context, wishlist *domain.Wishlist) []domain.ItemStatus {
+	statuses := make([]domain.ItemStatus, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		product, err := s.getProduct(ctx, item.CartItem.MarketplaceCode, item.CartItem.VariantMarketPlaceCode)
+		if err != nil {
+			s.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "itemStatuses").Warn(err)
+			continue
+		}
+
+		statuses = append(statuses, domain.NewItemStatus(item, product))
+	}
+
+	return statuses
+}
+
+func (s *WishlistService) getProduct(ctx context.Context, marketplaceCode string, variantMarketplaceCode string) (productDomain.BasicProduct, error) {
+	product, err := s.productService.Get(ctx, marketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Type() != productDomain.TypeConfigurable || variantMarketplaceCode == "" {
+		return product, nil
+	}
+
+	configurableProduct, ok := product.(productDomain.ConfigurableProduct)
+	if !ok || !configurableProduct.HasVariant(variantMarketplaceCode) {
+		return nil, errors.New("product has not the given variant")
+	}
+
+	return configurableProduct.GetConfigurableWithActiveVariant(variantMarketplaceCode)
+}
+
+func (s *WishlistService) getOrCreate(ctx context.Context, id string) (*domain.Wishlist, error) {
+	wishlist, err := s.storage.GetWishlist(ctx, id)
+	if err == domain.ErrWishlistNotFound {
+		return &domain.Wishlist{ID: id}, nil
+	}
+
+	return wishlist, err
+}
+
+// wishlistID returns the id of the customer wishlist or of the guest wishlist stored in the session
+func (s *WishlistService) wishlistID(ctx context.Context, session *web.Session) (string, bool) {
+	if identity := s.webIdentityService.Identify(ctx, web.RequestFromContext(ctx)); identity != nil {
+		return identity.Subject(), true
+	}
+
+	if id, ok := session.Load(GuestWishlistSessionKey); ok {
+		return id.(string), true
+	}
+
+	return "", false
+}
+
+func (s *WishlistService) ensureWishlistID(ctx context.Context, session *web.Session) string {
+	if id, ok := s.wishlistID(ctx, session); ok {
+		return id
+	}
+
+	id := "guest-" + uuid.New().String()
+	session.Store(GuestWishlistSessionKey, id)
+
+	return id
+}
@@ -0,0 +1,232 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/application"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/infrastructure"
+)
+
+type (
+	stubProductService struct {
+		products map[string]productDomain.BasicProduct
+	}
+
+	recordingEventRouter struct {
+		events []flamingo.Event
+	}
+
+	// wishlistTestSetup holds a wishlist service, identity can be changed to simulate a login
+	wishlistTestSetup struct {
+		service  *application.WishlistService
+		storage  *infrastructure.InMemoryWishlistStorage
+		products *stubProductService
+		router   *recordingEventRouter
+		identity auth.Identity
+		session  *web.Session
+		ctx      context.Context
+	}
+)
+
+func (s *stubProductService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	product, ok := s.products[marketplaceCode]
+	if !ok {
+		return nil, productDomain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return product, nil
+}
+
+func (r *recordingEventRouter) Dispatch(_ context.Context, event flamingo.Event) {
+	r.events = append(r.events, event)
+}
+
+func simpleProduct(marketplaceCode string, price float64, stockLevel string) productDomain.SimpleProduct {
+	return productDomain.SimpleProduct{
+		Identifier: marketplaceCode,
+		BasicProductData: productDomain.BasicProductData{
+			MarketPlaceCode: marketplaceCode,
+			Title:           marketplaceCode,
+			StockLevel:      stockLevel,
+		},
+		Saleable: productDomain.Saleable{
+			IsSaleable:  true,
+			ActivePrice: productDomain.PriceInfo{Default: priceDomain.NewFromFloat(price, "EUR")},
+		},
+	}
+}
+
+func newWishlistTestSetup() *wishlistTestSetup {
+	setup := &wishlistTestSetup{
+		storage: new(infrastructure.InMemoryWishlistStorage).Inject(),
+		products: &stubProductService{products: map[string]productDomain.BasicProduct{
+			"product-a": simpleProduct("product-a", 10, productDomain.StockLevelInStock),
+			"product-b": simpleProduct("product-b", 20, productDomain.StockLevelOutOfStock),
+		}},
+		router:  new(recordingEventRouter),
+		session: web.EmptySession(),
+	}
+	setup.ctx = web.ContextWithRequest(context.Background(), web.CreateRequest(nil, setup.session))
+
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			if setup.identity == nil {
+				return nil, errors.New("not logged in")
+			}
+			return setup.identity, nil
+		},
+	)
+	webIdentityService := new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil)
+
+	setup.service = new(application.WishlistService).Inject(setup.storage, setup.products, nil, webIdentityService, setup.router, flamingo.NullLogger{})
+
+	return setup
+}
+
+func TestWishlistService_GetWishlist(t *testing.T) {
+	setup := newWishlistTestSetup()
+
+	wishlist, err := setup.service.GetWishlist(setup.ctx, setup.session)
+	require.NoError(t, err)
+	assert.Empty(t, wishlist.Items)
+
+	_, found := setup.session.Load(application.GuestWishlistSessionKey)
+	assert.False(t, found, "reading must not create a guest wishlist")
+}
+
+func TestWishlistService_AddItem(t *testing.T) {
+	t.Run("guest wishlist is created and stored in the session", func(t *testing.T) {
+		setup := newWishlistTestSetup()
+
+		wishlist, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 0)
+		require.NoError(t, err)
+		require.Len(t, wishlist.Items, 1)
+		assert.Equal(t, 1, wishlist.Items[0].CartItem.Qty)
+		assert.Equal(t, productDomain.StockLevelInStock, wishlist.Items[0].StockLevel)
+		assert.Equal(t, 10.0, wishlist.Items[0].CartItem.SinglePriceGross.FloatAmount())
+
+		guestID, found := setup.session.Load(application.GuestWishlistSessionKey)
+		require.True(t, found)
+		assert.Equal(t, wishlist.ID, guestID)
+
+		stored, err := setup.storage.GetWishlist(setup.ctx, wishlist.ID)
+		require.NoError(t, err)
+		assert.Len(t, stored.Items, 1)
+	})
+
+	t.Run("adding an existing product updates the quantity", func(t *testing.T) {
+		setup := newWishlistTestSetup()
+		setup.identity = &authMock.Identity{Sub: "customer"}
+
+		_, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 1)
+		require.NoError(t, err)
+		wishlist, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 3)
+		require.NoError(t, err)
+
+		assert.Equal(t, "customer", wishlist.ID)
+		require.Len(t, wishlist.Items, 1)
+		assert.Equal(t, 3, wishlist.Items[0].CartItem.Qty)
+	})
+
+	t.Run("unknown product", func(t *testing.T) {
+		setup := newWishlistTestSetup()
+
+		_, err := setup.service.AddItem(setup.ctx, setup.session, "unknown", "", 1)
+		assert.Error(t, err)
+
+		_, found := setup.session.Load(application.GuestWishlistSessionKey)
+		assert.False(t, found)
+	})
+}
+
+func TestWishlistService_RemoveItem(t *testing.T) {
+	setup := newWishlistTestSetup()
+
+	wishlist, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 1)
+	require.NoError(t, err)
+
+	_, err = setup.service.RemoveItem(setup.ctx, setup.session, "unknown")
+	assert.Equal(t, domain.ErrItemNotFound, err)
+
+	wishlist, err = setup.service.RemoveItem(setup.ctx, setup.session, wishlist.Items[0].CartItem.ID)
+	require.NoError(t, err)
+	assert.Empty(t, wishlist.Items)
+
+	stored, err := setup.storage.GetWishlist(setup.ctx, wishlist.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.Items)
+}
+
+func TestWishlistService_RefreshWishlist(t *testing.T) {
+	setup := newWishlistTestSetup()
+
+	wishlist, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 1)
+	require.NoError(t, err)
+	wishlist, err = setup.service.AddItem(setup.ctx, setup.session, "product-b", "", 1)
+	require.NoError(t, err)
+
+	setup.products.products["product-a"] = simpleProduct("product-a", 8, productDomain.StockLevelInStock)
+	setup.products.products["product-b"] = simpleProduct("product-b", 20, productDomain.StockLevelLowStock)
+
+	statuses, err := setup.service.RefreshWishlist(setup.ctx, wishlist.ID)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	require.Len(t, setup.router.events, 2)
+	priceDropped, ok := setup.router.events[0].(*domain.PriceDroppedEvent)
+	require.True(t, ok)
+	assert.Equal(t, wishlist.ID, priceDropped.WishlistID)
+	assert.Equal(t, "product-a", priceDropped.Status.Item.CartItem.MarketplaceCode)
+	backInStock, ok := setup.router.events[1].(*domain.BackInStockEvent)
+	require.True(t, ok)
+	assert.Equal(t, "product-b", backInStock.Status.Item.CartItem.MarketplaceCode)
+
+	// changes are only reported once
+	_, err = setup.service.RefreshWishlist(setup.ctx, wishlist.ID)
+	require.NoError(t, err)
+	assert.Len(t, setup.router.events, 2)
+}
+
+func TestWishlistService_MergeGuestWishlist(t *testing.T) {
+	setup := newWishlistTestSetup()
+
+	guestWishlist, err := setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 1)
+	require.NoError(t, err)
+	_, err = setup.service.AddItem(setup.ctx, setup.session, "product-b", "", 2)
+	require.NoError(t, err)
+
+	err = setup.service.MergeGuestWishlist(setup.ctx, setup.session)
+	assert.Error(t, err, "merging without a logged in customer must fail")
+
+	setup.identity = &authMock.Identity{Sub: "customer"}
+	_, err = setup.service.AddItem(setup.ctx, setup.session, "product-a", "", 5)
+	require.NoError(t, err)
+
+	err = setup.service.MergeGuestWishlist(setup.ctx, setup.session)
+	require.NoError(t, err)
+
+	customerWishlist, err := setup.service.GetWishlist(setup.ctx, setup.session)
+	require.NoError(t, err)
+	assert.Equal(t, "customer", customerWishlist.ID)
+	require.Len(t, customerWishlist.Items, 2)
+	item, found := customerWishlist.FindItem("product-a", "")
+	require.True(t, found)
+	assert.Equal(t, 5, item.CartItem.Qty, "items of the customer wishlist are kept")
+
+	_, found = setup.session.Load(application.GuestWishlistSessionKey)
+	assert.False(t, found)
+	_, err = setup.storage.GetWishlist(setup.ctx, guestWishlist.ID)
+	assert.Equal(t, domain.ErrWishlistNotFound, err)
+}
@@ -0,0 +1,15 @@
+package domain
+
+type (
+	// PriceDroppedEvent is dispatched if the price of a product on a wishlist dropped since it has been added or last checked
+	PriceDroppedEvent struct {
+		WishlistID string
+		Status     ItemStatus
+	}
+
+	// BackInStockEvent is dispatched if a product on a wishlist is back in stock
+	BackInStockEvent struct {
+		WishlistID string
+		Status     ItemStatus
+	}
+)
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+)
+
+type (
+	// Wishlist holds the products a customer (or guest) wants to remember
+	Wishlist struct {
+		// ID is the main identifier of the wishlist, e.g. the customer id
+		ID    string
+		Items []Item
+	}
+
+	// Item on a wishlist
+	Item struct {
+		// CartItem holds the product reference and quantity using the cart item model,
+		// which allows moving the item to the cart without mapping
+		CartItem cartDomain.Item
+		AddedAt  time.Time
+		// PriceInfo is the active price of the product when it was added or last checked
+		PriceInfo productDomain.PriceInfo
+		// StockLevel of the product when it was added or last checked
+		StockLevel string
+	}
+
+	// ItemStatus is the result of comparing a wishlist item with the current product data
+	ItemStatus struct {
+		Item          Item
+		Product       productDomain.BasicProduct
+		PreviousPrice priceDomain.Price
+		CurrentPrice  priceDomain.Price
+		PriceDropped  bool
+		BackInStock   bool
+	}
+
+	// WishlistStorage interface - Secondary PORT to persist wishlists
+	WishlistStorage interface {
+		// GetWishlist returns the wishlist with the given id or ErrWishlistNotFound
+		GetWishlist(ctx context.Context, id string) (*Wishlist, error)
+		StoreWishlist(ctx context.Context, wishlist *Wishlist) error
+		RemoveWishlist(ctx context.Context, id string) error
+	}
+)
+
+var (
+	// ErrWishlistNotFound is used if a wishlist was not found
+	ErrWishlistNotFound = errors.New("wishlist not found")
+	// ErrItemNotFound is used if an item was not found on the wishlist
+	ErrItemNotFound = errors.New("wishlist item not found")
+)
+
+// GetItem returns the wishlist item with the given id
+func (w *Wishlist) GetItem(itemID string) (*Item, error) {
+	for i := range w.Items {
+		if w.Items[i].CartItem.ID == itemID {
+			return &w.Items[i], nil
+		}
+	}
+
+	return nil, ErrItemNotFound
+}
+
+// FindItem returns the wishlist item for the given product
+func (w *Wishlist) FindItem(marketplaceCode string, variantMarketplaceCode string) (*Item, bool) {
+	for i := range w.Items {
+		if w.Items[i].CartItem.MarketplaceCode == marketplaceCode && w.Items[i].CartItem.VariantMarketPlaceCode == variantMarketplaceCode {
+			return &w.Items[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// RemoveItem removes the item with the given id from the wishlist
+func (w *Wishlist) RemoveItem(itemID string) error {
+	for i := range w.Items {
+		if w.Items[i].CartItem.ID == itemID {
+			w.Items = append(w.Items[:i], w.Items[i+1:]...)
+			return nil
+		}
+	}
+
+	return ErrItemNotFound
+}
+
+// ItemCount returns the number of items on the wishlist
+func (w *Wishlist) ItemCount() int {
+	return len(w.Items)
+}
+
+// IsEmpty checks if there are items on the wishlist
+func (w *Wishlist) IsEmpty() bool {
+	return len(w.Items) == 0
+}
+
+// NewItemStatus compares the stored price and stock level of the item with the given current product
+func NewItemStatus(item Item, product productDomain.BasicProduct) ItemStatus {
+	status := ItemStatus{
+		Item:          item,
+		Product:       product,
+		PreviousPrice: item.PriceInfo.GetFinalPrice(),
+	}
+
+	if product.IsSaleable() {
+		status.CurrentPrice = product.SaleableData().ActivePrice.GetFinalPrice()
+		status.PriceDropped = status.CurrentPrice.IsLessThen(status.PreviousPrice)
+	}
+
+	wasInStock := productDomain.BasicProductData{StockLevel: item.StockLevel}.IsInStock()
+	status.BackInStock = !wasInStock && product.BaseData().IsInStock()
+
+	return status
+}
+
+// HasChanged returns true if the price dropped or the product is back in stock
+func (s ItemStatus) HasChanged() bool {
+	return s.PriceDropped || s.BackInStock
+}
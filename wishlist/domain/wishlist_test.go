@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cartDomain "github.com/lunarforge/flamingo_commerce/cart/domain/cart"
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDomain "github.com/lunarforge/flamingo_commerce/product/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+)
+
+func simpleProduct(price float64, stockLevel string) productDomain.SimpleProduct {
+	return productDomain.SimpleProduct{
+		Identifier: "product",
+		BasicProductData: productDomain.BasicProductData{
+			MarketPlaceCode: "product",
+			StockLevel:      stockLevel,
+		},
+		Saleable: productDomain.Saleable{
+			IsSaleable:  true,
+			ActivePrice: productDomain.PriceInfo{Default: priceDomain.NewFromFloat(price, "EUR")},
+		},
+	}
+}
+
+func TestWishlist_RemoveItem(t *testing.T) {
+	wishlist := &domain.Wishlist{
+		ID: "wishlist",
+		Items: []domain.Item{
+			{CartItem: cartDomain.Item{ID: "a", MarketplaceCode: "product-a"}},
+			{CartItem: cartDomain.Item{ID: "b", MarketplaceCode: "product-b"}},
+		},
+	}
+
+	assert.NoError(t, wishlist.RemoveItem("a"))
+	assert.Equal(t, 1, wishlist.ItemCount())
+
+	_, found := wishlist.FindItem("product-a", "")
+	assert.False(t, found)
+
+	assert.Equal(t, domain.ErrItemNotFound, wishlist.RemoveItem("a"))
+}
+
+func TestNewItemStatus(t *testing.T) {
+	item := domain.Item{
+		CartItem:   cartDomain.Item{ID: "a", MarketplaceCode: "product"},
+		PriceInfo:  productDomain.PriceInfo{Default: priceDomain.NewFromFloat(20, "EUR")},
+		StockLevel: productDomain.StockLevelOutOfStock,
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		status := domain.NewItemStatus(item, simpleProduct(20, productDomain.StockLevelOutOfStock))
+		assert.False(t, status.PriceDropped)
+		assert.False(t, status.BackInStock)
+		assert.False(t, status.HasChanged())
+	})
+
+	t.Run("price dropped", func(t *testing.T) {
+		status := domain.NewItemStatus(item, simpleProduct(15, productDomain.StockLevelOutOfStock))
+		assert.True(t, status.PriceDropped)
+		assert.False(t, status.BackInStock)
+		assert.Equal(t, priceDomain.NewFromFloat(15, "EUR"), status.CurrentPrice)
+		assert.Equal(t, priceDomain.NewFromFloat(20, "EUR"), status.PreviousPrice)
+	})
+
+	t.Run("back in stock", func(t *testing.T) {
+		status := domain.NewItemStatus(item, simpleProduct(25, productDomain.StockLevelInStock))
+		assert.False(t, status.PriceDropped)
+		assert.True(t, status.BackInStock)
+		assert.True(t, status.HasChanged())
+	})
+}
@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+)
+
+type (
+	// InMemoryWishlistStorage is the default WishlistStorage, wishlists are lost on restart
+	InMemoryWishlistStorage struct {
+		wishlists map[string]domain.Wishlist
+		locker    sync.Locker
+	}
+)
+
+var _ domain.WishlistStorage = &InMemoryWishlistStorage{}
+
+// Inject dependencies and prepare storage
+// Important: InMemoryWishlistStorage MUST be bound as singleton, Inject MUST be called in tests to behave as expected
+func (s *InMemoryWishlistStorage) Inject() *InMemoryWishlistStorage {
+	s.locker = &sync.Mutex{}
+	s.wishlists = make(map[string]domain.Wishlist)
+
+	return s
+}
+
+// GetWishlist returns the wishlist with the given id
+func (s *InMemoryWishlistStorage) GetWishlist(_ context.Context, id string) (*domain.Wishlist, error) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	wishlist, ok := s.wishlists[id]
+	if !ok {
+		return nil, domain.ErrWishlistNotFound
+	}
+	wishlist.Items = append([]domain.Item(nil), wishlist.Items...)
+
+	return &wishlist, nil
+}
+
+// StoreWishlist stores the wishlist
+func (s *InMemoryWishlistStorage) StoreWishlist(_ context.Context, wishlist *domain.Wishlist) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	toStore := *wishlist
+	toStore.Items = append([]domain.Item(nil), wishlist.Items...)
+	s.wishlists[wishlist.ID] = toStore
+
+	return nil
+}
+
+// RemoveWishlist removes the wishlist from the storage
+func (s *InMemoryWishlistStorage) RemoveWishlist(_ context.Context, id string) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+
+	delete(s.wishlists, id)
+
+	return nil
+}
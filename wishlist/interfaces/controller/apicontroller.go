@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/application"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+)
+
+type (
+	// APIController for the wishlist rest api
+	APIController struct {
+		responder       *web.Responder
+		wishlistService *application.WishlistService
+		logger          flamingo.Logger
+	}
+
+	// APIResult view data
+	APIResult struct {
+		// Contains details if success is false
+		Error    *resultError
+		Success  bool
+		Wishlist *domain.Wishlist
+		// Changes contains the items with a dropped price or that are back in stock
+		Changes []itemChange
+	} // @name wishlistAPIResult
+
+	itemChange struct {
+		ItemID        string
+		PriceDropped  bool
+		BackInStock   bool
+		PreviousPrice priceDomain.Price
+		CurrentPrice  priceDomain.Price
+	} // @name wishlistItemChange
+
+	resultError struct {
+		Message string
+		Code    string
+	} // @name wishlistResultError
+)
+
+// Inject dependencies
+func (c *APIController) Inject(
+	responder *web.Responder,
+	wishlistService *application.WishlistService,
+	logger flamingo.Logger,
+) *APIController {
+	c.responder = responder
+	c.wishlistService = wishlistService
+	c.logger = logger.WithField(flamingo.LogKeyModule, "wishlist").WithField(flamingo.LogKeyCategory, "apicontroller")
+
+	return c
+}
+
+// GetAction returns the wishlist of the current customer or guest
+// @Summary Get the current wishlist
+// @Description Changes contains the items whose price dropped or that are back in stock since they have been added
+// @Tags Wishlist
+// @Produce json
+// @Success 200 {object} APIResult
+// @Failure 500 {object} APIResult
+// @Router /api/v1/wishlist [get]
+func (c *APIController) GetAction(ctx context.Context, r *web.Request) web.Result {
+	wishlist, err := c.wishlistService.GetWishlist(ctx, r.Session())
+	if err != nil {
+		return c.errorResponse(ctx, err, "get_error")
+	}
+
+	statuses, err := c.wishlistService.GetItemStatuses(ctx, r.Session())
+	if err != nil {
+		return c.errorResponse(ctx, err, "get_error")
+	}
+
+	result := APIResult{Success: true, Wishlist: wishlist}
+	for _, status := range statuses {
+		if !status.HasChanged() {
+			continue
+		}
+		result.Changes = append(result.Changes, itemChange{
+			ItemID:        status.Item.CartItem.ID,
+			PriceDropped:  status.PriceDropped,
+			BackInStock:   status.BackInStock,
+			PreviousPrice: status.PreviousPrice,
+			CurrentPrice:  status.CurrentPrice,
+		})
+	}
+
+	return c.responder.Data(result)
+}
+
+// AddItemAction adds a product to the wishlist
+// @Summary Add a product to the wishlist
+// @Tags Wishlist
+// @Produce json
+// @Success 200 {object} APIResult
+// @Failure 500 {object} APIResult
+// @Param marketplaceCode query string true "the product identifier that should be added"
+// @Param variantMarketplaceCode query string false "optional the product identifier of the variant (for configurable products) that should be added"
+// @Param qty query integer false "optional the qty that should be added"
+// @Router /api/v1/wishlist/item [post]
+func (c *APIController) AddItemAction(ctx context.Context, r *web.Request) web.Result {
+	marketplaceCode, _ := r.Params["marketplaceCode"]
+	variantMarketplaceCode, _ := r.Params["variantMarketplaceCode"]
+	qty, err := strconv.Atoi(r.Params["qty"])
+	if err != nil {
+		qty = 1
+	}
+
+	wishlist, err := c.wishlistService.AddItem(ctx, r.Session(), marketplaceCode, variantMarketplaceCode, qty)
+	if err != nil {
+		return c.errorResponse(ctx, err, "add_item_error")
+	}
+
+	return c.responder.Data(APIResult{Success: true, Wishlist: wishlist})
+}
+
+// RemoveItemAction removes an item from the wishlist
+// @Summary Remove an item from the wishlist
+// @Tags Wishlist
+// @Produce json
+// @Success 200 {object} APIResult
+// @Failure 500 {object} APIResult
+// @Param itemID query string true "the item that should be removed"
+// @Router /api/v1/wishlist/item [delete]
+func (c *APIController) RemoveItemAction(ctx context.Context, r *web.Request) web.Result {
+	itemID, _ := r.Params["itemID"]
+
+	wishlist, err := c.wishlistService.RemoveItem(ctx, r.Session(), itemID)
+	if err != nil {
+		return c.errorResponse(ctx, err, "remove_item_error")
+	}
+
+	return c.responder.Data(APIResult{Success: true, Wishlist: wishlist})
+}
+
+// MoveToCartAction adds an item to the cart and removes it from the wishlist
+// @Summary Move an item from the wishlist to the cart
+// @Tags Wishlist
+// @Produce json
+// @Success 200 {object} APIResult
+// @Failure 500 {object} APIResult
+// @Param itemID query string true "the item that should be moved"
+// @Param deliveryCode query string false "optional the identifier for the delivery in the cart"
+// @Router /api/v1/wishlist/item/move-to-cart [put]
+func (c *APIController) MoveToCartAction(ctx context.Context, r *web.Request) web.Result {
+	itemID, _ := r.Params["itemID"]
+	deliveryCode, _ := r.Params["deliveryCode"]
+
+	wishlist, err := c.wishlistService.MoveToCart(ctx, r.Session(), itemID, deliveryCode)
+	if err != nil {
+		return c.errorResponse(ctx, err, "move_to_cart_error")
+	}
+
+	return c.responder.Data(APIResult{Success: true, Wishlist: wishlist})
+}
+
+func (c *APIController) errorResponse(ctx context.Context, err error, code string) web.Result {
+	c.logger.WithContext(ctx).Error("wishlist.apicontroller: ", err)
+
+	response := c.responder.Data(APIResult{
+		Success: false,
+		Error: &resultError{
+			Message: err.Error(),
+			Code:    code,
+		},
+	})
+	response.Status(http.StatusInternalServerError)
+
+	return response
+}
@@ -0,0 +1,33 @@
+package dtowishlist
+
+import (
+	priceDomain "github.com/lunarforge/flamingo_commerce/price/domain"
+	productDto "github.com/lunarforge/flamingo_commerce/product/interfaces/graphql/product/dto"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+)
+
+type (
+	// ItemStatus is the graphql representation of a compared wishlist item
+	ItemStatus struct {
+		Item          domain.Item
+		Product       productDto.Product
+		PreviousPrice priceDomain.Price
+		CurrentPrice  priceDomain.Price
+		PriceDropped  bool
+		BackInStock   bool
+	}
+)
+
+// NewItemStatus maps the domain item status to the graphql dto
+func NewItemStatus(status domain.ItemStatus) ItemStatus {
+	variantMarketplaceCode := status.Item.CartItem.VariantMarketPlaceCode
+
+	return ItemStatus{
+		Item:          status.Item,
+		Product:       productDto.NewGraphqlProductDto(status.Product, &variantMarketplaceCode),
+		PreviousPrice: status.PreviousPrice,
+		CurrentPrice:  status.CurrentPrice,
+		PriceDropped:  status.PriceDropped,
+		BackInStock:   status.BackInStock,
+	}
+}
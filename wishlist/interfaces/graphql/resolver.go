@@ -0,0 +1,77 @@
+package graphql
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"github.com/lunarforge/flamingo_commerce/wishlist/application"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/interfaces/graphql/dtowishlist"
+)
+
+type (
+	// WishlistResolver graphql resolver for the wishlist queries and mutations
+	WishlistResolver struct {
+		wishlistService *application.WishlistService
+	}
+)
+
+// Inject dependencies
+func (r *WishlistResolver) Inject(
+	wishlistService *application.WishlistService,
+) *WishlistResolver {
+	r.wishlistService = wishlistService
+
+	return r
+}
+
+// CommerceWishlist resolves the wishlist of the current customer or guest
+func (r *WishlistResolver) CommerceWishlist(ctx context.Context) (*domain.Wishlist, error) {
+	return r.wishlistService.GetWishlist(ctx, web.SessionFromContext(ctx))
+}
+
+// CommerceWishlistItemStatuses compares the wishlist items with the current product data
+func (r *WishlistResolver) CommerceWishlistItemStatuses(ctx context.Context) ([]dtowishlist.ItemStatus, error) {
+	statuses, err := r.wishlistService.GetItemStatuses(ctx, web.SessionFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtowishlist.ItemStatus, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, dtowishlist.NewItemStatus(status))
+	}
+
+	return result, nil
+}
+
+// CommerceWishlistAddItem adds a product to the wishlist
+func (r *WishlistResolver) CommerceWishlistAddItem(ctx context.Context, marketplaceCode string, variantMarketplaceCode *string, qty *int) (*domain.Wishlist, error) {
+	variant := ""
+	if variantMarketplaceCode != nil {
+		variant = *variantMarketplaceCode
+	}
+
+	quantity := 1
+	if qty != nil {
+		quantity = *qty
+	}
+
+	return r.wishlistService.AddItem(ctx, web.SessionFromContext(ctx), marketplaceCode, variant, quantity)
+}
+
+// CommerceWishlistRemoveItem removes an item from the wishlist
+func (r *WishlistResolver) CommerceWishlistRemoveItem(ctx context.Context, itemID string) (*domain.Wishlist, error) {
+	return r.wishlistService.RemoveItem(ctx, web.SessionFromContext(ctx), itemID)
+}
+
+// CommerceWishlistMoveToCart moves an item from the wishlist to the current cart
+func (r *WishlistResolver) CommerceWishlistMoveToCart(ctx context.Context, itemID string, deliveryCode *string) (*domain.Wishlist, error) {
+	code := ""
+	if deliveryCode != nil {
+		code = *deliveryCode
+	}
+
+	return r.wishlistService.MoveToCart(ctx, web.SessionFromContext(ctx), itemID, code)
+}
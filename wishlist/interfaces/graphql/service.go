@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"flamingo.me/graphql"
+
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/interfaces/graphql/dtowishlist"
+)
+
+//go:generate go run github.com/go-bindata/go-bindata/v3/go-bindata -nometadata -o fs.go -pkg graphql schema.graphql
+
+// Service is the Graphql-Service of this module
+type Service struct{}
+
+var _ graphql.Service = new(Service)
+
+// Schema returns graphql schema of this module
+func (*Service) Schema() []byte {
+	return MustAsset("schema.graphql")
+}
+
+// Types configures the GraphQL to Go resolvers
+func (*Service) Types(types *graphql.Types) {
+	types.Map("Commerce_Wishlist", domain.Wishlist{})
+	types.Map("Commerce_Wishlist_Item", domain.Item{})
+	types.Map("Commerce_Wishlist_ItemStatus", dtowishlist.ItemStatus{})
+	types.Resolve("Query", "Commerce_Wishlist", WishlistResolver{}, "CommerceWishlist")
+	types.Resolve("Query", "Commerce_Wishlist_ItemStatuses", WishlistResolver{}, "CommerceWishlistItemStatuses")
+	types.Resolve("Mutation", "Commerce_Wishlist_AddItem", WishlistResolver{}, "CommerceWishlistAddItem")
+	types.Resolve("Mutation", "Commerce_Wishlist_RemoveItem", WishlistResolver{}, "CommerceWishlistRemoveItem")
+	types.Resolve("Mutation", "Commerce_Wishlist_MoveToCart", WishlistResolver{}, "CommerceWishlistMoveToCart")
+}
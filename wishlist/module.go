@@ -0,0 +1,88 @@
+package wishlist
+
+import (
+	"flamingo.me/dingo"
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	flamingographql "flamingo.me/graphql"
+
+	"github.com/lunarforge/flamingo_commerce/cart"
+	"github.com/lunarforge/flamingo_commerce/product"
+	"github.com/lunarforge/flamingo_commerce/wishlist/application"
+	"github.com/lunarforge/flamingo_commerce/wishlist/domain"
+	"github.com/lunarforge/flamingo_commerce/wishlist/infrastructure"
+	"github.com/lunarforge/flamingo_commerce/wishlist/interfaces/controller"
+	"github.com/lunarforge/flamingo_commerce/wishlist/interfaces/graphql"
+)
+
+type (
+	// Module registers the wishlist module
+	Module struct {
+		useInMemoryStorage bool
+	}
+)
+
+// Inject dependencies
+func (m *Module) Inject(
+	config *struct {
+		UseInMemoryStorage bool `inject:"config:commerce.wishlist.useInMemoryStorage,optional"`
+	},
+) {
+	if config != nil {
+		m.useInMemoryStorage = config.UseInMemoryStorage
+	}
+}
+
+// Configure module
+func (m *Module) Configure(injector *dingo.Injector) {
+	if m.useInMemoryStorage {
+		injector.Bind((*domain.WishlistStorage)(nil)).To(infrastructure.InMemoryWishlistStorage{}).In(dingo.Singleton)
+	}
+
+	flamingo.BindEventSubscriber(injector).To(application.EventReceiver{})
+	injector.BindMulti(new(flamingographql.Service)).To(graphql.Service{})
+	web.BindRoutes(injector, new(routes))
+}
+
+// CueConfig defines the wishlist module configuration
+func (*Module) CueConfig() string {
+	return `
+commerce: {
+	wishlist: {
+		useInMemoryStorage: bool | *true
+	}
+}
+`
+}
+
+// Depends on other modules
+func (m *Module) Depends() []dingo.Module {
+	return []dingo.Module{
+		new(cart.Module),
+		new(product.Module),
+		new(auth.WebModule),
+	}
+}
+
+type routes struct {
+	apiController *controller.APIController
+}
+
+// Inject dependencies
+func (r *routes) Inject(apiController *controller.APIController) {
+	r.apiController = apiController
+}
+
+// Routes defines the wishlist api routes
+func (r *routes) Routes(registry *web.RouterRegistry) {
+	registry.MustRoute("/api/v1/wishlist", "wishlist.api.wishlist")
+	registry.HandleGet("wishlist.api.wishlist", r.apiController.GetAction)
+
+	registry.MustRoute("/api/v1/wishlist/item", `wishlist.api.item(marketplaceCode?="",variantMarketplaceCode?="",qty?="1",itemID?="")`)
+	registry.HandlePost("wishlist.api.item", r.apiController.AddItemAction)
+	registry.HandleDelete("wishlist.api.item", r.apiController.RemoveItemAction)
+
+	registry.MustRoute("/api/v1/wishlist/item/move-to-cart", `wishlist.api.item.move-to-cart(itemID,deliveryCode?="")`)
+	registry.HandlePut("wishlist.api.item.move-to-cart", r.apiController.MoveToCartAction)
+}
@@ -0,0 +1,17 @@
+package wishlist_test
+
+import (
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"github.com/lunarforge/flamingo_commerce/wishlist"
+)
+
+func TestModule_Configure(t *testing.T) {
+	if err := config.TryModules(config.Map{
+		"core.auth.web.debugController": false,
+	}, new(wishlist.Module)); err != nil {
+		t.Error(err)
+	}
+}